@@ -0,0 +1,210 @@
+package sentences
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+Abbreviation classes refine what an abbreviation says about the sentence
+break after it.  They are flags, an abbreviation can be in more than one
+class, and only abbreviations can have them.
+*/
+const (
+	// AbbrevTitle precedes a name, e.g. dr. or prof., and does not end a sentence before a capitalized word
+	AbbrevTitle = 1 << iota
+	// AbbrevInternal is only used inside a sentence, e.g. e.g. or cf., and never ends one
+	AbbrevInternal
+	// AbbrevTerminal often ends a sentence, e.g. etc. or inc.
+	AbbrevTerminal
+	// AbbrevUnit is a measurement unit, e.g. ft. or oz., that is only an abbreviation after a number
+	AbbrevUnit
+)
+
+// all of the abbreviation class flags
+const abbrevClassAll = AbbrevTitle | AbbrevInternal | AbbrevTerminal | AbbrevUnit
+
+// abbrevClassNames names every abbreviation class, in the order of their bits
+var abbrevClassNames = []struct {
+	flag int
+	name string
+}{
+	{AbbrevTitle, "title"},
+	{AbbrevInternal, "internal"},
+	{AbbrevTerminal, "terminal"},
+	{AbbrevUnit, "unit"},
+}
+
+// AbbrevClassNames decodes a set of abbreviation classes into their names, e.g. title
+func AbbrevClassNames(classes int) []string {
+	names := make([]string, 0, len(abbrevClassNames))
+	for _, class := range abbrevClassNames {
+		if classes&class.flag != 0 {
+			names = append(names, class.name)
+		}
+	}
+
+	return names
+}
+
+// AbbrevClassFlag returns the flag for a name returned by AbbrevClassNames, or 0 if it is unknown
+func AbbrevClassFlag(name string) int {
+	for _, class := range abbrevClassNames {
+		if class.name == name {
+			return class.flag
+		}
+	}
+
+	return 0
+}
+
+// ParseAbbrevClasses reads a set of abbreviation classes, either a number or names joined by "|"
+func ParseAbbrevClasses(classes string) (int, error) {
+	if n, err := strconv.Atoi(classes); err == nil {
+		if n <= 0 || n&^abbrevClassAll != 0 {
+			return 0, fmt.Errorf("abbreviation classes %d are not within %d", n, abbrevClassAll)
+		}
+		return n, nil
+	}
+
+	n := 0
+	for _, name := range strings.Split(classes, "|") {
+		flag := AbbrevClassFlag(strings.ToLower(strings.TrimSpace(name)))
+		if flag == 0 {
+			return 0, fmt.Errorf("unknown abbreviation class %q", name)
+		}
+		n |= flag
+	}
+
+	return n, nil
+}
+
+/*
+AbbrevClassAnnotation revisits the abbreviations the earlier passes found with
+their classes, if the training data has any:
+  - an internal abbreviation is never a sentence break.
+  - a title is not a sentence break before a capitalized word.
+  - a unit is not an abbreviation unless it follows a number, after one it
+    is a sentence break before a paragraph and before a capitalized word,
+    unless that word is only seen capitalized inside sentences, like a name,
+    and is not a frequent sentence starter.  It is not a break before a lower
+    case word.
+  - a terminal abbreviation is a sentence break before a paragraph and
+    before a capitalized word, unless that word is known to be capitalized
+    inside sentences and is not a frequent sentence starter.
+
+Abbreviations without a class keep the decision of the token-based pass.
+*/
+type AbbrevClassAnnotation struct {
+	*Storage
+	TokenParser
+	Ortho
+}
+
+// WithStorage returns a copy of the annotation that reads from s
+func (a *AbbrevClassAnnotation) WithStorage(s *Storage) AnnotateTokens {
+	ann := *a
+	ann.Storage = s
+	if ortho, ok := a.Ortho.(StorageOrtho); ok {
+		ann.Ortho = ortho.WithStorage(s)
+	}
+	return &ann
+}
+
+// Annotate applies the classes of the abbreviations to their sentence breaks
+func (a *AbbrevClassAnnotation) Annotate(tokens []*Token) []*Token {
+	for i, token := range tokens {
+		if !token.Abbr {
+			continue
+		}
+
+		classes := a.AbbrevClass(a.TokenParser.TypeNoPeriod(token))
+		if classes == 0 {
+			continue
+		}
+
+		var prev, next *Token
+		if i > 0 {
+			prev = tokens[i-1]
+		}
+		if i+1 < len(tokens) {
+			next = tokens[i+1]
+		}
+
+		a.classAnnotation(classes, prev, token, next)
+	}
+
+	return tokens
+}
+
+func (a *AbbrevClassAnnotation) classAnnotation(classes int, prev, token, next *Token) {
+	if classes&AbbrevInternal != 0 {
+		token.SentBreak = false
+		return
+	}
+
+	if next == nil {
+		return
+	}
+
+	capitalized := a.TokenParser.FirstUpper(next)
+	nextTyp := a.TokenParser.TypeNoSentPeriod(next)
+	starter := capitalized && (a.IsSentStarter(nextTyp) || a.Ortho.Heuristic(next) == 1)
+
+	if classes&AbbrevTitle != 0 && capitalized {
+		token.SentBreak = false
+		return
+	}
+
+	if classes&AbbrevUnit != 0 {
+		if prev == nil || a.TokenParser.TypeNoPeriod(prev) != "##number##" {
+			// without a number the word ends a sentence if the next token starts one, e.g. "Put it in. Then"
+			if next.ParaStart || capitalized {
+				token.Abbr = false
+				token.SentBreak = true
+				return
+			}
+
+			// otherwise it is an abbreviation like any other, e.g. "a few km. away" or "the min. value"
+			switch a.Ortho.Heuristic(next) {
+			case 1:
+				token.SentBreak = true
+			case 0:
+				token.SentBreak = false
+			}
+			return
+		}
+
+		if next.ParaStart {
+			token.SentBreak = true
+		} else if a.TokenParser.FirstLower(next) {
+			token.SentBreak = false
+		} else if capitalized {
+			flags := a.OrthoFlags(nextTyp)
+			name := flags&orthoMidUc != 0 && flags&orthoLc == 0
+			token.SentBreak = starter || !name
+		}
+		return
+	}
+
+	if classes&AbbrevTerminal != 0 {
+		if next.ParaStart {
+			token.SentBreak = true
+		} else if capitalized {
+			token.SentBreak = starter || a.OrthoFlags(nextTyp)&orthoMidUc == 0
+		}
+	}
+}
+
+// AbbrevClass returns the classes of an abbreviation, 0 if it has none
+func (p *Storage) AbbrevClass(typ string) int {
+	classes := p.AbbrevClasses[typ] | p.tables.abbrevClass(typ)
+
+	for _, layer := range p.layers {
+		change := layer.AbbrevClasses[typ]
+		classes = (classes | change.Add) &^ change.Remove
+	}
+
+	return classes
+}
@@ -0,0 +1,193 @@
+package sentences
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const classOverlay = `capt
+etc
+cf
+in
+km
+min
+
+[AbbrevClasses]
+capt title
+etc terminal
+cf internal
+in unit
+km unit
+min unit
+`
+
+func TestAbbrevClasses(t *testing.T) {
+	t.Log("Abbreviation classes should decide the sentence breaks after abbreviations")
+
+	overlay, err := LoadOverlay(strings.NewReader(classOverlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unclassified := NewOverlay()
+	for typ := range overlay.AbbrevClasses {
+		unclassified.AbbrevClasses[typ] = OrthoChange{Remove: abbrevClassAll}
+	}
+
+	tokenizer := loadTokenizer("data/english.json")
+	classified := tokenizer.WithOverlays(overlay)
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{
+			"We asked Capt. Page about the ship.",
+			[]string{"We asked Capt. Page about the ship."},
+		},
+		{
+			"We sold apples, pears, etc. Zebras were harder to sell.",
+			[]string{"We sold apples, pears, etc.", "Zebras were harder to sell."},
+		},
+		{
+			"The results differ, cf. Section 3 for details.",
+			[]string{"The results differ, cf. Section 3 for details."},
+		},
+		{
+			"The board is 6 in. wide. Put it in. Then close the lid.",
+			[]string{"The board is 6 in. wide.", "Put it in.", "Then close the lid."},
+		},
+		{
+			"The board is 6 in. After that it narrows.",
+			[]string{"The board is 6 in.", "After that it narrows."},
+		},
+	}
+
+	for _, test := range tests {
+		actual := sentenceTexts(classified, test.text)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+
+		// without their classes the abbreviations get every one of these wrong
+		if reflect.DeepEqual(sentenceTexts(tokenizer.WithOverlays(overlay, unclassified), test.text), test.expected) {
+			t.Fatalf("The classes made no difference for %q", test.text)
+		}
+	}
+
+	t.Log("A unit without a number should only end a sentence before the start of one")
+
+	for _, test := range []struct {
+		text     string
+		expected []string
+	}{
+		{
+			"We walked a few km. away from the road.",
+			[]string{"We walked a few km. away from the road."},
+		},
+		{
+			"Take the min. value of the range.",
+			[]string{"Take the min. value of the range."},
+		},
+		{
+			"We drove for km. The road was empty.",
+			[]string{"We drove for km.", "The road was empty."},
+		},
+		{
+			"We drove for km.\n\nthe road was empty.",
+			[]string{"We drove for km.", "the road was empty."},
+		},
+	} {
+		actual := sentenceTexts(classified, test.text)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+	}
+}
+
+func TestAbbrevClassesStorage(t *testing.T) {
+	t.Log("Abbreviation classes should survive the binary format and only belong to abbreviations")
+
+	storage := loadStorage("english")
+	sum := storage.Checksum()
+
+	b, err := storage.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b[len(binaryMagic):len(binaryMagic)+4], []byte{1, 0, 0, 0}) {
+		t.Fatalf("A model without classes should be written as version 1")
+	}
+
+	storage.AbbrevTypes.Add("etc")
+	storage.AbbrevClasses = SetString{"dr": AbbrevTitle, "etc": AbbrevTerminal}
+	if storage.Checksum() == sum {
+		t.Fatalf("Classes should be part of the checksum")
+	}
+
+	b, err = storage.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := LoadBinary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if binary.AbbrevClass("dr") != AbbrevTitle || binary.AbbrevClass("etc") != AbbrevTerminal || binary.AbbrevClass("mr") != 0 {
+		t.Fatalf("Actual: dr=%d etc=%d mr=%d, Expected: dr=%d etc=%d mr=0",
+			binary.AbbrevClass("dr"), binary.AbbrevClass("etc"), binary.AbbrevClass("mr"), AbbrevTitle, AbbrevTerminal)
+	}
+
+	if !reflect.DeepEqual(binary.Expand().AbbrevClasses, storage.AbbrevClasses) || binary.Checksum() != storage.Checksum() {
+		t.Fatalf("Actual: %v, Expected: %v", binary.Expand().AbbrevClasses, storage.AbbrevClasses)
+	}
+
+	storage.AbbrevClasses["walrus"] = AbbrevUnit
+	if err := storage.Validate(); err == nil || !strings.Contains(err.Error(), `"walrus"`) {
+		t.Fatalf("Expected an error for a class of a word that is not an abbreviation, got: %v", err)
+	}
+	if _, err := storage.MarshalBinary(); err == nil {
+		t.Fatalf("Expected an error writing a class of a word that is not an abbreviation")
+	}
+}
+
+func TestAbbrevClassesOverlay(t *testing.T) {
+	t.Log("Overlays should set and clear abbreviation classes")
+
+	overlay, err := LoadOverlay(strings.NewReader("[AbbrevClasses]\ndr title|terminal\n-etc\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := NewStorage()
+	base.AbbrevTypes.Add("dr")
+	base.AbbrevTypes.Add("etc")
+	base.AbbrevClasses = SetString{"etc": AbbrevTerminal}
+
+	storage := base.WithOverlays(overlay)
+	if storage.AbbrevClass("dr") != AbbrevTitle|AbbrevTerminal || storage.AbbrevClass("etc") != 0 {
+		t.Fatalf("Actual: dr=%v etc=%v, Expected: dr=[title terminal] etc=[]",
+			AbbrevClassNames(storage.AbbrevClass("dr")), AbbrevClassNames(storage.AbbrevClass("etc")))
+	}
+
+	var out bytes.Buffer
+	if _, err := overlay.WriteTo(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[AbbrevClasses]\ndr title|terminal\n-etc title|internal|terminal|unit\n\n"
+	if out.String() != expected {
+		t.Fatalf("Actual: %q, Expected: %q", out.String(), expected)
+	}
+
+	if _, err := LoadOverlay(strings.NewReader("[AbbrevClasses]\ndr\n")); err == nil {
+		t.Fatalf("Expected an error for an abbreviation without classes")
+	}
+	if _, err := LoadOverlay(strings.NewReader("[AbbrevClasses]\ndr honorific\n")); err == nil {
+		t.Fatalf("Expected an error for an unknown class")
+	}
+}
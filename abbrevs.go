@@ -0,0 +1,220 @@
+package sentences
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/neurosnap/sentences/utils"
+)
+
+// typeCounts are the counts punkt's trainer scores abbreviations with
+type typeCounts struct {
+	types        *utils.FreqDist
+	periodTokens int
+}
+
+func newTypeCounts() *typeCounts {
+	return &typeCounts{types: utils.NewFreqDist(nil)}
+}
+
+// add counts a token under its document type
+func (c *typeCounts) add(parser WordTokenizer, tok *Token) {
+	typ := documentType(parser, tok)
+	c.types.Inc(typ)
+	if strings.HasSuffix(typ, ".") || parser.HasPeriodFinal(tok) {
+		c.periodTokens++
+	}
+}
+
+/*
+abbrevTypes are the types seen with a final period, without it, that are not
+abbreviations of the storage yet.  Numbers and types without a letter are
+left out like NLTK's trainer does.
+*/
+func (c *typeCounts) abbrevTypes(storage *Storage, parser WordTokenizer) []string {
+	types := []string{}
+
+	for typ := range c.types.Samples {
+		if !strings.HasSuffix(typ, ".") || typ == "##number##." {
+			continue
+		}
+
+		typ = strings.TrimSuffix(typ, ".")
+		if typ == "" || storage.IsAbbr(typ) || !parser.IsNonPunct(NewToken(typ)) {
+			continue
+		}
+		types = append(types, typ)
+	}
+
+	sort.Strings(types)
+	return types
+}
+
+/*
+abbrevScore is the log-likelihood that typ is followed by a period and the
+score punkt's trainer gives it as an abbreviation: the log-likelihood
+penalized for the length of the type and for every time it was seen without
+a period.  Periods inside the type, e.g. "e.g", count towards an abbreviation.
+*/
+func (c *typeCounts) abbrevScore(typ string, total float64) (float64, float64) {
+	periods := strings.Count(typ, ".") + 1
+	nonPeriods := utf8.RuneCountInString(typ) - periods + 1
+
+	withPeriod := c.types.Samples[typ+"."]
+	withoutPeriod := c.types.Samples[typ]
+
+	ll := utils.DunningLogLikelihood(
+		float64(withPeriod+withoutPeriod),
+		float64(c.periodTokens),
+		float64(withPeriod),
+		total,
+	)
+
+	score := ll * math.Exp(-float64(nonPeriods)) * float64(periods) * math.Pow(float64(nonPeriods), -float64(withoutPeriod))
+	return ll, score
+}
+
+/*
+documentType is the type of a token without the brackets, quotes and commas
+around it, which NLTK's word tokenizer splits off, so that "(e.g." and "e.g."
+are counted together.  Quotes are left out whichever way they face, e.g. the
+»…« and „…“ of German.
+*/
+func documentType(parser WordTokenizer, tok *Token) string {
+	typ := strings.TrimLeftFunc(parser.Type(tok), func(r rune) bool {
+		return unicode.In(r, unicode.Ps, unicode.Pi, unicode.Pf, unicode.White_Space) || strings.ContainsRune(`"'¿¡`, r)
+	})
+	return strings.TrimRightFunc(typ, func(r rune) bool {
+		return unicode.In(r, unicode.Pe, unicode.Pi, unicode.Pf, unicode.White_Space) || strings.ContainsRune(`,;:"'`, r)
+	})
+}
+
+// AbbrevCandidate is a type ending in a period that might be an abbreviation
+type AbbrevCandidate struct {
+	Type          string  `json:"type"`
+	Count         int     `json:"count"`
+	WithPeriod    int     `json:"with_period"`
+	WithoutPeriod int     `json:"without_period"`
+	LogLikelihood float64 `json:"log_likelihood"`
+	// Score is the punkt score, NLTK's trainer takes types scoring 0.3 or more
+	Score float64 `json:"score"`
+	// Contexts are examples of the type with its period, keyword in context
+	Contexts []string `json:"contexts"`
+}
+
+/*
+AbbrevFinder collects the types of a corpus that end in a period and are not
+abbreviations of the training data yet, and scores them the way punkt's
+trainer does.  Documents are added one at a time so that a corpus does not
+have to fit into memory.
+*/
+type AbbrevFinder struct {
+	*Storage
+	WordTokenizer
+	// Contexts is the number of examples kept for every candidate
+	Contexts int
+	// Width is the number of characters of text shown on each side of an example, words cut by it are left out
+	Width int
+
+	counts   *typeCounts
+	contexts map[string][]string
+}
+
+// NewAbbrevFinder creates a finder that keeps three examples for every candidate
+func NewAbbrevFinder(s *Storage, word WordTokenizer) *AbbrevFinder {
+	return &AbbrevFinder{
+		Storage:       s,
+		WordTokenizer: word,
+		Contexts:      3,
+		Width:         30,
+		counts:        newTypeCounts(),
+		contexts:      map[string][]string{},
+	}
+}
+
+// Add counts the types of a document
+func (f *AbbrevFinder) Add(text string) {
+	for _, tok := range f.WordTokenizer.Tokenize(text, false) {
+		f.counts.add(f.WordTokenizer, tok)
+
+		typ := documentType(f.WordTokenizer, tok)
+		if !strings.HasSuffix(typ, ".") {
+			continue
+		}
+
+		typ = strings.TrimSuffix(typ, ".")
+		if len(f.contexts[typ]) < f.Contexts {
+			f.contexts[typ] = append(f.contexts[typ], kwic(text, tok, f.Width))
+		}
+	}
+}
+
+// Candidates are the scored types, best first
+func (f *AbbrevFinder) Candidates() []*AbbrevCandidate {
+	total := f.counts.types.N()
+	candidates := []*AbbrevCandidate{}
+
+	for _, typ := range f.counts.abbrevTypes(f.Storage, f.WordTokenizer) {
+		ll, score := f.counts.abbrevScore(typ, total)
+
+		candidate := &AbbrevCandidate{
+			Type:          typ,
+			WithPeriod:    f.counts.types.Samples[typ+"."],
+			WithoutPeriod: f.counts.types.Samples[typ],
+			LogLikelihood: ll,
+			Score:         score,
+			Contexts:      f.contexts[typ],
+		}
+		candidate.Count = candidate.WithPeriod + candidate.WithoutPeriod
+		candidates = append(candidates, candidate)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	return candidates
+}
+
+/*
+kwic shows a token in brackets with the text around it on one line, up to
+width characters on each side without the words cut by them.
+*/
+func kwic(text string, tok *Token, width int) string {
+	start, end := tok.Start, tok.Position
+
+	left := start
+	for i := 0; i < width && left > 0; i++ {
+		_, size := utf8.DecodeLastRuneInString(text[:left])
+		left -= size
+	}
+	if left > 0 {
+		if prev, _ := utf8.DecodeLastRuneInString(text[:left]); !unicode.IsSpace(prev) {
+			if space := strings.IndexFunc(text[left:start], unicode.IsSpace); space >= 0 {
+				left += space
+			} else {
+				left = start
+			}
+		}
+	}
+
+	right := end
+	for i := 0; i < width && right < len(text); i++ {
+		_, size := utf8.DecodeRuneInString(text[right:])
+		right += size
+	}
+	if right < len(text) {
+		if next, _ := utf8.DecodeRuneInString(text[right:]); !unicode.IsSpace(next) {
+			right = end + strings.LastIndexFunc(text[end:right], unicode.IsSpace) + 1
+		}
+	}
+
+	before := strings.Join(strings.Fields(text[left:start]), " ")
+	word := strings.Join(strings.Fields(text[start:end]), " ")
+	after := strings.Join(strings.Fields(text[end:right]), " ")
+
+	return strings.TrimSpace(before + " [" + word + "] " + after)
+}
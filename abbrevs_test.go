@@ -0,0 +1,93 @@
+package sentences
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAbbrevFinder(t *testing.T) {
+	t.Log("AbbrevFinder should score the period-final types missing from the training data")
+
+	storage := loadStorage("english")
+	finder := NewAbbrevFinder(storage, NewWordTokenizer(NewPunctStrings()))
+	finder.Contexts = 2
+	finder.Width = 12
+	finder.Add(adaptiveText)
+
+	candidates := finder.Candidates()
+	if len(candidates) < 2 || candidates[0].Type != "eq" || candidates[1].Type != "fig" {
+		t.Fatalf("Expected: eq and fig first, the shorter type scores higher")
+	}
+
+	fig := candidates[1]
+	if fig.Count != 4 || fig.WithPeriod != 4 || fig.WithoutPeriod != 0 {
+		t.Fatalf("Actual: %d %d %d, Expected: 4 4 0", fig.Count, fig.WithPeriod, fig.WithoutPeriod)
+	}
+
+	if fig.Score < 0.3 || fig.LogLikelihood <= fig.Score {
+		t.Fatalf("Actual: score %f, log-likelihood %f", fig.Score, fig.LogLikelihood)
+	}
+
+	expected := []string{"is shown in [Fig.] 3 and the", "valve in [Fig.] 4 of the"}
+	if len(fig.Contexts) != len(expected) {
+		t.Fatalf("Actual: %q, Expected: %q", fig.Contexts, expected)
+	}
+	for index, context := range fig.Contexts {
+		if context != expected[index] {
+			t.Fatalf("Actual: %q, Expected: %q", context, expected[index])
+		}
+	}
+
+	for _, candidate := range candidates {
+		if storage.IsAbbr(candidate.Type) || candidate.Type == "##number##" {
+			t.Fatalf("%q should not be a candidate", candidate.Type)
+		}
+		if candidate.Score > candidates[0].Score {
+			t.Fatalf("Candidates should be sorted by score")
+		}
+	}
+}
+
+func TestDocumentType(t *testing.T) {
+	t.Log("Document types should leave out the quotes and brackets around a token")
+
+	word := NewWordTokenizer(NewPunctStrings())
+	for _, test := range []struct {
+		tok, expected string
+	}{
+		{"(e.g.", "e.g."},
+		{"«chap.", "chap."},
+		{"‹chap.›", "chap."},
+		{"„Nr.", "nr."},
+		{"‚Nr.‘", "nr."},
+		{"»Nr.«", "nr."},
+		{"[Fig.],", "fig."},
+	} {
+		if typ := documentType(word, NewToken(test.tok)); typ != test.expected {
+			t.Fatalf("Actual: %q, Expected: %q", typ, test.expected)
+		}
+	}
+}
+
+func TestKWIC(t *testing.T) {
+	t.Log("Contexts should count characters and leave out the words cut at their edges")
+
+	text := "Über Straßen läuft die Maus, Abb. 2 zeigt ihren Weg durch den Wald."
+	start := strings.Index(text, "Abb.")
+	tok := &Token{Tok: "Abb.", Start: start, Position: start + len("Abb.")}
+
+	for _, test := range []struct {
+		width    int
+		expected string
+	}{
+		{9, "Maus, [Abb.] 2 zeigt"},
+		{10, "die Maus, [Abb.] 2 zeigt"},
+		{24, "Straßen läuft die Maus, [Abb.] 2 zeigt ihren Weg durch"},
+		{100, "Über Straßen läuft die Maus, [Abb.] 2 zeigt ihren Weg durch den Wald."},
+		{1, "[Abb.]"},
+	} {
+		if context := kwic(text, tok, test.width); context != test.expected {
+			t.Fatalf("%d: Actual: %q, Expected: %q", test.width, context, test.expected)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package sentences
+
+import "github.com/neurosnap/sentences/utils"
+
+/*
+Adaptive configures the two pass mode of DefaultSentenceTokenizer.  Punkt was
+designed to collect its type-based statistics from the text it segments, the
+shipped models freeze them for a general corpus.  In the first pass the
+tokenizer scores the abbreviations, orthographic contexts and sentence
+starters of the document itself, the second pass annotates with them added to
+the base Storage through an overlay.  Base entries are never removed.
+
+Every weight scales the document's evidence before it is compared to its
+threshold, 0 ignores the document for that part of the model.
+*/
+type Adaptive struct {
+	// AbbrevWeight scales the abbreviation score of a type ending in a period
+	AbbrevWeight float64
+	// AbbrevThreshold is the score a type needs to become an abbreviation
+	AbbrevThreshold float64
+	// AbbrevMinCount is how often a type has to end in a period, a short
+	// word at the end of a single sentence scores like an abbreviation
+	AbbrevMinCount int
+	// StarterWeight scales the log-likelihood of a type after sentence breaks
+	StarterWeight float64
+	// StarterThreshold is the log-likelihood a type needs to become a sentence starter
+	StarterThreshold float64
+	// OrthoWeight scales how often a type was seen in an orthographic context,
+	// a context is added once the weighted count reaches 1
+	OrthoWeight float64
+}
+
+// NewAdaptive uses the thresholds of NLTK's punkt trainer and trusts the document fully
+func NewAdaptive() *Adaptive {
+	return &Adaptive{
+		AbbrevWeight:     1,
+		AbbrevThreshold:  0.3,
+		AbbrevMinCount:   2,
+		StarterWeight:    1,
+		StarterThreshold: 30,
+		OrthoWeight:      1,
+	}
+}
+
+// documentStats are the counts punkt's trainer collects, for a single text
+type documentStats struct {
+	*typeCounts
+	sentBreaks    int
+	starters      *utils.FreqDist
+	orthoContexts map[string]map[int]int
+}
+
+/*
+Overlay runs the first pass over text and returns what the document adds to
+the tokenizer's training data.
+*/
+func (a *Adaptive) Overlay(s *DefaultSentenceTokenizer, text string) *Overlay {
+	return a.overlay(s, s.WordTokenizer.Tokenize(text, false))
+}
+
+// overlay runs the first pass over the word tokens of a document, it annotates them
+func (a *Adaptive) overlay(s *DefaultSentenceTokenizer, tokens []*Token) *Overlay {
+	overlay := NewOverlay()
+
+	if len(tokens) == 0 {
+		return overlay
+	}
+
+	stats := &documentStats{
+		typeCounts:    newTypeCounts(),
+		starters:      utils.NewFreqDist(nil),
+		orthoContexts: map[string]map[int]int{},
+	}
+
+	for _, tok := range tokens {
+		stats.add(s.WordTokenizer, tok)
+	}
+
+	if a.AbbrevWeight > 0 {
+		a.addAbbrevs(s, stats, overlay)
+	}
+
+	// sentence starters and orthographic contexts depend on the breaks the
+	// abbreviations of the document already fixed
+	storage := s.Storage.WithOverlays(overlay)
+	NewTypeBasedAnnotation(storage, s.PunctStrings, s.WordTokenizer).Annotate(tokens)
+	a.collect(s, stats, tokens)
+
+	if a.StarterWeight > 0 {
+		a.addStarters(s, stats, overlay)
+	}
+
+	if a.OrthoWeight > 0 {
+		for typ, flags := range stats.orthoContexts {
+			add := 0
+			for flag, count := range flags {
+				if float64(count)*a.OrthoWeight >= 1 {
+					add |= flag
+				}
+			}
+
+			if add&^s.Storage.OrthoFlags(typ) != 0 {
+				overlay.OrthoContext[typ] = OrthoChange{Add: add}
+			}
+		}
+	}
+
+	return overlay
+}
+
+// addAbbrevs scores every type ending in a period the way punkt's trainer reclassifies them
+func (a *Adaptive) addAbbrevs(s *DefaultSentenceTokenizer, stats *documentStats, overlay *Overlay) {
+	total := stats.types.N()
+
+	for _, typ := range stats.abbrevTypes(s.Storage, s.WordTokenizer) {
+		if stats.types.Samples[typ+"."] < a.AbbrevMinCount {
+			continue
+		}
+
+		if _, score := stats.abbrevScore(typ, total); score*a.AbbrevWeight >= a.AbbrevThreshold {
+			overlay.AbbrevTypes.Add(typ)
+		}
+	}
+}
+
+// collect walks the annotated tokens of the first pass for sentence starters and orthographic contexts
+func (a *Adaptive) collect(s *DefaultSentenceTokenizer, stats *documentStats, tokens []*Token) {
+	parser := s.WordTokenizer
+	context := "internal"
+
+	for i, tok := range tokens {
+		if tok.ParaStart && context != "unknown" {
+			context = "initial"
+		}
+		if tok.LineStart && context == "internal" {
+			context = "unknown"
+		}
+
+		firstCase := "none"
+		if parser.FirstUpper(tok) {
+			firstCase = "upper"
+		} else if parser.FirstLower(tok) {
+			firstCase = "lower"
+		}
+
+		if flag := orthoMap[[2]string{context, firstCase}]; flag != 0 {
+			typ := parser.TypeNoSentPeriod(tok)
+			if stats.orthoContexts[typ] == nil {
+				stats.orthoContexts[typ] = map[int]int{}
+			}
+			stats.orthoContexts[typ][flag]++
+		}
+
+		if tok.SentBreak {
+			stats.sentBreaks++
+		}
+
+		switch {
+		case tok.SentBreak && !(parser.IsNumber(tok) || parser.IsInitial(tok)):
+			context = "initial"
+		case tok.SentBreak, tok.Abbr, parser.IsEllipsis(tok):
+			context = "unknown"
+		default:
+			context = "internal"
+		}
+
+		if i == len(tokens)-1 || !parser.HasPeriodFinal(tok) {
+			continue
+		}
+
+		next := tokens[i+1]
+		if tok.SentBreak && !(parser.IsNumber(tok) || parser.IsInitial(tok)) && parser.IsAlpha(next) {
+			stats.starters.Inc(parser.Type(next))
+		}
+	}
+}
+
+// addStarters finds the types that follow sentence breaks much more often than chance
+func (a *Adaptive) addStarters(s *DefaultSentenceTokenizer, stats *documentStats, overlay *Overlay) {
+	if stats.sentBreaks == 0 {
+		return
+	}
+	total := stats.types.N()
+
+	for typ, atBreak := range stats.starters.Samples {
+		if s.Storage.IsSentStarter(typ) {
+			continue
+		}
+
+		count := stats.types.Samples[typ] + stats.types.Samples[typ+"."]
+		if count < atBreak {
+			continue
+		}
+
+		ll := utils.ColLogLikelihood(float64(stats.sentBreaks), float64(count), float64(atBreak), total)
+		if ll*a.StarterWeight >= a.StarterThreshold && total/float64(stats.sentBreaks) > float64(count)/float64(atBreak) {
+			overlay.SentStarters.Add(typ)
+		}
+	}
+}
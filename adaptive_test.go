@@ -0,0 +1,75 @@
+package sentences
+
+import (
+	"strings"
+	"testing"
+)
+
+const adaptiveText = "The pump is shown in Fig. 3 and the valve in Fig. 4 of the manual. Its flow follows Eq. 2 closely. The pressure drop in Fig. 5 matches Eq. 7 as well. See Fig. 6 for the housing."
+
+func TestAdaptive(t *testing.T) {
+	t.Log("Adaptive mode should learn the abbreviations a document uses")
+
+	tokenizer := loadTokenizer("data/english.json")
+	if len(tokenizer.Tokenize(adaptiveText)) != 10 {
+		t.Fatalf("Fig. and Eq. should not be abbreviations in the english model")
+	}
+
+	tokenizer.Adaptive = NewAdaptive()
+	actual := tokenizer.Tokenize(adaptiveText)
+
+	expected := []string{
+		"The pump is shown in Fig. 3 and the valve in Fig. 4 of the manual.",
+		"Its flow follows Eq. 2 closely.",
+		"The pressure drop in Fig. 5 matches Eq. 7 as well.",
+		"See Fig. 6 for the housing.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if strings.TrimSpace(sent.Text) != expected[index] {
+			t.Fatalf("Actual: %q, Expected: %q", sent.Text, expected[index])
+		}
+	}
+
+	if tokenizer.IsAbbr("fig") {
+		t.Fatalf("The base training data should not be modified")
+	}
+
+	overlay := tokenizer.Adaptive.Overlay(tokenizer, adaptiveText)
+	if !overlay.AbbrevTypes["fig"] || !overlay.AbbrevTypes["eq"] {
+		t.Fatalf("Actual: %v, Expected: fig and eq", overlay.AbbrevTypes)
+	}
+
+	t.Log("A weight of 0 should ignore the document's abbreviations")
+
+	tokenizer.Adaptive.AbbrevWeight = 0
+	if len(tokenizer.Tokenize(adaptiveText)) != 10 {
+		t.Fatalf("Actual: %d, Expected: 10", len(tokenizer.Tokenize(adaptiveText)))
+	}
+}
+
+func TestAdaptiveOrtho(t *testing.T) {
+	t.Log("Adaptive mode should collect the orthographic contexts of the document")
+
+	tokenizer := loadTokenizer("data/english.json")
+	tokenizer.Adaptive = NewAdaptive()
+
+	overlay := tokenizer.Adaptive.Overlay(tokenizer, "The flowmeter reads high. Flowmeter readings drift.")
+
+	expected := orthoMidLc | orthoBegUc
+	if actual := overlay.OrthoContext["flowmeter"].Add; actual != expected {
+		t.Fatalf("Actual: %v, Expected: %v", DescribeOrtho(actual), DescribeOrtho(expected))
+	}
+
+	t.Log("Contexts seen less often than the weight requires should be left out")
+
+	tokenizer.Adaptive.OrthoWeight = 0.5
+	overlay = tokenizer.Adaptive.Overlay(tokenizer, "The flowmeter reads high. Flowmeter readings drift.")
+	if _, ok := overlay.OrthoContext["flowmeter"]; ok {
+		t.Fatalf("Actual: %v, Expected: no contexts", DescribeOrtho(overlay.OrthoContext["flowmeter"].Add))
+	}
+}
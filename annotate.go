@@ -12,6 +12,16 @@ type AnnotateTokens interface {
 	Annotate([]*Token) []*Token
 }
 
+/*
+StorageAnnotation is implemented by annotations that read training data, it
+lets a tokenizer run them against a view of its Storage with overlays applied
+without changing the annotation it was built with.
+*/
+type StorageAnnotation interface {
+	AnnotateTokens
+	WithStorage(*Storage) AnnotateTokens
+}
+
 /*
 TypeBasedAnnotation performs the first pass of annotation, which makes decisions
 based purely based on the word type of each word:
@@ -42,14 +52,22 @@ func NewTypeBasedAnnotation(s *Storage, p PunctStrings, e TokenExistential) *Typ
 
 // NewAnnotations is the default AnnotateTokens struct  that the tokenizer uses
 func NewAnnotations(s *Storage, p PunctStrings, word WordTokenizer) []AnnotateTokens {
+	ortho := &OrthoContext{s, p, word, word}
+
 	return []AnnotateTokens{
 		&TypeBasedAnnotation{s, p, word},
-		&TokenBasedAnnotation{s, p, word, &DefaultTokenGrouper{}, &OrthoContext{
-			s, p, word, word,
-		}},
+		&TokenBasedAnnotation{s, p, word, &DefaultTokenGrouper{}, ortho, nil},
+		&AbbrevClassAnnotation{s, word, ortho},
 	}
 }
 
+// WithStorage returns a copy of the annotation that reads from s
+func (a *TypeBasedAnnotation) WithStorage(s *Storage) AnnotateTokens {
+	ann := *a
+	ann.Storage = s
+	return &ann
+}
+
 // Annotate iterates over all tokens and applies the type annotation on them
 func (a *TypeBasedAnnotation) Annotate(tokens []*Token) []*Token {
 	for _, augTok := range tokens {
@@ -64,7 +82,7 @@ func (a *TypeBasedAnnotation) typeAnnotation(token *Token) {
 	if a.HasSentEndChars(token) {
 		token.SentBreak = true
 	} else if a.HasPeriodFinal(token) && !strings.HasSuffix(token.Tok, "..") {
-		tokNoPeriod := strings.ToLower(token.Tok[:len(chars)-1])
+		tokNoPeriod := strings.ToLower(string(chars[:len(chars)-1]))
 		tokNoPeriodHypen := strings.Split(tokNoPeriod, "-")
 		tokLastHyphEl := string(tokNoPeriodHypen[len(tokNoPeriodHypen)-1])
 
@@ -79,7 +97,9 @@ func (a *TypeBasedAnnotation) typeAnnotation(token *Token) {
 /*
 TokenBasedAnnotation performs a token-based classification (section 4) over the given
 tokens, making use of the orthographic heuristic (4.1.1), collocation
-heuristic (4.1.2) and frequent sentence starter heuristic (4.1.3).
+heuristic (4.1.2) and frequent sentence starter heuristic (4.1.3).  The tokens
+are visited in the pairs of the TokenGrouper, or in the windows of the
+WindowGrouper when there is one.
 */
 type TokenBasedAnnotation struct {
 	*Storage
@@ -87,18 +107,35 @@ type TokenBasedAnnotation struct {
 	TokenParser
 	TokenGrouper
 	Ortho
+	WindowGrouper WindowGrouper
+}
+
+// WithStorage returns a copy of the annotation that reads from s
+func (a *TokenBasedAnnotation) WithStorage(s *Storage) AnnotateTokens {
+	ann := *a
+	ann.Storage = s
+	if ortho, ok := a.Ortho.(StorageOrtho); ok {
+		ann.Ortho = ortho.WithStorage(s)
+	}
+	return &ann
 }
 
-// Annotate iterates groups tokens in pairs of two and then iterates over them to apply token annotation
+// Annotate groups the tokens in windows and then iterates over them to apply token annotation
 func (a *TokenBasedAnnotation) Annotate(tokens []*Token) []*Token {
-	for _, tokPair := range a.TokenGrouper.Group(tokens) {
-		a.tokenAnnotation(tokPair[0], tokPair[1])
+	grouper := a.WindowGrouper
+	if grouper == nil {
+		grouper = &PairWindowGrouper{a.TokenGrouper}
+	}
+
+	for _, window := range grouper.Windows(tokens) {
+		a.tokenAnnotation(window)
 	}
 
 	return tokens
 }
 
-func (a *TokenBasedAnnotation) tokenAnnotation(tokOne, tokTwo *Token) {
+func (a *TokenBasedAnnotation) tokenAnnotation(window TokenWindow) {
+	tokOne, tokTwo := window.Token, window.Next(1)
 	if tokTwo == nil {
 		return
 	}
@@ -119,8 +156,7 @@ func (a *TokenBasedAnnotation) tokenAnnotation(tokOne, tokTwo *Token) {
 	   frequent sentence starters as their second word are
 	   excluded in training.
 	*/
-	collocation := strings.Join([]string{typ, nextTyp}, ",")
-	if a.Collocations[collocation] != 0 {
+	if a.IsCollocation(typ, nextTyp) {
 		tokOne.SentBreak = false
 		tokOne.Abbr = true
 		return
@@ -149,7 +185,7 @@ func (a *TokenBasedAnnotation) tokenAnnotation(tokOne, tokTwo *Token) {
 			frequent-sentence-starters list, then label tok as a
 			sentence break.
 		*/
-		if a.TokenParser.FirstUpper(tokTwo) && a.SentStarters[nextTyp] != 0 {
+		if a.TokenParser.FirstUpper(tokTwo) && a.IsSentStarter(nextTyp) {
 			tokOne.SentBreak = true
 			return
 		}
@@ -188,7 +224,7 @@ func (a *TokenBasedAnnotation) tokenAnnotation(tokOne, tokTwo *Token) {
 		if isSentStarter == -1 &&
 			tokIsInitial &&
 			a.TokenParser.FirstUpper(tokTwo) &&
-			a.OrthoContext[nextTyp]&orthoLc == 0 {
+			a.OrthoFlags(nextTyp)&orthoLc == 0 {
 
 			tokOne.SentBreak = false
 			tokOne.Abbr = true
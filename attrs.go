@@ -0,0 +1,142 @@
+package sentences
+
+import "fmt"
+
+/*
+Attrs are the attributes annotations record on a token beyond its fixed
+flags, e.g. "url", "list-marker", "quote-depth" = 2 or "lang" = "de".  Values
+are booleans, numbers or strings so they can be written as JSON.
+*/
+type Attrs map[string]interface{}
+
+// SetAttr records an attribute of the token
+func (p *Token) SetAttr(key string, value interface{}) {
+	if p.Attrs == nil {
+		p.Attrs = Attrs{}
+	}
+	p.Attrs[key] = value
+}
+
+// Attr returns an attribute of the token and whether it has it
+func (p *Token) Attr(key string) (interface{}, bool) {
+	value, ok := p.Attrs[key]
+	return value, ok
+}
+
+// AttrAggregation is how the attribute of the tokens of a sentence becomes an attribute of the sentence
+type AttrAggregation int
+
+const (
+	// AggregateFirst takes the value of the first token that has the attribute
+	AggregateFirst AttrAggregation = iota
+	// AggregateAny is true when a token has the attribute
+	AggregateAny
+	// AggregateCount is the number of tokens that have the attribute
+	AggregateCount
+	// AggregateTokens lists the tokens that have the attribute
+	AggregateTokens
+	// AggregateMax takes the largest number of the tokens
+	AggregateMax
+	// AggregateStart takes the value of the first token of the sentence, e.g. a list marker
+	AggregateStart
+)
+
+var attrAggregations = map[string]AttrAggregation{
+	"first":  AggregateFirst,
+	"any":    AggregateAny,
+	"count":  AggregateCount,
+	"tokens": AggregateTokens,
+	"max":    AggregateMax,
+	"start":  AggregateStart,
+}
+
+// ParseAttrAggregation returns the aggregation of a name: first, any, count, tokens, max or start
+func ParseAttrAggregation(name string) (AttrAggregation, error) {
+	aggregation, ok := attrAggregations[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown aggregation %q", name)
+	}
+	return aggregation, nil
+}
+
+/*
+WithAggregations returns a tokenizer that aggregates the attributes on
+sentences as given, on top of the aggregations of the tokenizer it is called
+on.  Attributes without an aggregation take the value of the first token that
+has them.
+*/
+func (s *DefaultSentenceTokenizer) WithAggregations(aggregations map[string]AttrAggregation) *DefaultSentenceTokenizer {
+	merged := make(map[string]AttrAggregation, len(s.Aggregations)+len(aggregations))
+	for key, aggregation := range s.Aggregations {
+		merged[key] = aggregation
+	}
+	for key, aggregation := range aggregations {
+		merged[key] = aggregation
+	}
+
+	tokenizer := *s
+	tokenizer.Aggregations = merged
+	return &tokenizer
+}
+
+// number converts the numbers JSON and annotations produce to a float64
+func number(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+/*
+aggregateAttrs sets the attributes of each sentence from the tokens that end
+inside it.  Sentences whose tokens have no attributes keep nil Attrs.
+*/
+func aggregateAttrs(sentences []*Sentence, tokens []*Token, aggregations map[string]AttrAggregation) {
+	i := 0
+	for _, sentence := range sentences {
+		for first := true; i < len(tokens) && tokens[i].Position <= sentence.End; i++ {
+			tok := tokens[i]
+			for key, value := range tok.Attrs {
+				sentence.aggregate(aggregations[key], key, value, tok, first)
+			}
+			first = false
+		}
+	}
+}
+
+func (s *Sentence) aggregate(aggregation AttrAggregation, key string, value interface{}, tok *Token, first bool) {
+	if s.Attrs == nil {
+		s.Attrs = Attrs{}
+	}
+	current, ok := s.Attrs[key]
+
+	switch aggregation {
+	case AggregateFirst:
+		if !ok {
+			s.Attrs[key] = value
+		}
+	case AggregateAny:
+		s.Attrs[key] = true
+	case AggregateCount:
+		count, _ := current.(int)
+		s.Attrs[key] = count + 1
+	case AggregateTokens:
+		toks, _ := current.([]string)
+		s.Attrs[key] = append(toks, tok.Tok)
+	case AggregateMax:
+		n, isNumber := number(value)
+		max, _ := number(current)
+		if isNumber && (!ok || n > max) {
+			s.Attrs[key] = value
+		}
+	case AggregateStart:
+		if first {
+			s.Attrs[key] = value
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package sentences
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// attrAnnotation records attributes the way a custom annotation would
+type attrAnnotation struct{}
+
+func (a *attrAnnotation) Annotate(tokens []*Token) []*Token {
+	for _, tok := range tokens {
+		if strings.HasPrefix(tok.Tok, "http") {
+			tok.SetAttr("test-url", true)
+			tok.SetAttr("test-url-count", true)
+		}
+		if strings.HasPrefix(tok.Tok, "\"") {
+			tok.SetAttr("test-quote-depth", strings.Count(tok.Tok, "\""))
+		}
+		tok.SetAttr("test-lang", "en")
+		tok.SetAttr("test-first", tok.Tok)
+		if tok.Tok == "Go" {
+			tok.SetAttr("test-start", "go")
+		}
+	}
+	return tokens
+}
+
+func TestAttrs(t *testing.T) {
+	t.Log("Token attributes should be aggregated on the sentences")
+
+	tokenizer := loadTokenizer("data/english.json").withAnnotation(&attrAnnotation{}).WithAggregations(map[string]AttrAggregation{
+		"test-url":         AggregateTokens,
+		"test-url-count":   AggregateCount,
+		"test-quote-depth": AggregateMax,
+		"test-lang":        AggregateAny,
+	}).WithAggregations(map[string]AttrAggregation{"test-start": AggregateStart})
+	text := `See http://a.io and http://b.io today. He said ""Go"" and left. Go home.`
+	sents := tokenizer.Tokenize(text)
+
+	expected := []Attrs{
+		{"test-url": []string{"http://a.io", "http://b.io"}, "test-url-count": 2, "test-lang": true, "test-first": "See"},
+		{"test-quote-depth": 4, "test-lang": true, "test-first": "He"},
+		{"test-start": "go", "test-lang": true, "test-first": "Go"},
+	}
+
+	if len(sents) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(sents), len(expected))
+	}
+
+	for i, sent := range sents {
+		if !reflect.DeepEqual(sent.Attrs, expected[i]) {
+			t.Fatalf("Actual: %v, Expected: %v", sent.Attrs, expected[i])
+		}
+	}
+
+	// another tokenizer aggregates the same attribute its own way
+	other := loadTokenizer("data/english.json").withAnnotation(&attrAnnotation{})
+	if sents := other.Tokenize(text); sents[0].Attrs["test-lang"] != "en" || tokenizer.Tokenize(text)[0].Attrs["test-lang"] != true {
+		t.Fatalf("Actual: %v, Expected: test-lang=en", sents[0].Attrs)
+	}
+
+	if sents := loadTokenizer("data/english.json").Tokenize("No attributes here."); sents[0].Attrs != nil {
+		t.Fatalf("Actual: %v, Expected: no attributes", sents[0].Attrs)
+	}
+}
+
+func TestRuleAttrs(t *testing.T) {
+	t.Log("Set rules should record attributes on tokens")
+
+	rules, err := LoadRules(strings.NewReader("set test-code=1: text /^`/\nset test-lang=de: text /^Straße$/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := loadTokenizer("data/english.json").WithRules(rules).AnnotatedTokens("Run `ls` on Straße now.")
+	actual := map[string]Attrs{}
+	for _, tok := range tokens {
+		if tok.Attrs != nil {
+			actual[tok.Tok] = tok.Attrs
+		}
+	}
+
+	expected := map[string]Attrs{"`ls`": {"test-code": 1}, "Straße": {"test-lang": "de"}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %v, Expected: %v", actual, expected)
+	}
+}
@@ -0,0 +1,394 @@
+package sentences
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+/*
+The binary model format stores the four sections of a Storage as sorted
+string tables that are searched in place, so loading a model is a matter of
+checking a header instead of decoding JSON into maps.  All integers are little
+endian:
+
+	magic      "PUNKTBIN"
+	version    uint32
+	sections   4 x (offset uint32, count uint32), in the order AbbrevTypes,
+	           Collocations, SentStarters, OrthoContext
+	metadata   offset uint32, length uint32 of the JSON encoded Metadata
+
+Every section starts at its offset with count+1 uint32 string boundaries
+relative to the end of the boundaries, followed by the sorted strings and, for
+OrthoContext, one byte of orthographic flags per string.  Version 2 adds one
+byte of abbreviation classes per string to AbbrevTypes, models without classes
+are still written as version 1.  A model without metadata has a metadata
+length of 0.
+*/
+const (
+	binaryMagic   = "PUNKTBIN"
+	binaryVersion = 2
+	binaryHeader  = len(binaryMagic) + 4 + numSections*8 + 8
+)
+
+// sections of the binary format
+const (
+	abbrevSection = iota
+	collocationSection
+	sentStarterSection
+	orthoSection
+	numSections
+)
+
+// stringTable is one sorted section of a binary model
+type stringTable struct {
+	count  int
+	bounds []byte
+	blob   []byte
+	flags  []byte
+}
+
+// str returns the i-th string of the table as bytes, without copying
+func (t *stringTable) str(i int) []byte {
+	start := binary.LittleEndian.Uint32(t.bounds[i*4:])
+	end := binary.LittleEndian.Uint32(t.bounds[i*4+4:])
+	return t.blob[start:end]
+}
+
+// find returns the index of key in the table or -1
+func (t *stringTable) find(key string) int {
+	lo, hi := 0, t.count
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		// the compiler does not allocate for conversions in a comparison
+		str := t.str(mid)
+		switch {
+		case string(str) == key:
+			return mid
+		case string(str) < key:
+			lo = mid + 1
+		default:
+			hi = mid
+		}
+	}
+
+	return -1
+}
+
+// binaryTables is the read-only training data of a binary model
+type binaryTables [numSections]stringTable
+
+func (b *binaryTables) has(section int, key string) bool {
+	if b == nil {
+		return false
+	}
+
+	return b[section].find(key) >= 0
+}
+
+func (b *binaryTables) orthoFlags(typ string) int {
+	if b == nil {
+		return 0
+	}
+
+	table := &b[orthoSection]
+	if i := table.find(typ); i >= 0 {
+		return int(table.flags[i])
+	}
+
+	return 0
+}
+
+func (b *binaryTables) abbrevClass(typ string) int {
+	if b == nil || b[abbrevSection].flags == nil {
+		return 0
+	}
+
+	table := &b[abbrevSection]
+	if i := table.find(typ); i >= 0 {
+		return int(table.flags[i])
+	}
+
+	return 0
+}
+
+/*
+LoadBinary reads training data written by Storage.MarshalBinary.  The data is
+used in place and must not be modified while the Storage is in use, which
+makes it suitable for embedded bytes and memory mapped files: only the header
+and metadata are decoded and lookups search the sorted tables directly.  The
+bounds of every table are checked, the entries themselves are only checked by
+Validate.
+*/
+func LoadBinary(data []byte) (*Storage, error) {
+	if len(data) < binaryHeader || string(data[:len(binaryMagic)]) != binaryMagic {
+		return nil, fmt.Errorf("not a binary punkt model")
+	}
+
+	version := binary.LittleEndian.Uint32(data[len(binaryMagic):])
+	if version < 1 || version > binaryVersion {
+		return nil, fmt.Errorf("unsupported binary model version %d", version)
+	}
+
+	tables := &binaryTables{}
+	for section := range tables {
+		pos := len(binaryMagic) + 4 + section*8
+		offset := int(binary.LittleEndian.Uint32(data[pos:]))
+		count := int(binary.LittleEndian.Uint32(data[pos+4:]))
+
+		hasFlags := section == orthoSection || (section == abbrevSection && version >= 2)
+		table, err := readTable(data, offset, count, hasFlags)
+		if err != nil {
+			return nil, fmt.Errorf("binary model section %d: %v", section, err)
+		}
+		tables[section] = table
+	}
+
+	storage := NewStorage()
+	storage.tables = tables
+
+	pos := len(binaryMagic) + 4 + numSections*8
+	offset := int(binary.LittleEndian.Uint32(data[pos:]))
+	length := int(binary.LittleEndian.Uint32(data[pos+4:]))
+	if length > 0 {
+		if offset < binaryHeader || offset > len(data) || length > len(data)-offset {
+			return nil, fmt.Errorf("binary model metadata out of range")
+		}
+
+		storage.Metadata = &Metadata{}
+		if err := json.Unmarshal(data[offset:offset+length], storage.Metadata); err != nil {
+			return nil, fmt.Errorf("binary model metadata: %v", err)
+		}
+
+		if storage.Metadata.Version > StorageVersion {
+			return nil, fmt.Errorf("training data format version %d is not supported, this package reads up to version %d",
+				storage.Metadata.Version, StorageVersion)
+		}
+	}
+
+	return storage, nil
+}
+
+// readTable checks the bounds of a section so lookups never go out of range
+func readTable(data []byte, offset, count int, hasFlags bool) (stringTable, error) {
+	table := stringTable{count: count}
+
+	boundsLen := (count + 1) * 4
+	if offset < binaryHeader || offset > len(data) || boundsLen > len(data)-offset {
+		return table, fmt.Errorf("table out of range")
+	}
+	table.bounds = data[offset : offset+boundsLen]
+
+	blobStart := offset + boundsLen
+	blobLen := int(binary.LittleEndian.Uint32(table.bounds[count*4:]))
+	if blobLen > len(data)-blobStart {
+		return table, fmt.Errorf("strings out of range")
+	}
+	table.blob = data[blobStart : blobStart+blobLen]
+
+	prev := uint32(0)
+	for i := 0; i <= count; i++ {
+		bound := binary.LittleEndian.Uint32(table.bounds[i*4:])
+		if bound < prev || (i == 0 && bound != 0) {
+			return table, fmt.Errorf("string %d out of order", i)
+		}
+		prev = bound
+	}
+
+	if hasFlags {
+		flagStart := blobStart + blobLen
+		if count > len(data)-flagStart {
+			return table, fmt.Errorf("flags out of range")
+		}
+		table.flags = data[flagStart : flagStart+count]
+	}
+
+	return table, nil
+}
+
+// MarshalBinary encodes the base training data, without overlays, in the binary model format
+func (p *Storage) MarshalBinary() ([]byte, error) {
+	base := p.Expand()
+
+	sets := [numSections]SetString{base.AbbrevTypes, base.Collocations, base.SentStarters, base.OrthoContext}
+	out := make([]byte, binaryHeader, binaryHeader+len(base.OrthoContext)*16)
+	copy(out, binaryMagic)
+
+	version := 1
+	for typ, classes := range base.AbbrevClasses {
+		if classes == 0 {
+			continue
+		}
+		if !base.AbbrevTypes.Has(typ) {
+			return nil, fmt.Errorf("abbreviation class of %q which is not an abbreviation", typ)
+		}
+		if classes < 0 || classes > 0xff {
+			return nil, fmt.Errorf("abbreviation %q has classes %d that do not fit in a byte", typ, classes)
+		}
+		version = binaryVersion
+	}
+	binary.LittleEndian.PutUint32(out[len(binaryMagic):], uint32(version))
+
+	for section, set := range sets {
+		keys := make([]string, 0, len(set))
+		for key, value := range set {
+			if section == orthoSection {
+				if value < 0 || value > 0xff {
+					return nil, fmt.Errorf("orthographic context %q has flags %d that do not fit in a byte", key, value)
+				}
+			} else if value == 0 {
+				continue
+			}
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		pos := len(binaryMagic) + 4 + section*8
+		binary.LittleEndian.PutUint32(out[pos:], uint32(len(out)))
+		binary.LittleEndian.PutUint32(out[pos+4:], uint32(len(keys)))
+
+		bound := uint32(0)
+		out = appendUint32(out, bound)
+		for _, key := range keys {
+			bound += uint32(len(key))
+			out = appendUint32(out, bound)
+		}
+
+		for _, key := range keys {
+			out = append(out, key...)
+		}
+
+		if section == orthoSection {
+			for _, key := range keys {
+				out = append(out, byte(set[key]))
+			}
+		} else if section == abbrevSection && version >= 2 {
+			for _, key := range keys {
+				out = append(out, byte(base.AbbrevClasses[key]))
+			}
+		}
+	}
+
+	if base.Metadata != nil {
+		meta, err := json.Marshal(base.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		pos := len(binaryMagic) + 4 + numSections*8
+		binary.LittleEndian.PutUint32(out[pos:], uint32(len(out)))
+		binary.LittleEndian.PutUint32(out[pos+4:], uint32(len(meta)))
+		out = append(out, meta...)
+	}
+
+	return out, nil
+}
+
+func appendUint32(out []byte, n uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], n)
+	return append(out, buf[:]...)
+}
+
+/*
+Expand returns a copy of the base training data and its metadata held in Go
+maps, reading every entry of a binary model.  Overlays are not part of the
+copy.  It is the way to
+edit or JSON encode a model loaded with LoadBinary.
+*/
+func (p *Storage) Expand() *Storage {
+	storage := NewStorage()
+	if p.Metadata != nil {
+		meta := *p.Metadata
+		storage.Metadata = &meta
+	}
+
+	sets := [numSections]SetString{storage.AbbrevTypes, storage.Collocations, storage.SentStarters, storage.OrthoContext}
+
+	if p.tables != nil {
+		for section, set := range sets {
+			table := &p.tables[section]
+			for i := 0; i < table.count; i++ {
+				if section == orthoSection {
+					set[string(table.str(i))] = int(table.flags[i])
+				} else {
+					set.Add(string(table.str(i)))
+				}
+
+				if section == abbrevSection && table.flags != nil && table.flags[i] != 0 {
+					if storage.AbbrevClasses == nil {
+						storage.AbbrevClasses = SetString{}
+					}
+					storage.AbbrevClasses[string(table.str(i))] = int(table.flags[i])
+				}
+			}
+		}
+	}
+
+	for section, base := range [numSections]SetString{p.AbbrevTypes, p.Collocations, p.SentStarters, p.OrthoContext} {
+		for key, value := range base {
+			if section == orthoSection {
+				sets[section][key] |= value
+			} else if value != 0 {
+				sets[section].Add(key)
+			}
+		}
+	}
+
+	for typ, classes := range p.AbbrevClasses {
+		if classes == 0 {
+			continue
+		}
+		if storage.AbbrevClasses == nil {
+			storage.AbbrevClasses = SetString{}
+		}
+		storage.AbbrevClasses[typ] |= classes
+	}
+
+	return storage
+}
+
+// MappedStorage is a binary model read straight from a memory mapped file
+type MappedStorage struct {
+	*Storage
+	unmap func() error
+}
+
+/*
+OpenBinary memory maps a binary model so that processes loading the same file
+share its pages and only the parts that are looked at are read from disk.
+The Storage must not be used after Close.  On platforms without mmap the file
+is read into memory instead.
+*/
+func OpenBinary(fname string) (*MappedStorage, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	data, unmap, err := mapFile(f, int(info.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := LoadBinary(data)
+	if err != nil {
+		unmap()
+		return nil, fmt.Errorf("%s: %v", fname, err)
+	}
+
+	return &MappedStorage{Storage: storage, unmap: unmap}, nil
+}
+
+// Close releases the memory mapping
+func (m *MappedStorage) Close() error {
+	return m.unmap()
+}
@@ -0,0 +1,292 @@
+package sentences
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+var binaryLanguages = []string{"english", "french", "german", "spanish"}
+
+func loadStorage(lang string) *Storage {
+	b, err := asset("data/" + lang + ".json")
+	if err != nil {
+		panic(err)
+	}
+
+	storage, err := LoadTraining(b)
+	if err != nil {
+		panic(err)
+	}
+
+	return storage
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	t.Log("Binary models should convert to and from JSON without losing anything")
+
+	for _, lang := range binaryLanguages {
+		storage := loadStorage(lang)
+
+		b, err := storage.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		binary, err := LoadBinary(b)
+		if err != nil {
+			t.Fatalf("%s: %v", lang, err)
+		}
+
+		if !reflect.DeepEqual(binary.Expand(), storage.Expand()) {
+			t.Fatalf("%s: the binary model does not expand to the JSON model", lang)
+		}
+
+		fromJSON, _ := json.Marshal(storage)
+		fromBinary, _ := json.Marshal(binary)
+		if string(fromJSON) != string(fromBinary) {
+			t.Fatalf("%s: the binary model does not encode to the same JSON", lang)
+		}
+
+		for typ, flags := range storage.OrthoContext {
+			if binary.OrthoFlags(typ) != flags {
+				t.Fatalf("%s: %s Actual: %d, Expected: %d", lang, typ, binary.OrthoFlags(typ), flags)
+			}
+		}
+
+		for colloc := range storage.Collocations {
+			if !binary.Collocations.Has(colloc) && !binary.tables.has(collocationSection, colloc) {
+				t.Fatalf("%s: collocation %s is missing", lang, colloc)
+			}
+		}
+	}
+}
+
+func TestBinaryTokenize(t *testing.T) {
+	t.Log("Tokenizer should make the same decisions with a binary model")
+
+	storage := loadStorage("english")
+	b, err := storage.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binary, err := LoadBinary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromJSON := NewSentenceTokenizer(storage)
+	fromBinary := NewSentenceTokenizer(binary)
+
+	files, _ := filepath.Glob("test_files/english/*.txt")
+	for _, fname := range files {
+		text := readFile(fname)
+		expected := fromJSON.Tokenize(text)
+		actual := fromBinary.Tokenize(text)
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("%s: Actual: %d sentences, Expected: %d", fname, len(actual), len(expected))
+		}
+	}
+}
+
+func TestBinaryLookupAllocs(t *testing.T) {
+	t.Log("Lookups in a binary model should not allocate")
+
+	b, _ := loadStorage("english").MarshalBinary()
+	binary, err := LoadBinary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		binary.IsAbbr("mr")
+		binary.IsSentStarter("however")
+		binary.IsCollocation("##number##", "nov")
+		binary.OrthoFlags("monday")
+	})
+
+	if allocs != 0 {
+		t.Fatalf("Actual: %.0f allocations, Expected: 0", allocs)
+	}
+}
+
+func TestLoadBinaryErrors(t *testing.T) {
+	t.Log("Loading a corrupt binary model should fail instead of panicking later")
+
+	b, _ := loadStorage("english").MarshalBinary()
+
+	version := append([]byte{}, b...)
+	version[len(binaryMagic)] = 9
+
+	small := NewStorage()
+	small.AbbrevTypes.Add("a")
+	small.AbbrevTypes.Add("b")
+	bounds, _ := small.MarshalBinary()
+	// the second boundary of the abbreviations points past the strings
+	bounds[binaryHeader+4] = 200
+
+	corrupt := map[string][]byte{
+		"empty":     {},
+		"magic":     []byte("PUNKTJSON and more bytes than a header needs......"),
+		"version":   version,
+		"truncated": b[:len(b)/2],
+		"bounds":    bounds,
+	}
+
+	for name, data := range corrupt {
+		if _, err := LoadBinary(data); err == nil {
+			t.Fatalf("%s: Expected an error", name)
+		}
+	}
+}
+
+func TestOpenBinary(t *testing.T) {
+	t.Log("Binary models should be usable from a memory mapped file")
+
+	b, _ := loadStorage("english").MarshalBinary()
+
+	dir, err := ioutil.TempDir("", "sentences")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fname := filepath.Join(dir, "english.bin")
+	if err := ioutil.WriteFile(fname, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	mapped, err := OpenBinary(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mapped.Close()
+
+	if !mapped.IsAbbr("mr") || mapped.OrthoFlags("monday") == 0 {
+		t.Fatalf("Mapped model is missing training data")
+	}
+}
+
+// heapAfter returns the live heap after load runs, the result of load is kept alive until then
+func heapAfter(load func() interface{}) uint64 {
+	var stats runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	before := stats.HeapAlloc
+
+	kept := load()
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	runtime.KeepAlive(kept)
+
+	if stats.HeapAlloc < before {
+		return 0
+	}
+	return stats.HeapAlloc - before
+}
+
+func BenchmarkLoadTrainingJSON(b *testing.B) {
+	data, _ := asset("data/english.json")
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := LoadTraining(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.StopTimer()
+	b.ReportMetric(float64(heapAfter(func() interface{} {
+		storage, _ := LoadTraining(data)
+		return storage
+	})), "heap-B")
+}
+
+func BenchmarkLoadTrainingAsset(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		data, err := asset("data/english.json")
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if _, err := LoadTraining(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadBinary(b *testing.B) {
+	data, _ := loadStorage("english").MarshalBinary()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := LoadBinary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	// the model bytes themselves live in the binary or in a mapped file
+	b.StopTimer()
+	b.ReportMetric(float64(heapAfter(func() interface{} {
+		storage, _ := LoadBinary(data)
+		return storage
+	})), "heap-B")
+}
+
+func BenchmarkOpenBinary(b *testing.B) {
+	data, _ := loadStorage("english").MarshalBinary()
+
+	dir, err := ioutil.TempDir("", "sentences")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fname := filepath.Join(dir, "english.bin")
+	if err := ioutil.WriteFile(fname, data, 0644); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		mapped, err := OpenBinary(fname)
+		if err != nil {
+			b.Fatal(err)
+		}
+		mapped.Close()
+	}
+}
+
+func BenchmarkLookupJSON(b *testing.B) {
+	storage := loadStorage("english")
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		storage.OrthoFlags("monday")
+		storage.IsAbbr("mr")
+	}
+}
+
+func BenchmarkLookupBinary(b *testing.B) {
+	data, _ := loadStorage("english").MarshalBinary()
+	storage, _ := LoadBinary(data)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		storage.OrthoFlags("monday")
+		storage.IsAbbr("mr")
+	}
+}
@@ -0,0 +1,163 @@
+package cjk
+
+import (
+	"testing"
+)
+
+func compareSentences(t *testing.T, actualText string, expected []string, test string) bool {
+	actual := tokenizer.Tokenize(actualText)
+
+	if len(actual) != len(expected) {
+		t.Log(test)
+		t.Logf("Actual: %v\n", actual)
+		t.Errorf("Actual: %d, Expected: %d\n", len(actual), len(expected))
+		t.Log("===")
+		return false
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Log(test)
+			t.Errorf("Actual: [%s] Expected: [%s]\n", sent.Text, expected[index])
+			t.Log("===")
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestGoldenRules(t *testing.T) {
+	var actualText string
+	var expected []string
+	var test string
+
+	test = "1. Japanese full stops"
+	actualText = "今日は晴れです。明日は雨でしょう。"
+	expected = []string{
+		"今日は晴れです。",
+		"明日は雨でしょう。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "2. Chinese exclamation and question marks"
+	actualText = "你好！你叫什么名字？我叫李明。"
+	expected = []string{
+		"你好！",
+		"你叫什么名字？",
+		"我叫李明。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "3. Halfwidth punctuation"
+	actualText = "本当?すごい!ありがとう｡"
+	expected = []string{
+		"本当?",
+		"すごい!",
+		"ありがとう｡",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "4. Consecutive punctuation"
+	actualText = "本当に？！すごいですね。"
+	expected = []string{
+		"本当に？！",
+		"すごいですね。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "5. Quotation followed by a particle"
+	actualText = "「こんにちは。元気？」と彼は言った。それから帰った。"
+	expected = []string{
+		"「こんにちは。元気？」と彼は言った。",
+		"それから帰った。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "6. Quotations that are sentences of their own"
+	actualText = "「おはよう。」「元気？」"
+	expected = []string{
+		"「おはよう。」",
+		"「元気？」",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "7. Quotation inside a sentence"
+	actualText = "彼は「行く。」と言った。"
+	expected = []string{
+		"彼は「行く。」と言った。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "8. Nested quotations"
+	actualText = "「彼は『はい。』と答えた。」と母が言った。次の日。"
+	expected = []string{
+		"「彼は『はい。』と答えた。」と母が言った。",
+		"次の日。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "9. Chinese quotation marks"
+	actualText = "他说：“我明天来。”然后就走了。"
+	expected = []string{
+		"他说：“我明天来。”然后就走了。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "10. Closing bracket stays with its sentence"
+	actualText = "結果は良かった（たぶん。）次に進む。"
+	expected = []string{
+		"結果は良かった（たぶん。）次に進む。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "11. Closing bracket after the full stop"
+	actualText = "東京に行った。（三日間。）"
+	expected = []string{
+		"東京に行った。",
+		"（三日間。）",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "12. Version numbers and URLs"
+	actualText = "バージョン1.2.3をリリースしました。詳細はhttps://example.com/a.b?id=1を参照してください。"
+	expected = []string{
+		"バージョン1.2.3をリリースしました。",
+		"詳細はhttps://example.com/a.b?id=1を参照してください。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "13. Latin text mixed with Japanese"
+	actualText = "Pythonを使います。This is English. 次の文です。"
+	expected = []string{
+		"Pythonを使います。",
+		"This is English.",
+		" 次の文です。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "14. Numbers with a decimal point"
+	actualText = "円周率は3.14です。Node.jsで書きました。"
+	expected = []string{
+		"円周率は3.14です。",
+		"Node.jsで書きました。",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "15. Heading without punctuation"
+	actualText = "見出し\n\n本文です。次です"
+	expected = []string{
+		"見出し",
+		"\n\n本文です。",
+		"次です",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "16. Fullwidth period used in technical writing"
+	actualText = "式を示す．結果は次の通り．"
+	expected = []string{
+		"式を示す．",
+		"結果は次の通り．",
+	}
+	compareSentences(t, actualText, expected, test)
+}
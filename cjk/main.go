@@ -0,0 +1,271 @@
+/*
+Package cjk is a sentence tokenizer for Chinese and Japanese.  Neither
+language separates words with spaces, so the punkt statistics used by the
+other language packages do not apply.  Instead text is split into chunks that
+end at sentence punctuation, whitespace or brackets and a set of annotations
+decides which of those chunks end a sentence.
+*/
+package cjk
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/neurosnap/sentences"
+)
+
+// Fullwidth and halfwidth punctuation that ends a sentence.  ASCII punctuation
+// only ends a sentence when it is not part of a latin word, number or URL.
+const terminators = "。！？｡．.!?"
+
+// Brackets and quotation marks that open or close a quotation or parenthetical.
+const (
+	openers = "「『（【〈《〔［｛“‘｢([{"
+	closers = "」』）】〉》〕］｝”’｣)]}"
+)
+
+// Characters that continue a sentence right after a closing quotation, e.g. the
+// quotative particle in 「元気？」と聞いた。
+const continuations = "とっ、，,"
+
+func isTerminator(r rune) bool { return strings.ContainsRune(terminators, r) }
+func isOpener(r rune) bool     { return strings.ContainsRune(openers, r) }
+func isCloser(r rune) bool     { return strings.ContainsRune(closers, r) }
+
+// WordTokenizer splits CJK text into chunks that the annotations decide on.
+type WordTokenizer struct{}
+
+// NewWordTokenizer creates a new WordTokenizer
+func NewWordTokenizer() *WordTokenizer {
+	return &WordTokenizer{}
+}
+
+/*
+Tokenize breaks text into chunks.  A chunk ends at whitespace, after a run of
+sentence punctuation together with the closing brackets that follow it, after
+any other closing bracket and right before an opening bracket.  Every chunk
+records the byte offsets of its start and end and whether it starts a new line or
+paragraph, just like the tokens of the default word tokenizer.
+*/
+func (p *WordTokenizer) Tokenize(text string) []*sentences.Token {
+	tokens := make([]*sentences.Token, 0, 50)
+	start := -1
+	lineStart := false
+	paragraphStart := false
+
+	emit := func(end int) {
+		if start < 0 || end <= start {
+			return
+		}
+
+		token := sentences.NewToken(text[start:end])
+		token.Start = start
+		token.Position = end
+		token.LineStart = lineStart
+		token.ParaStart = paragraphStart
+		tokens = append(tokens, token)
+
+		start = -1
+		lineStart = false
+		paragraphStart = false
+	}
+
+	for i, char := range text {
+		if unicode.IsSpace(char) {
+			emit(i)
+			if char == '\n' {
+				paragraphStart = paragraphStart || lineStart
+				lineStart = true
+			}
+			continue
+		}
+
+		if isOpener(char) {
+			emit(i)
+		}
+
+		if start < 0 {
+			start = i
+		}
+
+		end := i + utf8.RuneLen(char)
+		next, _ := utf8.DecodeRuneInString(text[end:])
+
+		switch {
+		case isTerminator(char):
+			if !isTerminator(next) && !isCloser(next) && !(char < utf8.RuneSelf && isLatinWord(next)) {
+				emit(end)
+			}
+		case isCloser(char):
+			if !isCloser(next) {
+				emit(end)
+			}
+		}
+	}
+	emit(len(text))
+
+	return tokens
+}
+
+// SentenceTokenizer splits Chinese and Japanese text into sentences.
+type SentenceTokenizer struct {
+	*WordTokenizer
+	Annotations []sentences.AnnotateTokens
+}
+
+// NewSentenceTokenizer creates a tokenizer with the default CJK annotations
+func NewSentenceTokenizer() *SentenceTokenizer {
+	return &SentenceTokenizer{
+		WordTokenizer: NewWordTokenizer(),
+		Annotations: []sentences.AnnotateTokens{
+			&BoundaryAnnotation{},
+		},
+	}
+}
+
+// AnnotateTokens runs every annotation over the chunks in order
+func (s *SentenceTokenizer) AnnotateTokens(tokens []*sentences.Token, annotate ...sentences.AnnotateTokens) []*sentences.Token {
+	for _, ann := range annotate {
+		tokens = ann.Annotate(tokens)
+	}
+
+	return tokens
+}
+
+// AnnotatedTokens are the fully annotated chunks of text
+func (s *SentenceTokenizer) AnnotatedTokens(text string) []*sentences.Token {
+	tokens := s.WordTokenizer.Tokenize(text)
+
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	return s.AnnotateTokens(tokens, s.Annotations...)
+}
+
+// Tokenize splits text input into sentence tokens.
+func (s *SentenceTokenizer) Tokenize(text string) []*sentences.Sentence {
+	annotatedTokens := s.AnnotatedTokens(text)
+
+	lastBreak := 0
+	sents := make([]*sentences.Sentence, 0, len(annotatedTokens))
+	for _, token := range annotatedTokens {
+		if !token.SentBreak {
+			continue
+		}
+
+		sentence := &sentences.Sentence{Start: lastBreak, End: token.Position, Text: text[lastBreak:token.Position]}
+		sents = append(sents, sentence)
+
+		lastBreak = token.Position
+	}
+
+	if lastBreak != len(text) {
+		sentence := &sentences.Sentence{Start: lastBreak, End: len(text), Text: text[lastBreak:]}
+		sents = append(sents, sentence)
+	}
+
+	return sents
+}
+
+/*
+BoundaryAnnotation marks the chunks that end a sentence:
+  - a chunk ending in sentence punctuation outside of any quotation is a
+    sentence break, unless it is an ASCII period followed by a lower case
+    word.
+  - sentence punctuation inside 「」, （） and friends never ends a sentence.
+  - a quotation that ends in sentence punctuation is a sentence of its own
+    when it started the sentence and is not followed by a particle such as
+    と, e.g. 「おはよう。」「元気？」 are two sentences but 「元気？」と聞いた。 is one.
+  - a blank line always ends a sentence, it usually follows a heading.
+*/
+type BoundaryAnnotation struct{}
+
+// Annotate walks over the chunks keeping track of open quotations
+func (a *BoundaryAnnotation) Annotate(tokens []*sentences.Token) []*sentences.Token {
+	// sentence-relative index of every open quotation, 0 means it opened the sentence
+	stack := make([]int, 0, 4)
+	sentLen := 0
+
+	for i, tok := range tokens {
+		var next *sentences.Token
+		if i < len(tokens)-1 {
+			next = tokens[i+1]
+		}
+
+		if tok.ParaStart {
+			stack = stack[:0]
+		}
+
+		closedAtStart := false
+		var last rune
+		var beforeClosers rune
+		for _, char := range tok.Tok {
+			switch {
+			case isOpener(char):
+				stack = append(stack, sentLen)
+			case isCloser(char):
+				if len(stack) > 0 {
+					closedAtStart = stack[len(stack)-1] == 0
+					stack = stack[:len(stack)-1]
+				}
+			default:
+				beforeClosers = char
+			}
+			last = char
+			sentLen++
+		}
+
+		tok.SentBreak = a.isBreak(tok, next, last, beforeClosers, closedAtStart, len(stack))
+
+		if tok.SentBreak {
+			sentLen = 0
+		}
+	}
+
+	return tokens
+}
+
+func (a *BoundaryAnnotation) isBreak(tok, next *sentences.Token, last, beforeClosers rune, closedAtStart bool, depth int) bool {
+	if next == nil {
+		return false
+	}
+
+	if next.ParaStart {
+		return true
+	}
+
+	if depth > 0 || !isTerminator(beforeClosers) {
+		return false
+	}
+
+	// e.g. an abbreviation in an English phrase
+	if beforeClosers == '.' && startsLower(next) {
+		return false
+	}
+
+	// the chunk ends in plain sentence punctuation
+	if !isCloser(last) {
+		return true
+	}
+
+	// the chunk ends a quotation, which is only a sentence if it started one
+	if !closedAtStart {
+		return false
+	}
+
+	first, _ := utf8.DecodeRuneInString(next.Tok)
+	return !strings.ContainsRune(continuations, first)
+}
+
+// isLatinWord is true for characters that continue a latin word, number or URL
+// after ASCII punctuation, e.g. 3.14, Node.js or ?id=1
+func isLatinWord(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("/=&%_-", r))
+}
+
+func startsLower(t *sentences.Token) bool {
+	first, _ := utf8.DecodeRuneInString(t.Tok)
+	return unicode.IsLower(first)
+}
@@ -0,0 +1,36 @@
+package cjk
+
+import (
+	"testing"
+
+	"github.com/neurosnap/sentences"
+)
+
+var tokenizer sentences.SentenceTokenizer = NewSentenceTokenizer()
+
+func TestCjkOffsets(t *testing.T) {
+	t.Log("Tokenizer should keep byte offsets of multi-byte text ...")
+
+	actualText := "今日は晴れです。「明日は？」と聞いた。 Next."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{
+		"今日は晴れです。",
+		"「明日は？」と聞いた。",
+		" Next.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %v, Expected: %d", actual, len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+
+		if actualText[sent.Start:sent.End] != sent.Text {
+			t.Fatalf("Offsets [%d:%d] do not match sentence text %q", sent.Start, sent.End, sent.Text)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/neurosnap/sentences"
+)
+
+// runAbbrevs lists the types of a corpus that could be abbreviations missing from a model
+func runAbbrevs(args []string) error {
+	fs := flag.NewFlagSet("abbrevs", flag.ExitOnError)
+	model := fs.String("model", "english", "Model whose abbreviations are left out, a file or a shipped language")
+	minCount := fs.Int("min-count", 1, "Only list types seen at least this many times with a period")
+	threshold := fs.Float64("threshold", 0, "Only list types that score at least this much")
+	limit := fs.Int("limit", 0, "List at most this many candidates, 0 lists all of them")
+	contexts := fs.Int("contexts", 3, "Examples to show for every candidate")
+	width := fs.Int("width", 30, "Characters of text on each side of an example")
+	overlayOut := fs.String("overlay", "", "Write the candidates to this overlay file, the ones scoring below -accept commented out")
+	accept := fs.Float64("accept", 0.3, "Score at which a candidate is written to the overlay as an abbreviation")
+	asJSON := fs.Bool("json", false, "Print the candidates as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: sentences abbrevs [flags] <corpus>...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("abbrevs takes at least one corpus file")
+	}
+
+	storage, err := loadModel(*model)
+	if err != nil {
+		return err
+	}
+
+	lang := sentences.NewPunctStrings()
+	finder := sentences.NewAbbrevFinder(storage, sentences.NewWordTokenizer(lang))
+	finder.Contexts = *contexts
+	finder.Width = *width
+
+	for _, fname := range fs.Args() {
+		text, err := ioutil.ReadFile(fname)
+		if err != nil {
+			return err
+		}
+		finder.Add(string(text))
+	}
+
+	candidates := []*sentences.AbbrevCandidate{}
+	for _, candidate := range finder.Candidates() {
+		if candidate.WithPeriod < *minCount || candidate.Score < *threshold {
+			continue
+		}
+		if *limit > 0 && len(candidates) == *limit {
+			break
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if *overlayOut != "" {
+		if err := writeCandidates(candidates, *overlayOut, *accept); err != nil {
+			return err
+		}
+	}
+
+	if *asJSON {
+		return printJSON(candidates)
+	}
+
+	fmt.Printf("%-20s %8s %8s %8s %10s %8s\n", "type", "count", "period", "none", "ll", "score")
+	for _, candidate := range candidates {
+		fmt.Printf("%-20s %8d %8d %8d %10.2f %8.4f\n", candidate.Type, candidate.Count,
+			candidate.WithPeriod, candidate.WithoutPeriod, candidate.LogLikelihood, candidate.Score)
+		for _, context := range candidate.Contexts {
+			fmt.Printf("    %s\n", context)
+		}
+	}
+
+	return nil
+}
+
+/*
+writeCandidates writes an overlay a curator can edit: candidates scoring at
+least accept are abbreviations, the others are commented out and only need
+the "# " removed.
+*/
+func writeCandidates(candidates []*sentences.AbbrevCandidate, fname string, accept float64) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintln(w, "# abbreviation candidates, delete a line to reject it or remove the \"# \" to accept it")
+	for _, candidate := range candidates {
+		fmt.Fprintf(w, "\n# score %.4f, %d with a period, %d without\n", candidate.Score, candidate.WithPeriod, candidate.WithoutPeriod)
+		if candidate.Score >= accept {
+			fmt.Fprintln(w, candidate.Type)
+		} else {
+			fmt.Fprintf(w, "# %s\n", candidate.Type)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/neurosnap/sentences"
+)
+
+type learnReport struct {
+	Before  accuracyReport            `json:"before"`
+	After   accuracyReport            `json:"after"`
+	Changes []sentences.LearnedChange `json:"changes"`
+}
+
+type accuracyReport struct {
+	sentences.Accuracy
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	F1        float64 `json:"f1"`
+}
+
+func newAccuracyReport(acc sentences.Accuracy) accuracyReport {
+	return accuracyReport{acc, acc.Precision(), acc.Recall(), acc.F1()}
+}
+
+// printAccuracy prints the accuracy before and after a change to a model as a table
+func printAccuracy(before, after accuracyReport) {
+	fmt.Printf("%-7s %8s %8s %8s %10s %10s %8s\n", "", "breaks", "found", "correct", "precision", "recall", "errors")
+	for _, row := range []struct {
+		name string
+		acc  accuracyReport
+	}{{"before", before}, {"after", after}} {
+		fmt.Printf("%-7s %8d %8d %8d %10.4f %10.4f %8d\n", row.name, row.acc.Breaks, row.acc.Predicted,
+			row.acc.Correct, row.acc.Precision, row.acc.Recall, row.acc.Errors())
+	}
+}
+
+// sentenceBreak separates the sentences of the expected output in test_files
+const sentenceBreak = "{{sentence_break}}"
+
+const goldFormats = "Gold format: lines (one sentence per line), conllu or marked (sentences separated by " + sentenceBreak + "), by default it follows the file"
+
+/*
+readGold reads gold documents from files.  A marked file such as
+test_files/english/dr_s.txt is aligned with the text it was made from,
+dr.txt, if that exists.
+*/
+func readGold(fnames []string, format string) ([]sentences.GoldDocument, error) {
+	docs := []sentences.GoldDocument{}
+
+	for _, fname := range fnames {
+		b, err := ioutil.ReadFile(fname)
+		if err != nil {
+			return nil, err
+		}
+
+		fileFormat := format
+		if fileFormat == "" {
+			switch {
+			case strings.HasSuffix(fname, ".conllu"):
+				fileFormat = "conllu"
+			case bytes.Contains(b, []byte(sentenceBreak)):
+				fileFormat = "marked"
+			default:
+				fileFormat = "lines"
+			}
+		}
+
+		var read []sentences.GoldDocument
+		switch fileFormat {
+		case "conllu":
+			read, err = sentences.ReadCoNLLU(bytes.NewReader(b))
+		case "lines":
+			read, err = sentences.ReadGoldLines(bytes.NewReader(b))
+		case "marked":
+			read, err = readMarked(fname, string(b))
+		default:
+			return nil, fmt.Errorf("unknown gold format %q", fileFormat)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+
+		docs = append(docs, read...)
+	}
+
+	return docs, nil
+}
+
+// readMarked reads a document whose sentences are separated by sentenceBreak
+func readMarked(fname string, marked string) ([]sentences.GoldDocument, error) {
+	sents := []string{}
+	for _, sentence := range strings.Split(marked, sentenceBreak) {
+		if strings.TrimSpace(sentence) != "" {
+			sents = append(sents, sentence)
+		}
+	}
+
+	raw := strings.TrimSuffix(fname, "_s.txt") + ".txt"
+	if text, err := ioutil.ReadFile(raw); err == nil && raw != fname {
+		doc, err := sentences.AlignGold(string(text), sents)
+		return []sentences.GoldDocument{doc}, err
+	}
+
+	for index, sentence := range sents {
+		sents[index] = strings.TrimSpace(sentence)
+	}
+	return []sentences.GoldDocument{sentences.NewGoldDocument(sents)}, nil
+}
+
+// runLearn learns abbreviations, collocations and sentence starters from gold segmented files
+func runLearn(args []string) error {
+	fs := flag.NewFlagSet("learn", flag.ExitOnError)
+	model := fs.String("model", "english", "Model to improve, a file or a shipped language")
+	format := fs.String("format", "", goldFormats)
+	out := fs.String("o", "", "Write the patched model to this file, binary if it ends in .bin")
+	overlayOut := fs.String("overlay", "", "Write the learned entries as an overlay to this file")
+	minErrors := fs.Int("min-errors", 1, "Only try entries that explain at least this many errors")
+	asJSON := fs.Bool("json", false, "Print the report as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: sentences learn [flags] <gold>...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("learn takes at least one gold file")
+	}
+
+	storage, err := loadModel(*model)
+	if err != nil {
+		return err
+	}
+
+	docs, err := readGold(fs.Args(), *format)
+	if err != nil {
+		return err
+	}
+
+	learner := sentences.NewLearner(sentences.NewSentenceTokenizer(storage))
+	learner.MinErrors = *minErrors
+	result := learner.Learn(docs)
+
+	if *out != "" {
+		if err := saveModel(result.Storage, *out); err != nil {
+			return err
+		}
+	}
+
+	if *overlayOut != "" {
+		f, err := os.Create(*overlayOut)
+		if err != nil {
+			return err
+		}
+		_, err = result.Overlay.WriteTo(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	report := learnReport{
+		Before:  newAccuracyReport(result.Before),
+		After:   newAccuracyReport(result.After),
+		Changes: result.Changes,
+	}
+
+	if *asJSON {
+		return printJSON(report)
+	}
+
+	printAccuracy(report.Before, report.After)
+
+	for _, change := range report.Changes {
+		fmt.Printf("+ %-13s %-20s fixes %d\n", change.Section, change.Entry, change.Fixed)
+	}
+
+	return nil
+}
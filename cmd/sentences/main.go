@@ -2,13 +2,21 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/neurosnap/sentences"
 	"github.com/neurosnap/sentences/english"
+	// the other languages register themselves for profiles
+	_ "github.com/neurosnap/sentences/french"
+	_ "github.com/neurosnap/sentences/german"
+	_ "github.com/neurosnap/sentences/spanish"
 )
 
 // VERSION is the semantic version number
@@ -17,16 +25,203 @@ var VERSION string
 // COMMITHASH is the git commit hash value
 var COMMITHASH string
 
-func run(fname string, delim string, debug bool) {
-	if debug {
-		fmt.Printf("file [%s], delim [%s]\n", fname, delim)
+func loadOverlays(fnames string) []*sentences.Overlay {
+	overlays := []*sentences.Overlay{}
+	if fnames == "" {
+		return overlays
+	}
+
+	for _, fname := range strings.Split(fnames, ",") {
+		f, err := os.Open(fname)
+		if err != nil {
+			panic(err)
+		}
+
+		overlay, err := sentences.LoadOverlay(f)
+		f.Close()
+		if err != nil {
+			panic(fmt.Errorf("%s: %v", fname, err))
+		}
+
+		overlays = append(overlays, overlay)
+	}
+
+	return overlays
+}
+
+// loadGazetteer reads names from text files, or JSON files if they end in .json
+func loadGazetteer(fnames string) *sentences.Gazetteer {
+	gazetteer := sentences.NewGazetteer()
+
+	for _, fname := range strings.Split(fnames, ",") {
+		b, err := ioutil.ReadFile(fname)
+		if err != nil {
+			panic(err)
+		}
+
+		var names *sentences.Gazetteer
+		if strings.HasSuffix(fname, ".json") {
+			names, err = sentences.LoadGazetteerJSON(b)
+		} else {
+			names, err = sentences.LoadGazetteer(bytes.NewReader(b))
+		}
+		if err != nil {
+			panic(fmt.Errorf("%s: %v", fname, err))
+		}
+
+		gazetteer.Merge(names)
+	}
+
+	return gazetteer
+}
+
+// loadRules reads boundary rules from files, the rules of later files have the last word
+func loadRules(fnames string) []*sentences.Rule {
+	rules := []*sentences.Rule{}
+
+	for _, fname := range strings.Split(fnames, ",") {
+		f, err := os.Open(fname)
+		if err != nil {
+			panic(err)
+		}
+
+		loaded, err := sentences.LoadRules(f)
+		f.Close()
+		if err != nil {
+			panic(fmt.Errorf("%s: %v", fname, err))
+		}
+
+		rules = append(rules, loaded...)
+	}
+
+	return rules
+}
+
+// loadProfile builds the tokenizer of a profile, its file names are relative to the profile
+func loadProfile(fname string) *sentences.DefaultSentenceTokenizer {
+	f, err := os.Open(fname)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	profile, err := sentences.LoadProfile(f)
+	if err != nil {
+		panic(fmt.Errorf("%s: %v", fname, err))
+	}
+	profile.Dir = filepath.Dir(fname)
+
+	tokenizer, err := sentences.NewFromProfile(profile)
+	if err != nil {
+		panic(fmt.Errorf("%s: %v", fname, err))
+	}
+
+	return tokenizer
+}
+
+// parseAggregations reads attr=aggregation pairs, e.g. url=tokens
+func parseAggregations(pairs string) map[string]sentences.AttrAggregation {
+	aggregations := map[string]sentences.AttrAggregation{}
+	for _, pair := range strings.Split(pairs, ",") {
+		eq := strings.Index(pair, "=")
+		if eq < 0 {
+			panic(fmt.Errorf("expected attr=aggregation: %q", pair))
+		}
+
+		aggregation, err := sentences.ParseAttrAggregation(pair[eq+1:])
+		if err != nil {
+			panic(err)
+		}
+		aggregations[pair[:eq]] = aggregation
+	}
+
+	return aggregations
+}
+
+// segmentTokens prints the sentence spans of a JSON list of tokens from another tokenizer
+func segmentTokens(tokenizer *sentences.DefaultSentenceTokenizer, fname string) {
+	b, err := ioutil.ReadFile(fname)
+	if err != nil {
+		panic(err)
+	}
+
+	external := []sentences.ExternalToken{}
+	if err := json.Unmarshal(b, &external); err != nil {
+		panic(fmt.Errorf("%s: %v", fname, err))
+	}
+
+	tokens, err := sentences.NewTokensFrom(external)
+	if err != nil {
+		panic(fmt.Errorf("%s: %v", fname, err))
+	}
+
+	if err := printJSON(tokenizer.SegmentTokens(tokens)); err != nil {
+		panic(err)
+	}
+}
+
+// options are the flags of the command that segments text
+type options struct {
+	fname     string
+	delim     string
+	profile   string
+	overlays  string
+	gazetteer string
+	rules     string
+	aggregate string
+	tokens    string
+	adaptive  bool
+	jsonOut   bool
+	debug     bool
+}
+
+// newTokenizer builds the english tokenizer, or that of a profile, with the options of the command line
+func newTokenizer(opts *options) *sentences.DefaultSentenceTokenizer {
+	var tokenizer *sentences.DefaultSentenceTokenizer
+	if opts.profile != "" {
+		tokenizer = loadProfile(opts.profile)
+	} else {
+		var err error
+		tokenizer, err = english.NewSentenceTokenizer(nil)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if opts.adaptive {
+		tokenizer.Adaptive = sentences.NewAdaptive()
+	}
+
+	if opts.gazetteer != "" {
+		tokenizer = tokenizer.WithGazetteer(loadGazetteer(opts.gazetteer))
+	}
+
+	if opts.rules != "" {
+		tokenizer = tokenizer.WithRules(loadRules(opts.rules))
+	}
+
+	return tokenizer.WithOverlays(loadOverlays(opts.overlays)...)
+}
+
+func run(opts *options) error {
+	if opts.debug {
+		fmt.Printf("file [%s], delim [%s]\n", opts.fname, opts.delim)
+	}
+
+	if opts.tokens != "" {
+		// spans have no attributes to aggregate
+		if opts.aggregate != "" {
+			return fmt.Errorf("--aggregate cannot be combined with --tokens")
+		}
+		segmentTokens(newTokenizer(opts), opts.tokens)
+		return nil
 	}
 
 	var text []byte
 	var err error
 
-	if fname != "" {
-		text, err = ioutil.ReadFile(fname)
+	if opts.fname != "" {
+		text, err = ioutil.ReadFile(opts.fname)
 		if err != nil {
 			panic(err)
 		}
@@ -37,7 +232,7 @@ func run(fname string, delim string, debug bool) {
 		}
 
 		if (stat.Mode() & os.ModeCharDevice) != 0 {
-			return
+			return nil
 		}
 
 		reader := bufio.NewReader(os.Stdin)
@@ -47,47 +242,96 @@ func run(fname string, delim string, debug bool) {
 		}
 	}
 
-	tokenizer, err := english.NewSentenceTokenizer(nil)
-	if err != nil {
-		panic(err)
+	tokenizer := newTokenizer(opts)
+	if opts.aggregate != "" {
+		tokenizer = tokenizer.WithAggregations(parseAggregations(opts.aggregate))
 	}
 
 	sentences := tokenizer.Tokenize(string(text))
 
-	if debug {
+	if opts.debug {
 		for _, s := range sentences {
 			fmt.Println(s)
 		}
 		fmt.Println("---")
 	}
 
+	if opts.jsonOut {
+		if err := printJSON(sentences); err != nil {
+			panic(err)
+		}
+		return nil
+	}
+
 	for _, s := range sentences {
 		text := strings.Join(strings.Fields(s.Text), " ")
 
-		text = strings.Join([]string{text, delim}, "")
+		text = strings.Join([]string{text, opts.delim}, "")
 		fmt.Printf("%s", text)
 	}
+
+	return nil
 }
 
 func main() {
+	commands := map[string]func([]string) error{
+		"storage": runStorage,
+		"learn":   runLearn,
+		"abbrevs": runAbbrevs,
+		"prune":   runPrune,
+	}
+
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			if err := command(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	opts := &options{}
+
 	var ver bool
 	verStr := "Get current version of sentences"
 	flag.BoolVar(&ver, "version", false, verStr)
 	flag.BoolVar(&ver, "v", false, fmt.Sprintf("%s (alias of --version)", verStr))
 
-	var fname string
 	fileStr := "Read file as source input instead of stdin"
-	flag.StringVar(&fname, "file", "", fileStr)
-	flag.StringVar(&fname, "f", "", fmt.Sprintf("%s (alias of --file)", fileStr))
+	flag.StringVar(&opts.fname, "file", "", fileStr)
+	flag.StringVar(&opts.fname, "f", "", fmt.Sprintf("%s (alias of --file)", fileStr))
 
-	var delim string
 	delimStr := "Delimiter used to demarcate sentence boundaries"
-	flag.StringVar(&delim, "delimiter", "\n", delimStr)
-	flag.StringVar(&delim, "d", "\n", fmt.Sprintf("%s (alias of --delimiter)", delimStr))
+	flag.StringVar(&opts.delim, "delimiter", "\n", delimStr)
+	flag.StringVar(&opts.delim, "d", "\n", fmt.Sprintf("%s (alias of --delimiter)", delimStr))
+
+	profileStr := "JSON profile with the language, model, overlays and annotators to segment with"
+	flag.StringVar(&opts.profile, "profile", "", profileStr)
+
+	overlayStr := "Comma separated overlay files that add or remove training data"
+	flag.StringVar(&opts.overlays, "overlay", "", overlayStr)
+
+	gazetteerStr := "Comma separated files of names that do not start a sentence after an abbreviation, text or .json"
+	flag.StringVar(&opts.gazetteer, "gazetteer", "", gazetteerStr)
+
+	rulesStr := "Comma separated files of rules that add or take back sentence breaks"
+	flag.StringVar(&opts.rules, "rules", "", rulesStr)
+
+	adaptiveStr := "Learn abbreviations, orthographic contexts and sentence starters from the input before tokenizing it"
+	flag.BoolVar(&opts.adaptive, "adaptive", false, adaptiveStr)
+
+	tokensStr := "JSON file of tokens from another tokenizer, with text, start, end, line_start and para_start, prints the sentence spans, cannot be combined with --aggregate"
+	flag.StringVar(&opts.tokens, "tokens", "", tokensStr)
+
+	aggregateStr := "Comma separated attr=aggregation pairs, how the attributes rules set are aggregated on sentences: first, any, count, tokens, max or start"
+	flag.StringVar(&opts.aggregate, "aggregate", "", aggregateStr)
+
+	jsonStr := "Print the sentences as JSON with their offsets and attributes"
+	flag.BoolVar(&opts.jsonOut, "json", false, jsonStr)
 
-	var debug bool
 	debugStr := "Debug mode"
-	flag.BoolVar(&debug, "debug", false, debugStr)
+	flag.BoolVar(&opts.debug, "debug", false, debugStr)
 
 	flag.Parse()
 
@@ -97,5 +341,8 @@ func main() {
 		return
 	}
 
-	run(fname, delim, debug)
+	if err := run(opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
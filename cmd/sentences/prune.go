@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/neurosnap/sentences"
+)
+
+type pruneReport struct {
+	Kept       map[string]int  `json:"kept"`
+	Removed    map[string]int  `json:"removed"`
+	Size       int             `json:"size"`
+	PrunedSize int             `json:"pruned_size"`
+	Before     *accuracyReport `json:"before,omitempty"`
+	After      *accuracyReport `json:"after,omitempty"`
+}
+
+// runPrune drops the entries of a model that a reference corpus does not use
+func runPrune(args []string) error {
+	fs := flag.NewFlagSet("prune", flag.ExitOnError)
+	model := fs.String("model", "english", "Model to prune, a file or a shipped language")
+	out := fs.String("o", "", "Write the pruned model to this file, binary if it ends in .bin")
+	budget := fs.Int("budget", 0, "Size in bytes of the binary model to prune to, 0 only drops entries used less than -min-uses")
+	minUses := fs.Int("min-uses", 1, "Drop entries that decide fewer sentence breaks of the corpus than this")
+	gold := fs.String("gold", "", "Comma separated gold files to measure the accuracy on, they are the corpus if none is given")
+	format := fs.String("format", "", goldFormats)
+	usageOut := fs.String("usage", "", "Write how many sentence breaks every entry decided to this JSON file")
+	asJSON := fs.Bool("json", false, "Print the report as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: sentences prune [flags] <corpus>...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	storage, err := loadModel(*model)
+	if err != nil {
+		return err
+	}
+
+	docs := []sentences.GoldDocument{}
+	if *gold != "" {
+		docs, err = readGold(strings.Split(*gold, ","), *format)
+		if err != nil {
+			return err
+		}
+	}
+
+	if fs.NArg() == 0 && len(docs) == 0 {
+		fs.Usage()
+		return fmt.Errorf("prune takes a corpus or gold files")
+	}
+
+	tokenizer := sentences.NewSentenceTokenizer(storage)
+	pruner := sentences.NewPruner(tokenizer)
+	pruner.Budget = *budget
+	pruner.MinUses = *minUses
+
+	for _, fname := range fs.Args() {
+		text, err := ioutil.ReadFile(fname)
+		if err != nil {
+			return err
+		}
+		pruner.Record(string(text))
+	}
+
+	if fs.NArg() == 0 {
+		for _, doc := range docs {
+			pruner.Record(doc.Text)
+		}
+	}
+
+	result, err := pruner.Prune()
+	if err != nil {
+		return err
+	}
+
+	if *out != "" {
+		if err := saveModel(result.Storage, *out); err != nil {
+			return err
+		}
+	}
+
+	if *usageOut != "" {
+		b, err := json.MarshalIndent(pruner.Usage(), "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(*usageOut, b, 0644); err != nil {
+			return err
+		}
+	}
+
+	report := pruneReport{
+		Kept:       result.Kept,
+		Removed:    result.Removed,
+		Size:       result.Size,
+		PrunedSize: result.PrunedSize,
+	}
+
+	if len(docs) > 0 {
+		before := newAccuracyReport(sentences.Evaluate(tokenizer, docs))
+		after := newAccuracyReport(sentences.Evaluate(sentences.NewSentenceTokenizer(result.Storage), docs))
+		report.Before, report.After = &before, &after
+	}
+
+	if *asJSON {
+		return printJSON(report)
+	}
+
+	fmt.Printf("%-13s %8s %8s\n", "", "kept", "removed")
+	for _, section := range []string{"AbbrevTypes", "Collocations", "SentStarters", "OrthoContext"} {
+		fmt.Printf("%-13s %8d %8d\n", section, report.Kept[section], report.Removed[section])
+	}
+	fmt.Printf("size %d bytes, pruned %d bytes\n", report.Size, report.PrunedSize)
+
+	if report.Before != nil {
+		fmt.Println()
+		printAccuracy(*report.Before, *report.After)
+	}
+
+	return nil
+}
@@ -0,0 +1,635 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/neurosnap/sentences"
+	englishdata "github.com/neurosnap/sentences/data/english"
+	frenchdata "github.com/neurosnap/sentences/data/french"
+	germandata "github.com/neurosnap/sentences/data/german"
+	spanishdata "github.com/neurosnap/sentences/data/spanish"
+)
+
+// shipped are the models embedded for the language packages, by language
+var shipped = map[string]func(string) ([]byte, error){
+	"english": englishdata.Asset,
+	"french":  frenchdata.Asset,
+	"german":  germandata.Asset,
+	"spanish": spanishdata.Asset,
+}
+
+const storageUsage = `usage: sentences storage <command> [arguments]
+
+A model is a path to a JSON or binary training file, an NLTK punkt_tab
+directory or the name of a shipped language, e.g. english.
+
+commands:
+  inspect [-json] <model>                    count entries and orthographic contexts
+  query [-json] <model> <word>               show everything the model knows about a word
+  add [-w] <model> <section> <entry>...      add entries to a section
+  rm [-w] <model> <section> <entry>...       remove entries from a section
+  diff [-json] <from> <to>                   print the overlay that turns one model into another
+  merge [-abbrevs] [-starters] [-ortho] [-collocations] [-o] <model[:weight]>...
+                                             combine models, by default every abbreviation and
+                                             sentence starter is kept and weights vote on the rest
+  convert [-format] <model> <out>            write a model as binary if out ends in .bin, as punkt_tab
+                                             if out is a directory or ends in /, JSON otherwise
+  stamp [-w] [-lang] [-corpus] [-tokens] [-tool] <model>
+                                             record metadata and the checksum of a model
+
+Sections are AbbrevTypes, Collocations, SentStarters, OrthoContext and
+AbbrevClasses.  Collocations are written as "first,second" and orthographic
+contexts as "word=flags" where flags is a number or names joined by "|", e.g.
+BEG_UC|MID_LC.  Abbreviation classes are written the same way with the names
+title, internal, terminal and unit, e.g. dr=title.
+Edited and merged models are written to stdout unless -w is given.
+`
+
+// loadModel reads training data from a file, a punkt_tab directory or a shipped language
+func loadModel(name string) (*sentences.Storage, error) {
+	if info, err := os.Stat(name); err == nil && info.IsDir() {
+		return sentences.LoadPunktTab(os.DirFS(name))
+	}
+
+	b, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		asset, ok := shipped[name]
+		if !ok {
+			return nil, fmt.Errorf("%s is neither a file nor a shipped language", name)
+		}
+		b, err = asset("data/" + name + ".json")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(b, []byte("PUNKTBIN")) {
+		storage, err := sentences.LoadBinary(b)
+		if err != nil {
+			return nil, err
+		}
+		// edits go into the maps, so copy the tables out of the file
+		storage = storage.Expand()
+		return storage, storage.Validate()
+	}
+
+	return sentences.LoadTraining(b)
+}
+
+func printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(b))
+	return nil
+}
+
+func runStorage(args []string) error {
+	if len(args) == 0 {
+		fmt.Print(storageUsage)
+		return nil
+	}
+
+	commands := map[string]func([]string) error{
+		"inspect": storageInspect,
+		"query":   storageQuery,
+		"add":     func(args []string) error { return storageEdit("add", args) },
+		"rm":      func(args []string) error { return storageEdit("rm", args) },
+		"diff":    storageDiff,
+		"merge":   storageMerge,
+		"convert": storageConvert,
+		"stamp":   storageStamp,
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprint(os.Stderr, storageUsage)
+		return fmt.Errorf("unknown storage command %q", args[0])
+	}
+
+	return command(args[1:])
+}
+
+type orthoCount struct {
+	Flag        string `json:"flag"`
+	Description string `json:"description"`
+	Types       int    `json:"types"`
+}
+
+type inspectReport struct {
+	AbbrevTypes  int          `json:"AbbrevTypes"`
+	Collocations int          `json:"Collocations"`
+	SentStarters int          `json:"SentStarters"`
+	OrthoContext int          `json:"OrthoContext"`
+	OrthoFlags   []orthoCount `json:"OrthoFlags"`
+	// AbbrevClasses counts the abbreviations of every class
+	AbbrevClasses map[string]int      `json:"AbbrevClasses"`
+	Metadata      *sentences.Metadata `json:"Metadata,omitempty"`
+}
+
+func storageInspect(args []string) error {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print the report as JSON")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("inspect takes one model")
+	}
+
+	storage, err := loadModel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	report := inspectReport{
+		AbbrevTypes:   len(storage.AbbrevTypes),
+		Collocations:  len(storage.Collocations),
+		SentStarters:  len(storage.SentStarters),
+		OrthoContext:  len(storage.OrthoContext),
+		AbbrevClasses: map[string]int{},
+		Metadata:      storage.Metadata,
+	}
+
+	for _, classes := range storage.AbbrevClasses {
+		for _, name := range sentences.AbbrevClassNames(classes) {
+			report.AbbrevClasses[name]++
+		}
+	}
+
+	for _, name := range sentences.OrthoNames(^0) {
+		flag := sentences.OrthoFlag(name)
+		count := 0
+		for _, flags := range storage.OrthoContext {
+			if flags&flag != 0 {
+				count++
+			}
+		}
+
+		report.OrthoFlags = append(report.OrthoFlags, orthoCount{
+			Flag:        name,
+			Description: sentences.DescribeOrtho(flag)[0],
+			Types:       count,
+		})
+	}
+
+	if *asJSON {
+		return printJSON(report)
+	}
+
+	if meta := report.Metadata; meta != nil {
+		fmt.Printf("version       %d\n", meta.Version)
+		fmt.Printf("lang          %s\n", meta.Lang)
+		fmt.Printf("corpus        %s\n", meta.Corpus)
+		fmt.Printf("tokens        %d\n", meta.Tokens)
+		fmt.Printf("tool          %s\n", meta.Tool)
+		fmt.Printf("checksum      %s\n", meta.Checksum)
+	} else {
+		fmt.Println("no metadata")
+	}
+
+	fmt.Printf("AbbrevTypes   %d\n", report.AbbrevTypes)
+	fmt.Printf("Collocations  %d\n", report.Collocations)
+	fmt.Printf("SentStarters  %d\n", report.SentStarters)
+	fmt.Printf("OrthoContext  %d\n", report.OrthoContext)
+	for _, ortho := range report.OrthoFlags {
+		fmt.Printf("  %-7s %6d  %s\n", ortho.Flag, ortho.Types, ortho.Description)
+	}
+	if len(report.AbbrevClasses) > 0 {
+		fmt.Println("AbbrevClasses")
+		for _, name := range sentences.AbbrevClassNames(^0) {
+			fmt.Printf("  %-9s %4d\n", name, report.AbbrevClasses[name])
+		}
+	}
+
+	return nil
+}
+
+type queryReport struct {
+	Word         string   `json:"word"`
+	Abbrev       bool     `json:"abbrev"`
+	AbbrevClass  []string `json:"abbrev_class"`
+	SentStarter  bool     `json:"sent_starter"`
+	Collocations []string `json:"collocations"`
+	OrthoFlags   int      `json:"ortho_flags"`
+	OrthoContext []string `json:"ortho_context"`
+}
+
+func storageQuery(args []string) error {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print the report as JSON")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("query takes a model and a word")
+	}
+
+	storage, err := loadModel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	word := strings.ToLower(fs.Arg(1))
+	report := queryReport{
+		Word:         word,
+		Abbrev:       storage.IsAbbr(strings.TrimSuffix(word, ".")),
+		AbbrevClass:  sentences.AbbrevClassNames(storage.AbbrevClass(strings.TrimSuffix(word, "."))),
+		SentStarter:  storage.IsSentStarter(word),
+		Collocations: []string{},
+		OrthoFlags:   storage.OrthoFlags(word),
+	}
+	report.OrthoContext = sentences.OrthoNames(report.OrthoFlags)
+
+	for _, colloc := range storage.Collocations.Array() {
+		for _, part := range strings.SplitN(colloc, ",", 2) {
+			if part == word {
+				report.Collocations = append(report.Collocations, colloc)
+				break
+			}
+		}
+	}
+
+	if *asJSON {
+		return printJSON(report)
+	}
+
+	fmt.Printf("word          %s\n", report.Word)
+	fmt.Printf("abbreviation  %t\n", report.Abbrev)
+	if len(report.AbbrevClass) > 0 {
+		fmt.Printf("abbrev class  %s\n", strings.Join(report.AbbrevClass, " "))
+	}
+	fmt.Printf("sent starter  %t\n", report.SentStarter)
+	fmt.Printf("collocations  %s\n", strings.Join(report.Collocations, " "))
+	fmt.Printf("ortho flags   %d\n", report.OrthoFlags)
+	for _, description := range sentences.DescribeOrtho(report.OrthoFlags) {
+		fmt.Printf("  %s\n", description)
+	}
+
+	return nil
+}
+
+// parseOrtho reads the flags of an orthographic context entry, either a
+// number or flag names joined by "|"
+func parseOrtho(flags string) (int, error) {
+	if n, err := strconv.Atoi(flags); err == nil {
+		return n, nil
+	}
+
+	n := 0
+	for _, name := range strings.Split(flags, "|") {
+		flag := sentences.OrthoFlag(strings.ToUpper(strings.TrimSpace(name)))
+		if flag == 0 {
+			return 0, fmt.Errorf("unknown orthographic flag %q", name)
+		}
+		n |= flag
+	}
+
+	return n, nil
+}
+
+func storageEdit(command string, args []string) error {
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	write := fs.Bool("w", false, "Write the result back to the model file")
+	fs.Parse(args)
+
+	if fs.NArg() < 3 {
+		return fmt.Errorf("%s takes a model, a section and at least one entry", command)
+	}
+
+	fname := fs.Arg(0)
+	storage, err := loadModel(fname)
+	if err != nil {
+		return err
+	}
+
+	section, err := storage.Section(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range fs.Args()[2:] {
+		entry = strings.ToLower(entry)
+
+		switch fs.Arg(1) {
+		case "Collocations":
+			if strings.Count(entry, ",") != 1 {
+				return fmt.Errorf("collocation %q must be two words separated by a comma", entry)
+			}
+		case "OrthoContext":
+			parts := strings.SplitN(entry, "=", 2)
+			entry = parts[0]
+			if command == "add" {
+				if len(parts) != 2 {
+					return fmt.Errorf("orthographic context %q needs flags, e.g. %s=BEG_UC", entry, entry)
+				}
+
+				flags, err := parseOrtho(parts[1])
+				if err != nil {
+					return err
+				}
+				section[entry] |= flags
+				continue
+			}
+
+			if len(parts) == 2 {
+				flags, err := parseOrtho(parts[1])
+				if err != nil {
+					return err
+				}
+				section[entry] &^= flags
+				if section[entry] == 0 {
+					section.Remove(entry)
+				}
+				continue
+			}
+		case "AbbrevClasses":
+			parts := strings.SplitN(entry, "=", 2)
+			entry = parts[0]
+			if command == "add" {
+				if len(parts) != 2 {
+					return fmt.Errorf("abbreviation %q needs classes, e.g. %s=title", entry, entry)
+				}
+
+				classes, err := sentences.ParseAbbrevClasses(parts[1])
+				if err != nil {
+					return err
+				}
+				// only abbreviations have classes
+				storage.AbbrevTypes.Add(entry)
+				section[entry] |= classes
+				continue
+			}
+
+			if len(parts) == 2 {
+				classes, err := sentences.ParseAbbrevClasses(parts[1])
+				if err != nil {
+					return err
+				}
+				section[entry] &^= classes
+				if section[entry] == 0 {
+					section.Remove(entry)
+				}
+				continue
+			}
+		}
+
+		if command == "add" {
+			section.Add(entry)
+		} else {
+			section.Remove(entry)
+			if fs.Arg(1) == "AbbrevTypes" {
+				delete(storage.AbbrevClasses, entry)
+			}
+		}
+	}
+
+	return writeModel(storage, fname, *write)
+}
+
+// writeModel prints the model as sorted JSON or writes it back to its file
+func writeModel(storage *sentences.Storage, fname string, write bool) error {
+	if !write {
+		storage.UpdateChecksum()
+
+		b, err := json.MarshalIndent(storage, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(b))
+		return nil
+	}
+
+	if _, err := os.Stat(fname); err != nil {
+		return fmt.Errorf("%s is not a file, only model files can be written", fname)
+	}
+
+	return saveModel(storage, fname)
+}
+
+// saveModel writes a model to a file, as binary if the name ends in .bin and as JSON otherwise
+func saveModel(storage *sentences.Storage, fname string) error {
+	storage.UpdateChecksum()
+
+	if strings.HasSuffix(fname, ".bin") {
+		b, err := storage.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(fname, b, 0644)
+	}
+
+	b, err := json.MarshalIndent(storage, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(fname, append(b, '\n'), 0644)
+}
+
+func storageDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print the differences as JSON")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("diff takes two models")
+	}
+
+	from, err := loadModel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	to, err := loadModel(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	overlay := sentences.DiffStorage(from, to)
+	if *asJSON {
+		return printJSON(overlay)
+	}
+
+	_, err = overlay.WriteTo(os.Stdout)
+	return err
+}
+
+/*
+parseWeighted splits a model argument of merge into its name and weight,
+"legal.json:2" has a weight of 2 and a name without a weight has 1.
+*/
+func parseWeighted(arg string) (string, float64, error) {
+	sep := strings.LastIndexByte(arg, ':')
+	if sep < 0 {
+		return arg, 1, nil
+	}
+
+	weight, err := strconv.ParseFloat(arg[sep+1:], 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("weight of %q is not a number", arg)
+	}
+	if weight <= 0 {
+		return "", 0, fmt.Errorf("weight of %q is not positive", arg)
+	}
+
+	return arg[:sep], weight, nil
+}
+
+func storageMerge(args []string) error {
+	fs := flag.NewFlagSet("merge", flag.ExitOnError)
+	abbrevs := fs.String("abbrevs", "union", "Abbreviations to keep: union or intersection")
+	starters := fs.String("starters", "union", "Sentence starters to keep: union or intersection")
+	ortho := fs.String("ortho", "majority", "Orthographic flags to keep: majority (weighted vote) or or")
+	colloc := fs.String("collocations", "priority", "Collocations to keep: priority (the heaviest model that knows both words decides) or union")
+	out := fs.String("o", "", "Write the merged model to this file, binary if it ends in .bin")
+	fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		return fmt.Errorf("merge takes at least two models")
+	}
+
+	rules := sentences.NewMergeRules()
+	for _, rule := range []struct {
+		value string
+		set   *sentences.SetRule
+	}{{*abbrevs, &rules.AbbrevTypes}, {*starters, &rules.SentStarters}} {
+		switch rule.value {
+		case "union":
+			*rule.set = sentences.MergeUnion
+		case "intersection":
+			*rule.set = sentences.MergeIntersection
+		default:
+			return fmt.Errorf("unknown merge rule %q, expected union or intersection", rule.value)
+		}
+	}
+
+	switch *ortho {
+	case "majority":
+		rules.OrthoContext = sentences.OrthoMajority
+	case "or":
+		rules.OrthoContext = sentences.OrthoOr
+	default:
+		return fmt.Errorf("unknown merge rule %q, expected majority or or", *ortho)
+	}
+
+	switch *colloc {
+	case "priority":
+		rules.Collocations = sentences.CollocationPriority
+	case "union":
+		rules.Collocations = sentences.CollocationUnion
+	default:
+		return fmt.Errorf("unknown merge rule %q, expected priority or union", *colloc)
+	}
+
+	sources := []sentences.WeightedStorage{}
+	for _, arg := range fs.Args() {
+		name, weight, err := parseWeighted(arg)
+		if err != nil {
+			return err
+		}
+
+		storage, err := loadModel(name)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, sentences.WeightedStorage{Storage: storage, Weight: weight})
+	}
+
+	merged := sentences.MergeStorages(rules, sources...)
+	if *out != "" {
+		return saveModel(merged, *out)
+	}
+
+	return writeModel(merged, "", false)
+}
+
+func storageConvert(args []string) error {
+	fs := flag.NewFlagSet("convert", flag.ExitOnError)
+	format := fs.String("format", "", "Output format: json, bin or punkt_tab, by default it follows the output name")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("convert takes a model and an output file")
+	}
+
+	storage, err := loadModel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	out := fs.Arg(1)
+	if *format == "" {
+		*format = "json"
+		if strings.HasSuffix(out, ".bin") {
+			*format = "bin"
+		} else if info, err := os.Stat(out); strings.HasSuffix(out, "/") || (err == nil && info.IsDir()) {
+			*format = "punkt_tab"
+		}
+	}
+
+	var b []byte
+	switch *format {
+	case "punkt_tab":
+		return storage.WritePunktTab(out)
+	case "bin":
+		b, err = storage.MarshalBinary()
+	case "json":
+		b, err = json.MarshalIndent(storage, "", "  ")
+		b = append(b, '\n')
+	default:
+		return fmt.Errorf("unknown format %q, use json, bin or punkt_tab", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(out, b, 0644)
+}
+
+func storageStamp(args []string) error {
+	fs := flag.NewFlagSet("stamp", flag.ExitOnError)
+	write := fs.Bool("w", false, "Write the result back to the model file")
+	lang := fs.String("lang", "", "Language code of the model, e.g. en")
+	corpus := fs.String("corpus", "", "Text the model was trained on")
+	tokens := fs.Int("tokens", 0, "Number of tokens in the training corpus")
+	tool := fs.String("tool", "", "Program that created the model")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("stamp takes one model")
+	}
+
+	storage, err := loadModel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if storage.Metadata == nil {
+		storage.Metadata = &sentences.Metadata{Version: sentences.StorageVersion}
+	}
+
+	meta := storage.Metadata
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "lang":
+			meta.Lang = *lang
+		case "corpus":
+			meta.Corpus = *corpus
+		case "tokens":
+			meta.Tokens = *tokens
+		case "tool":
+			meta.Tool = *tool
+		}
+	})
+
+	return writeModel(storage, fs.Arg(0), *write)
+}
@@ -0,0 +1,162 @@
+package sentences
+
+import (
+	"sort"
+	"strings"
+)
+
+// Span is the byte range [Start, End) of a text
+type Span struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// inside is true for a position strictly between the start and the end of the span
+func (s Span) inside(pos int) bool {
+	return s.Start < pos && pos < s.End
+}
+
+/*
+Constraints are decisions about sentence boundaries that were made before
+segmentation, e.g. by a named entity recognizer or a markup parser.  No
+sentence boundary falls inside a Protected span, such as "St. Louis Blues" or
+an inline code span, and a sentence always ends at a Boundary offset, unless
+that offset is inside a protected span.
+*/
+type Constraints struct {
+	Protected  []Span
+	Boundaries []int
+}
+
+// protects is true when a boundary at pos would fall inside a protected span
+func (c *Constraints) protects(pos int) bool {
+	for _, span := range c.Protected {
+		if span.inside(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// forced returns the token a forced boundary follows in the white space after it, or -1 if it falls inside a token
+func forced(text string, tokens []*Token, boundary int) int {
+	i := sort.Search(len(tokens), func(i int) bool { return tokens[i].Position > boundary }) - 1
+	if i < 0 || strings.TrimSpace(text[tokens[i].Position:boundary]) != "" {
+		return -1
+	}
+	return i
+}
+
+// valid is true for a forced boundary within the text and outside of the protected spans
+func (c *Constraints) valid(text string, boundary int) bool {
+	return boundary > 0 && boundary < len(text) && !c.protects(boundary)
+}
+
+/*
+mark flags the tokens a break after which falls inside a protected span and
+the tokens a forced boundary follows, so annotations can take the constraints
+into account.
+*/
+func (c *Constraints) mark(text string, tokens []*Token) {
+	if c == nil {
+		return
+	}
+
+	for _, tok := range tokens {
+		tok.Protected = c.protects(tok.Position)
+	}
+
+	for _, boundary := range c.Boundaries {
+		if !c.valid(text, boundary) {
+			continue
+		}
+		if i := forced(text, tokens, boundary); i >= 0 {
+			tokens[i].ForcedBreak = true
+		}
+	}
+}
+
+// enforce applies the constraints to the breaks the annotations decided on
+func (c *Constraints) enforce(tokens []*Token) {
+	if c == nil {
+		return
+	}
+
+	for _, tok := range tokens {
+		if tok.ForcedBreak {
+			tok.SentBreak = true
+		}
+		// annotations such as the realignment may have moved the token
+		if c.protects(tok.Position) {
+			tok.SentBreak = false
+		}
+	}
+}
+
+/*
+breaks returns the sentence boundaries of the annotated tokens, along with
+the forced boundaries that fall inside a token rather than in the white space
+after one.
+*/
+func (c *Constraints) breaks(text string, tokens []*Token) []int {
+	positions := make([]int, 0, len(tokens))
+	for _, tok := range tokens {
+		if tok.SentBreak {
+			positions = append(positions, tok.Position)
+		}
+	}
+
+	if c == nil {
+		return positions
+	}
+
+	for _, boundary := range c.Boundaries {
+		if !c.valid(text, boundary) || forced(text, tokens, boundary) >= 0 {
+			continue
+		}
+
+		positions = append(positions, boundary)
+	}
+
+	sort.Ints(positions)
+	return positions
+}
+
+/*
+TokenizeWith splits text into sentences within the constraints of c.
+Annotations see the constraints as the Protected and ForcedBreak flags of the
+tokens, the result keeps to them whatever the annotations decided.  The
+attributes of the tokens are aggregated on the sentences.
+*/
+func (s *DefaultSentenceTokenizer) TokenizeWith(text string, c *Constraints) []*Sentence {
+	tokens := s.annotatedTokens(text, c)
+
+	sentences := sentencesAt(text, c.breaks(text, tokens))
+	aggregateAttrs(sentences, tokens, s.Aggregations)
+
+	return sentences
+}
+
+// sentencesAt splits text at the sentence boundaries, which are in order
+func sentencesAt(text string, breaks []int) []*Sentence {
+	lastBreak := 0
+	sentences := make([]*Sentence, 0, len(breaks)+1)
+	for _, pos := range breaks {
+		if pos <= lastBreak {
+			continue
+		}
+
+		sentence := &Sentence{Start: lastBreak, End: pos, Text: text[lastBreak:pos]}
+		sentences = append(sentences, sentence)
+
+		lastBreak = pos
+	}
+
+	if lastBreak != len(text) {
+		lastChar := len(text)
+		sentence := &Sentence{Start: lastBreak, End: lastChar, Text: text[lastBreak:lastChar]}
+		sentences = append(sentences, sentence)
+	}
+
+	return sentences
+}
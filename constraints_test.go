@@ -0,0 +1,109 @@
+package sentences
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// flagAnnotation records the tokens annotations see flagged
+type flagAnnotation struct {
+	protected []string
+	forced    []string
+}
+
+func (a *flagAnnotation) Annotate(tokens []*Token) []*Token {
+	for _, tok := range tokens {
+		if tok.Protected {
+			a.protected = append(a.protected, tok.Tok)
+		}
+		if tok.ForcedBreak {
+			a.forced = append(a.forced, tok.Tok)
+		}
+	}
+	return tokens
+}
+
+func constrainedTexts(tokenizer *DefaultSentenceTokenizer, text string, c *Constraints) []string {
+	texts := []string{}
+	for _, sentence := range tokenizer.TokenizeWith(text, c) {
+		texts = append(texts, strings.TrimSpace(sentence.Text))
+	}
+	return texts
+}
+
+// span is the span of the first occurrence of part in text
+func span(text, part string) Span {
+	start := strings.Index(text, part)
+	return Span{start, start + len(part)}
+}
+
+func TestProtectedSpans(t *testing.T) {
+	t.Log("No sentence boundary should fall inside a protected span")
+
+	tokenizer := loadTokenizer("data/english.json")
+
+	text := "He leads Yahoo! Answers in Ohio. Run `make. all` first. Then stop."
+	c := &Constraints{Protected: []Span{span(text, "Yahoo! Answers"), span(text, "`make. all`")}}
+
+	if actual := constrainedTexts(tokenizer, text, nil); len(actual) != 5 {
+		t.Fatalf("Actual: %q, Expected: breaks inside both spans without constraints", actual)
+	}
+
+	expected := []string{"He leads Yahoo! Answers in Ohio.", "Run `make. all` first.", "Then stop."}
+	if actual := constrainedTexts(tokenizer, text, c); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+
+	// a span that ends with the sentence does not protect its end
+	c = &Constraints{Protected: []Span{span(text, "Ohio.")}}
+	if actual := constrainedTexts(tokenizer, text, c); len(actual) != 5 {
+		t.Fatalf("Actual: %q, Expected: 5 sentences", actual)
+	}
+}
+
+func TestForcedBoundaries(t *testing.T) {
+	t.Log("A sentence should end at every forced boundary outside of the protected spans")
+
+	tokenizer := loadTokenizer("data/english.json")
+
+	text := "Quarterly report\nSales grew in q3 the board was pleased. Costs.fell too."
+	c := &Constraints{
+		Boundaries: []int{
+			strings.Index(text, "\n") + 1,
+			strings.Index(text, " the board"),
+			strings.Index(text, "fell"),
+			0,
+			len(text) + 10,
+		},
+	}
+
+	expected := []string{"Quarterly report", "Sales grew in q3", "the board was pleased.", "Costs.", "fell too."}
+	if actual := constrainedTexts(tokenizer, text, c); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+
+	c.Protected = []Span{span(text, "q3 the board")}
+	expected = []string{"Quarterly report", "Sales grew in q3 the board was pleased.", "Costs.", "fell too."}
+	if actual := constrainedTexts(tokenizer, text, c); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+}
+
+func TestConstraintFlags(t *testing.T) {
+	t.Log("Annotations should see the constraints as token flags")
+
+	flags := &flagAnnotation{}
+	tokenizer := loadTokenizer("data/english.json").withAnnotation(flags)
+
+	text := "He met St. Louis Blues fans. They cheered"
+	c := &Constraints{
+		Protected:  []Span{span(text, "St. Louis Blues")},
+		Boundaries: []int{strings.Index(text, " They")},
+	}
+	tokenizer.TokenizeWith(text, c)
+
+	if !reflect.DeepEqual(flags.protected, []string{"St.", "Louis"}) || !reflect.DeepEqual(flags.forced, []string{"fans."}) {
+		t.Fatalf("Actual: %q %q, Expected: [St. Louis] [fans.]", flags.protected, flags.forced)
+	}
+}
@@ -1,276 +0,0 @@
-// Code generated by go-bindata. DO NOT EDIT.
-// sources:
-// data/english.json (403.121kB)
-
-package data
-
-import (
-	"bytes"
-	"compress/gzip"
-	"crypto/sha256"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %w", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("read %q: %w", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes  []byte
-	info   os.FileInfo
-	digest [sha256.Size]byte
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _dataEnglishJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xfd\x4d\xb6\xeb\xb8\xce\x2d\x0a\xd6\xb3\x15\xdf\x38\x85\x57\x4a\xc5\x39\xf1\x5e\x8c\x18\x71\x6e\x35\x1b\x90\x6d\x80\x48\x48\xc2\x36\x45\x70\x83\xa4\xbd\xbc\xb2\xf3\x39\x44\xc9\x92\x00\xae\x7b\x6b\x11\xde\xcb\xb2\x44\x91\xf8\x99\x98\x98\xf8\xff\xfd\xbf\xfe\xe7\x7f\xfe\xf5\xff\x95\xb2\xf0\xff\x87\x63\xc1\xaf\xf2\xaf\xff\xf5\x3f\xdb\x67\xff\xf3\x3f\xff\x72\x42\xee\x81\x25\xff\xeb\x7f\xfd\xcf\xff\xf3\x7f\xff\xbf\xf7\xcf\x78\x0c\x34\xa3\xbf\x7f\x24\x20\x38\x8c\xcc\x8f\xfb\x87\x05\xdc\xe3\xad\xfe\x0a\x93\x60\xc6\x58\xa0\x10\x47\x75\x4d\x88\x85\x7e\x57\xbc\x7f\xe4\x38\x04\x8a\xdb\x47\x7f\x1d\x9f\x84\xe8\x6e\xff\x87\x5f\x09\xa2\xa7\x38\xff\xeb\x7f\xfd\xcf\x7f\xff\x3e\x3e\x7c\x43\xae\x0b\x09\xdf\xfe\x2e\x09\x17\x74\x65\xff\xc3\xeb\x21\x4a\x61\x75\xf1\x08\x4f\xca\x05\xe4\xf6\x91\x0b\x28\xea\x89\x16\xfc\x1e\x39\x04\x58\x6e\x7f\x54\x16\x14\x48\x84\xea\x71\x56\x90\x07\x6e\xcb\xf8\xe7\x9f\xff\x39\x1f\x3e\x40\xa1\x27\x06\xb5\x24\x39\x41\x24\xdc\x7e\xf4\x9f\xe3\x13\xfa\x63\xfd\x23\xff\x71\xfb\x81\x9a\xc7\xc7\xfd\xdf\xa3\x30\x78\xf5\x6b\x13\x6c\x37\xb1\x7d\xf2\x59\x86\x04\x32\x43\xbe\x5d\x24\x17\x4c\x6e\xa1\xe0\x05\xa3\xba\x51\xca\x99\xc2\xb6\x0e\x7f\xfe\xe7\xf3\x0b\x63\xbc\xfd\x5c\x2e\x82\x71\x2e\x8b\x5a\xbb\x5a\x42\x7b\xba\xf3\x93\x47\x40\x8a\x2f\x96\xed\xc3\xcf\x3d\x64\x74\x1c\xb7\x5d\xf2\xd7\x79\x57\x01\x4a\xb9\x2f\x9d\x20\xe4\x2a\x7a\xe9\x32\xe9\xdd\xe6\xa1\xb4\xd7\xf4\x9f\x73\x5b\xac\xeb\xf9\xde\xaf\x25\x20\x9c\x3c\xaf\xea\x42\x0b\x49\xc0\xf7\xfd\x7d\x56\xc9\x2c\x6a\xfd\x23\xac\xed\xe7\xff\xfa\xfc\x0d\x3f\x51\x82\xd9\xc8\xe4\x31\x16\x2a\xea\x8b\x9e\x5f\xb1\xbb\x87\x10\xd4\x0d\xa0\x13\x2c\xfa\x7d\xe3\x57\x0a\x6c\x9e\x30\xa1\x3c\x21\xd3\x13\xf5\x59\x01\x4f\xfa\x90\x3d\x68\x1d\xa1\xfd\xc4\x3f\xe7\x5a\x54\x81\x59\x7d\x2f\xd7\x94\x58\xca\xfe\xcd\xf3\xed\xb3\x23\x08\x94\xcb\x7d\x4b\x80\xdf\x16\xb1\xf0\xf6\xb6\xcf\x6f\x43\x74\xdb\xd9\x54\xc7\x98\xab\x5b\xf2\x76\x5a\xb2\xb9\xc1\xb0\xea\x37\xb7\x80\xe8\x03\x1f\x60\x9a\xf0\x7e\xa2\x56\x8e\x9c\x38\xec\x57\xfa\x7c\x38\x92\x94\x25\x05\x70\xea\x41\x38\xe1\xb6\xc0\xe6\x37\xc7\x4a\x41\xed\x3c\xc8\x8f\x7d\x4b\x9f\xbb\x97\xb3\x7a\xa1\x39\x71\xcc\x2c\x7a\x29\x13\x98\x5d\x37\xd5\xb6\x55\xcf\x03\xf4\xda\xdf\xe5\x75\x7c\xe3\x1f\xfe\x0f\x65\x54\x30\xd7\x35\x75\x76\x6c\x5c\x5f\xea\xd8\xd5\x75\xd5\x27\xb3\x60\x8c\x94\xef\x9f\xe0\x17\x8a\xa3\xac\x1e\x7e\xa6\x10\x30\x27\x6a\x07\xf3\xb4\x3e\x20\x6b\x7b\x35\x9f\xab\xbf\xb0\xdd\xd3\x79\x8f\x30\xb2\x79\x8c\x85\x4a\x41\x51\x77\xe8\x20\x2f\x03\x44\x3f\xe4\xc2\x4e\x6d\xf3\x11\xd4\x9d\x8e\xeb\xfd\xec\x78\x28\x30\x8c\x60\x6e\x13\x65\x3d\xf7\xd5\xb5\xc7\x23\x8c\xa1\xdf\x2c\x34\xb7\xa5\xfa\xe7\xba\x59\x10\xaf\x0d\x7e\x2c\x14\x6b\xfb\xe6\x9f\xff\xf9\x3c\x94\xa7\x0c\x29\x31\xc5\x8f\x05\xbf\xd9\xf5\x85\x46\xb2\x86\x3d\x07\x7e\x45\xcc\x7a\x67\x16\xf0\x54\xf5\x6e\x5d\xf9\x1b\xe4\x6e\x8d\x9c\x70\xce\x66\x9b\x08\x3e\xb7\xa3\xbf\x1f\x88\xbf\x6f\x9f\x72\xbe\x7f\x75\x42\xd1\xdb\x20\x81\x3c\x5e\x70\x5f\x3f\x18\x85\x67\x28\xfa\xfa\xb9\xbc\x57\x42\x7d\x62\x52\xe1\xa4\xee\x13\xc5\x2d\x10\x8b\xda\x33\x2b\x14\xb7\x98\x07\x5f\xe1\x7c\xd7\x7f\x9f\xbe\xa2\x60\xdc\x5f\xd9\xb9\xdb\xaa\x70\x42\xd8\x1e\xe8\xcf\xbf\xce\xb7\x13\x71\xe6\x42\xf6\xf6\xf0\x0b\x56\x32\xc7\x1e\xa7\x09\x5d\x31\x2f\xf7\x49\xd9\x1e\x04\x27\xfc\x32\xeb\xdd\x0c\xdc\x75\x2e\x17\x7e\x39\x30\x0b\x9e\xdf\x42\x10\x87\x11\xdc\xa3\xfd\xc3\xe9\xf9\x29\x17\x8c\xfa\x6f\x63\x2d\x02\xf9\x85\xbb\x9f\xbd\xac\xda\x93\x9d\x7d\x90\x89\x65\x24\xef\xb5\xeb\x6b\x66\xf2\x6e\x9c\x28\x82\xc7\xdf\x15\x8a\xf5\xd1\x18\xa6\xc1\x6f\xb1\x8b\x03\xbb\xdb\x36\x9f\xb7\xbc\xef\x0b\x5c\xa3\x47\xc9\xce\x5a\x1c\x7c\x42\x62\xe9\xbe\x2f\xf0\xcd\x32\x6c\x96\x33\xe9\x8d\x81\x18\x86\x24\xec\x6b\x6f\x8f\x49\x1f\xdb\xb0\x3d\xaf\x5d\xfe\x04\x35\x60\x29\x68\xf7\xb7\xf9\xf9\x51\xe0\x9b\x02\xe9\xed\x30\x09\xbe\xee\x76\x87\x62\x76\x52\x0b\x8c\x14\x8c\x13\x1c\x43\xcd\x05\xb5\x47\xf5\xb4\x62\xdc\xb6\x43\x30\x87\x70\x0e\xf0\xdd\xd6\xfb\xcf\xeb\x4f\x63\x5e\x59\x50\xc7\x29\xa0\x9c\x68\xaa\x29\x61\x41\x63\xc4\xb6\x6b\x0f\x35\x2d\x1c\xb6\xdf\xd7\x2b\xbd\x80\x08\xbf\x6e\xd7\xfc\x05\x09\xe2\x90\xcb\x3b\xdc\x7f\x89\xd6\x24\xfc\xd4\x9b\x1b\xea\xbc\x62\x2c\x7a\x75\xf3\x3d\x84\x71\x1c\x9f\x28\x85\x0e\x03\xf7\xb9\xd8\x5c\xcb\x84\xe1\xbe\x63\x47\x28\xc5\x18\xc1\x66\x4b\xda\x27\xff\x9c\x81\x5b\x28\x28\xe6\x9d\x6c\xa1\xd4\x88\x32\xeb\xb5\x4a\x42\xab\xda\xad\x6e\x41\xf7\x18\x1e\x9d\xfd\x4b\xc2\xb3\x60\xce\xfa\xb7\x8b\x70\x22\xa7\x1f\xd6\xf5\xe1\xa8\xe0\x8b\xe5\x61\xae\xb8\xd4\xf8\xb0\x8b\x5c\xe0\xb1\x07\x8d\xd7\xd2\x15\x1e\x89\x67\x81\xb4\xe8\x18\x80\x22\xca\x69\xeb\x4f\x2f\x0d\xe6\xd0\x14\xf8\x32\xa6\xa0\x86\x40\xcf\x7d\x67\x5e\x3b\xf1\x77\xa5\xb6\x5f\xfe\xbe\x65\x01\x01\x48\xc7\xb4\x65\x54\xfb\x1e\xa2\xb6\x80\x9e\x0a\x2b\x4f\x87\x21\x10\xab\xc8\xf4\x57\x8d\x8f\x61\xdc\xc3\xd7\xbb\x17\xe9\xe2\xce\x17\xc4\xf1\xe6\xd4\x02\xde\x37\x1e\xac\x28\xe4\xf4\xe1\x1a\xb7\xe8\xdd\x41\xfe\xbc\xb6\x7f\xae\x20\xc8\xbf\x70\x0f\x3a\xae\x25\xc8\x05\xc8\x86\x99\x30\xcf\x82\xf3\x1e\x15\xdf\xf2\x88\xb0\x19\x7b\xbb\x97\x28\x16\x0e\x28\x9b\x3f\xbe\x7f\x5c\x31\xdd\x6e\xfa\x85\xbb\xe3\xbf\xcc\x7e\x11\xf0\x64\xed\x0e\x6c\x1b\x43\xe7\x5f\x24\x60\xff\xea\xc1\x71\x6e\x71\x82\x3a\x0c\x08\x6e\xb9\xaf\x78\x40\xc8\xea\x98\x2d\xe4\x5c\x4d\x7a\x07\xac\xec\x51\x3a\x6b\x0c\xb2\x62\xdc\x2d\xd6\x5f\xb7\x37\x6a\x0f\x51\x8d\x6e\x41\x7d\x6b\x1e\xc3\xf4\x4d\xbf\xc2\x3d\x1e\xad\x6e\x19\x02\x1c\x87\xed\x8a\x3d\xbf\xde\xb7\xf5\x49\x28\x99\x63\x44\x65\x97\xdc\x22\x94\xcb\xaa\x92\xac\x4f\x00\xa3\x02\x49\x18\xdd\x50\x9e\xf7\x5d\x21\xac\xa3\xa8\x75\x0b\xad\x33\x46\x75\xa5\x75\xe5\xa8\x1f\x7c\xe6\x52\x30\xaf\xfa\x44\x3c\x20\x84\xbc\xe8\xe5\x10\x4c\x40\x2a\x0c\x7c\xc3\x4b\xad\xc4\xc8\xee\x1b\x1f\xf7\xe4\x97\xdc\x66\x4b\xd5\x45\x0a\x04\xfa\xbc\xde\xff\xde\x02\x8f\x05\x57\x28\xc6\x96\x78\x8c\x34\xf7\xde\xad\xd0\xbc\x74\x7e\x3b\x43\xf4\x02\xf7\xd7\xe7\x38\x72\x0b\x36\xaf\xa8\x6f\x7c\xa7\x17\xdd\xfe\x66\x41\x71\x55\xdb\x5c\x8f\x0e\xbc\xde\xd6\x11\xb3\xbb\xa7\xfe\xfe\x1d\x61\x25\xa7\x42\x01\xe1\x68\xc3\x97\x4c\x73\xec\x5d\xe8\x0a\x1a\x7d\xc8\x9b\xfd\x1e\x92\xc9\xb7\xb6\xd8\xe9\xfe\x57\x90\x12\x84\x60\xbd\x3c\xd6\x11\xef\x79\x56\x81\x39\x10\x8c\x15\xd5\x23\x66\x2a\xe6\x58\x83\x73\xb8\x9d\xdf\xe3\x35\x9c\x49\xf0\x93\xb6\xb7\xa3\x9d\x71\xe4\x38\xcc\xc2\xce\xf8\xe3\x24\x38\xbc\x58\x82\x7a\x07\xe4\xd4\x26\x85\x35\x62\x6e\x57\xbb\xc7\xe2\xc6\xac\x2e\xbb\xe5\xbd\xa3\x39\x81\x9c\xb9\xe1\x2d\x34\x0e\x75\xbb\x5d\x10\xb2\x2e\x08\x62\x06\x67\xa3\xe9\x91\x38\xf0\xfc\x36\xce\xaa\xe8\x8b\x4e\x14\x21\x3a\xbd\x8f\x6a\x84\x27\x50\xb0\xb6\x8d\xe2\x16\x33\x6d\x7f\xad\x5e\x3b\x6d\x9f\xa8\x8f\x7e\x57\xd8\x02\xc0\xa1\xf0\x70\xfc\xa7\xb2\x63\xe3\x1d\xe4\x19\x59\x9b\x92\xbc\x7d\x03\x87\xc2\x66\x6b\xef\x91\x22\xd8\xf7\x4f\x71\xf3\x1d\xe5\xfe\x06\x5b\x98\xe8\x02\x98\x44\x05\x0f\xdb\xae\xc3\x57\xc1\xac\xcc\xa7\x5b\x68\x4d\x10\xbf\x51\xaf\x70\x84\x52\x05\xc2\x6e\x36\xce\x68\x0e\x72\x7b\xca\x05\xe2\x80\x5f\x09\x9d\xb9\xf8\xe6\x74\x86\x52\x25\xa2\x1f\xb8\xaa\x75\x9f\x12\xdc\xcc\xe0\x5a\x23\x7f\xdf\x6d\x0f\x97\x02\x2f\xe8\x8c\x3d\x87\xcc\xca\x46\xe5\xb2\xb9\x25\x13\x57\x39\xfe\x36\x91\x47\x4b\xd4\xa9\x05\x99\xfb\xce\x39\x8d\x8e\x27\x98\x23\x9b\x88\xe6\x9b\x59\x7f\x3f\xc0\x57\xe7\x8d\x9a\x4f\x94\xda\xe7\xe9\xed\xa9\x1b\xe6\x60\x16\xc3\xcf\x98\x0f\x64\xf0\xcf\x0b\x72\xaa\x62\x7e\x7d\x4f\x54\xee\xe6\x08\x3f\xb9\xcc\xcd\x1b\xcc\x81\xd7\xe6\xc2\x8c\x61\x71\x9e\xeb\x0c\x77\x24\x27\xd7\x31\x3b\xa1\x64\x1f\x80\x6b\xb6\x46\xd5\xff\xb1\xdc\x91\x08\x88\x65\xe1\xf8\xbe\x01\x6f\xaf\x23\x5b\xbc\x8c\x36\xd7\x58\xc2\x1e\xb1\xfd\x73\xae\x69\xd4\x5e\x7f\x24\x69\x3e\xfa\xc4\x96\xde\xf1\x1e\x6e\x2e\x10\x67\x93\x80\xb7\x58\xef\x70\x04\xe7\x69\x86\x18\xf5\x41\x12\x74\x82\xed\xc5\xe8\xc0\x9e\xab\x39\xa2\x2f\xd6\x08\xd8\xc8\xeb\x08\xe2\xf5\x0b\xf2\xfc\x52\x81\x05\x1f\xae\xfb\x74\x64\xef\x3b\x16\x84\x4f\xf0\x06\x88\x60\x3f\xef\xb9\xc4\x3f\x97\x3b\x9a\xb8\x05\xa7\x7f\x5e\x0e\x39\xe0\xe6\x6d\xb4\xb5\x99\xb6\x23\xab\x33\x50\x7e\x0d\x01\x67\x8c\x1e\xb4\xcd\x75\x0c\x61\x68\x80\x6f\xfb\xc2\xe9\xc1\x85\xf0\x89\x59\x61\x38\xb8\x36\xc7\x69\x90\xef\x38\xa1\x60\xd4\x46\x7a\xf3\x47\x05\xa3\xb5\x6c\xbe\x7e\x83\xc2\xbf\x02\xb8\xc7\x96\x2f\xab\x2b\x62\xc0\x51\x0e\x87\xf1\xb9\xe0\xaf\x3a\x2b\x30\x96\x92\x81\xe4\xdf\xc5\x62\x0c\xb4\x26\xd6\x78\x02\x27\x63\x4f\x46\x81\x18\x77\x64\xe9\x8a\x52\x28\x78\x50\x79\x5e\x12\x9e\x30\x77\xa8\x41\x12\x5e\x29\x1a\x53\x01\x93\x34\x1f\x7e\xa5\x01\x1e\x41\x3d\x9d\xd0\x4a\x11\x82\x76\x37\x2d\xf0\x35\x8b\x05\xd5\x13\xff\xfb\x49\x1e\xf9\x7e\xc1\x17\x48\xb4\x86\x60\xd4\x8b\x1c\x74\xf0\xb1\x52\x77\x2e\x51\xdd\xa3\xd4\xb2\xa8\xbc\xf6\x1d\x28\x2f\xda\xd6\xaf\xe4\xb8\x79\x2b\x96\x01\x7f\x57\x4a\x36\xf9\xcc\x6e\x21\x14\x65\x27\xe8\x6b\x70\xef\x40\x9b\xf7\x50\xce\x19\xad\xd3\xd8\x7c\x46\x9d\xa6\x60\x60\x88\x58\x20\xce\xe6\x43\xc8\x89\x3c\xe5\x22\xa0\x0f\x68\xf1\xf6\xa2\x09\x65\x42\xa7\x9d\x04\xd7\x1d\xc0\xc0\xce\xe3\x69\x38\x7c\x01\x31\xde\x2e\x61\xca\xd4\x02\xb6\x33\x3d\x7a\xb1\x64\x8c\x26\x6e\x40\xc8\x6c\xa0\x1b\x16\xd4\x3b\x7c\x04\xf7\xf0\xa2\xa1\xb3\xe7\x1e\xe6\x5c\x95\x8d\x07\x05\x1e\xdf\xc6\x1c\x63\xab\x81\xe8\x1f\x58\xaa\x8e\xf2\xc6\xf7\xbc\xfb\x84\x9b\x11\x7f\x2b\x7c\x18\x26\x39\x82\xee\xf3\x26\xe1\x2d\xac\x97\x00\xd2\x16\xeb\xd0\xb7\x2d\x9e\xa9\x10\x9b\x85\x66\x8a\x5d\x2c\xfd\x0b\x56\x20\x9d\x1e\xe2\x07\x62\xb8\x40\x35\x98\x50\xe3\x8e\x39\x99\xd8\x7f\x5b\xf1\xc8\x61\xba\x47\xd7\x53\x8d\x5d\x5c\x96\x5f\x18\xf7\x65\xff\xac\xde\x42\x25\xa8\x0c\x90\xc5\x60\x35\x27\x5e\xa5\x7c\xc2\x83\x82\xd9\x70\x23\x93\xfa\xa2\x50\x4a\x1d\x62\x7c\xd4\x0a\xb3\x39\x10\x75\x85\x18\xb5\xff\xa3\xa1\x06\xa7\xcf\x83\x0b\x20\x14\xb1\x18\x3b\x28\x98\x39\x3c\xcd\xcd\x90\x94\x65\xd8\x02\x5e\xe1\xa0\x2d\x19\x42\xbd\xe7\x81\x50\x75\xe2\xe6\x6a\x2e\xbc\x1a\xdb\x4f\xf1\x89\xb9\x6c\x27\x79\x18\x21\x3e\xee\x58\x85\xdb\xfc\x30\xca\xb6\x05\x9c\x36\x36\xf8\xf0\xf0\xd6\x85\x04\xc8\xdb\xfb\x55\xf0\xa4\x27\x57\x3a\x13\xb1\xd4\x68\x6c\xd7\x5c\x47\x94\x08\x85\x85\xf4\xfb\xd9\x16\xe5\xf0\x2a\xe7\x4f\x93\x7b\x04\x85\x96\x95\x17\xc6\xf2\x1e\xa6\xbd\x1e\x75\xae\x72\x29\xd8\x81\x1f\x05\x65\xfd\x61\xa7\x26\xa0\x38\xd5\xa0\xb3\x57\x5a\xc1\xe4\x66\x94\x93\x4e\xa9\x21\x67\x95\x03\x43\x75\x90\xeb\xdd\xea\x7e\xa3\xb0\xdd\x25\xf0\xb6\x4b\x32\xe2\x4c\x51\x1d\x8b\x2d\xbb\x09\xf7\xc7\xce\x89\xcd\xbb\x5c\x41\xe8\x57\x85\x08\xda\x87\x48\xa9\x06\xf9\x82\xf8\x45\x5c\x4d\x49\x25\xd0\xc4\x12\xa9\x0f\x86\xf7\x22\xe8\x20\x10\x1f\xb6\xb4\xc0\xbf\xd0\x15\x32\xdb\xfe\xcd\x55\xd7\x5a\xd7\x1a\x0a\x0d\x6f\x03\xd1\x44\x7a\xa0\x02\x1b\x43\x75\x0b\xe9\x82\x6f\x03\x01\x4d\xbc\xe2\xee\xc1\x91\x08\xb9\x87\x3e\xe2\x59\x70\xbe\xfd\xaf\x38\xb8\x1b\x9c\x76\xd4\x5c\xa0\x75\xcb\xe2\x9c\xc9\xed\xb6\xa7\x61\x9b\xf3\x61\x40\x57\xc4\x38\xf9\xf0\xd6\xf9\x81\x70\x2d\x14\x2d\xe8\x5e\x40\x1e\x1a\x42\xea\x60\xac\x85\xc7\xd1\xfc\x20\x38\xd7\xaa\xb5\x7a\xe3\x43\x29\x02\x1d\x05\x60\x44\xd1\x6b\xed\xe8\x7e\xde\xb8\x96\x64\xf3\x83\xec\x04\x31\xa6\x00\xea\x5e\x39\xc3\x03\x14\x76\xb2\xe2\x3a\x7e\x82\x8f\xcb\x36\x17\x9d\x31\x8c\x00\x61\x54\x30\x0c\x8f\xd9\xa1\x5d\x89\xb1\x8e\x5d\x8a\xfb\x29\x54\xa8\x7b\x03\x75\x0a\x4a\xfd\x42\xcf\xe6\x25\xad\xeb\x0e\x31\x5e\x87\xd0\x44\x4d\x9e\xeb\x18\x70\xd8\xd2\x20\x1d\x0f\xb1\x3c\xd4\x91\x1a\xab\xc4\x05\x56\x55\x4f\xe6\x90\x96\x5b\x1e\x22\x34\x2f\x65\x78\x59\xe2\xc5\xd3\xf8\x77\xd1\xbb\x61\x86\x5c\x84\x13\x6b\xb8\x19\x23\x8b\x0e\xc7\x3d\xca\x4f\xa8\xc0\x11\x4f\x1b\x7f\xee\xa0\xdc\x6d\xcf\x0a\x59\x33\x55\xfe\xc8\xe9\xfb\x5e\x6e\xc2\x9c\x07\x8f\x4f\x0c\x6c\x30\xc9\xcd\x0e\xae\xa9\xe8\xfa\xf5\x5b\xa2\x2a\xd4\x72\x76\x8a\x47\xb2\xb6\x14\xdc\x38\x53\x1e\xf7\xf2\xe3\xb9\xf2\x82\x63\x7e\xa8\x92\x2b\x42\xd0\xc5\xe3\xdd\x6d\x5e\x41\x47\xd8\xde\x93\xf6\x7b\x8c\xa2\x43\xde\xf0\x47\xfa\xe3\x7e\x73\x6e\x61\xc1\x1d\xa7\x37\x45\x95\xc4\xe1\x9d\xcb\x5b\x30\x1a\x46\x81\xb8\x05\xc4\x04\x44\x0c\xe2\x85\x79\x55\x9b\x29\x25\x8b\x55\xeb\x1d\x98\x20\xa2\xde\x6f\xe3\xb0\x39\xbf\xed\xfe\xce\x92\x35\xc4\xd9\xe2\xc0\x82\xc3\xc4\xae\x1a\x73\xe6\xc9\xa1\xb6\x19\x75\x6c\x38\x0b\x81\x71\x3e\x63\x17\x81\x84\xed\xe5\xaa\x28\x25\x50\x2a\x06\xb7\x58\x34\x1c\xc2\x4f\x14\x41\xe8\x0a\x72\x9b\x3f\xcf\x85\x9c\xb6\x39\x65\x0b\xc9\x55\xce\xf3\x0b\x9a\xb9\x7d\x6e\x37\x73\xff\x98\xab\x44\xb0\x19\x9b\xd8\xd0\x07\x5c\xab\x7d\xa8\x43\x38\x71\x08\xfc\x1a\xaa\x8a\x7a\x27\x1a\xcd\x8b\x9d\x19\xac\xeb\xde\xf6\xd6\xb0\x82\x2d\xf5\xf8\x75\xa6\x49\x11\x4c\xd6\xdd\xac\x9c\x4e\x03\xa6\xbb\x05\xab\x62\xab\x13\x49\xd0\x91\x0d\xa7\xdd\x42\xca\x54\x38\x5e\x3d\x14\xb8\x07\xfe\x5b\x60\x67\x72\xe0\x13\x3d\xa9\xa2\x3d\xc3\x96\x4a\x59\x07\x84\x11\xa6\xa0\x0a\xee\x5b\xda\xa0\x0e\x8f\xcc\x10\xe9\x5b\xfb\x0b\x17\x38\x9b\x4c\x62\xfb\xc5\x04\x6f\x93\x5f\x31\xa7\x7c\x94\xd7\x2f\x48\x44\xca\x0e\xc7\xff\x7d\x46\x5e\x31\x2f\x6c\x70\xae\x06\xa4\x1a\x7a\x16\x17\x5b\x6a\xff\x63\xfa\x83\x96\x5a\x8a\x02\x07\x12\xb4\x32\x46\x36\xc7\xf1\x89\x6f\xfd\x60\x3d\x0b\xc2\x6f\xf7\xc6\x3a\x07\x9d\x20\x38\x8e\xa3\x90\x9f\x51\x79\xab\x89\x22\xae\x76\xbf\xf1\x6a\xec\xb4\xc3\x58\xd8\xb1\xa8\x20\x63\xcb\xa7\x6b\xb6\x1c\xa7\x56\xad\x77\x16\xd3\xc8\x76\x8b\xb3\xf7\x6f\xe5\x0f\xa5\x01\x85\xf7\x5f\xd8\xfc\x8e\x75\xfb\xdb\x12\x6f\x2b\xad\xb2\xa3\x1a\x69\xf7\x10\xe7\xbd\x39\x78\x62\x9c\xcd\x51\xd8\x92\x49\x1d\x2c\x0a\x83\xaa\x95\xfc\xaa\xa2\x56\x17\x46\xde\x32\x77\x5b\x13\xc5\xf8\x40\x95\x8d\xb9\xcd\x3c\x98\x4a\x34\xcd\xcb\x10\x68\xa5\xa2\x63\x22\xaf\x77\x43\xe4\x38\xa4\x3a\x06\xbd\xa3\xf3\x02\x01\xc2\x1d\x80\x4d\xc2\x13\xe9\x02\xf2\xb6\x19\x0e\xe2\xd8\xc5\xf9\x78\x62\xb4\x69\xfb\x16\x97\x15\x13\x5e\x59\x93\x28\x35\xf2\x34\xa9\xaf\xd5\x7b\xac\x90\x17\xec\x0a\x1b\x23\x8c\xef\xc0\x47\x39\xee\xfc\x5a\xbe\xd3\x34\xb7\x6d\xa3\xcc\xa0\x5b\x70\xdd\x8c\xa5\x89\xf7\x56\x2a\x8b\x0a\x60\x97\x63\x7b\x5c\xef\x0e\x3a\xff\x92\x20\x7a\x5c\x39\x92\x36\x1e\x01\xea\xbc\xa0\x21\x17\xad\x13\x4b\x57\x26\xdc\x6c\x25\x04\xfa\x36\x7b\x35\xbd\x5b\xf0\xa2\xbc\x10\xae\x69\x0f\xb1\x2f\x07\x11\x58\x57\xe3\x1c\x57\x8d\xa1\x39\x3e\x48\x1c\xda\x9a\x94\x45\x10\x87\x5f\xd5\xcf\x26\xae\x7b\x92\x01\x27\x26\x90\x0f\xb7\x53\x65\x04\xa5\xea\xd2\x17\x7e\x15\xea\xa9\x7b\x13\xcd\x36\x7d\xf9\x46\x08\xdd\x89\x0d\xa6\x44\x3e\x5a\x77\xbf\xf2\x48\xc7\x2a\x9d\x79\x22\xaf\x50\xd8\x20\xdb\x74\x84\x69\x17\x5c\x75\xe0\xaf\x27\xfd\x2d\x81\xe1\x60\x60\x0f\xbe\xa0\xac\xaa\xa0\x39\x0b\x8c\xc6\xa1\x6d\xe9\xa8\xda\x3e\x2c\x3a\x0d\x9f\x05\x7c\xfd\x6c\xb2\xf3\x2c\x37\xf2\x52\xe7\x0a\xa3\x2e\x98\xb1\x7e\x86\x04\x05\xa3\xa1\x71\x8e\x18\x71\xfa\x81\x7a\x81\x1e\x0a\x8b\xb5\x7b\x45\x4e\x16\xcf\xe9\xba\x13\x87\xbd\x4e\x76\xbf\xbf\xe5\x9d\x50\xca\x4e\x9f\xd1\x36\x7f\xbd\xd7\x8e\x30\x17\x18\x37\xa3\xd4\x19\xed\x4c\x45\xbd\x48\xb7\x99\xd4\xdd\x04\x5e\xb5\x55\xc1\xf3\x02\x5d\x9d\x92\x93\x2d\xd7\x16\x14\x61\xa1\xac\x0e\x58\x61\xbb\xaf\x04\xec\xaa\x36\xb4\x41\x91\x67\x78\xfc\x81\xce\x10\xea\x9e\x59\x5c\x9b\x76\x0f\x6a\xf4\x0a\x60\xcc\xd5\xd6\x7a\x7f\x57\x1d\x71\xad\x30\xc3\xf7\x87\xaf\x76\xd6\x8e\x30\x90\x03\xf7\xd6\xdb\x34\x68\x64\xfd\xff\x5a\xff\xbd\x82\xf2\xed\xd9\x81\x06\x15\xf0\xab\x6d\x55\xb5\x97\x85\x52\xc0\x41\x01\xc5\xd9\x60\x11\x4f\x3e\x4c\xf1\x9d\x92\xf7\x43\xa5\xdc\x71\x6c\x86\xd2\x02\xae\xc7\x09\x31\xe6\x9c\xc9\xbe\xf7\x6d\xcf\x94\x8e\x42\xfc\xea\xea\x27\x14\x35\x48\x00\x31\xe0\xbb\xf0\xeb\x9e\x9a\x3c\x28\x29\xa4\xde\xdf\xfe\x2d\x22\xc8\x30\x5b\x27\xea\x99\x82\x49\xc4\x1d\xc4\x21\xf7\x95\xd0\x05\x73\x3e\xc2\xec\xcb\x1f\xb0\xe3\x70\x14\xc9\x6e\xf4\x40\x84\x62\x6c\xf8\x8c\xbc\xdb\xa5\xf3\xbb\x05\xb7\x3c\xd0\xae\xa5\xa0\xa7\x9e\xac\xf6\x82\x60\x30\x18\x27\x94\x73\xa3\xc5\xa9\xb5\xbc\xf8\xd3\x57\xd6\xb7\x72\xde\xf2\x25\x1d\x87\xdc\x01\x7c\x4d\x16\xae\x3d\x6e\xb4\xb7\x2b\xfc\x40\xa2\x13\xcc\x29\x6c\xcf\xa1\x77\xce\x44\x91\x4c\xff\x04\xc7\x2d\x0f\xaa\x16\xb5\xa8\x9a\x1b\x5a\x9a\xd7\xbc\x91\x88\x56\x2a\xbc\xaa\x8e\x08\x46\xc3\x71\xdb\xe2\xa4\xa1\xa0\xd8\x10\xdc\xb3\xf5\x6f\xa2\x61\x33\x10\xdb\x34\xe1\x71\xf3\x82\x26\xb6\x98\x05\x51\x73\xf1\x05\x5d\x47\x01\x9a\x97\xff\x53\xa1\x1a\x1c\xf8\x6d\xc5\x8f\xba\xeb\xcd\xd2\x08\xce\x35\x80\x35\xa6\xb0\x6e\x61\xb1\x81\xea\x60\xb4\x27\x74\xad\xd9\x99\xf8\xf2\x8f\xfb\x16\xfb\x65\x7a\x40\x72\x0a\x86\xbf\x3f\xeb\x3c\x43\xaa\x87\xa8\xb8\x2b\x40\x3a\x08\x15\xeb\x97\x46\x2e\xe5\x38\x29\x97\x99\xce\xe0\x2d\x8f\xb9\x51\x28\xb6\xed\x69\x43\x62\x38\x77\xf1\x19\xc6\x42\xd2\xc4\xb3\x95\xc2\xd2\x3a\x6a\xee\x39\x52\x00\x59\x6d\x17\x83\x60\xfc\x36\x0c\x92\xa3\x28\x67\xf9\x48\x14\xe7\x01\x5e\x1a\x0b\x7b\x42\xd5\x04\xfd\x95\x9c\xf0\xb0\x37\xc4\x74\xb4\x88\x89\x65\x6d\x2f\x6e\xc8\xaf\x3e\xba\x7b\x2d\xb8\x23\xc4\xa7\x3b\xab\x02\x0e\x14\x8a\x2c\xa0\x90\x50\x58\x13\x4a\x86\x78\xc7\x60\xd7\xb7\xcd\x16\x37\x33\x3b\x50\x74\x5b\x8a\xa8\x69\x22\x10\xa3\xda\xa5\x3b\x82\x7f\x63\xef\x07\x74\x0d\xf4\xb8\x08\x7d\xb5\x58\x0a\xec\x96\xb4\x84\x23\xad\xb8\xe7\x81\x68\x0b\xc8\x45\xe0\xd9\x9e\xf0\xe2\x97\x0a\xac\xf6\x8d\x34\x96\x7d\xec\x49\x75\x1c\xab\x29\x19\x48\x09\x98\x3f\xf8\xc2\x3f\x97\xe1\x39\xcf\xd2\xe9\xf1\x70\x65\x81\xe8\x4d\xd8\xbc\x17\x35\xf3\x0f\xef\xc8\x44\xeb\xb3\x90\x71\x2b\x49\x63\x25\x99\x56\x0a\x20\x7b\x46\x7e\x39\xf1\x22\x18\x3b\xe2\x7a\x4e\x48\x5e\xe3\x92\x73\x30\x7d\x3a\x9e\x79\xb5\x5c\x1b\x57\xe5\x43\x15\xb9\x4c\x6f\x11\xfa\x65\xfa\xd5\x46\xb6\x21\xb4\x87\xdd\x18\x9d\x86\x6d\x0b\x86\xf2\x54\x2d\x80\xb3\x9a\x50\x7c\x3d\x1b\x66\xce\xb3\x4d\x86\x2e\xbc\x45\xce\x59\x6f\xaa\xc4\x81\x3e\xf0\xd0\x7f\xff\xb9\xbc\x4c\x2c\x10\x91\x14\xed\x6a\x45\x83\xc7\xa6\xcd\xe2\x2a\x37\x72\x2c\xea\x9f\xff\xf9\xfb\x16\xaa\xf8\x76\xf1\xd3\x79\x6e\x0b\xdd\x13\x47\x72\x75\x0f\x9b\xf2\x05\xcc\x03\x6e\xaf\xd8\xd9\xb0\x0c\x26\x78\x1f\x0c\xf2\x7f\xce\xdd\xff\x29\x1f\x5d\xc7\x0f\xc1\x9b\x75\xf3\xd8\x7c\x5c\x17\xe2\xac\x35\x92\xa3\x04\x41\xc7\x50\x10\xf3\xb6\xc3\xba\xa0\xbc\x45\xea\x3a\xa0\x69\xdc\x3a\x70\x0b\x81\x2a\x70\x0a\xba\x07\x2a\xf2\x62\xc4\x57\x4e\x72\xbc\x9a\x93\x16\xa3\x77\x54\xf9\xc3\x2f\x2a\x4f\x85\x24\xe4\x1c\xb1\xfa\x6c\x55\xbd\x67\x23\x84\x42\x07\x63\xfd\x62\x8b\xb8\x59\x57\x24\xdd\x94\xd4\xfd\xf1\xe3\x43\xdb\x3c\x6b\xbc\x82\x0e\xe9\xd9\xb5\xc9\x78\xca\x3f\x04\x08\xb9\x55\x51\x3a\xae\x36\x84\xa2\x8b\x42\x29\xec\xb4\x88\x73\x63\x14\x76\x8f\xc1\x81\xa6\x1d\x6c\xb1\xf5\x8d\xcc\x73\x7a\x5f\xae\xa2\xaf\xef\x5a\x57\xa1\xe6\x40\x8e\x5d\x0c\x95\xa1\x99\xfb\xbf\x0c\xad\xbe\xcb\x4c\x26\x14\x4f\x51\x1b\xe6\x1f\xde\x3b\x8f\xd9\xd9\xc8\xc9\x0b\x3c\xc9\x1b\xba\x15\xd4\xc2\x43\x0a\x26\x4a\xcf\xa5\xae\xa3\x8d\x39\xf3\x96\xca\xdb\xcf\xd6\x1d\x7d\xbf\xbc\x36\x16\x12\xfc\x94\x12\xcf\x25\x7c\x60\xea\x90\xdd\x4c\xab\x01\x8a\xa9\x38\xdd\x7b\x88\x39\xa1\x23\x4b\xc5\xcb\x0b\x4c\xaa\xd7\x13\xa4\x7c\x9a\xa7\xce\xa8\xa5\xfc\xe0\x26\xb6\x07\x10\x4e\x9a\xf7\x43\x8a\x50\xef\xd1\x53\xd7\xf7\x92\x4b\xf7\xbe\x16\xc8\x0f\x9d\x04\xec\xfc\x57\x4b\x66\xf9\x72\x0d\x88\xb7\x81\xc6\x78\x14\x22\xef\xdc\x71\x1d\x3d\x2f\x40\xa3\x82\x7a\x83\xea\x3a\x9c\x60\x94\xfe\x46\x0f\xd0\xf4\xf4\xd8\xce\xd5\x10\x60\x5e\x54\x5c\x19\xe8\xd9\x53\xd9\x0f\xff\x14\x0d\x78\x9e\x50\xdb\x99\xec\x6c\xc7\xdf\x0b\x5a\xfa\x5b\xf8\xf2\xac\x27\x61\x21\xd2\xa4\x2d\x38\xca\x13\x25\x9b\x03\x38\x7f\x72\x16\xd5\x6e\xb5\x33\xdd\xf4\x5f\x4e\x64\xab\x32\x2f\x0c\xc1\xf1\xaa\x92\x99\x95\x47\xfc\xba\xef\xab\x06\x56\xda\xea\x76\xa2\x5c\x34\x4f\x21\xb3\xe8\x3d\xb4\x54\xd1\x44\x06\x4a\x6d\xa7\x35\x1b\x74\xd1\xf7\xd8\xf0\xd0\x23\x3e\xc1\xab\x82\xef\x1c\xc0\x90\xd0\x76\x2c\xa3\xda\x94\xcb\xc2\xb1\x01\x52\xee\xa0\x21\xca\xb6\x17\x91\xe2\xd4\x10\x5f\x6f\xfc\x6e\x74\x38\xb1\xbc\x4c\x7f\x60\xa6\x2f\xd5\x72\xe2\x02\xac\x1a\x6c\xa1\x10\x86\xe9\x87\x43\xb0\x5a\x86\x5f\xe2\xb0\x9b\xa2\xbf\x6f\xce\x7d\xd8\xe2\x36\x73\x87\x18\x85\xba\x80\x25\x05\xb0\xee\xb2\x1d\x51\x1b\xae\x0b\x4e\x75\xd6\xa4\x63\x50\x7d\x83\x2f\x8a\x67\x8f\xeb\x3f\xb7\x2f\xc9\xd8\x63\x33\x8e\xd7\x04\x76\xdd\x6b\x6c\x61\x90\x26\x9e\xae\xe4\x9d\x0a\x52\x1e\xd1\x74\x7a\x62\xd2\x51\x4c\x43\xde\xef\xa4\x48\xc1\x95\xfa\x60\x95\x3a\xfc\x37\xa1\x25\x9f\xac\x48\x28\x47\x2f\xd4\xc5\x35\xcd\x33\x1b\x44\xd1\x41\x84\xce\xa6\x3f\xf1\x5b\xaf\x9f\xdb\x37\xff\x5f\xd7\x22\x84\x00\x23\x5b\xc2\xe4\xc1\x44\xbd\xd7\x9e\x71\x6f\xef\xe3\x49\x31\x68\x22\x3b\x5b\x98\x48\x10\x70\x0b\x95\x74\x0f\xc5\x8b\x3c\x1e\x36\xf1\x6a\x0d\xff\x34\x33\x5f\x65\xe2\xa8\x1b\xf1\xe7\xed\xf8\x6b\xa2\xe0\xc2\x92\x31\x2f\xac\x89\x0b\x10\x32\xdf\x1d\xc1\xb8\x59\x8c\x85\x59\x05\xf8\xef\x23\xf9\xb8\x4e\xcb\xad\x19\xf5\x32\x63\x8d\xc6\xd1\xd7\x13\x95\x53\x41\xe1\x9c\xc0\xe1\xbf\x0b\xba\x25\x76\x84\xfb\xa3\xa7\x5e\x73\x6b\x22\xc7\x41\xb0\x95\x35\xac\xd1\xfd\xc5\x0f\x83\x84\xa0\x3d\x38\x60\x32\x84\xcd\x2b\x7b\x6d\x15\x1f\xe4\x1e\x86\x2b\x0c\x05\x7e\xb9\x6f\x78\x68\xa6\xe8\x41\x2a\x1b\x7e\x57\x2e\xa0\xed\x6a\x84\xb8\xf9\xd9\xe1\x96\x4a\xaa\x70\xe2\x89\xe2\x0c\x0f\x31\xc0\xdb\xf0\xdc\xb7\x60\x33\xfa\x4f\x8f\xed\x55\x7c\xcb\xb9\x2f\x20\xd5\x78\x30\xc5\xba\xc6\x83\xc6\xb2\x06\xd2\xdc\xb7\xbc\xf0\x6b\x8f\xfd\xae\x95\x21\x49\x1f\xc0\xfd\x0a\xca\x38\x2d\x6c\x5c\x2d\x0c\xff\xf7\xdd\x91\x56\xb1\x60\x46\x8d\xcd\x22\xfe\x75\x19\x83\x05\xe4\x11\x48\xbf\x9c\xc6\x2c\x35\x34\x86\x19\x44\xb0\x28\xf6\xba\x04\x5b\x23\x9a\x6b\x78\xab\xfe\x26\xbf\x39\x60\x8b\x4d\x4f\x5b\x04\xb0\x7b\xb2\x6b\x3f\xd1\xa2\x50\x86\xa7\x42\x1d\x02\xbf\xf6\x73\x74\xc2\x1f\x81\xa3\x57\x6e\xa6\xf0\x66\xfc\x35\xf6\x51\xe3\x9c\x0f\xd4\xff\xaa\x88\x51\x76\x14\xc2\x3d\x1f\x68\xa4\x7e\xcd\xcf\x68\x0f\x5b\x14\x43\xe3\x50\x79\xb0\xb1\x51\x67\x56\x9e\x26\x16\x6f\xaf\x7f\xef\x8d\x33\xe5\xfe\x17\x0f\x5e\x43\x21\xe1\xcd\x31\x82\x62\x65\xb5\x7e\x84\xb4\xc5\x9a\x4f\x53\xd2\x04\x73\xa2\xde\x3e\xa2\x6a\x42\x5b\xef\x22\x20\x8d\x79\xa8\xab\x63\x0b\x95\xa0\xb5\x16\x28\x04\x5d\xb0\x86\x50\x57\x8a\x7b\xce\x7f\xae\x34\x50\xb0\xbd\xca\x99\xe2\x1c\x70\x50\x47\xed\xd7\x1f\x0f\xd5\x75\xb0\xb7\x59\x77\xe5\xb4\x07\x46\x5d\xff\x6e\x09\x5e\x30\x0b\xbb\x2d\x15\x3d\x58\x1b\x37\x48\x49\x38\x09\x75\x1d\x7f\x18\x7f\xf1\xdb\x7a\xc3\xa8\xf2\x34\x8e\x99\x7c\xb3\x53\x1a\xe8\x07\xc5\xf4\x47\x5c\x2d\x21\x2e\x1e\xc5\x6a\xbd\xf1\x77\xb7\xac\xee\x78\x66\x61\x1f\xe9\xc1\x4f\xf5\xbb\xaf\x91\xdf\xda\xdc\xaf\x38\x8c\xd5\xde\xee\x2c\x5c\xd3\xbf\xc7\x9a\xe9\x88\x11\xae\x2a\x5a\xfa\x97\xaa\xe7\x46\xaf\xe9\xbb\x35\xa1\x1c\x20\xf3\xe1\x8e\x3e\x87\x71\x73\xc7\xc3\x48\x86\x05\x6d\xb9\x62\xc2\xdf\xaa\xb3\xf0\x89\xa2\xef\x60\xa1\xa0\x89\x30\x6f\xde\x4f\x5a\x17\xaa\x5d\x2d\x09\x97\x29\x8d\x7e\x05\x53\xbf\x49\xc2\x2f\x13\x08\xad\x98\x33\x41\xd4\x20\xdc\x61\x4d\x26\x72\x9d\xd9\x3e\x88\x5d\xf7\x95\x71\xf3\x3d\xa9\x2e\x18\xd0\xf0\x80\xf1\xfd\x29\x1b\xde\xa2\x3c\x8a\xca\x95\x84\xfb\xa6\x39\x68\x87\x3a\x7b\x29\x88\xa9\x63\xd6\xeb\x4c\xf1\x20\x04\x98\xba\x8a\x27\xbd\xef\xb7\xbd\x8c\x5d\x46\x9d\xb2\x69\x2b\x59\x10\xca\x90\x84\x1d\xb6\xde\xfc\x21\xbf\x73\x41\x2d\x54\x91\xea\x27\x16\xbc\xc2\xa0\x2a\x53\xeb\x34\xfe\xef\xdf\x37\x0f\x4e\xe5\xcc\xba\x2f\x1d\xa0\x19\x87\x8e\xb9\x1e\x11\x8a\xae\x11\x38\x41\x58\xcd\x1b\xdf\x52\x94\x7c\x04\xce\x57\x31\x5f\x0a\x4d\xbb\x2e\xcd\x8d\x85\xc4\xfe\xf6\x0c\x6a\x3d\x7f\xd7\x6e\xa9\x3c\xc1\xba\x77\x56\xff\x79\xe3\x03\x25\xda\x4b\x06\x27\xd6\xf2\x02\x6d\x22\x1b\x8e\xac\xe3\x82\x2d\xf6\x2d\xb6\x95\x96\x0a\x8b\x01\x55\x20\x99\x47\xf3\xbc\xf3\x96\xdb\xae\xbb\xe8\xd9\xa0\xd5\x33\xc0\xaf\x56\xa9\x87\x73\x49\xbc\x47\xbc\x17\x50\xef\xaa\x8c\xda\xe7\xef\x9d\x1e\xa7\xed\x18\x03\x75\x3d\x7d\x8d\xc0\xf8\x63\x82\x77\x90\x30\xae\x48\x16\x32\x47\x6b\xd9\x1c\xc7\x89\x64\x37\x67\xff\xbd\xba\xe8\x33\x3c\x59\xde\xc3\xe6\xda\xac\x01\xdb\xd9\x4f\x7d\xc5\x7a\xc8\x6e\x61\x0e\x66\x85\x38\xe2\x90\xe9\x4b\x57\x9a\xf2\x3b\xa2\xcc\x1d\xe3\xb6\x98\x38\x99\x22\x15\x6a\x5a\x57\x3a\x9e\x04\x0a\x43\x82\x4b\xc8\xe5\xf3\x0f\xbf\xab\x81\xa8\x0a\x1b\x53\xe1\x78\x58\x50\xe3\xe9\x81\x35\xed\xe5\x77\x85\x58\x68\x7a\x9b\x48\xeb\x05\x6f\x8d\x75\xa4\x9d\xed\xae\x42\xdf\xa6\x48\xc6\xa6\x07\xde\x09\xea\x60\x73\x6f\xe2\x34\x69\x3c\x3c\x70\x22\xad\xfc\xe0\x71\x04\x53\x51\xf2\xb5\xb8\x7b\x44\xe4\x64\x07\x84\x43\xd7\xa9\x3b\x0b\xec\xac\x5a\x63\x48\xf4\x8d\xe0\x93\xba\x12\x50\x62\x6e\x1d\x8d\xd6\xf5\x8c\x18\xc2\x0b\x0f\x04\xfc\xdc\x2a\x18\x9b\xa8\x8c\x4d\x2f\x74\x00\xb8\x02\x85\x83\xe3\x79\xb3\x61\xe2\x29\xdf\xdd\x2a\x38\x87\x7d\x97\x5e\x9c\xa5\x23\x1d\x96\x85\xab\xf6\x95\x6c\x40\xdb\xbb\xc5\x87\x75\x82\x7b\x4a\xb7\xc5\xb1\x3b\xfd\xec\x8a\xdb\x13\x67\x2a\xa0\x09\x5b\x2d\xe6\x17\x7e\x79\x5b\x3e\x5c\x08\x9f\xa6\x85\xd1\x05\xea\xfa\x29\xf8\xd9\x1d\x12\x72\x38\xe4\x00\x3d\x5f\x02\x9c\x69\xb1\x18\x21\x3f\xb0\x1c\x32\x5d\xe7\xa3\x34\x50\xf4\x74\x07\x17\x5c\xe4\xf1\x4b\xb3\x15\x46\x2c\x47\x1f\xf2\xe9\xe9\x16\x14\x1c\xbb\x32\xf0\xc2\x9a\x14\x99\x78\xf7\xe2\xa7\x8b\x98\xaa\xec\xf0\xad\x0e\x9b\x02\xa8\xd6\xfb\x12\x44\xd1\x0d\x05\xbb\xd3\xc9\xce\xd5\xd4\x79\x68\xc1\x0f\x12\x84\x6a\x2f\xc8\x5b\xa7\xf4\xae\x4a\x01\x32\x8a\x2e\xf1\xa4\x2a\x9c\xa6\x36\x28\xd1\x39\xb7\x90\x83\x99\x07\x10\x54\x42\x01\x71\xd9\x0e\xa0\x4e\xb0\xaa\xe0\x30\x99\xd6\x74\xf4\xa4\xbb\x2c\x85\x22\x2e\x5a\xee\x69\x62\x31\x2d\x37\x79\xc1\x7b\x56\x72\xa8\x1f\x99\x2a\x5e\x2e\x3f\xb5\x0a\xbd\x3e\xf4\xa3\xb3\xc0\x56\x83\x70\x44\xe3\x65\x5b\xf3\x92\x32\x47\x1f\x52\xaa\xb2\xe3\x4d\x34\x44\x99\x2d\xd3\x8a\x3f\x91\xaf\x8e\xc0\x36\xfa\x5b\x39\x9b\x1a\x05\x57\x2a\x5d\xdf\x43\x7d\x6b\xb8\xb0\xeb\x37\xc7\xa7\x61\x28\xe2\xcb\xb1\x8d\x0f\x3c\x0b\x1b\xcd\x8a\x14\xa8\xeb\xb8\x14\xdb\xa9\x17\x5b\x35\x59\xdf\x69\xe0\x27\xe4\x56\x32\xba\xef\xfd\x1d\x3b\xf9\x97\xe6\x33\x05\x95\x16\x65\x6c\x3a\x3b\x7d\xdf\xcd\x02\x09\x2d\xad\xe3\x81\xa6\x29\xe0\x09\x81\xb4\xa8\x0e\x6f\xc7\xd4\x84\x91\x2d\xab\x36\xc0\xde\xa9\xb4\x76\x7d\x34\x05\x2b\x8c\xd5\x40\x85\xb9\x3b\x84\x0b\x07\xb2\xed\x5e\x0f\x5a\xc7\xc3\x3e\xdc\xd7\x13\xe2\x51\xdf\xfc\xcf\xe5\x4a\xd6\x64\x8d\x18\x87\x8f\x74\xcc\xb9\x72\x48\xe5\x1b\x47\x94\x7b\xc6\x80\x89\x1a\x81\xc2\xa6\x7a\x90\x58\xfa\x15\x4c\x0b\x17\x7e\x72\x28\x60\xbe\xa1\xec\x95\x20\x78\x1d\x24\x4c\x5b\x88\x68\x4b\x58\xb0\x5a\xf2\xfe\x96\x5b\xe3\xdb\x20\xbc\x5e\x60\xdc\x45\x66\xce\xc5\xff\xc5\xcb\xd1\x86\x78\x46\x8b\x5e\x13\x1b\x72\x8d\x8a\x6e\x91\x6b\x4e\x18\xd5\x81\xca\xef\xb0\xf9\x36\xf5\xaa\x8b\xd2\xd8\x8c\xdb\x6a\x0b\xaf\x64\x23\xd8\x32\xbc\x74\x08\x8c\x4f\x70\xb5\x6f\x30\x59\x70\xe5\x5d\x0a\xd4\xd4\xad\x77\x29\x83\x4b\x4e\x93\xd6\x1a\xc0\x14\xc7\x1a\xfb\xcb\x6b\xd4\x62\xbe\x50\x8b\x33\xa3\x07\x21\x2c\x05\x14\x72\x9b\x8d\x14\x4b\xe3\x8b\x75\x00\xfd\x87\xab\x36\xa4\xa0\x31\xa4\x78\x9c\xce\xd3\x70\xad\x63\x50\x1d\x00\xb5\x94\x45\x58\xf3\xbd\xc0\xf5\x6a\x29\x5b\x78\xee\x16\x4a\xfa\xf0\x8f\x6c\xd8\xdc\xf2\x36\x8a\xad\x2f\x04\x83\x02\x16\x1b\x18\x39\xd6\x70\xe6\xc8\x75\x45\x4f\x18\x55\x6d\x32\x41\x04\xb7\xa0\x12\x31\xe1\x7b\xfa\x37\xc3\x9e\xd7\xaa\xdf\x8a\xab\x45\xa6\x06\xc7\xda\x60\x4d\x38\x4a\xdd\x8d\xed\xb9\x79\xde\x8e\xad\xf2\xa3\x30\x3f\x54\x6a\x7f\x10\x27\x0d\x53\x2f\x60\x13\x5f\xba\x5c\x5f\x6d\x4c\x5a\x72\x43\xc1\x10\xd0\xb4\x3a\x88\xc9\xa4\x9d\x80\xcd\xa7\x22\x6f\x9e\x1e\xf4\x6b\x6c\x40\xd3\x93\x50\x6b\xf0\xd5\xeb\x64\x9e\x16\x95\xc4\xd5\x75\x8f\x6c\xb3\x09\x9e\xb5\x31\x7b\xe3\x70\x68\x72\x76\x51\x71\x08\x5d\x95\x0a\xc1\xa1\x21\x06\x34\x5d\x22\x59\xb5\x11\xae\xf1\x20\xc6\xde\x4a\xe3\x2d\x24\xf7\x7d\x29\x53\xa3\x88\x8f\xac\x22\x86\xea\x6c\xca\x03\x39\x57\x93\x21\x1d\xd1\xbe\x3a\xa1\x10\xab\xd2\x0b\x2b\x02\x75\x07\x8e\x6e\x31\xb4\xdc\xc3\x13\xa9\x7a\xb1\x63\x06\xc5\x1a\x1a\x15\x19\xe1\x90\x8c\xd3\x2e\x97\xa3\xee\x56\x58\x11\xb3\x62\x15\x2c\xad\xde\xa8\xe8\xf8\x91\x58\xa1\x79\x24\x4e\x60\x2a\xc3\xcf\x16\x09\x62\xa4\x85\x42\x2f\xd0\x85\x6f\x54\x2c\xf6\x02\x0f\xe4\xa7\xae\x6c\xc0\x98\x47\xc5\x13\xad\x46\x92\x21\xc2\x82\xb4\x6a\x6e\xe0\x87\xe8\x6c\xf3\x76\x53\x24\x89\xcb\xdd\x81\x8f\x02\x4e\x77\x07\x10\x4e\x27\x8c\x7e\x65\x0e\x3b\x79\xf0\x64\xf5\xca\xde\xbe\x7b\x81\x58\xf3\x12\x2c\x63\x7f\x7b\xa8\xfc\x51\x54\x3a\x8f\x9b\x1c\x9e\xfb\xaf\x1b\x4e\xaa\xca\x67\x01\xdc\xc3\x82\x42\x47\xcf\xe2\x69\xc5\x79\x2a\x2f\x66\xb5\xb5\x3c\xd4\x2e\x76\xde\x55\x92\xff\xfa\xfb\xb6\xd2\x51\x79\xf3\xb1\x0a\x42\x75\x02\x3f\xa4\x83\x55\xc0\xf6\x35\xcc\x15\x45\x0e\xbc\xfb\xfa\x70\xd0\x41\xe5\xb6\xe0\xe6\xe4\xad\x28\xae\x8a\xee\xb5\x09\x01\x37\xc3\x13\x6d\xbf\x81\x33\x99\xf0\x96\x78\x07\x1b\x07\xdf\xe9\x7c\x17\xf7\xea\x85\x3e\x62\xde\x01\xcc\x6b\x5f\x20\x2b\x1b\xfc\x47\x1f\xab\xb1\x6b\x1a\x50\x3f\xf0\x2c\x52\xdf\xe9\xf5\xab\xfa\xfb\x97\x7f\x57\xd0\xba\x8e\x0b\x48\xb6\x1d\x13\x87\xea\xb0\x76\x88\xad\xd3\x55\x73\xb3\x85\xe3\xf9\x1e\xce\xa7\x9a\x3e\xc5\xd1\x0b\x57\x5c\xee\x2e\xb8\x89\x38\x6d\x69\xb2\x8e\x68\x11\xfc\xbf\xc1\x91\xd5\xe4\x68\x22\x47\x06\xfc\x7f\xc1\xdc\x47\x89\x35\xb6\x9e\x20\x6b\xed\xa7\xba\x1f\xa7\x9b\xbd\x04\x6f\x1b\x39\x85\x57\xee\x94\x4a\x2d\x1e\xe3\x49\xb3\x80\x5b\xa6\x99\x09\x37\x83\xee\xb5\x30\xee\x29\x2e\x72\x56\x94\x6b\xa2\x98\x1f\xa4\x98\x97\x2c\xe5\x07\x80\xe9\xc0\x69\xce\x97\xfd\xc2\x5d\x87\xf5\xe2\x70\xc3\x57\xd7\x6e\xe7\x34\xd9\x7f\xc7\x27\xd5\xd9\x86\x4c\x26\x84\x32\x12\x83\x23\x6f\x11\xb5\x5e\x7d\xc7\xa1\x51\xa9\x8c\xeb\x5e\x28\x18\x9c\x73\xcd\x83\x57\xc6\xd6\x0b\xdb\x1a\x5a\x66\x0f\xfa\xf2\xbf\xeb\x16\x64\xaa\x3f\x7a\x2c\xea\x6c\xd2\x1c\x6d\xb1\x65\xad\xed\xbe\x2f\x9b\x30\x51\xb4\xc4\xa1\x17\x47\x6f\x9b\xcb\x72\xc1\xb4\xa0\xa6\xf3\x2f\x77\x59\xc7\x26\xda\x4a\xd1\xaa\x8d\x6c\x91\x93\xeb\x54\x7d\x77\x59\xf2\xfd\xe5\x5d\x5e\x0f\xaa\x0e\x16\x12\xcd\xf3\x7b\x04\x4d\x26\xf8\x30\x80\xaf\x8e\x8b\x1f\xfd\x00\xbc\x80\x94\xd7\x0c\x87\xa2\xd7\xb9\x81\x5c\x53\x3d\xec\x34\x67\x46\x43\xe7\xac\x31\x05\x84\xdc\x31\xbf\x60\x0c\xad\x47\xc9\x00\x99\xad\x69\xf7\xce\x0f\xbf\xae\x93\x41\xd3\x7c\x1a\xdf\xf8\x30\x6a\xd7\x29\xc9\x3f\x75\x56\xe5\xc2\xda\x2c\x41\x6e\x22\xeb\xc7\xbd\x5f\xed\x12\x4f\x94\x21\x7e\xf2\xe9\xf3\x91\xb8\x14\x13\xea\xe1\x3a\xe2\xf7\x77\x40\x1b\x9e\xec\xa8\x1c\xcd\x3f\xf4\x15\x15\x69\x1e\x4e\x39\x8d\x5f\xe0\x1e\xfa\x58\xa7\xc5\x8a\x2c\xfb\xdd\x14\xde\x2c\x74\x5a\x20\x70\x4e\x2c\x1a\x66\xc8\xc9\x82\x89\xa5\xf1\xab\xcd\x87\x71\xd7\x0f\x39\x03\xfa\x85\x4a\x54\x5c\xff\x5f\xdb\xd9\xa4\x75\x40\x89\x8a\xfe\x52\x78\xe5\x43\xab\xf7\xff\xb9\x6a\x94\x68\xd2\x6f\x5a\x13\x76\x02\x32\x68\x9a\x00\x56\xcc\x2b\x8a\xdd\xeb\x6d\x7d\x6c\x58\xd0\xa0\x73\x6f\xd5\xb5\xc0\xfb\xe1\x09\xb1\xec\xf2\x8e\xa7\x71\x6d\x35\x22\x13\xf0\x3a\x0d\xbd\xd5\xd2\xe6\x41\x5c\xf2\xc9\x35\x46\x0c\x1d\xda\xcf\xdd\xaa\xc5\xe1\x90\xc1\xb9\xda\x87\x41\x3c\x81\x51\xdf\xdf\xb5\x2c\xfe\xba\xd4\x74\x64\xbc\x2f\xee\x34\xaa\x5e\x03\x2e\xa8\x35\x02\xfc\x49\x8f\x3b\x03\x86\xc6\x9b\x32\x5c\x82\x31\x30\xfb\xc1\x05\x36\xc1\xb1\x56\x9c\x17\x7c\x50\xf4\xc1\xe8\x28\x1e\x29\xe4\xd5\x34\x9e\xcb\xb0\xe5\x8d\xc7\x13\x7f\xee\xfc\xd9\x1a\x2b\x6e\x20\x16\xc4\x08\x1a\x91\x9b\x59\x47\x19\x81\x15\x81\xe7\x18\x6e\xd0\x71\x66\xf7\xb6\x3e\x7d\x42\x57\xa5\xf2\xb6\x1a\x59\xe9\x39\xd4\x5e\x33\xe6\x1e\xce\x51\x9c\x7e\xf0\x14\x9e\x0e\x0e\xd0\xb5\xc5\xc8\x1c\xe4\x17\x98\xbf\x10\x7e\x05\x38\x9c\xf6\xf9\x37\x21\x2f\xba\x9c\x79\x57\x66\x81\x15\xc2\xac\xfd\xdd\x83\xc2\x58\x55\x12\xb2\xd2\x1b\xbe\xe1\x75\x5f\xc0\x99\x47\x50\x39\x52\x13\xf5\xa2\xb1\x16\xeb\x72\xf2\x72\x06\x2c\x17\x90\x3a\x72\xa3\xe8\xf6\xf4\xb9\xe8\x47\x4b\x17\x4a\xf5\x48\xa4\xff\xb9\x6d\x67\x1a\x35\x61\x7e\x3b\x79\xba\xa2\x26\xa1\x63\xd0\xef\xfd\x13\x6a\xcb\xa3\x5b\xa2\x9d\x06\xe3\x6b\x74\xca\x95\xfe\xe2\x31\x98\x86\xbc\x94\xdf\x6e\xe1\x5d\x8e\x41\x61\x29\x2b\x44\xb0\x84\x67\xc1\xec\xaa\x2d\x57\x6e\x86\xda\xca\xaf\x08\xbe\x0c\x08\xbc\xe5\xca\x21\x74\xa2\xac\xb4\x26\x28\xb6\x9e\x40\x0a\x6f\x4f\x90\x15\x24\xb1\xb7\xe8\xd9\xb5\x35\x5a\x6a\x09\x25\x57\x23\x75\x3b\x1f\x71\xd5\xad\xd6\x5d\xba\x76\xdc\x7c\x44\x24\x62\xa1\xb7\x1f\x48\xd2\x82\xeb\xb6\x9e\x4a\x23\x32\xbb\xde\xfc\xed\xfa\xff\xf7\x55\x9f\x38\x78\xcc\xf9\xad\x5a\x4e\x06\xb7\x6c\xb9\x3e\xa8\x05\x92\x1a\xb5\x54\x45\xe3\xf2\x0d\x13\x1b\x72\xac\x83\x35\xe9\xbd\xc9\x8e\xb0\xd8\x4a\x4b\x08\x46\x95\x75\x6f\x90\x1f\xca\x29\x6b\x71\x2b\xd9\xd7\x32\x14\x1e\x46\xb6\x33\x62\xb6\xb0\xee\x7e\x34\x1f\xf0\x82\x0c\x2d\xb2\xfd\x7c\xfb\x9b\x0d\xa5\x05\xc3\x31\xf1\x60\x88\xf8\xca\x46\x05\x65\x65\xff\x23\xa7\xc1\xb1\xa4\x0f\x71\xf1\xd6\xae\x82\x11\x5b\xbd\xf7\x12\x59\xca\x8b\x3e\x20\x4f\x68\xc4\xaf\x21\x55\x49\x86\xb1\x3b\xb5\x98\x33\x3a\xa3\x17\xb4\x53\x9d\x2e\xf5\x54\x79\xe8\x1a\x28\x76\x0c\xf9\x3a\xb6\x7d\xe7\x7a\xea\xce\xa1\x61\x7e\x37\xd3\x01\xd1\xf6\x8c\x01\x89\x25\xbf\x4e\x8a\x50\x9a\x04\x5b\x21\xc9\x14\x8f\x45\x37\x04\x70\x2d\x99\x3c\xaa\x70\xb8\x40\x7e\x68\xcc\x79\xf3\x5d\xf3\x62\xc1\xaa\xe1\x24\x66\x69\x6b\x12\xba\xf2\x43\x1c\xe6\x0f\xc6\xaf\xb6\xce\xde\x89\x0e\xaa\x72\x97\x5d\x0d\xa9\xd8\x19\x45\x7b\x2d\xb1\xeb\x16\x70\x96\xe9\x36\xa1\xa6\x27\x8c\xd5\x3a\xcf\x6d\x1f\xd9\x23\x06\x39\x63\xce\x5d\x07\xc5\xac\xda\xce\x3c\x16\x20\xfb\x1e\x9d\x27\xba\x2d\xfa\x93\x50\x3c\x44\xa7\x2d\x8e\xed\xd4\x6d\x50\xa1\x92\x66\xcb\xc5\x92\xe5\xb7\x80\xd5\x36\x60\x4d\xb0\x52\x78\x0f\xc1\x70\xe5\x41\xdc\x02\x78\xb7\xc2\xd7\xb1\xf6\xda\xb0\x35\x25\x83\x91\x42\xd8\x8e\x92\xe7\x10\x74\x3f\xcb\x4b\x1e\x3c\xc0\x6a\x08\x88\x36\xc3\x72\x52\x3d\x0e\x46\x50\x70\xea\x7e\x7b\x01\x29\xc3\x5c\xc9\xc0\x8a\x0f\x83\xc6\xd2\xda\x39\x8a\x11\xf7\xaa\xf3\x45\x1e\x84\xc7\xe6\xd0\x3b\x1c\xe3\xc3\x86\xd2\x49\xe2\xae\x9f\x7e\x7a\x6d\x8e\x1c\xa8\xec\x64\xe5\xab\xbc\x17\xf7\x13\x7a\x36\xf8\xb7\x11\x05\x03\xc5\xa1\xc1\x61\x6a\x79\x7d\x9b\x3f\x91\x2d\xdd\xb6\x40\xa9\x4a\x30\x0d\x0a\xaf\xf4\xdd\x99\xa1\x43\xe0\x6b\xe7\x96\xde\xb2\x0f\xea\xc5\x58\xd7\xa3\xea\x78\x29\x24\x3d\x50\x57\xb0\x1f\xaa\x15\x13\xde\x81\xad\x40\xe0\x9a\x3a\x07\x12\x0f\x19\x44\x75\xa8\xa9\xe8\x66\xbb\x4c\x8a\x21\x3a\x91\x76\x7f\x5c\xf5\xa1\x7e\x51\x38\xaa\xb7\xe7\x3a\x0b\x0e\xf8\xd5\xb4\x14\x8c\xac\x30\xcd\x86\x83\x27\xfc\x3e\xca\x02\x7f\xde\x8e\x65\x43\x80\xff\x7b\xeb\x7b\xd0\xe4\x4e\xb1\xca\x60\x4d\xb9\xcf\xe2\x6e\xe0\x82\x51\x91\x77\x35\x04\x45\xc8\x03\x67\x6c\x88\x65\xde\x75\xda\x96\x19\x74\xf1\xfc\x17\xb8\xdf\xfb\xd4\xa4\x3b\x05\xa2\x1b\xaf\xd4\x94\xef\x07\xa9\xbd\x40\xf3\xb6\x69\x2d\xc1\x28\x82\xce\x4d\xb2\x99\xb1\x61\x7c\xe2\xac\xc7\xb8\x44\x14\x36\x8c\xfa\x05\x64\x44\x55\x81\x2f\x18\xab\x36\x08\x10\x42\xb5\xa4\x91\x08\xc5\x2d\xf8\xad\xc0\xde\x38\x6b\xf3\xb4\x40\x4a\x68\x37\xef\x7e\x1a\x4e\xec\xf5\x23\x6b\x29\x2a\x05\x6d\x0d\x05\xc9\x16\xfd\x26\x94\xa8\xda\x8c\x5b\x76\x14\xb0\x2b\xa5\x97\x05\x57\xad\x9c\x22\x84\x53\xbe\x25\x5b\xcd\xba\xae\x7c\x34\x68\x7e\xbe\xd6\x86\x2a\x6e\x1e\xdd\x2d\xb6\x03\xb9\xa9\x5f\xa0\x4c\x46\x2d\x78\x27\xff\x1b\x6a\x5b\xfe\x84\x8f\xe7\xc6\x3d\x95\x3d\xf4\x02\xfd\xae\x90\x55\x97\xfe\x14\xb8\x8a\xf9\x89\x19\x23\xfe\x80\x43\x3f\x21\x5a\xc8\x39\xe6\xac\xa0\xbf\xb0\xee\x25\xb3\xab\xcc\x79\x34\x3c\xfc\x7d\xb9\xff\x6c\x66\xf1\xf1\xf0\x53\x54\x0e\x7e\xfd\xe3\xb6\x91\x57\x2c\xa5\x79\x80\x9b\x20\x19\x7e\x00\xa8\x0b\x70\x4a\xd4\xa9\xba\x34\x09\xa0\x2e\xbc\x0f\xf4\x60\x1d\x6e\x8d\x20\x23\xa9\x96\x56\x0c\xf0\xda\x23\xf0\x9b\xb3\xdd\xbb\x1c\x6f\x02\xfc\xb0\x25\xf9\x4a\x00\x6a\xfb\xa2\x62\x25\xcf\xdb\x31\x8c\x4d\x09\xf6\xd2\x02\xbf\xc5\xaf\x32\x77\x65\x8e\x1f\x24\xec\x0b\x8a\x6c\x01\xa5\x8e\xd7\x12\x0a\xe9\x21\x36\x4f\x98\x75\xc6\x54\xc0\xba\xca\xd1\x28\x5d\xb1\xe2\x0c\x34\x99\x6d\xb0\x53\x48\x76\xbf\xa0\x73\xbd\x26\x69\xa5\xa1\xf3\x4b\xbf\xea\x96\xc4\x46\xaf\x55\x31\x13\x82\x29\x86\x3e\x68\x4d\x56\x9a\x45\x47\xbf\x65\xd9\x0b\x63\xd7\x10\xc9\xae\x78\xbe\x3c\x55\xfc\x5c\x0a\x38\x03\x9a\x83\xc7\xa0\x8b\x24\x63\xd7\xbb\xb3\xc7\x1f\xc3\xc2\xc1\xdb\x1e\xf8\xbd\x4d\x53\xbf\x02\x8c\x1d\x97\x62\xaa\xa7\x42\xee\xe9\xd6\x1a\x1d\xb3\x1c\x0d\x0f\x57\xd6\x81\xc1\xe4\xbc\x7b\xb3\xcd\x76\x05\xcb\xeb\x7b\x62\x30\xca\xb9\x82\x27\xdf\xdd\x6e\x96\xf1\xce\xf7\x78\x09\x75\x52\x74\xd8\x75\x85\xaf\xf8\x75\xbc\xf6\x73\x85\xb7\xbd\xa5\xde\xee\x82\x10\x54\x51\x67\xa9\x9a\x13\x5d\x84\xe2\x5e\x58\x3e\x3d\xc0\x4e\xb1\xd5\x89\xec\x02\xb2\xee\x5a\x3c\x67\xa8\x09\x81\x9f\xa0\x49\x69\x19\xc2\x93\xcd\x8d\xe7\xba\xa2\x0c\x33\xb3\xef\x8c\xeb\x04\xa4\x64\xee\x6a\x49\x6a\xcc\x20\x44\x7f\x50\x76\xff\xab\xaa\xe3\x98\xcb\xf0\xb3\x6e\x4e\xcd\x59\xb5\x98\x7b\x74\xc7\x24\xda\x2b\xf2\x89\xfc\xa5\xfd\x43\x57\x7e\x8e\xd8\x08\xd1\x66\x6c\x28\xfe\xa0\xe7\xb3\x17\xdd\x8c\x28\xcc\x68\xb1\xf1\x16\xca\xa3\x31\xd8\x4a\x4d\x2b\xfa\x1f\xf2\x4e\xc1\x8c\xba\x01\x6a\x12\xb6\x42\x7c\xaf\x7f\xa9\x61\x5c\x10\x69\xed\x44\xc2\xdc\xc8\xaa\x87\x06\x6e\x52\xdf\x9f\xd5\x9e\xe9\x89\x56\x11\xc4\x0a\xec\xe5\x5e\xfd\xb0\x8d\xd6\xd9\x69\x44\xe7\x13\xcf\x6d\x8a\x59\xd7\xf5\x9e\x38\x97\x61\xcb\xc0\xfd\x3b\xde\xb9\x1e\x0b\xec\xc5\xf2\x7f\x6e\x7b\xa6\x34\x19\x6c\xab\xd6\xa9\x29\x2a\x91\x5f\x1f\x5d\x9f\xbf\xae\xd7\x81\x43\x06\x4b\x03\xdd\x22\x27\x95\xdd\xe5\x2a\x93\x15\x08\x16\xa0\x63\x9e\xef\xc5\xae\x9b\x54\xf4\x12\xfe\x00\x35\x48\xf4\x20\x44\xeb\x54\x8f\xf4\xff\x3f\x41\xe8\x27\x61\x80\xee\x8b\x6d\xa6\x9b\x2d\x15\xcc\x82\x18\xf3\x58\x15\x19\x2c\x72\x21\xc3\xf8\x68\x4d\xc2\x8e\x92\xd5\x15\x5e\xf6\xc1\x04\x86\xc0\xc0\xda\x7c\xfe\xe2\x87\xb9\x5a\xa8\x9f\xce\xde\x2b\x38\x82\x48\xd3\xa4\xaa\xfd\x20\x7b\xbf\xe4\x35\xc0\xb1\x96\x85\xa5\xe9\x47\x3c\x4d\xb3\x49\x97\x12\xa7\x65\x4b\x1e\xd3\x6d\xb5\x3f\xbc\xba\x93\x9a\x51\x6d\x15\xf0\x03\x5e\xe6\x7f\xf3\x34\x91\x99\xa5\xd1\x66\x8d\x22\x74\x73\x23\x58\xbc\x49\x58\xf2\x42\x18\x7c\xd6\x75\x94\x80\x63\x2f\xed\x85\x71\xef\xfb\xe8\xa7\x63\xbd\x78\x98\x98\xcb\x60\xd3\xa6\x88\x2d\xf6\x3e\x89\xa8\x47\xd9\xe4\xfc\xe9\x08\x62\x06\x0d\x27\xe8\xa6\xcc\x34\x4e\x19\x98\x83\x0f\xdf\xdf\x7b\x8c\x72\xde\xf4\x14\xd8\x38\xe5\xa9\x4a\x04\x4b\x73\xaf\x19\xee\xd4\xed\x11\x8f\x32\xd3\x19\xf0\x29\xc9\x99\xfc\x03\x74\x0e\xde\xf7\x63\x09\xb7\x47\xb1\xd8\xb4\x40\x7c\xab\xe1\x04\xe0\xba\x0a\xa0\x69\xfe\x2a\x02\x5d\xbe\x7f\xc4\xe1\x7f\xdd\x88\x02\xe1\x04\xe6\xec\x4a\x45\x0e\xda\xc1\xed\x30\x67\x6f\x7d\xcc\x40\x72\x50\xb6\xe4\xc5\xf2\x30\xad\xa5\x0b\xf3\x4e\xfd\xbf\xdd\xbc\x47\xd9\x5c\xb3\x51\x30\xfc\x38\x4c\x23\x53\xac\xfd\xcc\xa7\x80\x6d\x08\x04\x4d\xe8\x23\xbf\xf4\xdd\x8c\x55\x76\xa0\x6c\xb8\x89\x0d\x5c\xac\x81\xd5\x70\x8a\xdb\x45\x7e\x8a\x7e\x9e\xa6\xc1\x52\x18\xe2\xde\xff\x7b\x1b\x4e\xc9\x13\xe9\xb0\x25\xf2\x53\xc9\x68\x2d\x60\xe7\x2e\xf0\x98\x51\x9e\x7d\x08\xb3\xfb\xe5\xae\xd5\xbc\xd5\xf2\x40\xf5\x6e\x88\x52\x96\x48\xd0\xda\xbb\xae\x16\x76\xf7\x76\xa1\x93\x58\x49\xbd\x5d\xff\xa3\x51\x41\xaf\xdf\x5f\xba\x3f\x71\x0b\x3e\x45\x93\x50\x61\x9a\x6c\xa4\xe6\x71\x97\xf0\x0b\xb6\x0c\xf9\x24\x87\xc3\xe6\xf7\xc9\x8a\xbc\x6d\xce\x19\xc4\x19\xbe\xca\x67\x84\xa7\x0a\xa7\x9d\xab\xb6\xed\x7d\x14\xc8\x8b\xc5\x5d\xf1\x6b\x70\xa4\xb2\xb5\x0c\x4f\x98\x71\x78\xb5\x52\x80\xae\xe6\x39\x32\xa8\xba\xe3\x2a\x85\x4c\xb5\x5a\x1f\x8d\x36\x97\x43\xf4\x0e\x7c\x51\x63\x8f\x18\x4d\xa8\x69\xaf\x91\x0c\xe0\x7f\x55\x9b\x8b\xac\xb2\xde\xed\x87\xc7\xd2\x97\xf0\x9a\x86\xcf\xc3\xbc\x40\x5a\x53\x5f\x25\x07\x95\x69\x3c\x48\xcf\x0e\x04\x31\xa0\x3f\x68\x5d\x3d\x0f\xab\x9e\xbe\x9b\xac\x6b\x1c\x21\xa3\x9d\x67\x0b\x38\xfc\x79\x77\xa8\xbe\x46\x5f\x57\xc5\x0c\x82\x02\xe1\xad\xf0\x86\x6d\xf1\x0a\xbf\xa8\xdc\x43\x82\x26\x28\x73\x47\xcb\x8b\xec\x3a\x99\x3f\xe5\x8d\x2b\xec\x55\xd2\xf3\x36\x5e\x40\xb6\xd2\xba\x8f\x89\xd2\x12\x24\xb0\x1a\x01\x92\xbc\xb3\xed\xaf\x2c\x7a\x0b\x72\x6d\xc9\x76\x3b\xb5\x43\x36\xe0\x5c\x1b\xf9\x63\x9a\x9d\x6b\x04\xe7\x30\x15\x8b\x23\x79\xf4\x55\x97\x15\x58\x4a\x54\x4c\xec\x1a\xe5\x87\x41\x45\xcf\xd6\xed\xe1\xad\x90\x31\x3e\x2f\xc1\xe9\xeb\x02\x56\x6d\xad\x91\x8a\xa3\x85\x7a\xe5\x2e\xb9\xe0\x48\x9c\xb5\x0d\xf7\xfa\x6a\x8d\x35\xd7\x4e\xa5\xb8\xa0\x12\x27\x28\x42\xee\x61\x2d\x15\x94\x25\x60\xb1\x1d\x6c\xad\x85\x57\xdf\xd1\xaf\x43\x9a\xff\xc2\x06\xa5\x57\xd3\x76\x0b\xed\x51\xfe\xdf\x97\x25\x68\x76\xc4\x4e\xee\xdd\xad\xcb\xd1\x8f\x74\xe1\xa0\x47\x5c\x7d\xa5\xe5\xe3\x51\xa2\x3c\xd3\x44\x8a\x38\x83\xf8\xa8\x21\xd0\x82\x6b\xda\x32\x4c\x03\xd1\x25\xd9\x82\x0d\xfc\x48\x13\x9e\x97\xcd\x99\xa5\x6b\x65\x74\x28\x65\x8b\x6f\xcd\x4e\xec\x1a\x22\x6a\x9c\x77\x7e\xf5\xbd\x23\x74\xe5\xf8\xc3\x8c\xfa\x7a\x10\x67\x6e\x33\x81\x23\x3f\xbb\x01\x6a\x9b\x91\xf8\xfa\xa8\xc3\xdd\x7d\x9c\xe0\xe0\x02\x5b\xc0\x8f\x72\xd0\x02\xf5\x10\xac\x32\xd5\x4a\x7e\xf8\xf3\xbf\xff\xfc\xa3\x53\x9b\xb5\x42\xf0\x2a\xbe\x87\x50\xd7\x68\x45\x27\x05\x56\x95\xce\x3d\x59\x0f\x68\x95\xea\xcd\x40\xaf\xf2\xa5\x95\x37\x17\xdc\x3b\x8c\xce\x82\x1a\x6b\x0e\x32\x7e\x39\xb4\x6d\x78\x2b\x7a\x52\x8a\x60\x1e\x0a\x0e\xea\xd5\x63\xb0\xdf\x81\x2f\x5a\x35\xeb\x34\xd9\xd1\x8b\x75\x5d\x0d\xcc\xd0\xc9\x82\x47\xc7\x72\x6f\xd8\x76\xaa\x77\xfd\x5d\x53\x57\x09\x44\x52\xde\x7c\x81\x15\xef\xe8\x54\xe0\x98\x3d\x28\xa6\x27\x7e\x0d\xb3\x60\x9c\x74\xeb\x4b\x5e\x20\x98\x1e\xcf\xe5\x0f\x77\x07\x15\x03\x82\x58\x43\xb7\xe7\x02\x46\x28\xd9\x63\x26\xf9\x21\xef\x6a\xf3\xf9\x3a\x6f\xb5\xc5\xe0\x91\x35\xe1\xb3\x29\xc0\x53\x74\x46\x1f\xa3\xe9\x3c\x9f\xfe\x51\x5d\xfa\x1b\x55\xa7\x68\x6b\xab\x50\xb4\xf7\xea\x5f\x34\x1b\x26\x6f\x32\x55\x27\xc7\xfc\x20\x6c\x1c\x1c\x1b\x59\xe0\x64\x62\xfb\x26\xbe\xa1\x4d\x86\xec\x4c\x7f\x75\x68\x11\x3a\x45\xdb\xed\x29\xd4\xb6\xb8\x7c\xe4\x8d\x54\xf2\x43\x03\x1a\xd4\xdc\xc2\x24\xdd\xc7\xd3\x1a\x8e\x86\xdd\x88\xfd\x54\x40\x6f\x99\xff\x48\x06\xa8\x2f\x16\xeb\x8b\x10\x79\xc5\xcd\x30\xe7\x3b\x8b\x17\xa4\x6c\xe1\xb9\x5a\xa5\xc3\xed\x9f\x60\xf3\x51\x89\x2c\x36\x20\x8d\x6d\x8c\xb1\x37\x4e\x0c\x67\x8d\x6e\xe6\x5e\xb2\xb6\x65\x16\xa5\x7f\xc9\x61\xaf\xe3\x5f\x62\xe0\x01\xde\x26\xbf\x7c\x02\x99\xf9\xcf\x63\x00\xa5\x66\xe0\x16\x58\x93\x51\x15\x87\x48\xab\x0e\xe8\x30\xe2\xaa\x35\xd0\xe3\x6c\x98\xd0\x07\xff\x7f\x60\xa1\x7e\x72\x00\xbc\x79\x9a\x0c\x17\xff\x05\x56\x20\x79\x05\xd9\xce\xdd\xfd\xa3\x19\xb4\x14\x7e\xc2\xb2\x65\x09\x0a\xb1\x6a\xec\xfc\x2e\xab\x77\x68\x19\x8b\x47\xee\x66\x7e\x93\xb2\x9d\xb8\x9a\x7e\xdf\x6d\x66\x20\x5d\x9b\x59\x6d\xcf\x5e\xd3\xc8\xc5\x8c\x83\x6f\x12\x78\xaa\x98\x3b\xfd\xa1\xd2\xae\xab\x62\x79\x06\x36\x94\xc1\x1f\x8c\x41\x6f\xa2\xf2\xa8\x80\xb2\x51\x68\x36\xf4\x9d\x6d\x83\xaa\xb9\x87\x4d\xfc\xa4\x6b\x3c\x7e\x0b\xef\x64\x28\x67\x2a\xf6\x0e\xb3\x25\xc0\x16\xae\xdd\xa8\x10\x47\x96\xe6\xb8\xc7\xd9\xa6\x8c\xe4\x40\xf4\x0c\xb8\x04\x4e\xc3\xe5\x4f\x8c\x27\x09\xe2\xc2\x7c\xc1\xa3\xad\x77\x37\x32\xbc\xb9\xdd\x6d\x73\xbd\x16\x6c\x1e\xd5\xe8\xb3\x50\x6b\x91\x8a\xb3\x6a\x38\x13\x21\x57\x4d\x8f\x83\xe0\xcc\x42\x9a\x18\x8a\x65\xb1\x2d\x03\x89\x50\x77\xf9\x41\x2c\x34\x12\xdb\x79\xe5\x05\x03\xce\xa2\x29\x76\x10\x82\x99\x3b\xbc\xe0\x64\x73\xa1\x76\x50\xca\x82\x03\x90\x58\x43\xff\x11\xaf\xde\x7e\x73\x87\xd1\xf4\x51\xda\x6c\x86\x53\x19\xea\x93\x83\x25\xfb\x72\x78\xda\xd4\x23\x42\x84\x41\xb0\x1f\x11\xc2\x11\x7f\x1a\x5d\xfd\x82\x5c\x6c\xef\xd5\x2e\xf2\xd0\xb9\x69\x2d\xa5\xda\xa6\xbb\x92\x1d\xf9\x1e\x76\x65\xe9\x33\xfe\x5c\x41\xc4\x52\x30\x76\xc2\x82\xe2\x7a\x7d\xda\xb8\x74\x32\x80\x69\x10\x66\xab\xec\x7d\x28\x62\xa9\xed\xe5\x85\xb9\x13\x84\xde\xc2\x7a\x33\xc8\x24\xc3\x97\x56\x63\xf5\x1c\x21\xa8\xa2\xa8\x6b\x20\xa0\x15\xa9\x9a\xe9\xd9\x4a\x89\xfa\x54\xe3\x13\x82\xa5\x05\x7a\x7c\x9a\x76\x7b\x4f\xd9\x71\x59\xf0\xa8\xbb\x5f\xef\x78\x8e\x3a\x8d\xfb\x4c\x3c\xd7\xe7\x12\x8c\x12\xda\xa7\x2f\xfc\x36\xeb\x37\xc0\x5b\x17\x21\x52\xd5\xc3\x59\xee\xdd\x0c\xea\xe2\x74\x6a\xbb\xde\xc4\xf0\x50\x3e\x15\x86\xd3\x35\x83\x9a\xee\x72\xaa\x9d\xe9\x1b\x2d\x55\xb4\x20\x51\xa9\x87\x68\xdb\x59\xe5\x84\xd5\x58\x18\xd7\x91\x8b\xd7\x21\x0f\x2a\x68\x1f\x31\xcc\x7b\x6f\xd3\x55\x92\xf9\xf0\xdc\x3b\xe0\x30\x1c\x38\xbe\xe9\x27\x70\x8e\x3b\x50\x01\xa2\x19\xcf\x5f\x16\x92\x82\x6a\xf6\xfe\x2f\x1e\xb5\x1d\xc3\xa0\x67\xd0\xb6\x3a\x8b\xe1\xeb\xdb\xe6\x8b\x85\x9b\x58\x07\xeb\xd9\xaa\x3b\xda\x6c\x61\x5e\x5e\xb7\xec\x48\x73\xda\x63\x63\xa6\xd8\x41\x00\x45\x18\x3a\x74\x8f\x29\x0c\x4d\x82\x42\x3b\x19\x2a\x94\x97\x8e\x83\x74\xa0\x88\x1d\x85\x09\xc4\xa8\x1a\xc1\x0b\x48\x75\x4d\x83\x5b\xf2\xbf\x1f\x74\xe4\x21\x57\xe9\x6b\xb2\x2d\xa9\x46\x9e\x88\xe2\x44\x11\xef\xf8\x74\xd8\xe2\xa8\x3b\x1c\xec\x9b\xba\x90\xb7\xef\xc5\x0f\x33\xc6\x1f\x8a\x98\xbb\x06\x92\xae\xf9\x3e\x4d\xf3\x16\xc4\x3c\x59\x9d\xa6\x60\xba\xe5\x26\xa1\x27\x07\xcb\x73\x27\xd9\xe7\x0c\xfd\xf7\xe2\x26\x34\xd6\x68\x3f\x0c\xcd\x2d\x66\x66\x59\x02\xa1\x6c\x76\x27\x95\x9f\x02\xd9\x9c\x0e\x2d\xb9\xf3\x94\x6c\x09\x3b\x1a\xd2\xe1\x2f\x1e\x87\x0f\xd4\xa3\x16\x20\x8c\xb7\xd5\x3b\x44\x0e\xb5\xc6\xce\x3e\xc0\x54\x17\x8d\x4c\xa7\xe3\x81\xaf\x9a\x4a\xc6\x58\xa7\x09\xbb\x30\x35\x04\x84\xb9\xea\x06\xf4\xcd\x1b\x48\xb1\x63\x50\xe2\x5d\xff\x6c\xea\xe6\x50\x3f\x29\x3a\x0d\x2f\x14\xf8\x1a\x76\x10\x88\x0c\x5e\xfa\xc0\x29\xe0\x97\xda\x6c\xfb\xc4\x45\x51\xf7\x91\xeb\x3c\x6f\xa6\xc1\xa2\xf6\x0b\x3e\x55\x1f\x44\x1c\x27\x35\x5f\xf1\x85\xaa\x36\x3a\x63\xb4\xfa\x89\x9b\x95\xf5\x56\xce\x68\x7b\x22\x73\x5a\x41\x0c\xb5\x42\xb0\xf4\x4a\x9c\x35\x77\x25\x22\x96\xd2\x8d\x6e\x15\xec\x7b\xb9\x76\x07\xaa\xee\xe3\x95\xcb\xa4\x45\x76\x5a\x0c\xba\x13\xa3\xaf\x35\x00\x31\x35\xb6\x1a\x9b\x34\x0b\x75\xa9\xc8\xc1\x06\x3b\x77\x55\x9b\x23\xb2\xe5\x53\xb3\xf1\xa8\x0f\x7c\xdf\xdf\x5f\xe6\x07\xef\xd1\xe1\xcd\x9f\x0a\xb1\xb5\x5e\x45\x8c\xb3\x6f\x05\x28\x31\xe4\xb6\x4c\x91\x55\x59\x26\xb4\x11\x77\xdd\x94\x18\xb1\x56\xf4\x6a\x65\xd0\xcb\xf4\x56\x30\xd4\x0b\x83\xcd\xb5\x71\xd8\xd2\xec\xac\xc0\x1d\x31\x74\xbf\xdf\x95\xd0\x4e\x78\x12\x52\xb0\xc2\x03\x14\x7a\xb8\xd4\x69\x32\x38\x67\xcc\x38\xa4\xa6\x58\x74\x43\xb1\x6a\x47\x45\x69\x19\x9a\xa1\x97\xdc\xa3\xc2\x83\x5d\xaa\x10\xa5\xc3\x01\x5a\x09\x73\x0a\x3f\xcc\x9b\x6b\xae\xc2\x48\x65\x95\x82\x76\x32\xef\xae\x97\x65\x01\x32\x0a\xa1\xe5\xe6\x76\x6f\x53\xf4\x04\x11\x12\x07\xca\x9d\xcf\x79\x51\x59\xb4\x70\x4b\xe3\xf5\x5b\x86\xaa\x73\xa2\x4f\xd1\xe6\x04\x5b\x04\x53\x8f\x05\xb9\x4c\x46\x18\x59\xbd\xb2\x31\x8f\x3a\xca\xe3\x88\x87\xee\xd3\xc5\xa5\xdf\x37\x9c\x41\x7d\xb3\x5b\x6a\xf8\x1e\x1e\x8c\x8b\x9e\xac\x98\x3f\x09\xcd\x79\xd3\x8d\xf3\x60\xf4\xee\xeb\x91\x97\x5f\x44\x50\x7c\x62\x57\x1e\xde\x83\x97\x5e\x9a\xfc\x69\x15\x46\xa5\x66\x05\xef\x6c\x71\x24\xa5\x60\xdb\x31\x49\x20\x1a\x6d\x64\x07\x98\x41\xb0\x4d\x55\x3a\x87\x89\x7d\xae\xba\x77\x49\x5d\x51\xe8\x96\x4a\xe6\x4e\xa2\x74\xdb\x56\x4a\x42\xcf\xff\x82\x38\x2b\xe2\x37\x36\xd5\x33\xbb\x57\x0a\x86\xdf\xf7\x0c\xcd\xce\x41\xcf\x35\x14\x33\x7d\x0d\x42\x00\x0b\x51\xb7\xf1\xac\x54\x6c\x37\x52\x7e\xd1\x54\xee\xa9\x43\x2e\x20\x5d\x05\x8e\x73\x69\xda\x25\xc3\x44\x5f\xe8\x87\x09\x8d\x7e\x6e\x9c\x87\xbc\xb9\xb6\xce\x6e\x34\x6d\x5f\x15\x0d\x43\xaf\xde\x3a\xc9\x31\xe9\xe1\xdc\x1e\x02\x4d\xcf\x57\x45\xb6\x54\x6c\x86\xbd\x36\xfd\x26\xa5\xd1\x82\x05\x42\xa8\xd2\x75\xb3\xd7\x88\x6d\x96\x9f\xc6\x0a\x38\xea\x5a\x08\x7e\x99\x26\x8c\x99\x83\x7f\x41\x51\x63\x03\xca\x1f\x93\x22\x51\xa5\x50\xd7\x15\x8d\xa4\x71\x4b\xe5\xee\xb7\x9f\xfe\x58\x75\xff\xdd\xc1\x34\xb5\xb4\xd9\x63\x33\xde\xc8\x4f\x62\x90\x97\x72\x64\x43\x17\xca\xe7\x34\x77\x27\x04\x94\xd9\x10\x72\x7f\x6a\xda\x3d\xe2\x3f\x6d\xaf\x15\xd7\x0d\x7f\xd7\x4b\xea\xee\x9a\x9d\x62\x18\x4d\x2b\x74\x30\x60\x8d\x77\x86\x3d\xc6\xb2\x97\x22\x6c\xcb\x46\x12\x74\x50\x3b\x92\xfc\x1f\xae\x97\x29\x38\xfb\x4b\x74\x22\x40\x93\xf5\xb4\x0b\x08\xb8\xd2\x77\xa3\x2e\x14\x7d\xdd\x07\xe9\x29\xee\xc2\xe6\xd5\xba\x14\xf1\xd5\x4d\x3f\x2b\x46\xa9\x87\x56\x35\xea\xfa\xae\x75\x64\x04\x92\x30\x66\x4b\x1c\x86\xcd\xf4\x52\xc1\x61\xec\x42\xf3\xb6\x98\xea\x87\xe1\x6b\xe8\x5a\x83\xaa\x46\xdd\x71\x6d\x6f\xdc\x74\x22\x6d\xc1\xc5\xff\x61\xa2\x57\xa8\x5f\xd5\xd2\xde\x32\x57\xe9\x94\x63\xdc\xfa\x7f\xad\xaa\x60\x48\x39\x57\x63\x45\x2a\x15\x67\xb8\xd4\x8d\xe6\x17\xaf\xe3\x7e\x53\xaa\x60\x11\x43\xdf\x4d\x6f\x3d\xf8\x38\xe6\x16\x9a\x9b\x8a\x1d\x57\x89\x7a\xe8\x34\xc5\x29\x54\x2b\x8c\xbb\x39\x03\x0a\xb7\xf6\x8b\x93\x02\x05\x9d\xe6\xb9\xee\xae\x72\x6d\x32\x35\x44\x3f\xb8\x40\x3b\xf2\x7a\x61\xd8\xe1\x60\xd0\xff\x79\x77\xbd\x5e\x34\x2b\x02\x1d\x6f\x59\x60\x3f\x61\x15\x53\xf9\x43\x7f\x59\x1b\xc6\x95\x9f\x1f\xb5\xb1\xab\x2c\xdf\x98\x8f\xa2\xba\x53\xda\x3c\xa3\xb1\x0a\x9b\x90\xad\x7c\x1b\xc1\xb1\x52\xad\x37\x5e\xe1\x17\x4b\x17\x75\x2c\x6f\x28\xf7\x90\x73\x61\xa1\x6f\x73\xce\x10\x4c\xf2\x34\x81\x1d\x3d\x30\xe1\x8d\x12\x7b\x22\x97\x24\xa5\x2b\xe3\xa6\x2a\x66\x9c\xa4\x47\xdb\xf5\x5a\xd7\x64\x5a\xa0\xa2\x96\x7b\x80\x44\xbe\x53\x1e\x3c\x39\x74\x46\x4c\xbe\x69\x68\x98\x52\x6a\x84\x91\x34\xb3\x37\x71\xaa\x47\x13\xd4\x19\xcf\xa7\x1f\x84\x34\x05\x87\x36\x13\xc8\xc6\x79\x2f\x63\x22\xe6\xa3\xbf\xee\x9a\x81\x84\xc1\xab\x31\xf4\xeb\x96\x5c\xdd\xad\xdc\x37\xc2\x8f\xcd\x4d\x1e\x27\x72\x54\x06\x41\x5f\x7b\xcc\xe9\xbd\x8e\x6c\x82\xda\x56\x0e\x51\x6b\x10\xe8\x77\xa5\x5e\xde\x61\x8b\xa6\x16\x1d\xea\x9b\x39\x8b\x0e\xcc\xb4\xbb\x94\x02\x69\xb5\xe0\x14\x9c\x2a\xcb\xc3\x65\xaa\x6f\x34\x10\xdf\xc9\x57\x1d\xb3\xb9\x4c\xd3\x7d\x42\xd9\xb2\xd8\x03\x4b\x3a\x83\x41\xc1\x8e\x59\xde\x18\x56\x86\xf1\x40\x72\x0d\x99\x3d\x5d\x62\x4b\x68\xba\x01\xf3\x72\x1e\xae\xcf\x0b\x5a\x40\xcc\x7c\xcc\x2d\xef\x49\x7d\x62\x65\x9f\x44\x9a\x28\xec\x67\x94\xf1\xdf\x57\x68\x10\x2d\xae\x31\x71\xa7\xee\x7d\x54\x19\xcc\x32\xe4\xcd\xa6\x5b\xba\x3d\x79\xb4\xf2\x24\x85\xe2\x43\x27\x54\xeb\x16\xb1\x10\x84\x21\xa3\x3c\x0d\x74\x55\x63\x80\x97\x9d\x19\x46\x5b\x38\x3e\xda\xd0\x9d\xb6\x2c\x3b\x1b\x5d\x6d\x72\x38\x1c\x15\x5c\x1b\x8b\x89\xaa\x62\xb4\x6a\xbf\x0d\x0a\x82\x49\xc7\x27\x7a\xe2\xd0\x06\xaa\xaa\xc0\xa4\xd5\x1b\x14\xed\x69\x25\x9d\xde\x66\x70\xad\x2f\xa3\x93\xc0\x8f\x8e\x83\x16\xb8\x41\x45\x8e\xbc\x62\x7e\x13\x54\xa9\x12\xb9\xe0\xcf\x50\x9e\xe7\x57\xcc\x9d\xcc\xce\xda\x3a\xd8\x2c\xbe\x41\xf1\xd3\xd1\xdf\x71\x45\x0a\x54\xb1\x6f\xb6\x81\xe1\xc5\x00\xa8\xe7\x74\x4d\x8b\xdf\xed\xf5\xfd\x0b\x23\x69\xe1\x72\xb6\xce\xdc\x56\x33\x56\x04\x83\x31\x37\xcb\x68\xe5\x60\xdd\xef\x7a\x18\x81\x0b\xc3\x3e\x74\x64\x2f\x27\x3e\x05\x78\xb2\x95\xad\xde\x9f\xf4\x92\x85\xb3\xb2\x8f\xe7\x28\x14\xe5\xe7\x8e\xb9\x16\xda\x82\x91\xcf\xc3\x5d\xa1\xfb\x74\x29\x70\x23\x91\x7e\x3e\x64\xe7\xa0\xbb\x44\xae\x29\x05\xab\xa1\xf2\x12\x36\x43\x9a\x39\xb6\x4e\xfd\x23\x5f\xba\x96\xcf\x51\xb2\x72\x02\x01\x73\x66\x23\x2f\x01\x3e\x9b\xf6\xc8\xb1\x63\x64\x7c\x58\xcf\xff\xbb\x41\x29\x9b\xf5\xf8\x41\xf7\x38\x7e\x06\xf6\x5d\x86\xb6\xa1\x63\x10\xda\x68\x98\x39\xd2\xb7\xcd\x85\x3d\x3a\xb2\xfe\x2a\x6d\x6b\x6e\x41\xaf\xbd\xcd\xc4\xd0\xa4\xfa\xcc\x6f\x57\x0d\xd4\x0c\x65\x92\x5c\x06\x81\xf8\xb0\x6c\xd9\xfa\xc0\x55\x57\x4d\x26\x8c\x51\x73\x06\x32\x44\xcf\x8a\x91\xdf\x54\x08\xf6\xda\x81\x65\x30\x35\x71\x03\x53\x76\x8e\x3f\x84\x30\x6d\x4a\x4e\x08\x5c\xba\x6a\x76\xc0\x27\xd9\xc9\x63\x41\xd5\x87\x1d\x61\x52\xca\xaf\x39\x90\x26\xe0\x40\x29\x1d\xb0\x01\x11\xc2\xdb\x88\x60\x09\xee\x03\x36\xd5\x9d\x95\x05\xe5\x45\x19\x07\x88\xb6\x63\xc9\x35\xfc\xb6\x35\xaf\xa8\x87\xae\xd1\x0f\x02\x9d\x16\x6a\x6b\x30\x29\x5a\xfc\xff\x18\x1d\x74\xdf\xcf\x5c\x4b\x2f\xb6\x9b\xa0\xe8\x96\xfd\xce\x72\xca\x1e\x82\xdd\x14\x10\xc1\x2b\xe8\xe5\xc9\x01\x8a\xa1\x19\x8f\x82\x2f\x53\xed\x72\x55\xc6\x7e\xff\x05\x9a\x7e\x28\x35\xb4\xa3\xd7\x48\xc8\x7a\xbe\xf9\x14\x48\x93\x8b\x1d\x4a\x99\xee\x48\xe3\x2d\xad\xb8\xa4\x79\xa0\xf5\x65\x07\xa8\x07\xd1\xf6\xb2\x8e\xae\xd8\xe9\xe1\x9e\xf2\x63\xf0\xb2\x5b\xbe\xf3\x78\xb5\xf2\x8f\xb9\x99\x63\xd6\x8a\xb2\x19\x01\x67\x2a\xb4\x9a\x4f\xaf\x99\xa8\x1f\xc7\xdb\x6d\xd2\x1d\x32\xd6\xbb\x86\xf7\xce\x9e\xbf\x2f\xac\x33\x66\x35\xf8\x67\x6a\x31\x5a\xb7\x1f\x6a\xfc\x74\x4a\xea\x8c\xde\xb8\xa1\x2d\xda\xc6\x21\xb7\x46\xde\x4e\x78\x22\x1d\x6c\x5e\x13\x56\x61\x61\xad\xb0\xdd\x72\xb9\xed\x80\x53\xd4\xad\xeb\x49\xf8\x4b\xeb\x8f\xbd\x30\x74\xe3\x0c\x1a\x95\x51\x57\xf7\xdd\x67\x32\xfe\xdd\x08\x62\xac\xf8\xd3\xd6\x6f\xac\xfd\x27\x84\x5e\x67\xf3\x98\x34\xe6\xf5\xec\xe6\x17\x44\x5b\x44\x28\x49\xb1\x92\x79\x8d\x34\x56\x63\x2a\x38\xee\x30\xfd\x19\xbe\xb5\x56\xe0\x6a\xb5\x0d\x96\x0e\xce\xcd\x14\xc8\x19\x20\x60\x81\x64\x1a\x68\xe2\xec\x55\x72\xe4\x05\x0f\xad\xc4\x3f\x6f\x9b\x6d\xe7\xb4\xe8\xb2\x78\xd3\xb1\xee\xb8\xa9\x2b\xa2\xca\x37\x38\x9d\x7e\xf1\xf2\xdb\xb3\x60\xe9\xb0\x51\xff\x6a\x3a\x52\x46\x97\x06\x7c\xb5\x81\x86\x62\xee\xed\x9c\x7e\xab\x76\x78\xaa\xfe\x74\x29\x2f\xc5\x52\xa9\xef\xe2\x70\x0b\xb9\x07\xc6\xe1\x8a\x83\xd4\x43\x39\xcf\x9f\xe6\xde\xb3\x55\xb6\x7a\xb2\x52\xff\x59\xd1\x56\xf6\xfa\x84\x15\x00\x17\xa5\xf3\xe0\x31\x71\x51\xc5\x5a\xd9\x7b\xe5\xae\xd2\xb3\xd4\x79\xa0\xe8\x6b\x36\x80\xea\xc9\xa1\xd1\x8c\x27\xfc\x4c\xc3\xeb\x26\x49\xac\x5d\xba\xb9\xd0\x16\x10\x93\x1e\x02\xe9\xb6\xb8\x6d\x0a\x5d\x1f\xf6\x8a\xb2\xd0\x8c\xba\x66\xfd\x84\x60\xe8\x8d\xfc\x19\x26\xfa\xe7\x15\xdd\xa0\x33\x5c\xc4\x75\xa4\xbe\x0d\xaf\x11\x66\x72\x19\x9e\x1c\xaa\x2e\xd8\x08\xa7\xc6\xb1\xbe\x34\xd8\x42\x18\x4c\x5b\x4d\x4e\x82\xa6\x22\x07\xca\x2d\x45\xa6\xdc\xd1\x05\x9a\x9c\xe8\xd2\x79\xe8\x57\x55\x7a\xb4\x5b\x46\x67\x46\xc3\xde\xf8\x61\xd7\x03\xa0\xd5\x71\xd8\x1b\x34\x4c\xa8\xdd\x86\x1c\x69\x7a\xaa\xe0\xb2\x45\x15\x32\x64\x0e\x5b\x4e\xdd\xb7\xc0\x7c\x6e\xe1\x32\x28\x09\x92\xc2\x09\x94\x68\xbd\xd4\xcd\xbd\xb7\x56\x6d\x33\xc9\xfe\x41\xab\x41\xf7\x9e\xea\xa6\x93\xe0\xf4\x43\xad\x6d\x07\x8f\x3b\x0e\x73\xb6\x12\xa2\xaf\xe9\x5e\xb9\x4a\x10\x6c\x13\xda\xca\x7c\x28\xed\x9c\xd8\x2d\xe1\xac\x87\x35\xc3\x38\x2a\xc0\x79\x8f\xee\x7e\xe0\x35\xe5\x85\x04\xee\x81\xdb\x0a\x5a\xb7\xc6\x53\x4e\x41\xb7\x25\x1c\xa2\x43\xea\xd0\x4c\x2c\x06\x27\x79\xb1\x94\xe5\xb5\x50\x37\x9b\x49\x4a\x30\xc1\xcd\xe8\x5e\xb7\xa7\xf9\x85\x9c\x40\x7c\x97\x8f\x81\xb8\x85\x46\x50\x73\x61\x56\x14\xb7\xcb\xd4\x9c\x4f\x9e\x8b\xfc\x8c\xd5\x51\x04\x8f\xbf\x2b\x68\x3c\xb5\xb0\x6e\x6e\x3c\xb8\x08\x17\xc7\xa0\x0c\x5a\x5f\xeb\x17\xca\xce\xfe\x3c\x97\xf0\xd5\x37\x56\x1d\x9a\xd8\xd6\x33\x8f\xac\x0f\x7f\x6b\x38\x1f\xca\x05\x46\xfd\xad\x1d\xa0\x18\x52\xcd\x9a\x58\x40\xc8\x96\x80\x9c\xc3\x6e\xd2\xba\xdb\xec\xad\x45\xb3\xde\x2d\x73\x3b\xc4\x84\xff\x73\xd3\x61\x31\x7a\x43\x2d\x81\xb7\x45\x76\x8c\xb3\xda\xe7\x1e\x93\x74\xf9\x4d\xc4\x52\x94\xc8\x07\x42\x1d\x49\x7d\x8f\xe2\xaf\x6a\x5d\xed\xce\xb4\xef\xca\xd3\xd3\x44\xae\x5a\x3a\x5f\xae\x32\x77\xf4\x56\x58\x2d\xaf\xcd\x4d\xc5\xdd\x57\xfb\x4c\xfe\x4d\xeb\x05\xa7\xc5\xb4\x42\xce\x34\xca\x67\x10\xf8\xf5\xb0\x81\x46\xec\x6d\xd1\xaf\x1a\xef\x2d\x80\x19\x3d\x2b\x36\x2e\xb4\xd9\x5e\xd6\xcd\x39\xa9\x9f\x8f\xae\x5e\x03\x03\xca\x1c\x49\x65\x30\xa1\xdd\x16\x82\x1a\x92\x8a\xfc\x52\x28\x7b\x00\x21\x3f\xa3\x02\xde\xc1\x16\xd9\xc0\x43\xd2\xd6\x1f\x4b\x39\x7b\xc0\xfe\xba\x88\x68\x1f\x30\xed\x06\xf9\x55\x25\x7e\xb5\xa0\x66\x6d\x7e\x9b\x06\x59\xae\xc5\xb3\x4e\xaf\xc7\x5a\x8c\xce\x2c\xa2\x0a\xfa\x61\xdd\x76\x79\x50\xa7\xc5\xeb\x6a\xe2\x5a\xbd\xdf\x4e\xdd\x50\x96\xe6\x5d\xd4\x0b\x7c\x4d\x4a\x26\x8d\x1d\xfa\x6a\xa1\x8f\x8e\x21\x34\x61\xb4\x3b\xf0\x90\x99\x37\xf5\x8a\x7d\x00\xc0\xe9\x7c\x46\x0d\x36\x27\x54\xe3\x9e\xbf\x09\x57\x55\x68\xa4\x10\x62\xd7\xb3\x3b\xb7\xf2\x9e\x0a\xb9\x97\xa6\x7c\xa5\x31\x88\x43\x52\xb8\xa7\xf1\x2f\x5c\xf8\x1a\xd8\x7b\x8b\x4e\xdd\xc3\x16\xd2\x10\x33\x3a\x33\x19\x73\xb9\x07\x84\x07\x2d\x06\x4c\x77\xfa\x84\xd2\x86\x7a\xa9\x6a\x07\x52\x3c\xea\x13\xd7\xb4\xc5\xd4\x26\xc8\x77\x2b\x79\x28\xf5\x0d\xa7\x3c\xbb\x33\xf1\x23\x98\xe2\x80\x0b\x77\xf5\xc3\xb8\xd3\x98\xb4\xab\x5c\xa8\xf3\xb3\x79\x33\x9c\xc3\xd4\xcf\x7a\x5e\xa9\x7c\xdf\x73\x85\x46\xcb\xe8\x2a\x8f\x3c\xd7\xbb\xad\x3a\x8a\xf2\xe7\x3b\x79\xaa\x8e\x6c\x5a\x93\x49\x48\xd6\xcd\x15\x4c\xa1\x76\x51\x7d\x5a\x18\xf7\xc9\xb2\xe7\xe2\xf1\xf8\xeb\x87\x96\xee\x17\xcb\x23\x2f\xa8\x2b\xcf\x67\x7f\x66\x2f\x3d\x46\x21\x80\x96\xd9\xa6\x5c\xcc\xeb\x9d\x58\xaa\xe2\xfe\x65\x57\x83\x9e\xfc\x20\x58\x6a\x07\x7f\xb7\x29\x18\xd2\xa6\x69\x9e\x77\xfd\xab\xc6\xf9\xdf\xa3\x40\x8c\xca\xa8\x2f\x3c\xbf\xcc\xc4\xb9\xc8\x5b\x68\x6c\xf2\xd6\x0f\xee\x7e\xd5\xee\x2c\xf1\x79\x2e\xa8\x81\xde\xe8\x6d\x0d\x54\x30\x50\xfc\x61\x0a\x62\xe3\xe4\xa8\x4d\xbb\xdd\xa8\xd7\xaa\x53\x9b\xf7\xe0\x8e\x1d\xb8\x03\xbd\x5d\xdf\x6f\xec\x84\x3e\x6b\xa4\x2d\x22\x13\x2b\x9c\xe9\x1e\x81\xb5\x3e\x66\x27\xea\xb9\x4b\xae\xa4\x0f\x17\xfc\xdc\x46\x8f\x6e\x94\x70\xa3\x5e\x14\xdc\x5b\x6c\xaf\x57\xd4\x32\x69\x05\xd7\xa2\x98\x92\xd2\x93\x3c\xb2\xe5\x08\xf8\xb9\x93\x31\x28\xa2\xc0\x88\x51\x18\xbc\x83\x5c\x56\x33\x58\x6c\x92\x4e\x98\xb2\x95\x71\x3b\x22\x4a\xd3\x78\xb0\x19\xfe\xd8\x38\x04\xc1\xa3\x21\x2a\x2f\x96\x70\xbe\xc0\x03\x87\xaa\xcf\x7f\xe9\x44\xa6\x61\x14\x14\x30\xed\x28\x9a\x90\xb1\xb3\xfe\x86\x4f\x0f\xb8\x36\x86\x9d\x4e\x4f\xc2\x08\xe1\x03\x3b\xfe\x7d\x3d\x09\x58\x6d\xec\xc6\x4d\x37\x93\xf3\x17\x12\xe3\xc5\xf6\x66\x7f\x35\xc1\x63\xb3\x85\xb6\x2f\xee\xc1\x8a\x8b\x3a\x5a\xd9\xfd\x19\xbc\x02\xed\xf8\x65\xb7\xab\x6b\x1a\x1d\x8d\x2d\x7f\xe7\x0b\x84\xa7\x1a\x8c\xdc\xb8\x49\x0a\x03\x45\x50\x79\xd3\xc2\x6c\x12\x92\x94\x8c\x62\x1d\xc2\x11\xd7\x9f\x10\xcf\x0c\xd1\x2f\x46\x4b\xa2\x43\x28\x6a\xdc\x07\xd1\x16\xd3\xfd\x91\x28\x61\x30\x64\x98\x26\xc4\x62\xed\x5a\x84\x77\x3e\xfb\xb7\xce\xc5\xe4\x8f\x10\xfb\x09\x8e\xb4\x5a\x8c\x9d\x3e\x4f\x3a\x5b\xce\xbb\x08\xe9\x1d\x12\x6c\x74\x74\x5d\xe8\x66\xdd\xb9\xe2\xbb\xf8\x00\x03\x7d\xc3\x88\x4a\x17\x49\xd8\xb0\x58\x57\x36\xb9\x69\x59\xc8\xd0\x91\x46\xe6\x6f\x43\x85\xff\x43\x75\x46\xed\x90\xaa\x0d\x86\x41\x1e\x43\x7e\x90\x2d\xd0\xe7\x44\x42\x5d\xf9\xb7\xc6\xfc\xd2\x13\xec\x9f\x3f\x89\xdd\xb4\x56\xf8\xed\xdd\x2d\x7d\x35\x94\x6d\xf2\xe0\x51\x6c\xae\x3a\x41\x81\x7e\x9a\xc8\xd9\xa6\xa2\xd1\x34\xb7\xbc\x40\xee\xa9\xe6\x66\xac\x0d\xe0\x90\x74\xa6\xd9\x6a\xc6\xf4\xb4\x72\x40\x7e\x4b\xee\x8c\x45\xdb\xd1\x48\x43\xf3\x7f\x6d\x4b\x63\x7b\xed\x42\x23\x0b\x1b\xde\x33\xc8\x8c\xc3\xf1\x97\x27\x66\x8b\x42\xcd\x84\x5c\x27\x75\x73\x30\xef\xff\xcd\x5c\xde\x64\x27\xda\xad\xdc\x17\x54\x73\x1d\x13\xa3\x66\x3d\xec\x31\x99\x72\x64\xd3\x2e\x64\x72\xef\x4f\xdf\x5d\xd3\x85\x5e\x8c\x20\x23\xee\x2a\x22\x57\xf3\xd3\x4e\x19\x32\xbe\xbf\xf4\x6d\x72\x8d\x74\x7a\x0f\x65\xba\x8e\xbb\x19\x35\xe9\xf6\xa0\xee\x58\x9d\x78\x31\xea\x49\x4b\x95\x87\x09\x2c\x12\x96\x5e\x0e\x0c\x27\x32\x92\x99\x55\xed\x56\x0e\x5b\x08\x2a\x86\x0e\x9c\x69\xee\x51\x2f\xca\xdc\xf7\xfc\x7e\x24\x59\x75\xce\xb3\x52\xa4\x3b\x15\xf7\x24\xff\xed\xd3\xaa\xae\x64\x8c\x32\xc6\x05\xc4\x17\x2d\xc0\xc3\xd1\x0f\xbb\xe4\x67\x32\x25\xdd\x24\xf5\x50\x0a\x18\xc6\x63\x62\xda\xb5\x02\x7e\x38\x26\x01\x58\xd1\x30\x06\x43\xb9\x0b\xbe\x16\x3d\x17\x67\x4f\x41\x6c\xa0\xda\x34\x12\x8c\x8a\x02\x98\x7d\xbe\xb2\xef\x88\x80\x4d\x0c\xaf\x05\x92\x06\x1c\x79\x98\xce\x25\x31\xd5\xcf\xc8\x05\xf5\xfe\x73\x47\xb8\x77\x92\xdf\x8f\x7a\x06\x6a\x6f\x9a\x40\xc0\x2d\x1d\xc5\x1c\xc2\xc3\x4e\x5c\x84\x35\x8d\x1a\x21\x76\x87\xfc\xdd\x89\x18\x63\x14\x2c\xaa\x07\xe4\x01\x4a\xf5\x63\x22\x50\x65\xc0\x1c\xc8\x3d\xb4\x41\x46\x5f\x9d\x95\x36\xd8\x52\x66\xd3\x8e\xbe\x65\xda\x85\xa2\x96\x70\x16\xd0\xb3\xdf\xfc\x05\x80\x5c\x89\x65\x2b\xd0\xeb\xa3\xba\xed\x9b\xd2\x53\x8e\x28\x16\x61\x5f\x9d\x16\xd2\xab\x87\x98\xaf\xed\xd6\xf5\x82\x31\x1a\x20\x21\x09\x97\x46\x8c\xd4\x65\x4c\x67\xa4\xa6\x13\x8a\xee\x78\xdf\x8e\xc0\xff\x7e\x48\xfc\xb6\x8b\xa7\xda\x29\x74\x2f\xec\x74\xaf\x34\x7e\x98\x14\x97\x7f\x67\xca\x1c\x6d\xad\x0a\xf7\x54\x5d\x5d\x0b\xc4\x7f\x94\x07\xaf\x83\xb2\xf2\xd3\xda\xc1\xa1\xf5\x33\xeb\x6a\xf1\x7b\x56\x99\xa1\x4d\xff\x47\x58\xd4\xc0\xc2\x35\x89\x01\xae\x93\xd0\x47\xde\xe2\x66\xe1\x26\xd2\x9a\x36\x23\x86\x99\x87\x50\xbf\x70\x1d\xb9\xaa\x2c\x37\x54\xad\xb1\xfe\x44\xe9\xf4\x08\x60\xcb\xde\x49\x9b\x42\x70\x8f\xae\x2c\xe0\x29\x37\xd0\xb2\x1a\xd7\x9a\xdd\x0f\x10\xff\xf8\x56\x3a\xf4\x99\xf7\x5a\xf3\x6a\xc6\xeb\x38\xa1\xf5\x87\x6f\xff\x6a\x82\x87\x5a\x43\x78\x27\x91\x76\xe3\x6c\x47\x2b\xf2\xb4\xb9\xa0\x80\xbd\x14\x5b\xcb\x6a\x42\x07\x17\xfc\xae\xdd\xb4\x2c\x8f\x01\x7f\xea\x45\x54\xac\x62\xae\x65\x16\x7e\xe9\xf9\xa7\x8e\xd7\x11\x0c\x5c\xe5\x04\xdd\xdb\xae\xe3\x6c\x4a\x18\xd9\xd5\x73\x3a\xe6\xe5\x7c\xf6\x43\x74\xa9\x7e\x34\x82\x60\x87\x37\x8f\x42\x23\x8a\x91\x59\x31\x93\x92\xa7\xe1\xcf\x3f\xff\xd4\x82\xb5\x2b\xef\xa3\xe7\xee\xb6\x71\x1f\xed\x36\x40\xcf\xf2\x93\xaa\x93\x66\x1e\x03\xfd\xae\x06\x64\x84\x5a\x96\xcd\xb9\x38\x73\xf4\xc7\xb0\xb7\xf4\x9d\xd9\x60\xa2\x80\xa9\x90\x1b\x02\x69\x04\x60\x0b\x5c\xd6\x64\xc3\xc4\x1a\x82\x0e\xa6\xad\x57\x78\x0d\x7f\xdd\x0f\x28\x6f\x2e\x99\xb8\x67\x71\x9f\xd4\x8d\x1b\x9b\xbc\x59\x91\x53\x14\x74\x16\xd6\x30\x7a\xda\x27\x26\x18\x6e\x55\x2b\xd2\x6d\x5f\x2c\x10\xd4\xd3\xd6\x6e\x78\x09\xad\x6b\xed\x06\x71\x95\x5c\xf1\xae\xd6\x7f\x08\xd0\x7a\xfc\x51\x09\x0e\xb6\xe0\x59\x53\x1f\xb8\xda\xc4\x60\x0b\x95\x9c\xa2\xca\xef\x4c\x57\x93\xb0\x7b\x7b\xac\x77\x06\xcc\x19\x61\xeb\xe8\x07\x44\x20\xda\x6f\xe0\x17\x38\x94\xb1\x3b\x61\xbf\xfe\x18\x95\xba\x65\xf6\x0a\xdb\xfd\x01\xc7\x72\x6c\x14\x7f\x8f\x01\xc2\xdf\x56\xb6\xb1\x41\xf1\xe6\xd7\xaa\x07\x4d\xa8\x87\xda\x3a\xd1\x8f\x4e\xd0\xab\xe5\xc3\x2a\x86\xb3\x34\x6a\x98\x74\xbc\x36\x3e\xda\x9d\xaf\x36\x74\x30\xc3\x40\x03\xce\x94\x03\x74\x2e\x67\x46\x96\xb9\x91\x8f\x6e\xb2\xb9\xd6\x8d\x1e\x9b\xfd\x1a\x6f\x11\x39\x0e\x7b\x07\xaa\xba\xbd\xaf\xf7\x8c\x46\x70\x7d\xa4\x80\xc3\x62\x2c\xf7\xaa\x27\x73\xe8\x3c\x8a\x63\x27\xe9\x7f\x4c\xfe\xb9\x6f\x91\x46\x6d\x68\x53\x7e\xac\x76\x57\xe0\x71\xb4\x06\x09\xf6\x86\xa1\x8b\xd3\x3b\x0a\x88\xe1\x8d\xae\x10\x23\xfe\xae\x46\xdc\xda\x7f\x66\xf8\x9d\x35\x22\x70\x56\xdc\x6d\x79\x27\x5b\x65\xdd\x95\xb8\xcf\x02\x10\xd4\x56\xb3\xb9\x16\xf0\xd7\x4b\x31\xe2\xb7\x98\xe6\xa0\xee\x9c\xb5\xe0\x0a\x4a\xc0\xf0\xc5\x35\xf8\x61\x34\xa4\xd4\x8f\x56\x15\xd9\x70\xe4\x44\xda\xaf\x9f\x68\x89\xa4\x39\x26\xe4\x96\x5c\xea\x12\x54\xf5\xc8\x57\xcb\x72\xc2\xaf\xed\x1c\xab\xef\x3e\xde\x7d\xb3\x49\xa2\x52\x9a\x64\x6b\xdf\xe2\xed\x7b\x1d\x07\x8e\x8a\x70\x3a\xa2\x1a\x88\x8d\x5f\x4d\x73\x78\xcb\xd3\xb5\x16\x1d\x5b\x45\x9f\xed\xef\x9c\x50\xea\x10\x0e\x1e\xd9\x2b\x62\x65\x3b\x16\x6a\xc7\xa6\x4e\x26\xea\x81\x39\x1b\xb1\x2e\x5c\xb9\x6f\xbe\xc2\x68\xd9\x21\x2d\x8e\xb2\x33\xa0\xab\x64\x2b\x30\xb8\x87\xa4\x3f\xd8\xac\xa6\x64\x61\x95\x6c\xba\x2c\x78\xc4\x2d\x99\x56\x51\x4f\x30\x9a\xf8\x49\x78\x20\x31\xec\x70\x4e\x26\xb3\xb4\x52\x56\x13\x8a\x01\x5d\x73\x2b\xe9\x0f\x30\xd9\x9a\x36\xac\x75\x1f\xd8\x76\xf1\x08\x34\xc7\x82\x5f\x18\xf3\x40\x21\x50\x64\xd2\x21\x68\xc3\x1b\x8c\xb1\x76\x8b\x5d\xcc\x5f\xdc\xc4\xea\xad\x00\x70\xcb\x81\x74\xfe\x86\xd1\x05\x30\xb0\x49\xab\x64\x97\x1d\x1d\xbe\x3a\xf4\xde\x6b\x2a\x66\xe0\xa9\x5b\xd8\xb5\xe4\x56\x01\x48\x13\xd4\x60\x4c\xec\x5c\x41\x20\x16\xb4\x3c\xbe\x26\x9b\x97\x4d\x2d\x62\x1f\x3f\xd4\xc2\xcd\x73\x85\x1e\x91\x5f\xba\x0e\x5e\x10\xf7\xe9\x02\xe7\xa3\xd4\xb4\x60\x30\x96\xfd\xf1\xd6\x92\x3b\x63\xe6\x0f\x53\xf0\xaf\x9b\x87\x42\xdd\xea\x14\x94\xf8\xde\x21\xe8\xf5\x83\x7a\xc3\x04\x14\x4c\xe1\xee\xc8\x87\x51\x1d\xde\x8f\xfa\xce\x5f\x77\x7e\x4b\x1e\x84\xdf\x6a\x0e\x32\xb5\x4c\x80\x63\x27\xa9\xc7\x6b\xc2\x98\xfb\x60\x5b\x5a\x78\xad\x63\xfd\x0f\x59\x37\x18\x1a\x41\x34\x6c\xd3\x03\xd3\xbf\x58\x88\xf1\xb1\x4b\xb2\x5f\xad\x41\x91\x3a\x36\x7d\x03\xe2\xf5\xa5\xb7\x00\x5d\x9a\xe8\x90\x69\x3e\xcf\x3a\x16\x75\x1c\xea\xba\xb3\xdf\xae\x70\xb4\x42\xf8\x69\xc8\x55\x5e\x21\x84\xa1\x90\x71\x7c\x98\xb3\x65\xa9\xad\x10\x29\x5d\x93\x3e\x2f\xe2\x28\xe5\x6a\xd1\x8b\x88\xf5\x69\xe7\xf4\xe1\xa8\xcb\xc5\x4d\x94\xa9\x2b\x47\x7b\x2c\x28\xab\xa5\xef\x8f\xf8\x54\xf0\xf6\x9e\xd3\x74\xb5\x8c\x5d\xc7\x3e\xed\x76\xcb\x64\xac\x1d\xf7\x7e\x0a\x86\x5f\x87\x6b\x0a\xfc\x46\x1d\xbf\xe2\x17\xfc\x94\x3f\xd5\xc8\x7b\x07\xea\xdf\xd7\xd5\xfc\x6c\x5d\xc7\x93\xa4\xbd\x85\xbf\xae\x19\xce\x21\x28\xef\x39\xee\x32\x0b\x37\xab\x2e\xfd\x50\xa2\x53\x34\xdb\x18\xc6\x90\xf5\xac\x3f\xee\x65\x62\x73\x35\xcd\xf8\x40\x76\x9f\xf1\xab\x03\x23\x32\xe5\x62\x9b\xee\xe6\x78\xe7\x48\x8d\x14\xd5\x50\xc5\x99\x83\x5f\x75\x63\xe4\xc2\x65\x18\xad\x56\xe0\x96\x1f\x5b\x30\x79\x7f\xe1\xdd\xa8\x12\xe8\xb2\x14\xe8\x74\x4c\x1b\xfe\x6a\xd5\x1e\x5a\xcb\xd1\xb7\xed\x38\x83\x78\x18\xb1\x5b\xfd\xcf\x34\xbf\x34\x09\xa8\xa1\xd5\x5d\xf5\xbb\x3e\xa0\x1c\x9d\x32\x8c\x02\x0b\xac\xa6\xec\xfb\xc4\x19\x3b\x3a\x66\xe1\x37\xdf\xe1\x23\x58\x47\x7a\x42\xb0\x2b\xec\xda\x0c\x1f\x55\xa7\x49\xfc\xd2\x9d\xa0\x45\x2a\x2a\x25\xa8\x7d\x78\x73\x57\x15\xaa\xb2\x57\x86\x54\x82\xdf\x8a\x5c\xea\xc6\x70\x11\x35\x9c\xa2\x45\xab\x5d\x4f\xc5\x8a\xf0\x81\xaf\x4f\x2f\x41\x59\x6d\xec\x05\x24\x65\x72\x8b\x29\x8d\xad\x14\x1e\xad\xa4\x67\x0e\xa3\x9f\x31\xb1\x9f\x0d\x21\x4c\xe8\x73\x46\x4f\x61\x8e\x58\x68\xf8\x05\xdf\xdf\x77\xc4\x65\x73\xf6\x5a\x40\x6d\x79\xa7\x6d\xef\x97\x6e\xc6\xf9\x0b\xd1\xb2\x81\xa6\xc0\x2a\xfd\x9c\x69\xe6\xa0\xea\xc2\x42\xb9\x70\x18\xd6\xb7\x69\x1e\x43\x5b\x86\xa4\xb8\xc0\xa8\x53\x8b\x4c\xa3\xe2\x0a\xb9\x60\x28\xdc\x9e\x72\xee\xf9\x60\xa3\x80\xff\xa8\xb1\x5e\xf1\x6d\xc1\xf9\x07\xb1\x88\x9c\xab\x29\x5a\x8c\x5b\x14\xa3\x6a\x8d\xc4\x11\x51\xcd\xf8\x5a\x58\x74\x22\xe8\x5b\x80\x63\x2b\x17\xe1\x50\xf8\x3f\x2f\x4d\xde\x5c\x7a\x01\x13\x88\x36\x44\x5b\x17\xcb\xa7\xe9\xc8\x12\x6e\x99\x18\xce\xd6\xf7\x53\xc4\xaf\x14\xc8\xd9\xc4\xa8\xfc\xd0\x37\x6f\x98\xf8\x4d\x92\x9d\xa2\x6f\x02\x37\xf7\xb5\xdd\xa7\x49\xeb\xb8\x6d\x82\xe0\x58\x09\x4f\xec\x1a\x48\x1a\x45\x79\x51\x98\x44\xa5\x02\x50\x0a\xc6\x6e\x2c\x48\xa8\x7a\x3e\xd2\x31\xdb\x90\xc5\x00\xb2\x1c\x87\x4c\x1a\x59\x5c\x39\x98\xc2\x31\x48\x32\x05\x70\xdf\x94\xa3\x7b\xa4\x60\xc1\xf5\x38\xe5\xb7\xe1\x02\xf8\x65\x55\x7f\x0e\xca\xce\xfd\x06\xb7\x50\xf4\x31\xed\x23\x65\xee\xe3\x94\x4c\x28\xea\x60\xd4\xa1\x29\xc9\xd9\x9c\x7c\x77\x0d\x36\x31\x7f\x91\x47\xdd\xb0\x98\x8b\x29\xbb\xee\x02\xef\x01\xd5\x1d\x34\x22\x6a\xee\x88\xb6\x2a\x8e\x09\x50\xfd\x2e\x19\x72\x6f\xef\xab\xb9\x47\x39\xa6\x09\x77\xc5\x30\xb3\xa1\x1f\xb1\x1f\xcc\x2f\x4d\xa3\xd1\x20\xff\xbd\x6e\xde\x66\x79\x8f\xbe\x25\x6d\xd3\x6a\x30\x79\x57\xb2\xdd\x27\x5b\xa0\x66\x89\x03\xdb\x6e\xdd\xb7\xd7\x75\xb6\x1a\x4b\xe5\x65\x28\x3e\x93\x20\x0e\x13\xe8\x02\x11\x24\xd8\x05\xa0\x87\xa5\xc9\xa8\xcc\x26\x00\xec\x66\x95\x70\xc2\x38\xec\x68\xa3\x7e\xfa\x78\xd4\x4d\x6e\xe9\xa7\x94\x65\x38\xc4\xf5\xef\x87\xa4\x8e\xad\xa2\x60\xa3\x83\x59\x69\x39\x4b\x1b\xb9\xd3\x5b\xb4\x03\x6c\xef\xb9\xcf\x82\x6d\x90\xbb\xae\x85\xc2\x97\x69\xde\x9d\x48\xba\xe9\x2b\x71\x0e\x76\x84\x16\xc4\x3d\xd8\xee\x74\x11\xad\x4a\x5c\xd3\x98\x8d\xfa\xa4\x6c\x99\x4e\x4f\x89\x29\x0b\x42\x91\xcd\x93\xd8\x42\x90\x50\xde\xfc\xd1\x3d\xff\x62\xc1\x15\x4d\xb9\x51\x09\x2b\x23\xec\xa0\xc0\x4d\x1e\x05\x53\x47\x67\x58\x28\x40\x47\x90\x7a\xb1\x58\x69\x90\x4f\x21\x4f\xb9\x5e\xcd\x5d\xaf\x86\x98\xb7\x72\xa7\xe6\xef\xc8\xf4\x05\xce\xcc\x7e\xc8\x36\x64\x5a\x9b\x20\x8b\x96\x71\x58\x49\xc0\x69\x8b\xb3\x8f\xca\x3f\x76\xfb\x7f\xaf\xb4\x8b\x1f\xa4\x73\x50\x74\x3b\x6f\xf3\xbf\x57\x8f\xd6\x8a\x7a\xe4\x51\xe1\xc8\xfd\x68\xa4\x03\x95\xbb\x86\x0d\x2e\x38\x69\xd9\xb4\x70\x9f\x7a\xd3\xa4\x5a\xef\xa7\x51\xb8\x98\x78\x21\x51\x54\x06\x4a\x70\x38\xfa\x70\xad\xf9\xa0\x68\xb5\xe6\x27\xd9\x76\x98\x58\xbd\x41\x79\xf6\x9a\x65\x63\x65\xbd\x83\xc2\x4f\x71\x7c\xc2\x18\xdf\xbd\x16\x7b\xfe\x5d\xc1\xf0\xcd\xfa\x76\xc9\xa6\xda\x9c\x53\xd0\x41\x48\x81\xa7\x1e\x45\x71\x80\x02\x2a\x2f\xdd\xd5\x2a\x3e\x5f\x69\xd2\x1b\x3f\x30\x38\x68\x67\x78\x0d\xdb\xbb\x13\x2d\x1a\x4b\x66\xe0\x64\xc0\x2d\xb2\xf9\xc1\x0c\xc0\xef\xfb\x86\x8c\xf8\x55\x14\x73\x8d\xad\x8c\xb6\xdb\x5c\x6c\x36\x47\x2f\x25\x9b\x5c\xbd\xc8\xa0\x99\x2f\xf8\xb2\x64\xbe\x57\xaf\x72\xea\x21\x3c\x54\x20\x54\xe3\xf6\x7b\x5a\x5a\x05\x46\xc5\xc0\x8d\x1c\x07\x07\x11\xfc\x21\x1b\x75\x05\x03\x66\x8e\xc8\xaf\x1a\xa8\x9d\xe2\x93\x23\x15\x78\x64\x6d\x31\xb4\x64\xf0\xc2\x7a\x64\xe6\x0a\x6e\x31\xd3\xd0\x1c\x47\x6f\x06\x6b\x17\x7c\x62\xd4\xd9\x1f\xe5\xb9\x2a\x6c\x88\x4c\xc9\x6a\xe5\x88\xef\xe1\x27\x71\xc7\xcc\x2b\x7f\x83\xe6\xe3\xa8\x34\xc4\xf7\x33\x49\xce\xaa\xa4\x6d\x39\x1c\xef\xdd\xd6\xb7\x21\x12\x5b\x62\xd1\x29\x71\xc5\xa6\x47\x6c\x3b\x55\xb3\x33\xf2\x21\x86\x5f\x77\xe8\xd0\xa9\xce\x11\xb7\x40\x58\x75\xc0\x3e\x4a\x4b\x88\xd5\xa6\x55\xd3\xc1\x4a\x3f\x80\x7d\xdb\xef\x54\x6a\x27\x2c\x23\xfc\x40\xd9\x93\x93\x6b\xda\x30\x95\x5c\x47\xca\x8a\x03\xd7\x18\xf6\xb6\x90\x02\x87\x92\xed\xc5\x46\x70\xbd\x68\xe7\x2f\xd8\x8e\xe9\xdd\xb5\xda\xd9\xcf\x17\x6b\x85\x4d\xeb\x0e\x78\x7e\x69\x3e\x17\x34\x61\x0a\x6d\x68\x08\x9d\xfd\x49\x8d\x32\xae\x94\x3d\xc1\x1c\xd9\xf0\x10\x47\xda\xc2\x83\x9e\x74\x20\xe0\x41\x86\x5f\xb0\x76\x09\x68\x6d\x70\xff\x7d\x09\x5f\xa6\xf2\x3e\x1e\xae\xf1\xb6\x75\xb6\xd4\xe8\xf6\xfc\xd1\x89\xf2\x6c\x27\x76\x75\x1e\xcb\x9f\x84\xa4\x16\x68\x83\xfa\x2c\x91\xf6\xd3\xf1\x7a\x3b\x54\x13\x65\x07\x76\x34\x20\x38\x67\xa5\x3f\x5b\xdf\xd5\xcf\x3d\x53\x2b\x5b\xf9\x8f\xf7\x67\x70\xd9\x25\x6c\x52\xd7\x64\xfc\xfd\x1c\xba\x40\x75\x97\xd7\x09\xa6\x39\x19\x5b\xbc\xad\x5e\xe2\x22\x46\x87\xa9\x95\x25\xb5\x56\xdf\x02\x59\x09\x30\x4c\x90\xcb\x90\xec\x34\x3d\x5f\x4d\xda\xf6\xec\x41\x8e\xd0\xea\x71\x77\x53\xa0\x6a\x30\x39\xc1\xcb\x2a\x6d\xec\x34\x6d\xdb\xc1\x5f\x4b\xe8\x95\x58\x29\x3c\x51\xdc\xc1\x2b\xbd\x3c\x04\x47\xc1\x1f\x0c\xc3\x08\xee\x31\x59\xf8\x2a\x23\x0c\x9d\x07\xf1\x52\x37\x1f\x3d\xec\xb3\x62\x4c\xac\xb9\x25\x7e\xfb\xbc\x98\x5d\x97\xa0\x4e\xe0\x4a\x57\xfc\x5c\xe1\xa1\x45\x2c\xb4\x96\x9f\xe3\x03\xdf\x3c\x53\xd2\x84\x8e\xa6\x5d\x08\xfd\x82\xfb\x84\x4a\x23\x13\xea\x4c\x6e\x67\xe0\x5c\x52\xd1\xbb\xd4\x89\x49\x17\x34\xbe\xe2\x16\x68\x2a\xdb\x3a\x98\xfe\xb1\x51\xa5\xc6\xbd\xbb\xf5\xca\xbd\x9b\x2a\xad\xb6\x83\x01\x35\xc9\xf5\xd3\x51\xa6\x3d\x86\x6b\x11\x81\x16\xa4\x16\xc2\xfa\xa5\x08\x62\x6d\xbc\xa4\xc5\xbc\x46\xa9\x96\x94\x2f\x78\xca\x8c\x5a\x12\xdb\x16\x7c\x8c\xa6\x0b\x75\xc5\x10\x18\xcc\x48\xc8\x1f\xea\x4c\x39\x58\xee\x72\x00\x31\xb6\xf5\x10\x90\xf8\x97\x62\x99\x05\x9a\x58\x22\x29\x1a\xf9\x03\x56\xd2\xc1\x01\xac\x23\xcd\xb5\xd3\x7e\x39\x91\x86\xae\x27\x7a\xcb\x99\xba\x92\x1b\x82\x04\xc5\xa3\xcc\xf5\x5b\x4f\xad\x1e\xb1\x13\x1a\x71\xe1\xce\x34\x6a\x79\xbc\x89\x8c\xd3\x9d\x30\xd3\xa6\x03\xd8\x62\xd9\x0a\x9d\x8a\xf0\xf8\x50\x29\x35\xd6\xa3\xb1\xe3\x4a\x27\x65\xd4\xef\x07\xca\x9e\x09\xea\x8e\xc8\x03\xd2\xe9\x34\x9f\x62\x21\x2d\xd4\x3a\x49\x37\x53\x1c\xbf\x8a\xc0\x13\x66\xd3\x1d\x3e\xc1\x28\x06\x96\xc1\x5c\x7a\x9d\x99\x0f\x79\xd6\x64\x2c\x18\x15\x92\x97\x6a\x08\x5c\x8d\x0c\x8f\xd8\xc9\x7c\xf4\x35\x14\x25\xb6\xf6\xc6\xa0\x47\xbf\xa2\x5f\x95\x63\x76\x30\x8e\x7b\x2c\x70\x42\x45\x0b\x47\x66\x35\x67\xf7\xd0\x91\xba\x47\x87\x79\xe1\xf4\x40\x4c\xc6\x89\x60\x98\x86\x23\x8b\x32\x3e\x54\x30\xba\xf7\x80\x5f\x6e\x81\x68\x58\xa6\x98\xee\xe6\xe1\x9b\xfd\xbe\xe6\x17\x3e\xbb\xbd\xf5\x45\xab\xec\x62\x4e\x51\x95\x9d\x64\x34\x1d\xe7\x08\xad\x1c\x71\x4b\xd1\x52\x60\x5d\x8a\xde\x5b\x46\x3c\x69\x2c\xef\x05\xe2\x0d\x9c\x14\x1d\x86\x1f\x28\x2f\x8d\x35\xfb\xaf\x5e\x8e\x48\x4b\x3c\x38\x81\x6f\xb2\x4d\x38\x1e\x53\xc7\x25\x87\xa7\x2e\xa0\x78\x5e\x75\xbf\x66\x76\xac\x1b\x51\x03\xb3\x65\x9c\xee\x22\x07\xc3\x83\x0e\x64\xe7\x96\x63\x34\xfa\xd9\xe5\x59\xe3\x1f\xbf\xee\x11\xa4\x87\x02\x83\xe3\x46\x40\xfa\x61\xf6\xe5\x28\xe0\x4d\xe9\x14\xe6\xde\x39\x8e\x81\x5f\xc3\xf8\xee\xaa\x25\xf9\x65\x46\xe7\x39\xa9\xf4\xc8\x8b\x9e\x54\x03\xc1\xf1\xa2\x83\x9b\x63\xca\xeb\x77\x2f\xfe\xce\x8e\xb0\x13\x08\x54\x29\xfe\x23\xa2\xb9\x3b\xfc\x4c\x97\xbf\x65\x8b\x2a\x50\x5b\xdd\x82\x51\xcd\xcf\x22\x23\x55\x89\x61\xd6\xa5\x88\xcd\x2c\xe5\x22\xc6\x0b\xe1\x97\xc3\xd4\xad\x62\xc0\xa9\x7c\x9a\x29\x6e\x4b\x2b\xa0\x27\x72\x3e\x8d\xf0\x53\xda\x61\x3e\xdd\x1e\xf0\xa4\x6c\x21\xd3\xa3\x6b\x5a\x47\xda\x4f\x03\x9a\x7c\x08\x69\xf7\xc7\xfe\xc4\x23\x3a\x6c\xf0\x1c\x23\x0c\x2b\x62\xc9\x6d\x94\x89\x6f\x83\x63\xf2\xc0\xd3\xf0\xa2\xe8\xb3\x12\x17\xd8\x89\x41\xe2\xe9\xdb\xbc\x12\x88\x6e\xc7\x03\x0c\x7f\xad\xc1\x04\x77\x23\xdc\xa0\x37\x0b\xf1\xcc\x35\xfa\xb7\x26\x71\xaf\x5d\xb7\xb8\x5b\x84\x23\xd9\x29\x7d\x9b\x21\x33\x2d\x11\xfb\xb8\xb7\x7f\x69\x11\x20\x69\x29\xde\x00\x4f\xa0\x60\xbd\x5d\x44\xf4\x9a\x4c\x1c\x60\x1d\xf9\x6d\x8c\xbd\xab\x32\xe1\xab\x9b\xcf\xdb\xb8\xa1\x66\xb0\x8e\xdb\xdb\x52\x6e\xc3\xc3\x3e\x91\x04\x9b\x36\xbf\x1a\x1e\x96\x0b\x8a\xa0\xd3\x86\xb1\x8a\x37\x58\x60\x82\x17\xac\x9a\xff\xd1\x0f\xfa\xf8\x05\x91\x14\x8b\xe0\x93\x4b\xf7\x3c\xd8\xe6\x2e\xcd\x3a\x82\xff\x55\x9f\x56\x0d\x85\x4d\x0b\x14\x4c\x13\x35\x01\xb1\xa7\x49\x96\x62\xc4\xa2\x7a\x10\x16\x8c\x10\x69\x36\x80\xc4\xc2\x81\x3c\xbc\x87\xa6\x8e\x6f\x67\x34\x2e\x2c\xd2\x0d\x7a\xf2\x30\x4d\xef\xf8\xd1\x73\xbc\x1a\x48\x42\x9b\xa9\xa0\xcf\x45\xda\x36\x61\xdb\x96\xb7\x66\x7a\x08\x34\x77\x44\x8a\x5f\xbc\x44\x23\xa0\x00\x02\xa3\x36\x02\x40\xb2\x83\xfe\x92\xd5\x80\xfc\x22\xbc\xc3\x2d\xda\x9f\xae\xa8\x54\xca\x47\x10\x5e\xd1\x44\xfe\x53\x97\x64\x28\xe1\x12\x7c\x1b\xb0\xb7\x8d\x4f\x98\xd0\xb6\x9e\xb6\x99\x04\xda\xd1\xbc\xd0\x8a\xd8\xff\xe2\x88\x59\xbd\xbd\xbc\xd0\xaa\x40\x99\xd2\xf0\x61\xdf\x4b\xfb\x70\xd9\x87\x9b\x5c\xdf\xd4\x37\x80\xf1\x49\xb2\xf3\x53\xf4\x0d\xbf\xcc\xe8\x7c\x3c\x26\x70\x9f\xc1\xd6\x66\x0f\x8a\x39\xd4\x79\x81\x55\x76\x7b\x72\xfe\xdd\xef\x0a\x99\x86\xd4\x66\x60\x95\x93\xbd\x76\xcf\xfc\x20\xaa\x28\xc8\x2d\xb8\x1e\x05\xd6\x6b\xb4\xda\xeb\x97\xaa\x58\xfe\x30\xa5\x2d\x41\x55\x82\x24\x7b\xa7\xe5\x5b\xe7\xe2\x6b\xc2\x8e\x70\xb0\xbd\x83\x7e\x7a\x99\x1f\x9e\x4a\x67\x3b\x05\xc8\x2b\x45\x36\x84\xce\x6d\x3d\xfb\x26\x8b\xbb\x38\xdf\x65\x4b\x1e\x10\xe1\xb5\x28\xfc\x4b\x98\x1e\xa0\x37\x8a\xd3\x18\x6b\x9b\x5a\x17\xd4\x63\x48\x9b\x50\xbc\x8f\xf3\xbd\x1a\xe3\xc1\xa4\xd4\xa5\x3a\x03\xdc\xed\x23\x36\x6d\x56\x13\x61\x36\x29\xd2\x03\x75\x6d\xf6\x51\xbd\x6e\xf0\x8d\x4a\x8e\x00\x3e\x4d\xc2\x17\xad\x3b\x17\xad\xe1\x49\xc8\xee\x76\xf2\x4a\x87\x9a\x08\x8e\x5c\xed\x98\xd8\x7d\x1c\x95\x06\x8e\x70\xe5\x7d\x96\x23\xe8\xa8\x50\x2f\x1a\x44\xad\xff\x0f\xc2\x3b\x97\xfb\x16\x34\x17\x1b\xe2\xad\xec\x77\x49\x9b\xdb\x10\xa4\xbc\xee\x63\x15\x35\x3e\x37\x1c\xf9\xa2\x72\x5d\x9b\xd9\x31\x35\x52\xd5\x38\x31\x51\xec\x86\x72\x0b\x65\x54\xe0\xd5\x2e\xb1\xf8\x30\x95\xda\x29\x58\x24\x2e\x09\x18\x98\x6b\x9f\xdb\x77\xb5\xcf\xd1\x73\x5b\x24\x6d\xb4\x1f\x14\xf8\x05\xa5\xd5\xf6\x8c\x92\xb8\x5b\xd0\xd7\xd0\x17\x26\x4f\xb9\xdb\xcb\x60\xb7\x2c\x5f\x5b\xb1\x75\x17\x79\x59\x3b\x90\xac\x74\x5d\xa5\x9b\xc1\xb7\xde\x29\x17\x90\x62\xfb\x9f\x61\xb5\x1a\x7f\x4f\x7c\x1b\x0a\x0e\x86\xbd\x8c\x70\x55\xbd\x7c\xaf\xc2\x33\x61\xe8\x46\xc5\xaf\x2b\x4a\x6b\x17\x48\x60\xb2\xa4\xb1\xfa\x19\x6d\x1f\xa0\x43\x39\xba\x97\x74\xb3\x27\x05\x1f\x76\xf0\xf4\xaf\xeb\xf8\x8c\xdc\x9d\x5f\xf3\xeb\x4f\xad\x44\xea\x20\xac\x26\xbb\x17\x88\x7b\xaa\xf6\xc3\x70\xca\x6e\x9b\x1d\x83\x8a\xad\x25\x16\x45\x1f\xc0\xaf\xa1\x4d\x21\xcd\xac\x6b\x5a\x31\x68\xdf\xd7\xf4\x45\x6d\x89\xee\xf5\xe9\x9b\xba\xe5\xc5\x99\xbc\x29\x78\xdb\x6a\xca\x14\x3a\x6d\xcf\xf1\x87\x56\xd8\xdf\x75\x9f\x0b\xd4\x61\x19\xcf\x51\xc1\xad\xaf\x07\xbe\x15\xb4\xb1\x46\xa7\xf4\x5a\xe6\x9d\xf6\x70\x25\x51\x23\x6a\x82\x5b\x43\x7a\x74\x7d\xae\xf1\x57\x3a\xdd\x8e\x36\x41\xf4\x47\xba\x60\x2f\x19\xdb\xf4\x24\x42\xfe\x21\x16\x54\xb6\x64\xea\x07\xf7\x05\x84\x34\x09\xcf\xb6\x2b\x23\x05\xa8\x5d\xf6\xf0\x02\xd1\xdd\x2c\xb6\x6a\x1c\x68\x9a\x58\xe9\x72\x64\x08\xf4\x54\x93\x83\x97\x77\xe2\x9d\xb7\x06\x9d\x70\xf3\x11\xe5\xea\x34\x90\x2d\xea\xba\xa2\x27\x47\x86\xde\x35\x92\x50\x7c\x93\xaa\xab\x4b\x63\x31\x19\x6e\x66\x93\xfc\x18\x38\x0e\xfb\xa8\x95\x8e\x15\x0b\x89\xca\xd1\xc3\x13\xb3\x09\x4d\xf7\x86\xe0\xfa\x09\x77\x6f\x15\x7c\x5d\x93\x5c\xec\x38\xb7\x16\x3d\x37\x3e\xbc\x3e\x21\x05\xb7\x7c\xe3\x7e\xa9\xb2\x08\xe2\xe0\x69\x1f\xbb\xa2\xee\x9c\xc7\xf1\x90\xc8\xbb\x4c\x5b\xcb\x4d\xf4\xfd\x37\xc9\x26\x6d\x1a\xbd\xaa\x96\x8d\xd8\xcd\xd1\x75\x1c\x67\xc1\xac\xfa\x3e\x17\xc1\x57\x1e\xab\x2a\x8e\x8d\x82\x2f\xb3\x9a\x16\x62\x32\x3d\x50\x3a\x3c\x12\xdf\x49\xcf\x88\x59\x12\x88\x3f\x48\xfd\x08\x4e\x66\x52\xcc\xd4\x14\x15\xf4\xdf\xfc\xc4\xe0\x9f\xb7\x6c\xda\x6a\x51\x24\x94\x2d\x3c\x52\x9b\x0a\x28\x96\x6b\x80\xda\x5f\xea\xeb\x8d\x8c\x92\x13\xc7\xfc\x61\x2e\x5f\x29\xe9\x31\x11\xea\xe2\xf1\x98\xda\x76\xa9\xa2\x0c\xc0\x21\x12\xd1\x35\x9d\x81\xd9\x6b\x39\xb1\x15\xd5\x8e\x0f\xa7\x55\x6f\x9a\x40\x47\xe0\xde\x78\xc0\x4a\x3f\x4d\x1d\x39\x14\x6a\x75\xbd\xcf\x71\xa0\x8c\x7a\xe0\x5d\xab\x18\xfd\x30\x04\x1e\xa6\xe9\x18\x57\x7a\xc6\x59\xe3\x6d\x8c\xfe\x3d\xc8\x85\xbe\x65\xe8\xa0\x4a\xf7\x0d\x70\xc5\x0c\xfc\xca\x0f\xd2\x71\x11\xad\xa9\xa9\xc0\x9b\x92\xd1\x82\xb0\xa3\x35\x17\x6f\x11\x1f\x1e\x6c\x8b\xf8\x66\x33\x87\x82\x9d\x10\x8a\xff\xa1\xbd\x62\xa1\xa3\x89\xec\xef\xbb\xf3\xb4\x9c\x71\x87\x6d\xca\xa8\x0a\xe7\x02\x57\x13\x3c\x7e\x2b\xb1\xa0\x99\xb1\x7c\x2b\xf8\x69\x3a\xd8\xb7\x97\x19\x2f\xd9\x2d\x20\xca\x8a\xe5\xd6\xb0\x61\x93\x53\xa1\x15\x87\x3f\xef\x4f\xbe\xb9\xc7\x1d\x53\x3f\x71\xd1\x2d\x91\xf6\x5a\x7a\xfe\x9d\x8b\x6d\x29\xcc\x4b\x57\xa0\xc3\xaf\xe1\x36\xc6\xfc\x0a\xde\xb7\xa0\x41\x9b\xe7\x03\x2d\x57\x2f\x1f\x64\x80\x9c\x71\xdd\xa9\x8e\x97\x4a\x6e\x0b\x12\xd4\x00\xcd\x4c\x41\x0f\x8d\xfa\x32\x73\x46\x9c\xa0\xd5\x5e\x00\x3b\x6d\x77\xa6\x71\x54\xd6\x78\x14\x8e\x5f\xea\xbe\x69\xdb\xc7\xfa\x6e\xf6\x8e\xb4\xae\xf7\xca\x75\x66\x46\x56\x0a\x70\x8f\x02\x78\x6c\xd2\x2a\x96\x22\x40\x32\x11\xea\x69\xb1\x1e\x64\xbc\xef\xce\xdf\x95\x0b\x28\x0e\x85\xa0\x33\x1d\xa2\xa5\x71\x5e\x6f\x6b\xb4\xe5\x76\x77\xb1\xbd\xbd\x12\x66\xa2\xd7\x27\x44\x8f\xd1\xf4\xe5\x24\xe1\xbd\x49\x4a\xdd\xa8\xa0\x47\xa1\x3d\x4c\x3a\x67\xd0\xed\x03\x9d\x2e\x94\x95\xd0\x4a\x06\x4e\x55\x7a\xc6\x3d\x45\x95\xd7\xb7\xd9\x1d\x26\x95\x48\x78\x88\x65\xfc\x73\x7b\xe7\x26\x16\x85\x5c\x54\x79\xe8\x49\xa8\xdb\x72\x28\x3e\xc9\x50\x18\xb1\x99\x63\x1a\xad\x8a\x37\xc4\x87\xd4\x54\xf6\xa9\x56\x57\xd4\xba\x9b\x5c\x7d\x6f\xf4\x44\xcd\xb4\x75\x02\xeb\x1e\xf2\x5c\x25\xb1\xa9\x86\x89\x0e\xc6\xd7\x79\x4e\x43\x5d\x54\xef\xb9\x90\x91\x43\xfe\x51\x19\xdf\xf1\x3a\xb1\xf4\xf5\xb2\x24\x60\xfa\x01\xda\x40\x5a\x75\x20\xc3\x2e\x11\x7b\xef\xf4\xe9\x26\x0e\x67\x87\xb1\x6b\x13\x71\x9d\xc0\xe4\xde\xed\xab\xe5\x0d\xff\x37\x83\xa6\x27\x78\x6e\xa9\xae\xed\x9f\x5b\xd7\xaa\xdf\xc6\x5e\xac\x30\xae\xb3\xe9\x28\x6b\xfd\x85\x7b\xc6\xf1\xda\xa2\x03\xdb\xb8\xc0\xee\xa1\x45\x5e\xd9\xb9\x9b\x1f\x3a\xcb\x83\xc1\x0c\xcd\x5e\xdd\x03\x55\x8a\xf8\x24\x67\xd9\x0e\x91\x9f\x1f\x00\xe9\xca\x50\xc5\xd5\x35\x97\x7e\xb4\xa9\x60\x9c\x41\x35\x1c\xb3\x81\x0b\x58\x68\xde\x82\x50\x13\x03\x7b\x5d\x31\xc6\x28\xa4\x94\x65\xf7\xad\xf8\x83\x84\xb1\x5d\xe4\xc0\x02\x9e\xef\x79\xff\x11\x59\xdc\x3a\x66\x4b\x09\x38\x44\x2e\xb6\xa0\xde\xd8\x1e\xe1\xd4\x32\x3d\x0d\x42\xe0\x97\xc5\x3c\xa6\x3a\xab\x43\x10\xde\x31\x6a\x73\x03\x32\xb2\xdc\x37\x5f\x2b\x51\x46\x5d\xc0\x8e\xad\x41\x6d\x34\x2d\x17\x55\xcc\x30\x9c\x9c\x69\x35\x3e\xbc\x6c\xc7\xdf\x00\x9b\x2c\xab\x85\x63\x73\x15\xe1\xda\x51\x00\x1e\xa4\x28\xa8\x8f\xb3\x0f\xe4\x9c\xe3\x49\xd9\x94\xad\xd7\xac\x66\x57\xb5\xc2\x93\x3d\x22\x47\x71\xea\x5c\x94\xcf\xbc\x21\x9b\xde\x1e\xfa\xcb\x59\x93\xfc\x16\xd2\x02\x2a\xb0\x5a\xa5\xbb\x46\x1f\xb4\x58\x59\x3f\x12\x2e\x81\x19\x44\xe3\x41\xe7\x15\xe1\x1d\x8f\x84\xeb\x06\x52\x7f\x64\x02\xb5\x47\x4f\x5d\x33\x14\xe2\x62\x09\xe3\x69\xcb\x79\x38\x0e\x29\x58\x70\x29\x25\xcd\x01\x59\x39\x82\xb8\xc5\xb0\x78\xeb\xee\x00\xff\x73\x4b\xea\x56\xd2\x2f\xf2\xe8\x8e\xbe\x6f\xe4\x26\xfc\x34\xd0\x1c\x6d\x5f\x20\xb4\xc6\xc0\xfb\xcf\x36\x09\x20\x1b\x3c\x8a\xd5\x6a\x6e\x50\xf7\x66\xdf\xcd\xf6\x8b\x3c\x66\xc7\x5a\x2b\x87\x37\xf3\xcb\xfa\x08\xbb\x4f\xf2\x75\x01\xa7\xeb\x5e\x0c\xbd\x3d\x3e\xbe\x87\x7d\x2a\x8b\xfa\xae\x80\xa5\x95\x22\xcc\xaa\x12\x10\x59\xb2\x42\xa7\x69\x6d\x9d\x21\xe5\x27\xe4\x71\xc7\x87\x66\x03\xf9\x78\xcc\xe9\xd0\x29\xbf\xb6\x02\x47\xcf\xb1\x8b\xfa\xdf\x9c\x17\x5a\x40\x0b\x08\x3e\x21\xdb\x89\x9a\xbb\xcc\xd3\x5c\x7b\x85\x2f\x4f\xa7\x1a\xec\x59\x83\x8f\xe4\x40\x60\xae\xea\xc1\x5e\x0b\x46\x3c\xa8\x42\xe7\xe6\xef\xe4\x4b\xaf\x39\x59\xca\x03\x2d\x6c\x64\xd1\x8e\x74\x4b\x99\xdc\x31\x1c\x63\xdd\xef\x91\x1c\xa4\x36\x74\xa9\x87\xcf\x39\xbd\x1d\xdc\x5d\x0e\xc4\xc2\x51\x9d\xcd\xcd\x94\xe1\x2e\xd4\x3e\xa4\x1d\x66\xbd\x77\x3a\x3c\x81\x14\x9e\x9f\x0b\x2b\xaf\xd0\x02\xb1\xd9\x46\x45\x0f\xc1\x55\xeb\x8c\x26\x94\x8c\xae\x23\x60\x3a\x3e\xb8\x84\x97\xe2\x99\x08\x6e\x0b\x6b\xdc\x78\xa0\xb8\xef\xa9\x7b\xd3\x45\x87\x4e\x50\xb4\x33\xc5\x1e\x02\x93\xa2\x9b\x3d\xad\x96\x7d\x53\x63\x35\xf7\x94\x6a\xb0\x0e\x6a\x0b\x25\x93\xd9\x32\xfb\xbc\xe5\x9d\x0a\x7f\xc5\xb3\xd0\x01\xcc\x05\x03\xee\x68\xc6\x50\xde\x7a\x8e\xc4\xf3\xce\x26\x5d\xd9\xd3\x44\x56\xab\x43\xa4\xce\x5d\x58\x42\x62\xc0\xca\x40\xd1\x6b\x65\x56\xa8\xf3\xa2\x3d\x33\x3e\x66\x53\xd0\xd6\x91\xf7\x76\x28\x58\x29\xcb\x8d\x18\x54\x66\x93\x61\x42\x5d\xcd\x79\xb3\x3c\x86\x88\x77\x13\xcd\xde\xe7\x61\x85\x07\x5a\x74\x5b\xd0\xcc\x31\xcd\xe0\x16\xbc\xf7\x97\x64\x9a\x95\x0a\x87\xb0\x99\xdf\x9c\x13\xcd\xac\x21\x95\x5d\x2b\xb0\x9b\xcb\x76\x16\x32\x2e\x5e\x12\xe2\xa7\x6b\xf5\x72\xda\xc3\x4b\xa3\x37\x4e\x49\x53\x15\x81\x27\x06\x23\x2e\x9b\xb6\xac\xf2\xce\x7f\x08\xb8\xa5\xc2\x66\x13\x6d\x26\xa3\x9b\x24\xe1\x3b\x06\xea\x2c\x84\xdd\x60\xf9\x07\x62\xb4\x52\x40\xbe\xb5\x82\x5b\x84\xf3\x36\xb5\xfc\x5a\x54\x0a\xa6\x60\x33\x2f\x46\x9e\xfd\x68\xe8\xbf\xa5\x07\xa3\xa9\xba\x34\x04\x6f\x04\xd3\xda\x55\xd7\xd6\x36\x61\x70\x1a\xc4\x40\xb3\xee\x8b\xcd\x19\x83\xb6\xd8\x18\x6c\xe4\x92\x9d\xd0\x38\x9a\x8a\x43\x9b\xe3\xa7\x7e\x14\x3c\x87\xe9\x1e\x06\x16\x96\xa8\x9e\xa6\xd1\xcd\x13\x68\x76\xd3\xae\xc3\xab\xfb\x54\x66\x88\xf3\x16\x6a\x0d\x67\x42\x73\x3d\xda\xd2\xc9\x89\x0a\xc7\x5e\x16\x68\xcb\x57\xc5\x82\xe7\x79\xd9\xfc\xbe\xb2\xc9\x33\xd7\xbc\x80\xce\x85\x1f\x5c\xd4\x27\xc7\xc8\x5e\x8b\x12\xd5\x98\xd1\x1e\xf8\xcd\x63\xcc\x76\xc8\x5c\xe4\x38\x7c\x34\xd4\x6f\x7f\xb9\x45\x6a\x3a\x2a\x69\x61\x9a\xc9\xeb\x7f\xd7\x23\xa1\xbc\xf4\xd2\xc9\xe1\x30\x91\x6d\xab\x58\xc8\x77\x27\xab\xbf\xe9\x24\xc4\x56\x4c\xcf\xf3\x2b\x6e\x11\xb0\xbe\x69\x10\xb1\x1d\x61\x8b\xc2\xf4\x04\x9b\x6a\x94\xb9\x8d\xdf\xf5\x10\xe4\x39\x3d\x40\x76\x6c\x35\xda\x57\x5c\x39\xeb\xb8\x35\x17\xb4\x7d\x8d\x19\x3b\xd1\xaf\x36\x5c\x40\xcc\x71\x6d\x6a\x9f\xb6\x5f\x8c\x25\xea\xc2\xd6\x07\x4e\xd0\xca\x39\x73\x0d\x20\xc1\xf2\xa2\xf4\x19\x9b\x04\xbe\xb5\xc4\x87\xff\x19\xc1\x6d\xaf\x5e\xc7\x54\xbb\x32\x28\xf5\xda\xae\x99\xeb\x2e\x55\x7b\xda\x3d\x90\x55\xff\x2c\xcd\x9f\x71\xed\xb7\xbc\x33\x14\x8c\x66\xe6\xec\x4c\xeb\x4a\xee\xa1\x97\x65\x5b\xcd\xf9\xbd\x0b\x5c\x99\x23\xc4\xb1\x74\x9a\x84\x4d\x81\x10\xad\x32\xa0\x60\x82\xbe\xd9\x39\xd0\xbc\x94\x9f\xc4\x6e\x47\xe6\x6c\x8e\x32\x8c\x23\x97\xd2\xcd\xf6\x3c\xb8\xf7\xe7\x69\xe5\xb1\xe6\xa2\x06\x33\xf8\x5f\xb5\xaf\x79\x40\x1e\x31\x17\xce\xad\xdc\x07\x3d\x75\xbd\xe6\x2d\xda\x30\x6d\xfe\x33\x98\x73\x21\xf9\x77\x35\x0d\xe0\xa3\x1d\x21\xe7\xc5\x68\x1d\xae\xa0\x0b\x0c\x35\x42\xfc\xc1\xee\xfc\xd8\xa9\xe1\x7f\x1c\x6b\x39\xbf\x53\xb6\x23\xad\x8a\x15\xf6\x4c\x5a\xcb\x59\xa0\xaa\x2c\x63\x2f\xe5\x5f\x48\x05\x59\x5e\x3d\x1d\x65\x9b\xf3\x85\xac\x18\xfd\xa2\x9a\x45\xdd\x1f\xcf\x3b\xa6\xb6\x0b\xb7\x1d\x74\xc4\xf3\xc3\xc4\xf7\xff\x7d\x9a\x2e\x11\x27\x9c\xf3\xb0\xd7\x39\x7f\x20\x38\xa6\xbd\x17\xf0\xf6\x0e\xba\x76\x8e\xb9\xa2\x08\x85\xa0\x75\xc7\x26\x10\x3b\x69\x31\x05\x7e\xdb\x6d\xd1\x26\xd2\x5b\xf5\xb4\x09\x42\x9e\x05\xee\x4d\x88\x13\x5a\xc2\x62\x3a\xf0\xc5\xdb\x58\x87\xa3\x8f\xf0\x16\xc1\x9a\x7e\x9c\x58\xd7\xf5\x2e\x62\x97\x30\x62\xb1\x9d\xcb\x63\xa5\xe0\x6d\x43\x57\x24\xc3\x0c\x8f\x7b\x63\xb9\xde\x68\x35\x36\x84\x2b\xf4\x03\x17\x6b\x11\xdb\xf7\x1c\xb1\x7c\x69\xc8\xb7\xd8\x5e\x50\x0f\x7a\xae\x5f\x46\x5c\x0d\x98\xcc\x49\x27\xa0\xd8\x9c\xb2\x89\x1f\x7c\x87\x0e\x14\x14\x01\x45\xbe\xdb\x92\xad\xd5\x14\x78\xaf\x16\x9d\x1b\xbb\x87\x7a\x65\xaa\xcf\xe0\xe6\xcb\x1d\xe6\x65\x64\x10\xc3\x96\xcb\xad\xec\x79\xf7\x30\x31\x15\x95\xea\xcc\x26\x18\xdd\x62\x7a\x53\x0d\x88\xb8\x99\x65\x2b\x60\x4c\xd3\x84\x72\xd5\x92\x7a\x57\x7a\xf7\xc3\x1f\x25\x08\xf3\x3e\xc8\x59\xf1\xfc\xa6\x00\xae\x5d\xeb\x2e\x08\x66\xf0\xa5\x07\x17\x95\x19\xbc\x76\xb2\x94\xa6\x2b\xe6\x5c\x6d\x5b\xd7\x31\x3f\x51\x17\x76\x71\x6d\x82\xdb\x56\x17\x80\xd6\x4e\x18\xb9\x51\xcd\x4c\x70\xb7\x43\x0d\xa6\xa8\x57\xba\x61\x0b\x42\xde\x70\x36\x13\x2b\xb2\x46\x80\x57\xae\x64\x1a\xe9\xf7\xae\xef\x6e\x5c\x25\x66\xb2\x94\xa6\xcd\x33\x0f\x3f\x1f\xf9\x71\xaf\xad\x5c\x29\x5e\x71\xcb\x03\x0d\x32\xf9\x91\xcc\xd1\xa8\x5a\xcd\xfb\x31\xbc\xaa\x2e\x73\x07\xf0\xae\xe4\x7d\x50\x70\xa3\xc1\x23\x33\x95\xda\x15\x28\x67\x41\xb0\x70\xc5\x0e\x68\x9c\xf5\x0b\xd6\x6a\x11\x5b\xca\xd9\x53\xd5\xff\xbc\x70\x50\xab\x6e\x32\x91\xc7\x23\x94\xb8\xe8\x4b\x8b\x50\x2e\x04\x91\x54\x33\xf1\xa1\x85\xa3\x66\x9a\xdc\x47\xb6\x2a\x97\xce\x4f\xcd\x64\x5d\xe1\xec\x73\xbf\x6a\xa5\x5b\xae\x44\xba\xee\x90\x04\x1d\xfa\x8e\x67\x3a\x6e\x1e\x3a\x3b\xdb\x3d\x9f\xf0\x40\xe7\xae\x12\x18\xcd\x43\x53\x20\xd3\xdb\xd2\xce\xf7\x6f\x11\xc7\xfa\x41\xc5\x2f\x8b\xb8\x6d\xf2\x6d\xfd\x48\x33\xd6\x5a\x17\x8b\x1d\xcb\x26\x1e\xdd\x02\xa4\xc2\xb8\xb1\x0c\x63\x3f\x84\x87\x03\xdf\x3d\xe2\x8a\x05\x94\xf0\x35\xcf\x14\x9e\xe6\x15\x40\xd3\x79\x16\xcb\x19\x4f\x58\x28\x29\xc1\x4a\x90\x15\x65\x18\xdb\xe4\x6e\xfd\x34\x2b\x8f\xf7\xf5\xce\xa6\xf1\xc0\x85\x6a\xf1\x67\xcf\x07\x2c\x73\x22\xba\xae\xc0\x53\x4d\x03\xce\x11\x41\xe5\x69\x8f\xaa\xaa\x1b\x11\x5f\xc3\x62\xf8\xf2\x65\xef\x3d\xba\x62\xb1\x27\x46\x3b\x5a\xf1\x98\xbd\xb4\x6d\x87\x61\x5b\x47\xfd\xfd\x3a\x4d\x76\xb0\xdc\xde\x8b\x70\x85\x81\x0b\xbf\x86\xb1\xe6\xae\x62\x9c\x6b\xcf\xfc\xc9\x38\xa4\x2a\x6e\xb1\x4d\x96\xf8\x4d\xaa\xbc\x35\x3b\xd2\x65\xc5\x59\x9a\x3f\xd7\xee\x0e\x3c\x68\x6f\xb4\x3d\x0b\x99\xb4\x72\x01\x8a\xba\xcf\xed\x1d\x0b\xb6\x20\xf1\x3a\x9e\x75\x2f\x6e\xe9\x48\xb2\xe4\xfb\x29\x6c\x1d\x77\x1d\x75\x52\xe2\x4f\x7d\x20\x66\x2e\xd0\x16\x39\x77\x93\x3f\xad\xc6\xa3\xaf\x65\x51\x0d\x10\xc2\x65\xc9\x6e\xa1\x86\x1c\x5c\xce\x6a\xea\x75\x14\x6a\x7c\x99\x01\xef\x9f\x32\xe8\xe0\x20\xd7\x0f\x21\xf8\xf4\x1d\x25\x1f\x71\xdc\x19\x7d\xc6\xd9\xc2\xf7\xb9\x08\xc6\xd9\xcc\x3d\x16\xcc\x5c\xc5\x68\x33\xaf\x10\x29\x80\x9a\x74\xba\xf9\x06\x6c\x8d\xd4\xda\xe9\x15\x56\x22\xaa\xd9\xd5\x90\x8e\xfd\x78\x22\x40\xe0\xb1\xe6\x6f\x35\xfe\xe9\x5b\x83\x35\x64\x1c\xf5\x97\xd6\x69\x03\x99\x81\x76\x58\xda\x5a\x47\x8c\x8e\xab\xfc\x30\x2a\xfb\x57\xdd\xe5\xb2\xfe\xba\x52\x45\x32\x0c\x91\x63\x25\xbb\x81\x7a\x09\x8a\x90\x09\x1a\x17\x04\x3f\x18\xb5\x8a\x9d\x5e\xf7\x2f\x5d\xab\xfe\x4c\x76\xee\xb8\x51\xce\x90\xda\x0a\x14\x33\x6f\x97\xca\x11\x17\x98\xf7\x1e\xd8\x8e\xc2\xd0\x7e\x7b\x44\x92\x7a\x37\x1c\x79\x74\x2a\xa8\x8c\x99\x46\x0b\x86\x39\xeb\x21\xdf\x08\x32\x1c\x7a\xba\x67\xee\x0f\xe3\x4e\x12\xb8\x36\xab\xd5\x1f\x3c\xe4\x5c\xf5\x06\xa2\x95\x4a\xb7\x57\x76\x06\xc4\x65\x89\x40\x62\xb3\xa2\x97\xa3\x8b\xf3\x20\x35\x76\xf1\xc7\xf2\xce\x0d\xeb\xd7\x69\x0d\x7a\x2a\x6d\x52\xa6\x76\xea\x0f\x4d\x2e\xdb\xb9\x83\x5d\x7e\x57\x77\x4b\x70\xad\x87\x6f\xd2\x98\x66\x5a\xea\x27\x18\xec\xed\xc1\x1e\x8f\xfe\x48\xff\xec\x48\x90\xdc\x11\xeb\x57\xc4\x62\xd8\x64\x11\x35\x07\x34\x97\x2d\x34\xbf\x1d\xc1\xa6\xae\xb4\x0f\x19\x30\x02\xa6\xac\x42\x81\x7d\xc4\xab\x26\xf1\x94\x05\xe3\x10\xb3\x33\xd6\xaa\x98\xd1\x8a\x58\x55\x9e\xff\xff\xa7\xeb\xcf\xb2\x1c\xc7\x91\x75\x61\x74\x2e\xb9\xd6\x3d\x6f\x8c\xaa\xdc\x3b\x4e\xde\xaa\xe1\x18\x41\x23\x69\x2e\x10\x60\xa0\x91\x5c\x3e\xfa\x7f\x11\x60\x67\x1f\x74\x1e\x2b\xca\xd3\x5d\x22\x01\x6b\xbf\x26\xcd\x52\x0b\xfc\x73\x8f\x9e\x92\x07\x5d\x18\x5a\xc9\x0d\x81\x7d\x56\x6a\xa7\xae\x2a\xe3\x63\xea\x5e\xd6\xe0\x17\x89\x38\x98\x90\xa8\xd1\x12\x27\x55\xfc\xfc\x86\x6a\xd9\x36\x7b\x37\x64\xed\xc1\xb5\x86\x5d\xe1\x12\xc6\x4f\xb9\x47\x01\xdd\xe0\x23\xbb\x9e\xf2\xbd\xc9\x3e\xe8\xa2\x88\x27\xf3\xaa\x37\x17\x2d\x5c\x62\x66\xe6\x07\x58\x07\x34\x09\x40\xac\xcd\xad\x71\x66\xf2\x3d\x19\xa3\x74\xab\x8b\x9c\x7a\x6b\x29\x51\x25\x0e\xd4\x5e\x98\xfe\x64\x85\x84\xed\xd9\x3c\x60\xfe\x95\xd8\x81\x53\x27\x53\x04\xac\xcf\x8b\xe9\x83\x8c\xc8\x81\x86\x11\xbd\xa0\x70\xbc\x75\xc3\x21\xcd\xa4\x16\x59\x23\x07\xa7\x91\x73\x55\x56\x4c\x07\x10\xc1\x01\x57\x71\x6e\xd5\x7b\x85\xd9\x67\x5d\x6d\x91\xd6\x2f\x99\xb7\x8a\x37\x2a\x44\xdd\xe5\xa0\x71\xf3\xa1\xe8\x2a\x8f\x13\x82\xaa\x4c\xc8\x3a\x28\xde\xd1\xb9\x61\xe0\x3f\xfc\x1d\xfc\xc1\xf6\xc9\xb0\xf8\x93\xee\x6b\x3b\xf0\x60\x39\xb4\x97\x28\xe2\x26\x58\x56\xf8\xb4\xfa\x1c\x0a\x12\xef\xd6\xa9\x8b\x3e\xa4\x41\xb6\x02\x75\x88\xde\x75\x4f\xd1\x54\xc5\x81\x8b\x73\xe3\x91\xd9\x6e\xd9\xb9\x8a\x34\x5f\xd5\x7f\x75\x88\xdc\x5b\x94\x73\x91\xf6\x66\x6b\xfd\x0b\xa6\x58\x4d\x82\x49\x33\x84\xa2\x53\xf2\xbf\x91\xd4\x23\x3b\x76\x65\xc6\xad\xbb\xc6\x0c\xe6\xcc\x66\x96\xf5\xa3\x6d\x78\xe2\xef\x24\x56\xab\x71\x7d\x65\x2d\xf8\xd5\x93\x2d\x6e\x38\x4e\x2b\xe9\x4b\xf0\x7f\xb2\x56\x9c\x8e\x8d\x4a\x43\x5e\x07\x6c\xfa\x67\x6a\x8c\x63\x38\x8c\xac\x1f\x41\x83\x0e\x8f\xde\xe6\xe6\xeb\x1b\x0a\x4e\x9e\x1e\xed\x46\x72\x99\xf2\x9c\x7e\x48\x1c\x96\x86\x56\x53\x84\x58\x71\x34\xb0\x70\x90\xbd\x6a\x3d\x23\x6e\xfe\xa0\x0d\xa2\x5b\x7d\xef\x20\x96\x2c\x34\xd3\xeb\xd1\x42\x0b\x61\x2e\x4c\x61\xeb\xa4\xbb\x5d\xa4\x50\x7d\x57\xeb\x5f\x03\x40\xcd\xcd\x3a\xab\xa5\x6a\x8e\x9c\x02\x69\x9d\xf1\x29\xf0\x7d\xd2\x5d\x2f\xfa\x55\xb8\x17\x81\x74\xd4\x4c\x2c\x1e\x07\xae\x2d\xd6\x48\xdb\xbc\x15\x26\xc2\x5f\x28\x6c\xa8\x34\x18\xbe\x0b\x53\x10\x1a\x7a\x4b\x8d\x77\xa9\x21\x33\x33\xec\x15\x5d\x12\x87\x02\x15\xfd\x56\x08\x2e\x3e\x70\xf7\xaa\xbf\x04\xd8\x42\x02\x15\xce\xa3\xfb\xfb\x7f\x54\xe4\xc3\x85\x61\x71\x95\x42\x1b\xea\x82\xcb\x37\xf0\xe5\x03\xbd\x9d\xf2\xb9\x7d\x93\xea\xe3\x17\x9a\x0a\xb7\xff\x9e\x30\x06\x8e\xeb\x07\x4f\xe5\xd2\x5b\x20\xea\x89\xfa\x5e\x49\x2f\xec\x83\x7a\x3d\x07\xb7\x5b\xac\x41\xc6\x70\x51\x8e\xc4\xe0\x90\x48\x9c\x56\x4b\x7f\x1a\xf3\x4b\x2d\x08\x5e\x84\xbe\x7a\x45\xe5\xe7\x03\x9c\x66\x67\x48\x20\xc7\xd6\xc8\x44\xc5\xaf\x4a\x3d\x3e\xc3\x96\xfb\xd0\xcc\x5e\xa3\x7c\x77\x2b\xe2\x18\x17\xca\xe1\xbe\xe0\xdc\x6e\x9a\xf3\x05\x4e\x78\x0f\x21\x0f\x9a\x24\xfd\x6b\x26\x07\x94\x93\x99\xed\xa2\xab\x70\x79\x0a\x4e\x42\xb6\xff\x0d\xf7\x33\x79\x95\x39\xb9\x2c\x7b\x21\xb6\x98\xd9\xb1\x80\xb1\x67\x89\xcb\x0c\x21\x88\x40\xa3\x67\x1f\xfa\x9e\xbd\x96\x7f\x0a\xdf\x4b\xc4\xb8\x64\x71\x60\x93\xbe\x7e\x30\xca\xad\x92\x8f\x31\x37\x92\x9e\x79\x41\xf0\xd4\x42\x66\xa4\x61\xe0\xad\xf9\x0a\x49\x4b\x2b\xbf\x98\x1f\xd0\x51\xbd\x5a\xf2\xe3\xf6\x53\xb8\xe9\x0d\x2a\x9f\x4d\xc1\xc3\x42\x67\xa5\x24\xad\x9e\x40\xc1\x87\x32\xba\xa7\x56\x7b\x03\x98\xb5\x70\x0e\x7e\xa9\xc4\xb1\xcb\x0b\x74\x71\x62\xfc\xa2\x77\x8c\x56\xa6\x46\xf1\x21\xa8\x21\x4d\xd1\xdf\x5c\xb7\x76\x86\xf1\x19\x12\x0a\xc5\xc4\x19\x6c\xea\xfa\x20\xf7\x9a\xa2\x0f\xcc\x3f\xdc\x0e\x61\x55\x65\xb9\xf8\x5a\xec\x74\x0f\x97\x87\x4a\xab\x3c\x03\x83\xcf\x6e\x02\x70\x0b\x05\x6c\xbd\x16\x1e\xa4\x89\x0c\xb3\x77\x1c\x61\xcd\xf9\xf2\xa0\xc5\xf0\x00\x8b\x66\x08\xaf\x95\x95\x03\xbd\x7b\x34\xb3\x8c\xf7\x4d\x8e\x58\xcb\x13\xc8\x9e\xf1\xd4\x6e\x04\x0f\x55\xd6\xdf\xb7\x9f\x3a\x04\x73\x8e\xf7\x58\x3c\x79\x0d\xc5\x88\x1a\xac\xab\xa5\xf7\xe1\x1c\x73\x16\x22\x07\xd0\xea\x5c\xbe\x92\xea\x82\xa6\xc0\x6f\x56\x36\x9a\x97\xb7\x0f\x2c\xb7\xa9\x8a\x94\x5e\xc1\x30\x11\x42\xc4\x0d\x82\x73\xe8\x20\x04\xfe\xbe\x02\x68\x71\xd5\x6a\x40\x65\xd1\xcc\xbe\xd9\x14\x3b\xde\x97\xdd\xd7\x76\x7e\x95\xe8\xc1\xc5\x7c\xab\x5e\x4a\x00\xd6\x3d\x14\x51\xf7\xbf\x2a\x7a\xd1\x13\x40\x50\x81\x5e\xdd\x42\x89\x03\x98\xe7\x9b\x79\x0b\xfc\x56\x6b\x2a\x84\x25\xde\x04\x76\xce\x1e\xe8\xb5\x2f\x28\x9a\x82\x1b\xed\x15\x12\x4f\x5e\x5b\xb2\x6f\xa7\x1c\x4b\x1b\xab\xc5\xa8\x8f\x36\xec\x83\x08\x6f\x9f\x6d\xa3\xb1\x4e\xd6\x16\x0d\x32\x70\x7c\x58\xd6\x99\x52\xcb\x9d\x7d\xd0\x0f\x3d\xe6\x98\x34\x88\xb2\xe0\x28\x10\x46\x9d\xdf\x31\x26\x66\x2d\xbe\x12\x63\x2b\x9c\x37\xfa\xd2\x79\xff\xbe\x08\x06\xb5\xe2\xfd\xd7\xc5\xf7\xfa\x57\x5d\x9d\xc0\xfb\x7f\xf1\xa8\xf2\xfd\x1a\xfc\x57\xd3\x2e\x2f\xe4\x66\x4a\xfa\x03\x2f\xf2\x0d\x76\x28\xdb\x2b\xd5\xbd\x53\x2f\x13\x5b\x85\xf7\x5d\x04\x51\x6c\x83\xc4\x9e\x30\x50\x17\x68\xb8\xfe\xa7\xa7\xb0\x82\x30\x1f\xfa\x1d\xad\x80\xc7\xbd\x51\x9a\xe8\xd0\xde\xb8\x21\x24\x8b\x12\x42\x57\x47\x39\xea\x2b\x7c\x91\x79\xac\x5e\x37\x38\x7e\xe9\xc8\x0d\xdd\xaa\x85\xe7\xab\xc4\xb1\xfa\xbb\xec\x12\xe5\xa0\xd7\xf0\xac\x00\x98\xb3\xc4\xe4\x03\xba\xec\x16\x68\xc9\x3d\xd0\x88\x73\xde\x60\x4d\x78\xe0\xe7\xbb\x3e\xf0\x4b\xbd\x7a\xf7\x56\x8b\x16\x0a\x0f\x71\x53\xd7\x07\x98\x12\xc7\x4a\x3c\xbf\x07\x39\x0e\x79\xd5\x3b\x48\xb2\x1c\x17\xdd\x2f\xbc\x66\x10\x6e\x0c\x5c\x3d\x64\xa1\xe3\xad\x48\x2f\x94\x79\x9d\xbd\xe5\xed\xb7\x06\x15\x4a\x2d\xd3\x03\x11\x90\x5b\x32\x59\x83\xa0\x63\x66\x7c\x49\xe3\xb0\x66\x56\x55\xa2\xd7\x01\xcf\xb5\x15\xfb\xf7\xf5\xcc\x8a\x9c\xde\x4b\x54\xb9\xde\x73\x7a\xd5\x2b\x75\xb1\x45\x06\xe1\xac\x3e\x4e\x7f\xf7\xc7\x48\xf3\x8b\x74\x99\x30\x70\x4c\x45\x31\xe1\x07\xd4\x1c\x0d\xcd\xde\x25\x35\x10\xca\xae\xec\x40\x5c\xeb\xee\x54\x1e\xa3\x9e\x3e\x18\x49\xd2\x6b\x89\xa8\xc0\x8b\x83\x89\x49\x9a\xfd\x82\x3a\x14\xfc\x4d\x08\x7c\x75\x11\x5b\x9b\x94\x10\x34\x6f\xc5\x0d\xba\xe2\x9f\xfd\x02\x1e\xb1\x4c\xa1\xc5\x5c\x51\xc4\xaf\x13\x1f\x9c\x34\x3a\xa6\x34\xe9\xcb\x35\x9a\xbe\xe2\x89\xb8\x31\xd0\xa2\x03\x88\x93\xd9\x6b\x81\xf0\x60\xdf\x9a\x4c\x6c\xf6\x1c\x74\x49\x47\x30\x3d\xb6\x86\x11\x00\x56\x64\xfc\xe2\xd5\xe3\x79\x92\x95\xa6\x21\x8f\xfc\x0d\xee\x37\x25\x66\x37\x6a\xda\xdb\x81\x8f\xb1\xe2\x15\x4e\x94\x28\x35\xd2\xc7\x39\x80\xeb\xef\xcf\x8b\x83\xae\x82\xb7\x5c\x5d\xe2\xc9\xe8\x43\xc2\xfe\xf0\xbd\x1b\xbb\x5f\x27\x78\xe6\xc0\xc9\x8f\xb5\x36\xba\x6c\xcf\x7d\xee\x1b\x73\xbe\x45\x66\xf9\xdc\x65\xbc\xcf\x6c\x71\x2b\x6a\xb7\x28\xa1\x8f\x50\x99\x29\xf5\x64\xad\xce\x77\xb4\xae\x04\x1d\xf0\xea\xed\x7b\x0a\xb4\xaa\x77\xbd\xbd\x66\x7c\xcf\xf6\xa0\x63\x9c\xf3\x00\xc3\x35\x89\xfc\xfb\x3a\x7e\xac\xea\xa4\x98\xfb\x95\xbd\x76\xd6\xf6\x8e\xbb\x9d\x04\x7b\x7c\x84\xbc\xce\xe0\xc7\x30\x5e\xa2\x4d\xff\xb9\xee\xd7\xa4\xa3\xed\x42\x7a\xbc\x5b\x5d\x83\x0a\x1b\xba\xb8\x23\x20\x68\xb4\x20\x17\x35\x5a\x58\x76\xc5\xca\xdf\x37\x6e\x70\x53\x55\x05\x46\x55\xb2\x35\xf0\x50\xfe\x51\x4f\xff\xbe\xca\x62\xf2\xaa\x97\xd7\xc6\x7b\x63\xc7\x10\x42\xc1\xb1\xae\xc1\x7f\xcb\x82\x9c\x46\x1f\x58\x26\xa7\x40\x2f\xbe\xd7\xda\x95\xb5\xa2\xc1\xe8\x0b\x0b\xf3\x62\x28\xe0\x5d\x4d\x8f\x17\x2c\x20\x27\x13\x40\x6b\x7b\xd9\x4a\x47\xdd\x3f\xee\x9f\xa2\x53\xe2\xf2\xb7\xa0\x27\x79\x59\xe7\xa8\x4a\x1a\x9c\x03\x86\x5f\x0f\xa5\xd4\xc7\xdb\x49\x0a\xd0\x6b\x8b\xb5\x39\x02\xfe\x7a\xe6\x9d\x50\x7e\xde\x7c\xc3\x7b\x71\x7b\x1d\x02\xb3\x7c\x7f\xdf\x2d\xa9\xc6\xcc\x56\x63\x18\x0e\x28\x2f\xe0\x06\x29\x49\x1c\xb1\xf8\xe3\xef\xd5\x7a\xbd\x04\x5b\x24\x04\xac\x44\x8b\xaf\x36\xce\xf5\xcb\x3a\x0c\x01\x54\x64\x82\x77\x6f\xdd\x4e\x58\x09\xad\x88\x80\xf1\x4b\x0f\x03\xb4\x5d\xec\x1b\xbc\xc7\x46\x2b\x7a\x6c\x6b\x99\x06\x0d\x5f\x1b\x64\xe1\x44\xeb\xaa\x06\x12\x20\xdd\xfd\xc5\x2f\x56\x53\xf7\xea\x1e\x83\xe2\xf7\xe4\x28\x26\x8a\xa2\x03\x70\xe2\x50\x3d\x9a\x6e\xe7\x9d\x86\x27\x39\xb4\x81\x1c\x6f\x96\xb2\xd7\xfb\x58\xe8\xad\x88\x71\x26\xd0\xcb\xea\xda\x6d\x91\x3b\xcd\x38\x48\x7c\x74\x23\x18\x09\xf3\xb7\xd9\x5a\x6a\x7c\xb1\x81\xbf\x5a\x2d\xd4\x32\x46\xe7\x46\xaf\x7b\xd0\xcd\xc4\xd7\xfd\x82\x9c\x5a\xf4\x67\xe3\x6a\x9f\xf7\x41\xe1\xd6\xe1\xc3\xa0\xbf\x9e\xb4\xcf\xf2\x12\x73\x23\x9c\x98\x02\xbb\x73\xa6\xa6\xd4\x8b\xc9\x76\x05\xe5\xd2\xca\x58\x9e\x04\xe2\xce\x6a\xb4\xcc\x83\xd2\x1c\xde\x9a\x64\x62\xc7\x2e\x26\x5e\x21\x89\x23\x0f\x29\x04\xd1\x14\xb0\xad\x29\x62\x5d\x77\xa7\xa8\xa8\xe2\x85\x92\x04\x64\x38\xb3\x13\x10\x70\x26\x85\x57\x27\x7e\x20\xe3\x96\xe7\xe5\x34\xc0\x2f\xb2\xc9\x01\xcc\xe6\x63\xf2\xeb\x8a\xa5\x6a\x12\xd4\x8c\x5b\x28\x18\xaf\x72\x77\x0a\x1f\x14\x6b\xf6\xbf\x20\x1c\xef\x3b\x85\xeb\x7e\x94\xe1\xc3\x99\xa3\x4e\xf7\x58\x7d\xbc\x9b\x6d\x57\xf9\xc1\xc1\xeb\x58\xe3\xc7\xd4\x40\x5e\x52\x90\x47\xd3\x66\x26\x0a\xe1\x0d\x8a\x74\x45\x98\xc8\x4a\x23\xb9\x34\xe7\xd0\x48\xec\x71\x2b\xbb\x27\x2e\x36\x56\xac\xaf\xad\x61\x3f\xf5\x5f\xaf\xb9\x82\x29\xcb\x75\x80\x42\x0e\x4c\xb8\x63\x18\x24\x4e\x07\x0a\xf4\xdf\x67\x21\x11\x52\x20\x10\xa0\x2a\x6b\xd1\x71\x67\xb4\x5c\x77\x31\x1c\xea\x3f\x97\xfd\x9e\x4c\x9d\x23\x34\xfd\xad\xaa\x27\xea\xb1\xcd\x21\xbb\x87\x9e\xf5\x9e\x4e\x9b\x4d\x17\x57\x54\x09\x95\x2f\xcd\x1a\xfc\x73\xc7\x1c\x5e\xda\x0f\xef\x55\x21\x8a\x56\xdf\xc6\xf1\xad\xb6\xd1\x63\xd3\x98\xa4\xd9\xbd\xc4\x05\xe6\x74\xe3\xf6\x8e\xf6\x84\x78\xd5\xe0\x93\xf8\x30\x41\x48\x2d\x2c\x07\xa8\xf0\x77\xae\xc8\x59\x02\xd1\x77\xf7\x92\x34\x6f\xd5\x52\xc3\xa9\xda\x29\xfd\xfa\x72\xe7\x98\xfc\xd2\x30\x80\xfb\x2a\x13\xd4\x28\xad\xe4\xed\x1a\x42\xe3\xc1\xc3\xd4\x54\x9b\x16\xdf\x32\x2d\x7d\xb6\x5b\xb9\xd5\x99\xad\xe0\x46\x46\x7f\x42\x0f\xd5\x29\xd0\xd0\xd4\xea\xa2\x34\xb8\x4b\xe9\xaa\xca\xcd\x57\x91\x9e\x56\xf5\xd0\xca\x21\xa2\x4a\xc6\x42\xda\x30\x43\xe2\x1a\xfc\x76\x36\xb7\x8e\xad\x59\x6b\x04\x66\xbd\x7c\x5a\x29\x90\x1e\x8d\x4d\xef\x35\x6a\x9b\xed\xf2\x52\x1b\x9d\xc8\x21\x1b\xba\xda\xc2\x4b\xc9\xef\xbb\x28\xa8\x35\x73\xf2\x0c\x91\xf2\x21\x4b\x1f\x2a\x5f\xfc\xf4\x30\xe1\x10\xd5\x1a\xbc\x7a\x60\x95\x0a\x17\x0a\x31\xf7\x26\x6d\x59\xb7\x95\x53\xad\xed\x37\x4d\x0a\x96\x28\x0e\xa6\x8f\xe2\xb6\x7c\x00\xa4\xcb\x32\x23\x00\x95\x69\x43\x61\x50\x24\xd2\x82\x34\xbb\x13\xc1\x42\x5e\x56\x56\x18\xf4\x62\x36\xa8\xf6\xac\xc5\xf1\x34\x1a\xf8\x10\xab\xfc\x14\x0f\x94\x2b\xf1\xbe\x8a\x01\x92\x0e\x2c\xd9\x72\xb7\x10\xce\x28\xb2\x1b\xe9\x07\xb5\x56\x23\x80\x9a\x26\x64\xe5\x1d\xca\xbd\x00\x5b\xf1\x33\x37\x02\x56\xbe\xd1\x84\x5a\x98\xdc\xa1\xc0\x73\x2d\x95\x83\xa4\x0f\xf7\x31\xbd\x95\x0a\xc4\xd4\xee\x7c\x9d\x95\x07\x1c\xd2\xdd\x49\x0e\xf6\x65\xfb\x0a\x06\x96\x83\x2f\xd8\x98\x17\xf8\x51\x3a\xdc\xbf\x6e\x31\x20\xa7\xe2\xa2\x08\xc7\xe3\x05\x73\xa6\xe2\x47\x35\x52\xd5\xde\xbe\xdd\x1a\x72\xac\xfd\xef\xc8\x39\xa8\x22\x2a\xfb\x51\xb7\x3f\x2b\x87\x95\x3f\x98\xd1\xcf\x47\x89\x78\x1e\x15\xc1\x17\x1b\x78\x8d\x77\x8a\x7b\x31\xfc\x52\x5a\x0a\x5b\x7d\x87\x06\x7e\x47\x11\xa5\x2b\xda\xc3\x8c\xe6\x7a\x1c\xd5\x27\xf1\xfe\x78\x29\x94\x7d\x32\x20\x25\xc9\x3d\xb6\x20\x04\x20\x7e\x5a\x57\x2b\x28\x94\xfc\x95\x77\xe6\xc4\x99\x66\x7e\xf2\xa4\xca\xb3\x65\x97\xce\x3c\xf7\x33\x65\xbd\x82\xce\x55\xbc\x65\x55\x45\x6d\x18\x18\x57\x92\x5c\x44\x1d\xbb\x9d\x87\x04\x6c\xb4\x75\xb2\x5e\xa9\xa4\xf9\x1c\xff\x64\x4e\x9d\xa1\x41\xac\x55\x62\xf5\xdb\x87\xc6\x24\x8a\xd6\x67\xeb\xd6\x73\xaa\x7f\xf9\x93\xb9\x67\x53\x2a\xeb\x1b\xed\xdd\xee\xb4\xa5\xeb\x2c\x3d\xb7\x22\x0f\xc5\x4c\xf6\x32\xf9\x8c\x1b\xdc\xba\xf6\x4c\x2d\xa6\x7d\x1c\xc5\xd4\x32\xe5\xf2\x05\x31\xd5\x98\xfb\x1e\x2f\x73\x43\x07\x2d\x83\x85\xfb\x1f\x7c\x30\x50\x57\x66\x9a\x26\x0a\x1a\x55\xbf\xb3\xbe\x10\x5d\x20\xce\xd8\x0f\x96\xc4\x8b\x0f\xb8\x26\x08\xb9\x09\x3a\xb9\x4f\x20\x60\xc8\x64\xf5\x45\x2b\xba\xc6\x9d\x84\x97\x0a\xb5\xc5\x9b\xbb\x58\x4c\xdc\x63\xe9\x16\x97\xba\xad\xb3\x56\xa5\xd6\xe0\x17\x76\xec\x55\x0e\x09\x0f\x4e\x5d\xe0\xc8\x14\xf4\x84\x2f\xf0\x10\x1f\xea\x4f\x51\x4e\xbe\x95\x0a\x0a\x3e\x03\xe5\xac\x38\x0f\x06\xb4\xac\x31\x86\x2d\x37\xb7\x7d\xa5\xb7\x8e\x1e\xeb\xa2\x34\x68\x06\x1f\xd4\x67\x28\xab\xba\x44\x9a\x9b\xbf\xc8\xd0\xdd\x34\x97\xaf\xa7\x6a\xc9\x3c\xba\x2f\x7a\x21\xb5\x23\x26\xf4\xbe\x1d\xb2\x96\xf4\x3f\x25\x2d\x60\xf8\x33\x6e\x81\xe5\xdd\x85\xec\x20\xd0\x22\x1c\x22\xec\x78\xc3\xb3\x60\xa9\x9b\x80\xeb\x0d\xe5\x84\x0e\x34\xc7\x26\x0f\xa7\x10\xc5\xff\x47\x7d\x2f\x32\xb3\xab\xff\xf1\x39\xfa\x96\x82\x3a\x80\xf2\x27\xcd\xe4\x1e\xdd\xbb\x49\x2b\xdb\x3d\x30\xb0\x96\x7d\x2b\x69\x80\xb9\x61\xfe\xe6\x01\x3c\x95\xb3\x2b\x9c\x7b\xf4\xd6\xf6\x93\xea\xe6\xb3\xeb\xf9\x83\xd6\x6f\xf1\xce\x56\xbd\xee\x20\x71\x2e\x9d\x48\xb7\x95\xb3\xba\x0f\xca\x4b\x8f\x7f\x67\xa1\x1c\x44\x4f\xa7\x06\x89\x46\x56\x28\x1f\xb6\xc4\x10\x1e\x9a\x77\xb0\xdd\x60\xa7\x17\x99\x43\xe3\x78\x5e\x06\x65\x72\x68\x0e\x9d\xa5\xd5\x56\x5a\x5b\xfd\xb9\x27\x07\xcc\xde\xc5\x50\xb6\x32\x8e\xf7\xdd\x44\xb5\x09\xd4\xfd\xbb\xf5\x7e\x30\x39\x0c\xf8\xdd\x7a\x81\x9e\xdb\x15\x71\x9d\x56\xa2\xd2\x40\xb4\xaa\xd7\x19\x1f\x36\x3f\x3d\x9c\xf6\x31\x90\x33\x5e\x4f\xb4\x17\x71\xfe\x5b\x06\xd1\x85\x78\x71\x7e\x68\x9a\xbd\xec\x50\x7f\xb8\x76\x49\x0d\x2c\xb9\x58\xff\x5a\x0b\xe2\x7c\xa3\x6e\xe3\x79\xed\xb4\xbc\xc5\xe8\x0f\x44\xe1\xdd\x68\x69\x0a\xba\xf9\x4b\x64\x21\xf5\x90\xa3\xe4\xad\x9e\x4d\x44\xe3\x15\x46\xf9\xe5\xbb\xfe\xdd\x8d\x00\x05\x5d\xfd\x6e\x7a\x7b\x2e\xdb\x2c\xbd\xc7\xac\xbb\x37\x9b\x83\x6e\xba\x5f\x87\x98\xc0\x55\x9c\x92\x33\x29\x23\x40\x98\x42\x72\x1c\x22\x9a\x25\xd0\x80\x76\x2d\xbb\xd8\x27\xf6\x91\xec\xc4\x6b\xbe\x4a\xc8\xa9\x58\xa4\xde\x57\x59\xe1\x0d\xc3\x90\x3a\xc2\xf8\x7d\xa5\x32\x37\x91\xde\xd6\x2d\xd9\x26\xf9\x48\xc1\x61\xf9\xc9\x81\xbb\x35\x00\x12\x90\xb6\x1a\x58\xc3\x45\x78\xe9\x29\x46\x35\x9a\x29\xd3\xa6\xc1\xff\x80\xf8\xfb\x56\x40\x6b\xb0\x44\x0d\x29\xd7\xf8\x26\x3b\xcd\xd9\x5f\x18\x45\x33\x76\xaa\x9c\xae\xb9\x8a\x1b\xda\x07\xbd\x8b\x97\xc4\x58\xe5\xd7\xce\x7c\x12\x32\x20\x99\x8b\xcc\xf3\x8b\x34\xe8\x29\xbb\x08\x4b\x88\xaa\x06\x5d\x0c\xaa\x55\xa6\x5d\xb9\x51\xad\xb3\x0c\x02\x05\xe2\xba\x88\xc8\x9e\x41\xc8\xfa\x09\xc0\x58\x28\x4a\x3f\x70\x85\x90\x5e\x58\x2c\x3b\x9c\xfa\xcb\xe7\xa6\x2c\xeb\xb8\x50\x9c\x84\x41\x6c\x21\xcd\xfe\x83\xd4\xf7\xea\xd7\x6c\x29\xc0\xaa\xb3\x1e\xd3\x1b\xb8\x8c\x03\xa9\x91\xcd\x94\x9d\xd3\xab\xbb\x55\x50\x7b\xe3\xd4\x61\xba\xfd\x5b\x9f\x53\x6a\x8c\x0a\xc5\x77\x97\xea\xce\xbd\xd8\x18\x16\x69\xf0\xa5\xbc\xf4\x01\xed\xf2\x02\xc7\x46\x5d\x22\x64\x8b\xbc\x59\xcb\xc5\xd5\x59\x2d\xdb\x44\xbd\xca\xa4\xbc\x59\x07\x6e\x06\x1b\x33\x6e\x58\x07\x12\xc5\xf4\x2a\xe2\x4e\xdd\x94\xeb\xa2\xe2\x8c\x53\x38\x6f\xa3\x5f\xc3\x2f\x0d\xfd\xfa\x48\x3a\x7e\xbc\x7f\xa9\x5d\xb1\xd9\x9d\xd2\x2e\xc5\x8c\xa2\xa9\xf7\x70\x80\xaa\xcd\xbf\xe2\xaf\xd3\x16\x55\x4f\x95\x76\x98\xde\xfd\x21\x54\xae\x87\x80\x62\x9a\x71\x4c\xf6\x0d\x4c\x91\xa1\xb1\x3d\x76\x43\x34\x33\x03\x1a\x70\x72\x8d\xca\xaf\xb8\xdb\x00\xf7\x76\x95\xc5\x19\x50\x3b\xf0\xc1\x32\x29\x7f\x92\xd5\x27\x28\xb4\x9d\x77\xbb\xbb\x7b\xb3\x77\x7f\xc9\xc0\x1d\xea\xde\x2f\x14\x5e\xa2\x06\x22\x5b\x44\x16\x0d\x68\x8b\x3e\xf4\x5e\xfb\x20\x58\xe9\xdf\xd4\xc5\xf4\x56\x38\x59\xc0\x41\xbf\x68\x67\xf9\x9f\x8d\x07\x85\x5e\xf3\x62\x82\xb7\x6a\xb7\x32\x17\x45\xde\xdb\x09\x99\x99\x00\xb0\xba\xf8\x1d\x3f\xad\x56\xb8\x8f\xd9\x2f\x2c\x4e\xf4\x43\xcc\xb8\x57\xac\x1d\xa0\x22\xc7\x39\x74\x51\x37\x46\x01\x54\xf5\x28\xc8\x0e\x5a\xa5\x80\x29\xe8\x25\x9a\xa5\xc4\x9d\x6b\x98\xc9\xfb\x76\xf0\x66\x8c\x76\x1e\xb3\x29\xa7\x9f\xd7\xe1\xe3\x75\x3e\x2a\xeb\x77\x32\xd4\x7d\x2a\x00\x35\x7c\x0e\xe0\x63\x6f\x66\xe6\x00\xb9\x39\xf0\x5c\x40\x1b\xed\x26\x8d\x56\xf0\x60\x9e\x55\x3d\x60\xbc\x9d\xb6\x2f\xb3\x92\xdd\x9e\xf9\xf3\xfe\x5a\x8c\x8f\xe9\x33\x8b\xd9\x6d\xad\x58\x6c\xaf\x6c\x9a\x79\x69\xe1\xe8\xe2\xb6\x9b\x48\xab\xb7\xba\xe2\x1a\x02\x6d\xed\x14\x4c\xa8\x9e\x5b\xdb\xda\x8a\xc5\xac\x80\x81\xdd\x22\x6c\x27\x4b\x99\x60\x43\x65\xec\x93\x0f\x55\x1d\xec\x3c\x81\xb9\xe8\x3c\xc5\x99\x45\x89\x78\x15\xa8\x87\xbe\x2a\x0f\xd6\xb5\x58\x94\xef\x53\x9c\xf3\x6a\xd3\xdd\xf0\xc5\x83\x0c\x1a\x7c\x65\x1e\x5e\x41\x34\x57\x36\xd9\x8a\xfe\x4f\xeb\xa2\xea\x6a\xd3\x7b\x0e\x49\x0b\xaf\xb2\x2c\x47\x31\x09\x0f\xb1\x38\x7d\x15\x64\x28\x24\x80\xa9\x11\x76\x27\xc3\x2d\x34\x22\x31\xfa\xea\xd6\x85\x39\xc8\xb8\x39\xf7\x01\xcc\x3c\xf0\xc8\x26\x7d\x68\xa3\x30\xaf\x2f\x14\x7a\xcb\xdd\x68\x7d\x03\x72\x2e\x3b\x47\x2d\x2c\xd4\xe7\x18\x95\x3f\x9b\x1e\x0a\x39\x19\x61\x2d\xd0\xec\xd4\xe6\xc6\x47\x32\x05\xaf\x68\x9a\xab\x57\x00\x48\xca\xd1\xcc\x2f\x49\x77\x84\x6a\x1f\xb8\xe9\x7f\x62\x0a\xe0\xd1\x38\xb0\x43\xee\x62\x55\x9e\xc0\x58\xba\x14\x99\xd4\xe2\x6b\x0c\xb4\x92\x89\xdf\x0b\xea\x45\x48\x44\x01\x7d\x23\x6b\xf0\xe0\xb9\xf0\xc5\xce\x61\x9c\x5d\x74\x8c\xac\x77\xc1\x48\x53\xe6\xec\x52\x3f\xe7\xca\x39\x8b\x63\xd2\x2f\xed\xb0\x9b\x45\x52\xec\x20\x13\x5e\xfe\xe8\x31\x1c\x16\xab\xc8\xed\x84\x34\xeb\xe7\x14\xbc\xd7\x2b\x85\x5d\x18\x12\xc0\x0d\xd9\x25\x6e\x1c\x3d\xa4\x38\x26\x6b\xf7\x3c\x9e\xa6\xe6\xa4\xeb\x4f\x47\x77\x65\x9a\xc2\x07\xbe\xaf\xce\x79\x37\x93\x3f\x9e\xd1\x55\xf0\x8e\x24\x01\xdb\x80\x59\x41\xad\x46\x69\xf6\x96\x0b\x6f\xa1\x1e\xfd\x31\xaa\xcc\x5b\x90\x66\xff\x1f\x0e\x85\xf0\x6b\xaf\x5e\x60\x26\x8a\x00\x57\x66\xad\x38\x77\xfc\x73\x6f\x38\x5e\x64\x11\x6d\x17\x35\x3f\xd7\x66\x3d\x5a\x21\x43\x03\xeb\x7d\xa2\x5f\x93\x2c\x38\x30\xa1\x93\x96\x78\xd3\x06\x28\xd2\x07\x80\x50\xb1\x42\xad\xa2\xd7\xc9\x12\xd7\x6d\x63\xa3\x59\x4f\xce\xe5\x86\x5f\x52\x07\x15\xa8\xb1\x07\xeb\x27\x43\x21\xbc\x91\xb8\xea\xfb\x32\x6e\x53\xbf\xed\xad\xf1\xaa\x5b\x3a\xd6\xe8\xeb\x62\xe9\xf4\x0d\xfc\xc7\xa5\x0f\x6c\xe6\xa4\x62\xce\xc8\x41\x00\xd9\x04\x96\x91\x01\xd7\x31\xd9\xed\x30\xec\xab\xc9\x9f\x5b\x92\xd6\xd2\x53\xc2\x31\xc2\x42\x91\x9d\x56\x36\xeb\x61\xec\x54\x11\x1d\xb8\xaa\xd0\x18\x23\xff\x6e\x09\xf7\xc1\x2f\x55\x3a\x1c\xd2\x07\xc7\x75\x2b\x23\x27\x68\x73\xcd\x2c\x89\xb7\x88\x00\x48\xc8\x99\x29\x44\xef\xba\xba\x7d\xbb\x67\x40\x1e\x64\x52\x11\x8a\xbf\x57\xdb\x12\x12\x0a\x41\x99\xe7\x4b\x13\xf5\x22\xa8\xd0\xb2\x74\x21\x0f\x9a\xfc\x5c\x7c\x10\x46\xf8\x32\xf4\xf2\xa0\x14\x41\xee\xa1\x17\xbb\x43\xa0\x79\x0b\xf5\xf4\xd0\x7b\x18\xe7\x60\x68\xf4\xae\xb3\x90\xdf\x57\x9e\x1a\x06\xcb\x80\x15\x2e\x98\xec\x0f\x1d\x59\x2f\xa0\xc3\xb5\x75\x9a\x1e\x35\xf0\xc4\xed\xa0\x5a\x7d\x5f\x2a\x87\x10\x65\xe8\x3c\xfe\x8d\xb8\x8a\xf3\xe3\xa8\x4f\x0f\x25\x3d\x54\x5a\x24\x1a\xd0\x24\x1b\x39\x26\x79\xea\x69\xdd\xee\x9c\xd6\xbe\x93\x9b\xe4\xd9\xf5\xc3\x3e\x6a\x90\xe3\x6e\xe0\xba\xff\xdc\x7f\xaf\x22\x66\xe0\xa7\xa0\x3e\xa6\xdd\x47\xa8\x67\xa7\x50\x5c\xc7\x05\xc4\x6a\xb7\x0c\x8a\x71\x72\x2f\x4a\xf5\xb0\x15\x9e\x9d\x6a\x9a\xaf\xf2\x39\xb6\x0b\x19\x9a\x55\xa9\x6f\xe6\x0c\xae\xcd\xcb\x41\x84\xbc\x1a\x16\x25\xa1\x70\xad\xf1\xcf\xa3\xee\x52\xe0\x35\xb0\x63\x30\x46\x1b\x5b\xec\xec\xcb\x83\x05\xb1\x58\xed\x36\x39\x26\x0e\x8d\x4f\xf1\x18\xc4\xb4\xa0\x38\x9b\x97\x5e\xa8\x0b\x3c\x15\xff\x73\x75\xfd\xd2\x5c\xd2\xf4\x79\x8c\x29\x46\x6f\xea\x0a\xed\xa6\x2f\xf4\xfb\xfe\xf8\xf0\xa0\xed\x7a\x51\xf5\xbb\xfe\xf7\xda\xad\x26\x0e\x3b\x72\xfb\x7e\x59\xc7\xd4\x3d\xe3\xaf\x0e\x37\xca\xa9\xd7\x58\xe2\x3c\x81\xba\x79\xd2\xaa\x02\x2e\xa7\x76\xfe\x76\x53\x13\x6a\xc5\x17\x4f\xe0\xc2\x55\x33\xb7\xd2\x2e\x91\xd3\x2e\x4c\x73\xf6\x60\x33\xfd\x50\x18\x7c\x8e\xdd\x6b\x2b\xd0\x74\x69\x97\x5a\xbe\x85\xcd\x63\x7a\xd1\xa8\x0c\xdb\x68\x78\x0a\x22\x33\x4f\x96\x28\xd4\x4e\xbd\xe5\xa5\xc1\xe9\x95\x0c\xd6\x8d\xbe\x7d\xe5\x2f\x49\xf3\x10\x08\x93\xe5\x48\x8b\x58\x41\x71\xcf\x20\x63\x52\xc3\x9c\x40\xeb\x4a\x6b\xdd\xdb\x9e\x0f\x1f\x79\xa0\x97\xce\x96\x1e\x90\xdb\x8c\x02\xd5\x0b\x19\x35\xfe\x08\x7e\xab\x87\xc1\x15\xb9\xe8\x64\x3f\x61\xad\xbd\xd5\xd2\x43\x01\x82\xf7\x19\xef\x42\x4c\xc1\x17\xad\x31\x9d\x98\xfc\xd2\xc0\x7d\x65\x80\x1b\x99\xaa\x65\xe0\xbd\x3d\x30\x84\xd8\xdc\xc8\x38\x46\x70\x34\xcd\x5a\x4b\xc3\x1e\xe2\xb4\x57\x48\x5c\x5b\x4c\x57\xfa\xb5\xbe\x46\x8d\x9c\xd9\x4e\x58\x16\xd4\x32\xb2\x84\xbb\xcc\xf9\x18\x66\xdc\x64\x98\x13\x4d\x35\x50\x9d\x31\xc7\x3f\x45\x65\x3f\x33\x26\x1d\x92\x92\x2e\xee\x93\x28\xae\x54\xf1\x05\x49\x24\x0e\x36\x2f\x75\xf9\xad\x12\xe1\x3a\x7b\xee\x73\x84\xa5\x77\x22\xf7\xfe\x4b\x33\x4d\x82\xa0\xd7\xe9\xa1\xc3\xd0\x0c\x81\xb6\x24\xbe\x35\xa9\xea\x2b\x9c\xe2\xf8\xea\x41\x7e\x2b\x14\xcf\xc0\xdb\x19\xc0\x01\x15\x87\x2d\x31\xa7\x6e\x8b\x32\xe8\x1a\x51\x15\xda\xa1\x11\x23\x55\xdd\x4e\xec\x04\x79\xe6\x62\x7d\x06\x09\xa3\xb9\x45\xe4\xde\x3a\xed\x33\xde\x31\xc5\x04\x6c\x8d\x7d\xc4\x75\x7d\xfb\x5c\xcf\xd0\xf9\xdf\xf8\x75\xf5\x5a\xae\x62\x2b\x52\x8b\x88\x83\x5e\x13\xcd\xec\xfc\xc2\xce\xc3\x26\x27\xaa\x40\xb5\xf8\x24\x3a\xdd\x2f\x32\x74\x07\x77\xfc\xde\xcf\x9b\x19\xba\x77\x7b\x5f\x8a\x7e\xf9\xad\xdc\xb9\x77\x30\x83\xb2\x2a\xf6\x39\x45\x06\xfb\xd8\x55\x1d\x8b\x2d\x7a\xa1\x02\xf1\xc8\x6a\x23\x3d\x06\xa9\x0c\x86\x9b\x66\x1f\x2c\x93\x77\x19\xb2\x7b\xc8\xee\x65\xc8\xaa\x2e\x0b\xde\xd1\xbd\x78\x7c\xc0\xde\xad\xf4\x98\xa9\x48\xc5\xeb\xda\xc8\x4b\xb3\x1a\x35\x13\xbd\xd4\x60\x9b\xa9\xf5\x1c\x08\x19\x4c\xf5\x7b\x5f\x68\x64\xbb\x4e\xe7\x79\x2f\x7c\xe8\x25\xa1\xae\xcb\xd6\xa2\x59\x75\x26\xb6\x7a\xc0\x93\x86\x10\x2c\xe4\xd0\x89\xa4\xda\xad\xbd\x77\x44\x33\x8e\x32\xa6\x19\x62\xa3\xb8\xe8\x2d\x9a\xbe\xa7\x39\xc0\xca\x63\xe0\xad\xbf\x53\x51\xcd\x14\x7e\x5a\xe2\xe0\x6d\x67\xfd\xab\x71\xad\xac\xd8\xaa\xbf\xd4\xf2\xa9\x5f\x28\x20\xea\x5e\xc2\x89\xab\xba\x42\x4a\x61\x6e\xf6\x05\xa3\xa9\x66\xe4\x85\xc3\x0e\x2c\x2c\x5a\x7a\x42\x71\xc9\x31\xbb\x81\x2a\xc6\x0c\x36\x59\x4f\x9e\xea\x02\x17\x20\x82\xd9\x45\xb6\x1e\xe4\x6b\x62\xf4\x59\x89\x7d\xad\xdb\xb7\x3c\xbc\xbd\xce\xba\xd3\xeb\x27\x43\x85\x01\x77\x9e\xd6\x07\xed\x0e\x2a\x37\xcb\x8d\x20\x0c\xca\x6f\x0b\x4d\xdc\x25\x2a\x50\x47\xdc\x56\xd7\xe9\xdb\xe5\xf4\x18\x68\x59\xa1\x18\xa6\x6f\x7d\xd2\xde\x20\x98\x54\x06\xf2\x26\x83\x16\x41\xd5\xac\x43\x1c\x49\x46\xdc\x66\x99\x82\x82\xe7\xcb\xcc\xe2\xb6\x1e\x46\xe9\xf7\x25\xc2\x45\xd1\x7a\x30\x67\x75\xc6\xd8\x0a\xff\xd6\x03\x23\x6e\x9d\xc3\x5f\x8a\x2b\x58\xf2\x66\x87\x14\xf1\x0a\xbc\xde\x4a\x03\xd0\x15\x0f\xa4\xd1\x2d\x6b\xa9\x96\x55\xb2\xa4\xf0\x56\xf9\x7a\xa2\x3d\xf8\x03\x30\x0c\xd2\xf5\x5c\xfd\x4e\xcf\x5f\x7c\x88\x99\x69\x86\x53\xf0\x8d\x93\xf4\x87\x59\xcb\xee\x6a\x7b\x75\x17\x57\x15\xb2\xe8\xdd\x4c\x12\x72\x5f\xa2\x3f\x6e\x18\xd8\x75\x1f\x8e\xd0\x1a\x04\xb5\x12\x28\x3e\xb4\x27\xc7\x87\xf2\x6f\x0d\xfe\xe9\x4d\xb3\xda\x2d\x1a\xae\x68\xd8\xbc\x5d\xcb\x15\xbd\x2a\x43\xd8\xda\xfe\x86\x9e\x93\xc8\xda\x96\x95\xcd\x06\x3a\xd7\x0a\x4e\x06\x2c\xcc\xc0\xa7\x0c\xf7\x99\x38\x68\x65\x44\xe4\x53\xb0\xa2\x4b\x18\xa2\xce\x04\x1e\xd5\x99\x0c\x04\xd9\x7d\x0c\xfc\x27\x37\x02\x14\x0b\xd9\x27\x07\xd8\xe7\x6d\x5f\x02\xb4\xc0\x06\x26\x3d\x8b\x95\x6f\xfd\xcd\x67\x06\x31\xe2\x42\xd4\xeb\x6e\x80\xea\xe3\x5b\xfa\x02\x4a\x8b\x38\x32\xff\xae\x4a\xc3\xe7\xb7\xf2\xc3\xa0\x32\x06\x05\x07\xb2\xb1\x14\x82\xb6\x02\x89\xdb\x87\x18\x95\x92\xa4\xf5\xda\x5e\x26\xf0\x16\x8f\xf5\x27\x8d\x79\xe5\x10\x93\x9c\xd3\xf9\xff\xdc\x1f\x0e\x65\x95\xdc\x7b\x1f\xaa\x34\xf1\x79\x7c\xe7\x46\xd6\xa2\xb4\x76\xc7\x08\xff\xf7\xbd\x8e\x2b\xe8\xbe\x76\x98\xbb\xe2\xa8\x33\xce\x82\x57\xca\x04\xdf\x2d\x34\x39\xaf\xae\x4a\xd9\x17\xe9\xea\x32\x26\x1f\x96\xb7\xae\x94\xf7\xf2\xef\xd2\x79\xd2\x7e\xd3\xc5\xfa\x36\xc2\x08\xdd\x64\xfb\x49\x5d\xde\x36\x5e\x2b\xc6\x13\x7a\x46\x70\x8b\xb0\x48\x81\x01\x2d\x24\xe9\x67\x42\x10\xc0\xc3\xcf\x16\xb4\x25\x16\xa6\xb4\x92\xc1\x0a\xb6\xcf\x07\x7b\xf4\x2a\x3d\x62\xd2\xd5\x83\xb8\xab\x00\x47\x25\x37\xed\xea\x3d\x93\x1b\x4c\x63\xa9\xbe\x52\x2b\x9c\xb0\xee\x11\xe8\x78\x96\xf4\x94\x46\xaf\xb9\xa0\x3a\x7a\xa0\xc3\xc8\x00\xc2\x06\xce\x5b\x25\x59\x78\x18\x61\xfe\x73\x65\x2f\x2b\x84\x02\x6a\x26\x54\xed\xbe\xf3\x93\xfb\x85\x2d\x50\x86\x66\x1f\x16\xef\xb8\x2b\x25\x1a\x08\x2e\x8a\xdd\x27\xe3\x37\xa1\x9d\x31\x47\xf8\x06\xe3\x6e\xfc\x73\xbe\x75\xa6\x41\x95\xe6\xd9\xc5\x5c\x2c\x28\xc6\x0c\x7b\x91\x24\xcd\xbb\x0a\x3c\x30\x2f\xb8\x88\xa8\xde\x8c\x08\xd3\x66\xe5\xd7\xbf\x06\xfe\xca\x83\xc0\x36\x22\x90\xd8\xce\xe8\xac\x5e\xe0\x06\xa0\x4d\xc5\xab\x05\x70\xc1\x2e\x9f\xaf\x40\x59\x33\x49\xe8\x52\x90\x09\xae\x71\xf0\x13\x6b\x97\x6b\xb6\x36\xc2\xe9\x9c\x29\x0c\x9d\xc9\x21\xa0\x1c\x78\x24\x37\xf4\x4d\x17\x3b\xe5\xb2\xad\x2e\x0b\x90\xeb\x66\xd0\xf1\x91\xb4\xd6\xd6\x2e\x70\xdf\x2e\x17\xe1\x62\xe5\x81\xe9\x5e\xf6\x37\x60\x96\xca\xc5\xc1\xa1\xf3\x53\x33\x66\x69\x38\xb8\xd5\xf7\x37\x34\x66\x5c\x77\x06\x72\xb1\xa9\x9f\x16\x4e\xa0\x53\x71\xc0\x7a\x4d\x57\x5c\x19\xfe\x82\xd1\x84\xde\x79\xae\x0f\xd6\x1c\xc2\xc8\x55\x26\xf6\xa6\x58\x49\xba\x57\x98\x25\xf1\x5c\x1d\xe0\xae\xcc\x6f\xb7\xf7\xdd\x45\x99\x9c\xb2\x53\xdd\xd1\x28\x80\xe5\x0e\x5c\x72\x5d\x6a\x20\xd7\x3d\xbb\x48\x56\x19\x53\xad\x30\x90\x36\x26\xaf\x04\x6f\xfc\xa3\x61\x7a\x21\x84\xab\x57\x4b\xdf\x9d\xc9\x8d\xff\x38\xc5\x48\x19\x78\x93\xfb\xa3\xbf\xd0\xbd\x7f\x72\x45\x89\xdc\xfe\xe4\x50\x75\x68\x2e\xa9\x1a\x53\x39\xe3\xd0\xb7\x5b\xea\xcc\xdb\xbd\xde\xf7\x48\xd6\xd3\x82\xd6\x89\x3b\xb9\xf4\xfe\x79\xfb\xbb\xf3\xef\xe2\x43\x22\xf0\x96\x0a\x19\x70\xb5\x87\x5d\x07\x16\x47\xc6\x66\xbd\x76\xca\x4e\xbc\xc3\xc5\x49\x99\xe4\x35\x94\xc3\x05\x6c\xdb\xbe\xc8\x3c\x60\x83\x13\xb7\x0c\x93\xd4\xf3\x5a\x99\x3e\xc8\x77\x71\xe8\x0a\x81\xbc\xd9\x95\x99\xb9\x68\xb6\x5c\xb6\x5e\x4f\x8e\x7f\x94\x15\x71\x76\x1f\x7c\x6f\x96\x3a\x10\xbd\x01\xd7\x40\xa3\x52\x70\x1f\xb5\x63\x9c\x55\xc6\x2c\x65\x5d\xe8\xd2\x4c\x95\x08\xcf\xcb\xda\xfc\xa5\x97\xf7\x43\xc8\x0a\xa1\x6d\xfc\xcc\x78\xe6\x52\x43\x59\xa2\x18\x39\x34\x14\x82\x03\x38\x73\x96\x32\x8e\x19\x3c\x5c\x8a\xbf\x7a\x81\x2e\x20\x93\xcc\x4d\xb1\xf7\x85\xa4\xab\x7f\x1c\x25\x62\x8a\xe5\xb3\xd6\xf6\x7f\x91\x5e\x1a\x8e\x00\x42\x59\xd9\xb9\x1f\x5f\x20\xc3\x77\x15\x82\xb8\x4b\xb8\xfe\xf7\x2a\xe6\x86\x0c\xe2\xdf\xf5\xa6\xeb\x38\xd7\xb3\x1b\xe4\xfb\x5f\x75\xf8\x77\x83\x3d\x96\xc3\xde\xc2\x17\x9f\x84\x30\x4b\xe7\x4d\x53\x38\x0f\x12\x8b\xa7\xa1\x3e\x09\x39\x84\x32\xb4\x39\xfb\x45\xbf\xf2\x56\xeb\x42\xf7\x10\x04\xc3\xfa\x18\x95\x6e\xdb\x8b\x29\xcd\x1c\x34\x56\x2e\x05\x49\xba\x73\xfa\x22\x27\xc8\xce\x1a\xb2\xda\xa9\xc4\xd9\xbf\x7a\x4f\xda\x29\xe9\x6d\x9f\xe4\xd4\x1f\x4c\x19\x06\x14\xa6\xfb\xef\xdd\x6e\x18\xe9\x59\x0f\x9a\xd5\x27\x29\x43\x0b\xa3\x58\xb0\x07\x1f\x5a\x27\x94\xe0\xb4\x0e\x5f\xae\xf0\x41\x32\xad\x5f\x56\x28\x14\x85\x0f\x7e\x1e\xa9\x45\x4f\xec\xc6\x96\x4d\xb5\x16\x40\x83\x8c\x3e\x78\x0f\x47\x9e\xca\xd0\xa4\x95\xc9\x8e\xbb\x61\x35\xf0\x50\xbc\xd7\xae\x43\x64\x76\x8c\x58\xd3\x63\xfe\xbf\x38\xa1\xfc\x3d\x4b\x8f\xa2\x62\x81\xb3\x93\x11\x84\xef\x16\xee\xb6\xa0\x3b\xc1\x26\x79\x7e\x3d\xfa\xbb\xde\x5d\xe2\xa7\xb2\xc7\xae\xbe\xac\x5d\x91\x2b\x56\xbb\xc6\xd7\xa4\xb6\xc0\x7e\xfb\xe6\x7a\x01\x11\x38\x91\x95\x06\x4b\x42\x43\x85\x3c\xdf\x94\x69\xdc\x74\xcc\x42\xae\x75\x81\x84\x98\xba\xcb\xd8\x4e\x9d\x19\x35\x6a\x37\x38\x49\x4e\x7e\xed\x26\x24\xc6\x6e\xe7\xb2\xe9\x1a\x0a\x9f\x43\x41\xde\xb8\x4c\x9c\xe1\x26\xec\xe2\xc4\xf7\x63\xda\x07\xef\x1f\xc7\x24\xeb\xac\x03\xbe\xee\x8d\xe6\x4a\xa0\xae\x6e\x3c\xd9\x6e\x69\x16\xee\xbd\xf7\x6a\x75\xb7\x2f\x0e\xf4\x0a\x2d\x6d\x87\xf5\x10\x00\x39\x8b\x87\x37\xda\x6d\xa4\x39\x1c\xb1\xf8\x56\xa9\x0e\xb4\xd6\xb9\xe5\x35\xbc\x45\x6d\xb2\xc2\x92\xfa\x4b\x6b\x6d\x6d\x47\x0e\x46\xcb\x53\x7c\xaa\xc1\x7b\x71\x3a\xea\xee\x2a\x09\xb7\xec\xbb\x16\x9d\x3e\x95\xd0\xd4\xbc\xcb\xfa\x2a\xfd\x7f\x3e\xd4\x89\x7d\xd1\x48\x43\xc1\xe4\x38\x7b\x8f\xd8\xb6\x76\x3a\x4b\x4f\x56\x1d\xed\x16\x58\x2d\xbd\xf1\xca\x99\x16\x10\x4f\xe6\x4f\x16\x8e\xa8\x48\x6b\x4a\x74\x15\x82\x02\xc2\x9b\x40\x06\x12\x7e\x45\xb9\x21\xfa\x7a\xa1\x68\xd4\x62\x47\xdc\x69\xf9\x03\xda\xb7\x6e\x20\x15\xcd\xd6\xa0\x55\xdf\x8c\x5f\x16\xef\x56\x4b\x60\x2d\x79\x76\x76\xe8\x14\x15\x0e\x01\xa4\x5b\x9f\x65\x42\x5e\xb3\x85\x80\x17\x38\x35\x8a\x72\xeb\x96\x0e\x7e\xf4\xf6\x0e\xf1\x03\x49\x06\x18\x35\x90\x33\x97\x12\xda\xb9\x2b\x91\xa1\x81\x67\x56\x67\x19\x0d\x51\x4b\x34\x8e\x38\xcf\x2c\xda\x57\x1a\x55\x37\x16\xb0\xe0\xef\x1b\x5a\xc0\x7b\x0d\x59\xf4\x8e\x0f\x82\xf3\x59\x59\xfa\x66\x5c\xbd\x0f\x80\xcf\x6d\xfc\xee\x5c\xb6\x3f\x9b\xeb\x53\xe5\x43\xb6\xf0\xd2\xa3\x2c\x7a\x32\x87\x12\xf2\xf9\x49\xcc\xd6\x21\x8b\x9e\xa9\x54\x6d\x12\x35\x7f\x8c\x3c\x65\x37\xdc\x67\xac\x65\xd3\xa0\xfe\xe8\x96\xdb\xe0\xd4\x16\xf3\x86\x1f\xdc\x55\x89\xf5\xd1\xaf\x1a\x59\x56\x80\xd8\xf3\x7b\xe5\xf0\xa1\x87\x28\xde\x9d\x2f\x74\x2a\x37\xb5\xd4\x38\x43\x8d\xf1\xca\x3a\x37\xbe\x83\x90\xeb\x2c\xf7\x87\xe6\xfb\x05\x69\xa8\x75\x5b\xeb\x4e\xdb\x82\x23\x76\xce\xe5\x5f\xb7\xa9\xd8\xe8\x5d\x22\xa7\xfe\xd4\x2c\x75\x67\x79\x3e\xec\xb8\x95\x7c\x30\x8c\xaf\xe6\x7c\xa0\xd3\x42\xad\x0a\x66\x91\x68\x6b\xe4\x49\x93\x74\xbd\x05\x4b\x9c\xea\x28\x8c\x6b\x2a\xd1\x7c\x06\xb6\xfc\x44\x49\x9e\xa2\xb5\x7d\xff\x07\x57\x6d\x19\x7a\xcb\xdd\xe4\xbd\x06\x10\xd0\x9f\x2c\xee\xee\x3f\x3b\x30\xaf\x68\xf7\xbb\xb5\x6f\x6e\xd0\x10\x8c\xa1\x0b\xa4\xa8\x20\x03\x6f\x9d\x29\xca\x15\x82\x32\xf9\xea\xad\x82\x56\xf1\xa0\x16\x78\x27\xba\x5b\x23\x17\x14\x2c\x7f\xcc\xd6\x76\x78\x9b\x8c\x5f\x4a\x1c\x6a\x96\x5e\xcc\xdd\x4e\x1c\xb8\xca\x93\xd3\x79\x7d\xe4\xd6\xeb\xed\x58\x5e\x01\x4a\x7d\x8b\xa5\x46\xf0\xec\xae\xb2\x32\x8a\xb6\x7f\x80\x05\x93\x23\xfb\x2e\xcf\xf4\x86\x90\x0c\x39\x42\x39\xf0\xb5\x23\xcb\xcf\x5a\x5d\xee\xb2\x8b\x75\x0c\x53\x0b\xf5\xcb\xda\x54\x06\x46\x85\xa2\xe8\xad\x0c\x2d\x5c\xa5\x02\xf9\xd6\x66\x2e\x2f\xb1\x0a\x79\x51\x73\x3d\xb2\x62\xb6\xcf\xb9\x49\xbd\x95\x90\xae\x2b\x18\xde\x32\xa5\xa4\x1f\x70\xbe\x58\x29\x1c\xf6\x69\xbf\xaf\x21\xe1\x50\xf8\x10\x5d\xf2\xdd\xff\xed\xe8\xd7\xf2\x0b\x0b\xd4\xe2\x24\xa3\xde\x28\x84\xed\x52\xc5\x66\xfc\xb5\xae\x20\x9d\x04\x69\x2e\x55\xf7\x45\xf7\x92\xb8\x35\xdc\x5d\x0f\x55\x55\x59\x74\xa7\x60\xbe\xbb\xf5\xda\x98\xb1\xff\x64\x29\x02\xc0\xb7\x2f\xfe\xcc\x76\x2b\x16\x1b\x90\xf2\xa9\x36\xd3\x41\xe9\x9b\x5d\x35\x43\xb2\xaa\xdb\x88\xe2\x0c\x07\xc6\x35\x4d\x9c\xdb\x09\x6e\xb5\xb5\xf3\xeb\xac\x5e\x40\xd1\x5d\xda\xb5\xe0\xae\x44\x12\x64\x45\xb1\x82\x17\x4d\xde\xb1\xfa\x6f\xd3\xf7\xbd\x4c\x0b\xbc\x1e\x46\x11\xd7\xc3\x78\x64\x5d\x28\xa0\x8d\x5c\x85\x12\xbf\x55\x54\x3d\x96\xcf\x57\xa3\x62\x5b\xf7\xcc\xd2\x0f\xa5\xdc\x5a\xa3\x86\x91\x9b\xf3\xea\xf8\xd5\xfb\xe0\x74\x7f\x4e\xe2\x1a\x04\xb6\xb8\x24\x3b\x58\xe9\x4a\x43\xec\x14\xfa\xb7\x67\xc7\x45\x22\x21\xc0\x4a\xc9\x71\x46\x1d\xb2\xc0\x25\xf8\x34\x23\x60\x79\x8a\x05\x73\xa4\xc8\x49\x73\x45\xd8\x52\xef\x11\x08\x96\x68\x21\x57\x44\x36\xd5\x98\x30\xbe\xee\x17\x72\x37\xb9\x22\xb0\xa1\xdb\x6e\xd9\x8f\x2a\x39\x03\xb2\x30\xea\xb4\x0a\x98\xaf\x36\x07\x1c\xac\x58\x19\x1b\xa7\xee\x87\xda\x9e\x4f\x81\x22\x1e\xa1\xc5\xa3\x57\x8e\x2d\xfb\xb4\xf3\x30\x4c\x72\x5f\xbb\x7a\xc7\x9d\x77\x1d\x70\x66\x87\xac\x55\x2d\xea\xe9\x40\x6e\x4a\x48\xf8\x7e\x62\xbc\x2b\xea\xc5\x95\xdf\x1a\xb6\x69\x26\x01\x67\x9c\x4a\x09\xbc\x5f\x0c\x33\x1f\xeb\x45\x45\x56\xf8\xa8\x49\xf7\xc1\xb5\xc3\x32\x0d\x20\x2d\xbc\x95\x4f\x38\xf5\xfb\x3f\x8a\x60\xff\xf2\xa1\x1b\xef\xb3\x29\xe7\xb7\x24\xe4\x33\x4c\x57\xc6\x46\xb1\xb6\x2c\x7a\x61\x23\xb1\xe5\x74\x20\x60\x64\x37\x59\x42\xc3\x6f\xeb\x15\xa0\x26\x78\x60\xf0\x04\x26\xdb\xf1\xb1\xec\xfb\xef\xc5\xa2\x74\x83\x7e\x88\x25\x9e\xc1\xa8\x69\xd4\x33\x6d\x5e\x7a\x8f\x6c\xc8\xfc\xab\x48\xd2\xdc\x98\x0d\x03\x10\x73\xbe\x08\x76\x28\xce\xef\x52\x9a\x20\xb3\x95\xa4\x91\x7d\x17\xf7\x6c\x2c\x4a\x8b\xe8\x1a\x38\xdd\x0e\x6d\xbd\xb4\xd5\x13\x9c\x1a\x0d\xb3\x5e\x23\x9f\x24\x12\x99\x3b\x72\xb5\x68\x04\x74\xa7\x4b\x4f\xf3\xa7\x13\xe9\xdf\x66\x17\xba\x9f\xce\xd7\x5c\xe6\x6e\x98\xe0\xf2\xfe\xda\xae\x06\x83\x2c\x0f\x1d\x7a\x28\x16\xa3\xd1\x1c\x1c\x0a\x85\x47\x1c\xc5\xac\x3e\xa4\x3c\x65\x5d\x37\x8f\x5b\x0c\x0a\x88\x98\xde\x5a\xc3\x14\x32\xac\x99\x98\x7f\xf4\xeb\xdf\x05\x96\x00\x75\xd2\xf8\x93\x26\x3a\x36\xd8\xd7\x2b\xfa\x93\x45\x4b\x48\xf6\xd6\xb3\x06\x72\x5a\x26\xe8\x1b\x0b\x3e\x28\xd2\xa0\x0b\xdf\xad\x37\xd6\x6b\x9a\x1d\x54\x72\xf0\x25\x2f\x49\xf1\xaa\x9f\xa7\xba\xb6\x24\xa3\x6d\x4a\xa3\x62\x78\xa6\x3b\x89\xaa\x05\x74\x4b\x98\xd6\x9e\x8e\x01\x37\x61\x88\x38\x43\xe9\x1b\x73\x1f\x4d\x90\x5e\x17\x65\x23\x40\x4d\xb3\xa3\xb4\x15\x11\x98\x4b\xa6\xd9\x03\xed\x66\x3a\x56\xd5\xb7\x2a\x81\x97\x3a\x6e\x38\x05\x93\x98\xe0\xac\xa7\x39\x80\xef\x54\x76\x27\x1b\xfa\x3c\x0b\x24\x69\x06\xb6\xe8\x42\x96\xde\x51\x34\xea\x69\xf4\x1a\xa3\xd0\x93\xdd\x55\x22\x14\xe8\x33\x83\x15\xcb\xe1\xe5\x89\x55\xf2\xb0\xb3\x74\xce\x63\xb5\xac\x16\x66\x86\x56\xac\x12\xab\xf0\x94\x66\x9c\xfe\x78\xab\x00\x24\xa1\x51\x5f\xd2\x05\x93\xdf\x4b\xe2\x13\x01\x99\x9f\xec\xb0\xc4\xa1\x49\x54\x1b\x9d\x03\x42\x80\xb6\xba\x1f\xfd\xb2\xe7\xa0\x46\x71\x64\xfe\x64\x4e\x5c\xc3\xd4\xa5\x49\x88\xf8\xc4\x55\x78\x58\xaa\xb2\xe4\x85\x38\xdb\x1a\xe7\xc3\xfa\xe8\x5a\xcf\x2e\x05\x50\xa7\x17\xbb\x85\x13\x85\xe7\x27\xe6\xde\xf8\x65\x91\x94\xa0\x90\x2d\x5e\x48\x3a\x2e\x9d\x4e\xdb\x40\xa7\x4d\x05\x29\x57\x9c\xcb\xee\xd1\x33\x00\x93\xf4\x78\xe5\x18\x34\xac\x7f\x75\x06\x80\xff\x27\xfc\x37\xaa\xe7\xb4\xcb\x8b\x29\xd7\xf0\x2d\xf5\x76\xde\x41\x3b\xcd\xd6\xa3\xc8\x98\xc9\xa1\x51\xdb\xac\xaa\xd1\x30\x19\x9f\x41\x04\x20\x2e\x75\x0f\xa0\x7c\xa6\x4f\x65\xef\x9b\x6e\xda\x92\x2d\xe9\x4c\x17\x4d\x1b\xef\xe5\x8f\x86\xa1\xc4\x6c\x11\x13\xfa\xe4\xd0\x18\x2c\xad\xde\x8a\x79\x7f\x50\xe6\x4b\xc1\xe7\x42\x93\x05\x76\xc0\x7a\xe0\xbf\xae\xf3\x83\x42\x86\x37\xa8\xcb\x19\xef\x50\x20\x69\xf7\x88\xef\x51\x4a\xd0\x3d\x60\xd4\xc1\xdf\xa2\x4b\x33\x6f\x84\x93\x62\xce\x8b\x99\xbd\x55\xe3\x82\xcf\x33\xc6\x7d\x6d\x0a\x0b\xc7\x01\x02\x33\x7d\x50\x9e\x25\xcb\x71\x65\x0f\xca\x45\xd3\x5b\xc1\xc6\x47\xca\x1a\xe8\xb1\x06\x3e\x8c\x33\xae\x58\x14\xa0\x67\xeb\xa9\x7f\xf7\xde\xa3\x01\xb2\x11\x5d\xa3\x3f\x79\x16\x63\xb9\xb3\x7c\x32\x7d\x2e\x9f\x7e\x6b\xf1\x46\xb0\x8b\x1f\xbc\x4e\xab\xdf\xf0\x65\x5d\xe9\xb3\x33\xa2\xa9\x66\xb2\xac\x1c\x44\x89\xed\xef\xe4\x45\x05\xde\x1c\x6d\xae\x62\xa7\x17\xc6\x4c\x0e\x01\xd0\xab\xa8\xee\x7b\x14\x6e\x5c\xa4\xf3\x7d\x34\xac\x6b\xd8\x05\x24\xc4\xd2\xcb\x77\x47\xa3\x7d\x4b\x85\x4c\x11\xe8\xbf\xa5\xf9\x3e\xd2\xdc\x39\xb7\x27\x5b\xee\xd0\x85\xfd\x59\xc1\x7e\xad\xcc\xe7\x2e\x97\xce\x73\xbc\x35\xaa\x11\x44\x99\xf2\xdc\x1c\xdf\xef\x0d\x1d\x9a\x0f\x5b\x5e\x67\x02\xf8\xf6\x43\x74\x1e\x8a\xe5\x98\xde\x33\xce\xcd\x44\xf2\xef\x6b\x78\x51\xe4\xd6\xf5\x6f\xa2\xf7\x7d\x73\x1e\x17\xb6\xcd\x5e\xe2\xc4\x98\x5f\x6f\xb7\x10\x30\x70\x2f\x36\xb7\x12\xc8\xf2\x24\xe7\x9f\xaa\x6f\x71\x2c\xaa\xc7\x5e\x56\x69\x9e\xcc\xd6\xfa\x21\x92\x3a\x07\xa0\x2e\xae\xde\x7a\x55\x4b\xa5\x04\x9c\xa7\x3e\x43\x9b\x20\x81\x9c\xd6\x45\xa9\xd6\x5e\xa0\xf4\xc6\xdf\xdb\x29\xe7\x2e\x7c\x8a\xb6\x6b\xf0\x45\x02\xb0\x59\x23\xd6\x31\x72\xb3\x4f\x2b\x2c\x70\x84\x07\xd5\x8e\xb7\x5b\xad\x6e\x6e\x06\x3f\xfa\xa0\x99\x7f\xbb\x29\x84\x5e\x58\x59\xca\x6e\x08\xbb\x61\xd5\xb5\x7b\x3f\x79\xec\x6a\xfa\x6e\x28\xa4\x99\x26\x1d\x19\xa2\xc2\xd5\x05\xbf\x0b\x9c\x5f\x0b\x2a\xa7\xac\x82\x0b\x04\x5c\x3f\x23\x33\x7b\x44\xd5\x17\xa3\xab\x96\xb2\x26\x3d\x07\x04\x71\x2c\x30\xc9\xf0\x5f\xca\x9a\x7f\xeb\x0e\x5f\x3e\x14\x58\xc8\xbd\xee\xe4\xf0\x44\xf8\x18\x49\x58\x71\xf9\x1b\x79\x21\xf0\xbd\x74\xe2\xb8\x4b\xbe\xfb\xfb\xdf\xdd\x56\x92\xa8\x44\x50\x54\x3b\x01\xbb\x56\x2c\xec\xbb\x53\x18\xfb\x6a\xa9\xc2\xa2\xe5\x64\x12\xbb\xad\x08\x44\xf2\x3c\xad\x2b\x16\x1d\xc5\x19\xbd\x99\x84\xef\x5b\xb3\x78\x7f\x65\xbc\x15\xeb\xaf\x19\x2c\x44\x7b\x0a\xf4\x50\xaa\x96\x3d\x1d\x4e\xd6\x67\x9b\x99\x63\xda\x71\x78\x17\xa3\x86\x63\x0c\x4a\x32\xf5\x75\xe0\xb4\xce\x2a\x70\x6d\xb4\x77\x53\x3b\x53\x7b\xd2\xde\x56\x9d\x11\xc5\x7f\xb0\x70\x0d\xbc\x15\x08\xfa\xfa\x36\x83\xa1\xe2\x9e\xd3\x02\x56\x6a\xa7\x5b\x64\x8d\x3f\xd0\xa1\x7a\x32\x33\x12\x21\x7a\x8a\x0a\x67\x5d\xe7\x2b\xea\xf3\x48\x7c\xa8\x18\xfd\xe5\xc3\xa0\xcb\xff\xdd\x2c\xbb\x43\xc2\xca\xe9\x85\xa6\x92\x60\xa5\x51\xdf\x03\xe2\x4c\xd3\x84\xa5\xda\x4c\x46\xd8\x0d\xa4\xa6\xc7\x6e\xd7\xbb\xb8\xc3\x92\xd5\xcc\xd7\xfa\x57\x45\x5e\xfc\x7d\xab\x02\x13\x89\x45\xf4\xdb\xa0\xa0\x73\x73\x8e\xe9\x50\xbd\xba\x61\x71\x1e\x9c\xba\xa5\xd1\x88\x71\x39\x68\xeb\xa7\xed\x08\xe6\xc0\x88\x61\x2d\x66\xe9\x8b\xc4\x5d\xeb\xa4\x5a\x91\xdd\x2a\x97\xcf\xc3\xf6\x95\x49\x75\xf3\x0f\x1f\x58\xe1\x67\x09\x24\xd0\x8b\x8d\x52\x81\x7b\xe8\x7a\x9e\xa3\xde\x0d\xee\x4d\x82\xea\x5d\xfc\x43\x1c\x45\xe0\xff\x7f\x93\x13\x75\x41\x43\xf0\x41\x74\xbd\x1e\xfd\xb2\xe8\xdd\x27\x2e\x9b\x62\xee\x5f\xfb\x82\xfb\xc2\xb2\x5b\x19\x9b\x41\x75\x9f\x5b\x61\xf4\x37\xc8\xb4\xf1\x9f\xdc\xc4\xc5\xef\xee\xff\x67\x66\x20\x72\xf8\x1c\x01\x24\xec\xf2\xd2\x73\xe8\x4c\xc8\xae\x51\xc3\x35\xde\x15\xf1\x30\xc0\xdd\xfa\x9c\x10\xe9\xbe\xdd\x2a\xaf\xc1\xab\xc1\xcf\xfe\x15\x1f\x6f\x85\x52\x73\xe6\x03\x1b\x94\xa0\x90\xb3\x9e\xd4\xfc\x7d\xb5\xa4\xb7\xa4\x7d\xc8\x6b\xdd\xef\x5c\xbc\xc2\xef\x95\xa0\x79\xf6\x62\xbb\x4f\x7b\xa7\x02\x49\xf5\xbd\xe8\x70\x64\x25\x71\xc0\x19\xe9\x2c\x8d\x09\xc4\x78\x98\xfd\x5c\x57\x6c\x51\xa5\xd7\x31\x44\xba\xd4\x29\xbc\x47\x9b\xef\x48\xdd\xff\x5f\x4d\x56\x7b\x06\x1f\x84\xa5\xcc\x93\xda\x81\xa5\xf8\x0f\x90\xd1\xbc\xac\x1f\x14\x8d\xb6\xfa\xcf\xeb\xea\x91\xdd\x30\x81\xf3\x81\xa1\xd0\x85\x56\x90\xdc\xca\xd2\x37\x02\x2b\xe4\x0c\x50\x55\x56\xeb\x11\xa5\x19\x17\xef\x75\xfa\x1b\xc3\x2e\xc7\xfa\x5b\xfd\x48\x83\xa4\x5b\x04\xf5\x1a\xca\xbf\xc0\xa8\xa9\xdc\xe5\xf1\x53\x71\xfb\xdd\x85\x7a\xa1\x8e\xbf\xf3\x2e\x53\xdb\xff\xbd\x90\x9c\x7e\x11\x07\xfa\x04\x53\x20\x37\x8c\x67\x23\x7d\x1d\x07\x20\x01\xee\x86\x15\xbb\xce\xe6\xed\x11\x44\x8d\x2d\x7d\xf8\x19\xfc\x66\x12\x62\x4b\xe2\x1a\x2a\x62\xf9\xba\x02\xc5\xe5\x44\x41\xef\xb7\xd3\x7b\x40\x94\xae\x77\xcd\x93\xb2\x0f\x77\x75\x15\x78\x15\x9b\xd3\x27\xb3\xb8\x02\xd4\x46\xb1\xc3\xe6\x68\xf9\x27\x87\x82\x8b\xc7\x20\xb0\xa3\xda\xbb\x14\x08\xff\xbf\xad\x27\x4c\x3e\x88\x86\x8e\xbd\x98\x1f\xf6\xdd\xd1\x93\x51\xae\xa6\x70\x6c\x66\x0f\x0c\xa1\x91\x91\x08\xb4\xd2\x30\x28\xd3\x0a\x23\x49\x7e\xf4\x03\xb2\x1c\xef\x61\xbf\xb7\xef\x34\xf3\x53\xac\xc2\x98\x3a\xd2\xee\x93\xbd\xf7\x8f\xf8\xaf\xd7\x56\x1e\x2b\x80\x6b\xe2\x70\x1b\x44\x9f\x26\x58\x86\xee\x7f\x62\xeb\x88\x59\x5b\x85\xad\xc1\xa7\xba\x5f\x03\xaa\x65\x90\x69\x4e\x0d\x29\xa2\x78\xeb\xfd\xc9\xc8\xd5\xd9\xae\x30\x9c\x91\xc2\xdb\x98\x25\xc0\x60\xdc\xbf\xea\xd5\x3a\x69\x27\x6e\x12\x5f\x26\x2f\x60\x49\x09\x74\xae\x85\x42\x9f\x95\x5b\xc5\x24\x30\x62\x89\xa2\xf1\xcb\x31\x87\xa7\x3c\x11\x95\xbd\x7e\x50\x5a\xb1\x62\xb6\x76\x06\x40\xd4\xd3\xfd\x64\xba\x68\xba\xba\x5f\xd3\xa9\xed\xa1\xe6\x7b\xcf\xa0\x4e\xf7\x41\x3d\x82\xdf\x4c\xd5\xa9\xe2\x8c\xbf\xf4\xe8\x7e\xdf\xa3\xe9\xcc\xfb\xe2\xea\xef\xdb\x5c\x1c\x68\x54\x09\x81\x47\x8f\x3c\xb0\x7d\xdc\xdf\xf6\xf4\xfe\xe0\x8a\xb4\x15\xbf\x0f\x28\xe4\x7b\x31\x5a\x3a\x61\xaf\x7d\x2f\x89\x8f\x43\x5c\x4e\xf1\x40\xb7\x08\x03\xea\x34\x47\x35\xd8\xd8\xc4\x52\x50\xae\x20\xf3\xce\xd2\xd0\xdd\xea\xaa\x9b\xb5\xa4\xb4\xd8\x07\x58\xd0\x3c\xc4\x7a\x00\xf0\x4b\x1c\xb8\x8c\xeb\x8e\x17\xf2\xa2\x86\xe4\x15\xd8\x0d\x8d\x7a\x5e\xcc\x0d\x41\xbf\x18\x78\x6e\xfd\x7a\x42\x4f\x31\x59\x56\x8a\xfa\x87\xfb\xe0\x69\x30\x04\x63\xfb\xe1\x97\xf9\xa5\x02\x29\x33\xf6\x0b\x6b\xe0\x05\x62\xcf\x28\x0e\x75\xf8\xb7\x22\x19\xb9\x79\x3e\x6f\xe5\x2c\x40\xfe\xbc\xc9\x15\x8c\x87\x84\xce\x65\xa5\x03\x65\x77\x46\xbe\x5f\xfd\x2f\xfa\x75\x3f\xad\x65\xab\x84\xd8\x35\xed\x34\x63\xbd\x6f\x9c\xed\x8b\x13\x0e\x72\xdd\xc8\xee\xda\x3d\x3a\x55\xe7\xb5\xa8\x22\xde\x52\xe6\xd0\xf8\x28\x0f\xe4\x94\xc8\x40\x01\x8e\xbc\x28\xa9\x66\x35\xb0\xdd\xd7\x3a\x57\xbe\xd9\xf2\x6a\xdd\xbc\x34\xc5\xc4\xc2\x2e\xeb\xff\xbd\xf8\x00\x72\x46\xe9\xe5\x8b\xa0\x33\xce\x98\x67\x59\xc4\x58\x35\xb6\x4e\xef\x49\x6f\x2f\x6c\xf4\xea\x6a\x68\xaa\x4c\xd1\x91\x3e\x46\xed\xfa\x36\xd6\xad\xe8\x39\xfa\x9f\xbd\xef\x2e\x66\xc6\xef\x2b\x03\x1e\x6b\xe6\x6b\x75\x06\x55\x30\xa5\xe4\x83\xe3\xb7\xea\x92\xcf\x45\x94\x46\x8d\xd1\xc8\x87\x0a\xef\xf5\xa2\x4b\x01\xd1\x2e\x3a\xd0\xe9\x95\x29\x6a\x4f\xe9\x52\x0a\xe0\xb1\x16\x6b\x11\x43\x03\x4a\x72\x61\x89\x5d\x24\x44\x97\xac\xa8\x88\x31\xf0\x53\x22\xae\x85\xc3\xa2\x23\x5a\x4b\xdb\xde\x55\x32\x01\xe3\x40\xa5\x4f\x6b\xba\x50\x8d\xb3\x7b\xd4\x75\xe7\xad\x19\xb3\xd9\x18\x51\x4d\x6a\xe2\xc6\x7f\x6a\x28\xc2\x91\x77\x87\x12\x63\x82\x52\xc2\xe5\xe2\xdf\x33\x7b\x6f\xf1\xc3\x5a\x29\xa4\xe7\xcb\x5b\xcc\x5b\x5b\xeb\xcd\xb3\x3b\x28\x32\x50\x0e\xe0\x16\xb3\x58\x6a\x55\x83\x3f\x83\xd6\x23\x5b\x50\xfa\xfa\x34\xa3\x1b\x65\xca\xad\xce\xd1\x6e\x1c\x71\x89\xf8\x7c\x91\x1a\xa3\xef\x6c\x2b\x0b\x64\x5d\x87\x64\xcc\x52\xc9\x15\x85\x1d\xf5\x37\x7d\x80\xdd\x71\x10\xe3\x71\x48\xdd\x4b\x4b\x84\x83\xc3\xf3\xa2\xf5\xfe\xe6\xb6\x06\x62\x42\xd2\xa9\x2f\xb4\x65\xe4\x38\x86\xec\x8a\x24\xd1\x94\x41\xee\x35\x4c\x7e\x57\xf4\x39\xaf\xfa\x6e\x12\x84\xc3\x57\xb8\x14\x3e\x59\xd4\xff\x4d\xa1\xce\xd0\x91\xc0\xfb\x44\x4d\x8c\x25\xeb\x3e\xfb\x29\xbe\x15\xb0\xea\x79\x92\x63\xb4\x75\xbd\x98\xd1\x32\x28\x47\x04\x3f\x96\x1d\xce\xcd\xb5\x35\x04\x8e\xab\x77\x03\xe3\x8c\x3f\xbb\x47\x23\x1e\xbe\x73\xf9\xfe\xef\x99\x52\x79\xfb\x5e\x11\xfa\xa5\x30\x51\xec\x3d\x84\x94\x98\x2f\xa0\xf2\x75\xf1\x6f\xf0\xc0\x5b\xb3\x46\x6b\x0a\xb4\xea\x92\x32\x79\xd7\xe8\x29\xf1\x37\x2d\x50\x37\x4c\xec\xc3\xa4\x67\xd6\x2b\x93\x81\xf9\x0d\x57\xaa\x63\x63\x19\x44\x8d\x60\xc7\xd6\x13\x72\x43\x6e\x67\x50\xe2\xdf\xbd\xa3\xce\x32\xda\x3b\xc7\x9a\xd1\x30\xb0\x1a\xbe\x2f\xbe\x38\xc6\xdd\x7e\xe4\x87\x83\xef\xa2\xce\xfe\x12\x03\xb1\x22\x8c\x8d\x3b\x10\xe9\x26\x0b\x21\xce\x8f\x38\x5e\x39\x76\xcd\xf7\x77\x57\x4c\x6b\x76\x46\xee\xf9\x52\x7c\xd9\x79\x6b\xa9\x94\x01\xfe\x84\x95\x45\x12\xeb\x61\x44\x0d\x29\x10\x9d\x05\x7a\xc7\x82\xfc\xee\x3d\x18\x6c\x0c\x13\x6c\x31\xdf\x03\xec\x63\x07\xa6\x34\x77\x2b\x3b\xb2\xa0\x19\xba\xac\xe8\x9a\x6f\x73\xa3\x76\xc1\xbb\xc1\xdc\x0d\x62\xf5\xa0\x81\xc0\x75\x64\xab\x4e\x59\x99\x60\xd5\x82\x5e\x9d\xd9\x87\x0c\xb0\xa5\xdc\x03\x19\xd4\x3c\x45\x16\xa5\xd9\xe8\xc6\x97\x2c\x90\x95\x1b\xd1\x9f\x20\xf6\x7d\xa7\x38\xad\x41\x5c\x6b\xcc\x22\x5a\xdf\x70\x20\xad\x7b\x32\xcb\x34\x89\xeb\x7d\xd2\x34\xc7\xed\xbe\xc1\x47\xdd\xea\x41\x24\x4f\xf4\xd9\x3c\xf6\x0e\xe3\x3a\x2e\x8b\x87\x5e\x89\xff\xe4\x06\x19\x10\x94\x42\xf6\x54\x1e\xa9\xa6\x61\x6c\x07\x11\xcb\xff\x9c\xbc\x62\x2b\x50\xda\x92\x00\x35\x83\xc2\x9b\xb9\x9c\xae\xb9\x7d\x90\xe9\xec\x6e\xff\x39\xff\x3c\x28\x30\x9b\x37\x39\x5a\xb4\xee\xf6\xb9\x5a\xba\xc9\x04\x44\x19\xb8\xbb\x21\x73\xf5\xe7\x27\x87\x6c\xe2\xbe\x06\xe4\x4b\xc2\xc7\x07\xd4\x42\x36\x33\xad\xa5\xb5\x52\x48\x39\x9f\x5e\x80\x62\xde\xba\x9a\x03\xae\x7c\x45\x64\xbb\x23\x43\x11\xe5\xba\x85\x6a\xc5\x93\x5a\x9c\x12\x17\x79\x92\x81\x64\x5a\xda\x79\x8d\xa7\xa4\x35\xa8\xdc\xf9\xc3\xb3\xbd\x97\x33\xd5\x7b\x1b\xbd\xc6\xb2\x93\x5f\x4a\x40\x6f\xf0\xe8\x9b\x51\x56\x3f\x5d\xc8\x0e\x3d\xf2\x69\x80\x65\x7c\x71\x0a\x0f\x46\x48\xab\xc4\x05\x8f\xc3\xb1\x3f\x59\x34\x94\x6a\xd0\x0c\x8d\x35\xb0\xe1\x01\xfd\xb4\x8e\x09\x86\xae\x6f\x62\x2e\xee\x8c\x8d\x3f\xda\x61\xb2\x72\x8b\x94\x6f\x77\xef\x1a\xe3\x1c\x76\x39\xcb\xf3\x00\xf8\xe0\x9f\xfe\x3e\x08\x9e\x7c\x9a\x45\xb9\xc1\xa9\xd0\xbe\xe4\x94\x35\xfd\x7c\xb4\x3e\x87\x26\x9d\x6d\x25\x41\xcb\xa5\x30\x33\xe9\x12\x77\x21\x71\x63\x80\xb9\xe7\xcb\xbb\x01\x2d\x11\xad\xd6\xe1\xa3\x18\xb7\xdf\xdf\xba\xae\x89\x56\xfc\x7b\xd1\x08\xef\xcb\x8d\x1f\x54\xde\x2f\x6a\x0d\x10\x01\x42\xd0\xd2\xfd\x45\x91\xa0\x35\x95\x77\xf4\xa3\xc6\x23\xf3\x31\x46\x3a\xef\xbf\xcd\xd3\x24\x11\x06\xab\x65\x3e\xcc\x2d\xac\xac\x18\x13\x34\x1a\x7a\xc5\xf1\x2d\xb4\xab\xb1\xe2\x76\xd3\x44\x9c\xe5\x43\x4d\x36\xf8\xbe\x8f\x5d\x8d\xb0\x51\xa1\xb0\xf8\x4f\x96\x27\xd9\x5a\xbd\x9c\xb5\x79\xf2\x0f\x76\x1d\x06\xbd\x7d\x69\x53\x01\x89\xb7\xd3\xc7\x0e\xd1\x9c\x6c\xbc\xf3\xa7\xf0\xc4\xd9\x8d\x97\x3e\xfe\x3e\x01\x21\xa9\x8a\x3d\xb7\xee\xce\x50\x2f\x8e\x53\x2b\xfe\x47\x2e\x49\x42\x1d\x38\xca\x03\x35\x66\x0d\x5f\x60\xbf\x9c\x32\x32\x29\xb5\x20\xed\x50\xdb\xaf\x1b\x46\xea\x62\x86\xde\x1a\x39\x46\x8e\x62\x91\x9b\x3d\x86\xd2\xb7\xa2\x88\x9a\x50\x04\xd8\xd2\x75\x65\xc2\xfc\x35\xa8\x61\xc3\xd6\xa0\xe3\xf1\xdd\x62\x13\x7d\x18\x6b\xbe\xca\x1c\x71\xba\x33\x65\x43\x91\x9e\x38\xdf\xf1\x77\x6a\x24\xbd\xbd\xe3\x2e\xaf\x0b\xb9\x66\x78\x4e\x4f\x76\xbb\xac\xea\x79\x99\x82\x4d\x1a\xe0\xcd\x2e\x78\x6b\x1b\x89\xc1\x2d\x79\x5e\x4c\xbe\xeb\xee\xbb\xdd\xf9\xf5\x1f\xf5\xe4\x50\xef\x2a\x7e\x6b\xcc\xd5\x9d\x3e\x50\xf0\xb8\x73\x95\xf0\x3c\x5f\x14\xa9\x70\xce\xe3\x58\x39\x18\xb8\x97\x2f\x4e\x3a\x26\xb7\x03\x77\xe3\x63\x22\x9d\x16\xad\xb6\xbf\xb7\xb6\x73\xd4\xc8\xb3\x04\x6f\x1e\x8c\x46\xea\x8b\x9e\xbd\x58\x0a\x86\x1d\xb0\x34\x74\x4b\x85\xa4\xb9\x20\x13\xa0\x1b\xbf\xbb\x0f\xa2\x16\x94\xfc\x7e\x3a\xfe\xb9\x3e\xa6\x07\x5a\xee\x7e\xc2\x6f\x10\x1b\xc8\x68\x65\xd1\x3b\x48\x3c\xd2\xe4\x7f\xef\xee\x5e\x21\x10\x3e\xc2\x98\x3e\xa4\xf3\x9e\x60\xe5\x19\x08\x1e\x0a\x2e\xac\x52\x20\x01\x23\x97\x2d\xd8\xe2\xbc\xcc\x56\x00\xde\x35\x4f\x2e\x2c\x43\xb2\xd5\xcb\x40\x4d\xcd\x9e\xec\x74\x31\xee\x73\xaa\x43\x79\x9d\xc1\xed\xe8\x5d\xf4\xfa\x3f\x05\x41\x76\xc7\x7d\xc3\xe6\xad\x40\x70\x55\xeb\xd5\x2d\xb8\x56\xa6\xf0\x89\xbb\x3e\x63\xd0\x28\xb8\x7e\x2d\xbe\x3b\xb2\xbd\x63\xa3\xf6\xae\xe1\x24\x30\x96\x39\x49\xe7\x5f\x58\x80\x44\xca\x83\x92\x85\x88\x89\x10\xc4\xcb\xcb\x6a\xfd\xbb\xfe\x87\xe7\xeb\xa4\x5e\x92\xf4\x52\xd3\x8a\xba\x3c\x02\xbb\x86\x15\xb1\x27\x65\xd7\x51\x12\x0f\x0a\x9d\x33\x5a\x90\x6c\xf1\x49\xae\xda\xf3\xa6\x40\xbd\x2c\xaa\x3f\xa9\x82\x1d\x7f\x69\xc4\x81\x52\x20\xf1\x1f\x26\x5a\x7d\xe0\x57\x44\xea\xbb\x5f\x56\x0a\xd4\x8a\x2e\xc6\x17\x73\x6a\x20\x37\xa2\x81\x05\x03\x47\x69\xa8\x6d\x33\xf6\xfb\x54\x97\x04\xaa\x02\x2e\xa2\xcb\xba\x5a\x20\xdb\xec\x13\xc7\xc6\x0b\x7f\xbd\x9f\xf4\x6b\xf0\x16\x0d\x23\xda\x98\xbf\xd7\x6c\x51\x0a\xa3\xac\x26\x74\x95\xd2\x93\xe8\xaa\xbe\xba\xc0\xee\x0a\x3a\x3a\xb8\x1e\xf0\xc7\xeb\xa0\x15\x38\x2e\xfd\x8b\xba\xbf\x1b\x48\xda\xbe\x25\xd4\x1f\x5f\x80\xcb\x0b\x43\xcd\x6a\x91\x0b\xcf\x80\xb9\x6c\x28\x35\x51\x54\xd5\x69\xab\xb7\xb6\xd1\xdd\x2c\x98\x04\x97\x3e\xed\x7a\x6e\x48\x70\x15\x01\xcd\x9f\x2c\x58\xcd\x17\x11\xa3\xae\xcf\xb1\xa9\x98\xdf\x7e\x4e\xec\x1a\x04\x11\xb0\xcd\x17\x0a\x5b\x8b\x06\x79\x6a\x16\x95\x07\x27\x2b\x71\xee\x2c\xb9\x29\x6b\xbc\xdd\x8f\xb8\x07\x08\x38\x19\x5f\x34\x3b\xaf\x41\x48\x76\x4e\x40\x5b\x02\x28\x02\x0b\x8d\x23\x8b\x5a\x81\x2d\x8e\xb6\x20\x08\x3b\x30\x6f\x1e\xb3\xb7\x70\x2d\x8b\x2d\x63\x33\x70\x58\xeb\x46\xab\x11\x22\x2b\x1b\x19\xfc\xb5\x40\x6d\xf7\x6b\xb3\x0a\xdb\x2e\xc4\x02\x37\x69\x08\xf4\xd2\x3f\x53\x88\x09\x00\xb5\x2a\xe6\xb7\x0d\xd8\xbf\x1a\x47\xa0\x08\xf3\x20\x75\x42\x7b\x0d\x63\x63\x2c\x76\x2e\x3a\x15\xf6\x59\x8d\xa8\x77\x43\xe3\xeb\x48\xac\x53\xdd\x3c\xa8\x2f\x55\x70\x82\x28\xaa\x30\x73\xa3\xd4\xa5\x37\x00\x69\x16\x46\x82\x4d\xb9\x56\x9a\x62\xf3\xf6\xc5\x45\x43\xa9\x3c\xbe\x98\x9e\x3a\x9a\x2d\xfe\x10\xbf\xfb\xe7\x16\xdf\x72\xe2\xd0\x4d\x59\x6a\x80\x38\xdf\x9f\x8f\x51\x7a\x70\x04\x68\xdc\x28\xcc\xcc\x43\x06\x78\x1f\xf8\xd2\x51\xe5\x36\x5e\x21\x95\x42\xf2\xfa\x0e\xcc\x62\x1e\xf1\xd8\xe0\x5f\xea\x9b\x5f\x1e\x23\x0c\x85\x45\x93\x67\x8a\xbf\xbd\xbe\xf8\x83\x98\x96\x14\x28\x22\x2a\x29\xaf\xc1\x0f\xb9\x31\xc8\x98\x69\x4b\x2f\x4e\x1b\x9e\xa0\xdf\xe8\x1a\xf8\xb0\x7e\xba\xff\x53\xee\x5b\x29\x92\xa7\x47\x47\xfd\x71\xd7\x96\xbd\x3e\xc9\xae\xc6\x82\x32\x11\xbd\x8f\x69\x8b\x2b\xc8\x5d\x0e\x9a\x79\x3c\x48\x5c\x29\xa1\x53\x4e\xd8\x1a\xae\x16\x76\x57\x71\xf2\x71\x6e\x6c\x93\x7b\xb6\xba\x61\xab\x16\x9e\xe9\xb0\x01\xf9\xf7\xbd\x40\x1a\x42\xd6\x3d\xcb\x7b\x59\x29\xcd\xb0\xef\x2a\x88\xb6\xfb\x8e\x6e\x0c\xc2\xbb\x07\xcd\xdf\x57\x53\x61\x1f\xa0\x02\x14\x8b\xb5\x05\xc0\xcc\x1e\x87\x29\xe0\x4d\x89\xf1\x40\x14\x5c\x9f\x8e\xbf\x4d\xeb\xb8\x10\xad\x7c\x58\xd0\x19\xca\xed\x54\x76\x96\xad\xfc\xbc\x04\xc1\xce\xb1\xd5\x0e\x9e\x53\x83\xeb\x14\x0e\x81\xe7\xab\xfa\x8d\xa9\x8b\xf4\xc4\x7b\x6f\x4e\x35\xdd\xb3\x5a\x0a\xa6\x8e\x67\x6e\x71\xa7\x68\x59\x68\x78\xcc\x3b\x40\xfc\xed\x83\xdf\x4d\x66\xaf\x16\x6c\xda\xde\x93\xd7\xfc\xc0\x62\xaa\xe9\xe1\x98\x55\x30\x8f\x9e\xff\xd2\x34\x29\x88\xeb\xf2\xde\x35\xbb\xef\xe0\x81\x50\x47\x8b\x1e\x30\x06\x87\x8b\xf9\xf5\xfc\xd7\xe0\x8b\x96\x97\x0a\x06\xb9\x47\x78\x48\x61\x03\x42\xde\x0f\xde\xde\x27\xf3\xce\xbb\x43\x41\x1b\x24\x0a\x68\x58\x04\x30\x16\xc9\x68\xb1\x74\x51\xcd\xd6\xca\xa1\xcb\x4e\x00\xd2\x65\xd1\x5c\xfe\x75\xa0\xd0\xcf\x77\x64\xca\xb0\xef\xd2\x9f\x33\xa6\x68\x3e\x77\x23\x87\x29\xeb\xdb\xf2\xa4\xfb\x41\xb7\xf4\xea\xa8\x97\xc6\xbe\xb7\x52\xc2\x2e\xb9\x18\xb5\x08\xdf\x2e\x56\x21\x40\x2d\x00\x57\xa8\xc6\xa5\x8d\x53\xc4\x3e\x52\xd2\x7d\xf1\x93\x6c\x6e\x19\x34\xec\x02\x0d\xaa\x9a\x48\x68\xfa\x83\x20\x99\x51\xbe\x79\xf8\xc8\xe6\xde\x1a\xf9\xf7\x5e\xbf\x5e\x6a\xb7\x4e\x0a\x21\x5c\x05\xdc\x12\x6b\xf4\xc0\xf6\xc9\x98\x3f\xc8\x0d\x5b\xd1\xad\xf0\xc6\x13\xca\x9b\xd3\x93\x82\xde\x53\x55\x04\xad\x7a\x7f\x33\x03\x89\xe3\xe5\xf5\xa7\x5c\xb3\x16\xb2\x1c\x80\x36\xf9\x95\x97\x15\x76\x15\x03\x87\x2a\xd5\x77\xff\xe7\x32\xdc\x6f\x8c\xb3\x56\x0a\xea\x58\xae\xf4\xee\x28\x76\x2f\xee\x26\xaf\xbe\x8c\xcd\xdc\x05\xe9\x7b\x98\x9e\xfb\xa7\xd4\x16\xee\xd6\x4b\xaf\x96\x13\xa8\x15\x59\x39\x5c\xf1\x2e\x61\x23\x9a\x2c\x27\x2d\x85\x39\xe4\x40\x2a\x36\xad\x96\xd2\xe8\x83\x56\x5d\x95\x6f\xf9\x81\x33\x58\x8a\x08\x9d\x1c\x46\x71\xe8\x10\x1f\x7c\xaf\xd7\x0f\x79\x91\xe4\x17\xdf\x19\x3d\xf2\xea\x39\x28\xe4\xd9\x76\x98\x8a\x01\xd3\x13\x75\x80\x9f\x1c\xe2\x87\xd5\x3b\xc3\xb9\x94\x58\xf3\xd1\xf9\xb5\x28\xf8\x41\xd0\x0d\x72\xd0\x1f\x82\xd6\x24\x30\x81\x09\x04\x05\x67\xcc\xfd\x17\x4a\x40\xbc\x38\x38\x34\xb4\x9a\x40\xf5\x60\x2b\x05\x90\x3d\x9b\x0a\x7e\x47\x17\x71\x81\x69\x78\x29\xb9\xa6\x8a\xff\x88\x33\x33\xda\xe6\x44\x9f\x83\xde\xc2\x2e\x32\x74\x1f\x81\x0c\x03\x3f\xd9\x6a\xc5\xb1\x09\x65\xa2\xa6\xd3\x66\xf7\xaa\xfe\xd1\x40\x68\x6b\x71\xed\x1b\x85\x58\xbd\x2b\x3a\x39\xcd\x7b\x19\xb3\x1d\x81\xbe\xb7\xf0\x20\x79\xe9\x86\xdc\x72\xe3\x8d\x34\x30\xf2\xdd\x0a\x4e\x97\x55\x14\xa8\xbf\x5f\x00\x71\x64\x4c\x6e\x35\xdb\xde\x79\x5d\xb5\xc7\x4f\x05\xa1\x23\x3e\x39\x70\x04\xef\xef\x12\xcf\x9e\xc2\xe8\x05\x10\x68\xd5\xbc\xc0\x52\x48\x0c\x78\x46\xd3\xd6\xe2\xe8\xbb\xcc\x11\xb1\x32\x5b\x72\x03\xbe\x53\x73\xef\x12\x2d\xab\xd2\xde\x94\xaf\x4c\x8e\xba\xa8\xcf\x6d\x1e\x47\xb2\x5e\x0f\x2b\x6f\xa2\x24\xd7\x27\x0b\xb8\x8c\xdd\x7e\xf0\x26\x85\x74\x3d\xe1\x54\x54\x06\xa1\x67\xaf\xe2\x92\xed\x86\xea\xc3\x82\x6a\xe1\xed\xc5\x45\xbf\x80\x9a\x47\xd4\x32\xa5\xf9\x0e\xee\x1c\xb7\xd2\x0d\x68\x7e\x53\xc8\x8e\xc5\x68\xe5\xd8\xa7\x8a\x45\x94\xbc\x55\x33\x11\xa9\x02\x6e\x9c\xae\x7e\xfd\x8a\x2b\x0f\x71\x03\xb2\x0b\xd6\xc0\xa5\x0e\x8e\xc0\x4a\xdd\xe1\x15\x8d\xee\x18\x3d\xfd\xde\x02\x29\x4b\xd7\x7d\xce\xfb\xf7\x2d\xf3\x76\x71\xb5\xa0\x07\xed\x94\x3c\x4a\x35\x45\x42\x15\x92\x57\xd7\x60\x5b\xaa\x1e\xb1\x83\x96\xca\x7b\xfb\x46\x4c\x48\x99\x06\x0a\xca\x65\xe4\x1d\xbe\x7f\x1e\x25\x0e\x34\x54\x0d\x8d\x6b\x1a\x56\xd6\xb6\x17\x16\xee\x3f\x57\x82\xb4\xc3\x6b\x37\x4a\xbf\x26\x56\x2b\x4e\x79\xbf\xf2\x30\x35\x33\xf8\x2a\xf5\x05\x73\x93\x9e\x1d\x93\x66\x00\x18\xdf\x95\x75\xb8\xfe\xd2\x79\x1c\xf1\x6d\x8d\xfe\xbb\x95\x13\x9e\xc9\x0d\xe8\x59\x63\xf9\xc9\xab\x72\x9f\x7c\xec\xab\xf4\xeb\xe9\x7f\xa1\x15\x56\xa4\x25\x6a\x5d\xdc\x3f\x99\x00\xcf\xbb\xd5\xba\xf1\x83\xa1\x91\x27\x30\x94\x23\x0b\xa2\xd2\xc2\x13\x5b\x90\xa2\x6e\x46\x1f\x29\x87\x1e\x5d\x80\xe8\x29\x43\xf7\xda\x69\xab\xb7\x99\xe3\xb1\x45\x84\xbb\x3a\xfa\xd0\xeb\xbb\xb6\xfd\x68\x2b\x24\x50\x20\x5e\xa8\x86\x3a\x53\xe8\x7d\xd0\x6d\x02\x0c\x67\xed\x5b\x8b\xb9\x46\x31\x0f\x5c\x73\x8c\xbb\xec\xc3\xd9\x82\x0c\x1a\x76\xf3\x08\xde\xdc\xaf\xbf\x09\xec\x55\xe5\xda\x07\x9f\x66\xe0\x36\xf3\xb2\xfa\xa0\xeb\xf6\x7e\xeb\xaf\x74\xf0\x29\xb6\x4f\xa0\x4a\xfb\xe5\x67\xe7\x54\x0f\x23\xda\x74\x67\x60\x73\xe0\x7d\xaf\xba\x21\x26\x59\x72\x8b\x0d\x0b\xdc\xe7\x07\x74\xb1\xce\x83\x0b\x91\x6c\xc7\xf4\x1e\x95\xdf\x6b\xd0\x7a\xa8\x41\xa2\x52\x86\x7d\x70\xd0\xd5\x6b\x0a\xb2\x72\x83\x20\x9e\x83\x7f\xe9\xf8\x25\x4b\x23\xaf\x93\xbe\xb3\x16\xd3\x59\xc4\x4d\x1a\xcb\xb2\xf5\x96\x83\xe8\x3e\x8e\x26\xee\x40\xd8\x2d\xce\x32\x36\x8c\xa2\x75\x0d\x07\x9a\xe5\x02\xe1\xea\xd5\xa3\xdd\x55\xc1\xce\x46\x69\x61\x37\x34\xb7\xa6\x06\x36\x14\xbb\x91\x63\xb3\x7d\x1e\x41\xb2\xe0\xab\x5f\x47\xbe\xd7\xfb\x13\x37\x64\x3b\x61\xe7\x3a\x7a\x54\x41\x32\xde\x3f\x1a\xeb\xe3\x5d\xdf\x5f\x27\x7b\x74\x0f\x97\xa1\x0b\x65\x67\x0d\xdc\x34\x5d\xe1\xb8\x5f\x4f\xa5\xc3\x44\xcb\x76\xc9\x07\xd5\x22\x66\xb6\xda\x7f\x24\xe1\x88\xe1\xe7\x5e\x02\x7e\xf1\xab\xea\x46\x9c\xc3\xb8\xcb\x46\xe2\x7a\x00\xd3\x32\x92\xcb\xe6\xbe\x78\x75\xde\x7d\x96\xce\xaf\x43\xeb\xc6\x58\xae\x51\xce\x5d\xb1\xd7\x71\x3c\x59\x99\x04\xe6\x42\x87\x15\xbd\x1b\x70\x62\xe4\xc7\xb1\xba\x82\xa9\x77\xee\xa8\x21\xf6\xce\x1c\x98\x7a\x0f\x80\xa3\x99\xc2\x0a\xa5\xb5\xa3\x29\x6b\xca\x8d\x49\xb2\xa8\x28\x41\xc3\xf3\x04\xaf\x5e\x4c\x85\xa9\xc1\x21\x65\x67\x66\xdc\xfa\xbe\xdd\x2e\xed\x77\x66\x59\x90\x73\x98\xbc\x1f\x5e\x50\xd5\x7a\xb1\x5d\x21\x86\x03\xc4\x67\xbd\x8b\x4e\x3e\x7c\xb2\x1e\x8c\xef\xff\x64\x31\x8f\x06\x9e\xba\x15\x35\xcc\xaa\x1f\x12\x97\xca\x20\x12\x04\xa9\xc8\xfd\x70\x4a\xa2\xda\x05\xef\xb5\x77\x61\x34\x5e\x43\xfb\x8d\xb7\x3e\x80\x24\x51\x81\x5c\xeb\x5f\x6d\x33\x8c\xcd\x66\x1f\x22\x77\x7d\x68\x8f\x49\x8e\xf9\x98\x85\xfd\xe7\x3c\x28\x32\xcd\xbd\x0f\x33\x70\x76\x03\xa7\xe0\x9f\xa2\x04\x15\xcb\xfa\xbd\x8b\x6c\x9a\x45\x51\xf4\xee\x4b\x0b\xc8\x96\x32\x08\xcf\x53\x76\x38\xf6\xa0\x45\xf9\x15\x54\x49\xad\xb0\x35\xdf\x11\x12\x65\x7c\x1f\x56\x32\x67\x58\x4c\xf1\x21\x7a\x34\x7d\x9a\x66\x03\x4b\x0f\x50\xb0\x5b\xe9\xd6\x02\x66\x06\xaf\x26\xbb\x55\x55\x92\x5d\x64\x30\x37\x1b\x30\x8b\x39\x1c\xb6\xb8\xa3\x58\xbc\xc9\xc7\x99\xaa\x66\xa4\x42\x52\xf5\x66\x26\x8d\xe7\x7e\x08\xdb\xb8\x33\xc9\xaf\xcc\xed\xad\x42\x0c\x95\x6a\x97\xbb\x62\x7a\xab\x66\x9b\xa3\x50\x25\x3f\x9d\x53\x77\x9d\xef\x0e\x51\x9e\x66\x47\x36\x84\x3c\x75\xec\x46\x1f\x4c\xe3\x9f\xf2\x45\xe6\xa1\x72\xf2\xc8\xcb\x41\x59\xb8\x46\x19\x4c\x4e\xb3\x81\xb6\xc7\x5c\x7b\x6c\x15\xd4\x5e\x8b\xd2\xd6\x34\x6f\x03\x43\xa4\x37\x1f\x8e\xb8\x77\x41\x78\x10\x21\x5b\x83\xff\x6a\xf5\x30\x2f\x50\xb7\xbe\x5b\x1c\x9e\xa8\x8c\xb2\x73\xdd\xba\xc0\x8e\x5f\x50\xd0\xca\x77\x59\xec\x60\x8a\xed\xbd\x47\xa5\xf7\x20\x66\x46\x27\xc5\x41\x16\x3c\xf9\x73\x10\x86\x22\x93\x9f\xdc\x0c\x70\x8b\xc1\x09\x87\x75\x06\xd8\x7f\x71\xd4\x53\x99\x86\x5c\xf7\x55\xb4\xb5\xe2\xbd\x21\xec\x0b\x55\xb8\xdd\x8b\x6e\x3f\x77\x1f\x1f\xb9\xc6\x1b\xa9\x08\x77\xde\x47\x53\x89\x7a\x2d\x30\x62\xc5\x19\x6f\x5d\xb7\x70\x30\x95\x80\xf8\x9f\x33\xb4\x57\x8a\x32\xc2\x31\x9e\xe4\xd5\x0e\x3c\x87\x2a\xa8\xd1\xad\x1c\x3a\xfe\xa6\x05\xce\xdf\xd2\xc5\x19\x27\x97\x53\x90\xc1\x7a\x8d\x78\x79\x58\xad\x5e\x14\xd8\xf8\x30\xc0\x62\x66\xb7\x36\x84\x39\x42\x61\x0d\x2b\xfd\xe5\x62\x68\x8d\x1b\xfd\x4a\x3e\xbe\x1a\x60\xbd\x36\xae\xa9\x0c\x93\xf5\x22\xdf\x08\x86\xdd\xbe\x13\x54\x30\x4f\xdf\x58\xb9\xe4\xe4\xbb\xc0\x86\xe5\x79\x48\xf3\xfe\x73\xcd\xc3\x12\xf8\x5f\xa6\x20\xa6\x14\xd6\x57\x98\x12\x43\x53\x6b\x94\x51\x05\x98\x41\x1e\x9f\xca\xbc\xed\x32\xa4\x17\x74\xc2\x28\xa3\x2e\xb2\xdd\x57\xf3\x31\xb7\x5c\x5a\xde\xd8\xed\x32\x74\xdb\x85\x50\x17\x84\x12\x5a\xe3\xec\x9f\xef\x1e\x83\xbc\x31\x19\x55\x6e\x4c\xf0\x66\xae\x98\xd7\xb3\x13\x11\xf3\x7d\x0f\x64\xd9\x26\x69\xfc\xbf\x62\xf2\xdb\x5b\xd6\x44\xa8\xd5\x3f\x78\x21\x10\xca\x9d\x7c\x12\x3c\xa3\xbd\x05\x09\x8a\xf8\xe0\x63\xf8\x7a\xcd\x07\x04\x9c\xb0\xa7\xe2\xc2\xa6\xc7\xfa\x3a\x58\xb9\xd6\x88\x08\x2d\xcf\x86\x8a\x01\x3e\xab\x72\x1e\x64\x3b\x8a\xe4\x58\xab\x0e\x51\x00\x17\x77\x71\x6a\xd5\xba\x35\xfc\x5d\xef\x07\x98\xd3\x95\x3c\xea\xc7\x11\x6e\x39\xbb\x98\x55\xc3\x79\x32\x55\xe1\x07\xab\x94\x08\x7c\x09\x26\xb0\x4f\xdd\xc2\xad\xff\x60\x8f\x9f\x82\x87\x5d\x52\x01\x67\xac\x09\x26\x07\xb2\xeb\x9a\x23\xe4\x25\x62\xaf\xf8\xa3\x39\x31\x5b\x81\x50\x3f\xf1\xcd\x7e\x3f\x17\x17\x61\x7d\x27\xf9\x2f\x25\x46\xb5\x80\xed\xe7\xb2\x55\x39\xab\xb7\x92\x34\xf0\x6d\x3b\x8c\x0c\xf2\x02\x95\x91\xa2\xb2\x70\xdd\x3b\x41\x13\xb6\xf4\xf9\xfe\x02\x1f\x39\xb0\x44\x05\xff\x78\xfd\xd2\x0b\xa6\x3e\x48\x12\x8d\xac\x0f\x1c\xd9\x35\xf4\xa0\x91\x24\x74\xf1\x21\x58\x71\xd0\xd6\xa6\x4c\xa0\x73\x40\xd6\xdd\x87\x43\x01\x5c\xc8\x57\x2d\x56\x57\x96\x48\x40\xbd\xa0\x20\xb1\x1b\xe8\x41\x5a\xdd\xd2\x15\x47\x92\x42\xd7\xb9\xde\x6f\x51\xda\x5d\xe8\x01\xd5\x29\x1a\x39\x14\x95\xf2\xa8\xa7\x07\x34\xe8\xe4\x56\xcc\xd9\xf7\xc9\xca\x39\x75\x2d\x13\xe6\x08\xb1\x50\x55\xd4\x0b\x85\x3b\xc4\x2f\xd1\x37\xf0\xf0\x8f\x29\xc5\x0d\xcd\xb5\x7c\x91\xfb\x2a\xd3\xcd\x1b\x8c\x97\x0d\x45\x5c\x49\xca\xf2\x61\x6e\x3e\xfb\x41\x8f\x89\x2c\xfb\x3b\xa1\x25\x70\xca\x55\x2b\xfe\x22\x7c\x70\xf0\x7d\x5d\x26\x5c\x67\xf0\x9e\x8e\x1c\x2d\x1a\x29\x5d\x15\x5e\x51\x38\x34\xfe\x1f\x05\x09\x5a\xb7\x73\x6c\xb9\xbc\x93\xf3\x6f\xb9\xac\xa7\x3f\x05\x10\xce\x8e\xc3\x04\x3e\x40\x05\x62\x81\x57\x87\x1d\x0a\x67\xec\x1b\x5c\xa3\x16\xcc\x62\x5f\x94\x1f\xac\x2a\xf3\xed\xe6\xc4\xfb\xc4\x72\x59\xfd\xb3\x00\x15\x20\xfa\x52\xe8\x66\xdf\xb4\x98\x3b\x74\xf6\xd2\x07\x3b\x1f\x5e\xbb\x84\x9d\xce\x40\x70\xdb\x5d\xd5\x8d\xf2\xef\x5b\xe6\x1a\x10\x8c\x35\xcd\x3b\x05\x15\x02\x57\x04\xc2\x46\x60\x13\x32\x64\x49\x03\x77\x7e\xf2\xe2\xa6\xae\xaa\xba\xe9\x60\xf0\x4b\x29\x27\x78\xd7\x19\x18\x42\xac\x5b\x15\x9d\xc4\x89\xf6\x4b\x1e\x32\xd4\x85\x6b\x8d\x07\x50\x2a\xde\xc1\xc9\x67\xb8\x49\xe6\xde\xcc\x86\x5c\xf5\x68\x4e\x98\xee\x93\xd6\x0f\x88\x5c\x5e\x24\xec\xea\x0c\xe7\x91\xca\x1f\x3c\xbc\x5f\x0c\x9e\xff\xa3\xf5\x1a\xae\xb3\x36\x21\x2b\x35\xdc\xa9\x7e\x2b\xe8\xfa\x1c\xd1\x33\x2d\xe5\xd0\xfb\x6e\xd4\x89\x9b\xbf\xeb\xe6\x07\xf1\x9a\x4f\x39\xd1\x4c\xd7\x23\x91\xd0\x68\x12\xcd\x3e\xc8\x8f\x07\x83\x6b\x29\x82\xff\xfa\x34\x7e\xf1\x4b\xd7\xf0\x70\xe3\x52\xb5\x9f\x32\x73\x7e\xe8\xb8\xd9\xd0\x3b\x28\xc6\x53\x11\xe2\x3c\xbb\x31\xcd\xc5\x3d\x13\x56\xd2\x81\xad\x34\x22\xfa\x33\xcc\x68\xa3\xa9\xef\xeb\x4c\x64\xef\xf2\x4c\x6e\xa1\x46\x0a\xf4\x11\x57\x85\xc3\x8b\xc2\x88\x6a\xf6\x55\x68\xec\x56\xd6\x7b\xa7\x27\xbe\x8b\xaf\xd5\xc4\xcd\xaa\xcf\x8e\x5b\x86\x54\x45\xce\x4a\x8e\x16\xad\xd6\xd5\x78\x16\xd0\xaf\xe5\x17\xff\xba\x33\x35\xca\xfe\x31\x68\x69\xb8\xad\x08\x28\x5a\xa9\x8d\x75\x53\xef\xfb\x5e\x3b\xe6\x15\x81\x4f\x15\xd5\xef\xcd\x24\x9b\xe1\x1d\x85\xc0\xca\xc5\x87\x4f\x47\xfe\x21\xc1\xcc\x3a\x74\x1c\xd3\x07\x2f\x8d\x64\xeb\xf1\x3b\xb0\xad\xa3\xb0\xea\xea\x2c\xaa\x02\x61\xb7\x05\x07\x4a\x56\x91\x83\xe2\x69\xef\x4d\xfe\xfb\x8f\x7a\x80\x8d\xfa\xbd\x0f\x2f\xd8\x1d\x65\x57\x6d\xa8\xd4\xa3\xca\x41\xf4\x89\xb3\xc0\x5f\x59\xc8\x49\x01\x81\xa1\x8a\x40\x79\xfb\xd7\x23\xaf\xad\xf3\x35\xaf\x0c\x39\xee\x9c\xf9\x0b\x8c\x5e\xbc\x46\x60\x98\x31\x9f\x45\xf1\x39\x27\x77\xfe\xd5\x6e\xe5\x8c\x61\xcb\x01\xb5\x60\xf8\x3b\x05\x5a\x7d\xcb\xb6\x28\x34\x23\xa3\x36\x87\xb5\x7b\x8d\x10\x0d\x81\xa6\xdb\x37\xb2\xf0\x27\xd0\xfe\xcc\x90\x45\x57\xec\x13\x70\xb0\x28\x2d\x0e\x48\xe0\xd8\x12\x09\x40\x27\x86\x40\x63\x8a\xd0\x6b\x90\xab\xb0\x66\x75\x4e\xe7\x0f\x66\x86\xbb\x42\x01\x48\x7b\xad\x1a\x51\x3a\x51\xec\x1a\x0b\x84\xed\x40\xc2\xc0\x17\xbe\xff\x6a\x79\x98\xf4\x82\x70\xa5\x40\xeb\x1c\x40\x05\x6f\xfb\xdd\x5b\x54\xbf\xa7\x75\xdf\x27\xb4\x9b\xa7\x12\xaf\x75\x56\x72\x9c\x17\xef\xb4\x33\x5b\x9c\x25\xa0\xc4\xc5\xeb\x4e\x18\x0c\xcc\x6e\x01\x3a\xc7\x14\x76\xc4\xf0\x35\x16\x4a\x8d\x3e\x44\x9d\x01\xa1\x98\xaf\x09\xbc\x9b\xae\x9d\x75\xdd\x03\x12\xf6\x2e\x38\xf0\xee\x94\x27\xcf\xf5\x7f\xfb\xb2\x47\x27\x3c\x50\xe0\x0d\xb7\x0f\x21\xce\x76\x94\x93\x06\x43\xd1\x61\x4d\x7f\x7d\x36\x17\xa5\xd5\x58\x5a\xc4\x7d\x18\x1e\xe4\xc8\x30\x2b\x18\xc5\x35\x15\x53\xcc\xae\xe7\xd2\x85\x5f\x03\xbd\x79\x47\x2a\x9d\x27\x2d\xf0\x7e\x20\x6f\xaa\xd0\x65\xd2\x55\x30\x96\xc1\x3b\x44\x81\x5b\xff\x02\x07\x86\x4b\xb4\x0e\x14\x1b\x73\xa8\xc4\x34\x30\xf6\x24\x58\x3f\xf0\x38\x4a\x71\xef\x6e\x2c\xc9\x16\xd1\x31\x62\xa6\x65\xa9\xd4\xde\xbf\x6f\x27\x97\x50\x56\x82\xc7\xd4\x6d\xb1\x04\xae\xbd\xe3\xa7\x56\x4d\x67\x37\x89\x3b\x89\xd7\x57\x7b\x72\x6d\x3e\x60\x1e\x93\x76\x8c\x63\x5d\xcb\x9d\xd9\xdf\x87\x45\x70\x35\xb9\xf5\x43\xbd\x26\x18\x25\x0a\xed\x4b\xea\x73\x14\xc7\xb1\x89\x0a\x75\x17\x77\x8f\x3f\x05\x83\x98\x66\x95\xe2\xbe\x3c\xeb\x46\x70\xad\x36\x9d\x77\xb3\x08\xbf\x34\xba\xf8\x5b\xf6\xd7\xb7\xb3\xe8\x28\x62\x6f\xbe\x8f\x9c\x71\xf8\x4b\xbf\x92\x6a\x48\x01\x61\x31\xb3\x65\xad\x17\xce\x16\x21\xca\x31\xf1\xba\xaa\x3b\xbc\x48\xbc\x7c\x61\x6e\xd7\x64\x54\x44\xe6\xed\x0d\x5c\x3a\x2f\xd7\xaf\x23\xa7\x21\x5e\xd5\x00\x65\x10\xd4\x81\xa5\xed\xa4\x79\x47\x83\x87\xf1\x6a\x6c\x75\x42\x67\x0e\x2c\x00\xb8\x2a\xac\x63\xf0\xd4\x60\x46\x19\xe6\x13\xe9\x06\xbf\x72\x21\xc3\xca\x46\x70\xda\x8e\x13\x20\x5c\xbf\xbc\xa0\x9c\x0a\x4a\x1c\x07\x43\x2e\x89\x9a\x08\x8d\xd6\x17\x77\xef\xe3\x7f\xe7\x35\x32\x08\x93\x3a\xc7\xae\xb7\x9a\x6c\x9f\x7f\xc5\x5f\xdd\x05\x57\xb8\xce\xd1\x53\x58\xbb\x60\x4c\xfe\xa5\xc2\x84\x99\x69\xa5\x10\x08\xfc\x2f\x8d\xa5\x76\x9e\x9d\xfb\x2f\xbf\x25\xd8\x37\x80\xbe\x46\xa1\x68\x94\xdc\x07\x7f\xd3\x96\xad\x5b\x30\xe0\x8b\xd9\x69\x30\xe1\x9a\x3f\xec\x25\x68\x2d\xae\x34\x6a\x1a\xb0\x37\x6a\x0d\xda\x9f\x9f\x20\x74\xbd\x6e\x91\xad\x3e\x0d\x75\x93\x0f\xcb\x46\x6c\xab\xe7\x2d\x40\xf8\x05\x5a\x8d\x92\xb2\x5e\x1a\xde\xd4\xb3\xb5\xfc\xcc\xaa\xef\x8d\x4c\xbb\xaf\xd2\xad\x4a\x2e\x01\x17\x69\x29\xbd\x9f\x06\x72\xf0\x1b\x25\xe8\x61\x6f\xf2\x8d\xfc\xc3\x4c\x53\x66\x05\x6c\xa3\xb8\x7e\x78\xb8\x7d\x10\x1e\x71\x65\x3a\x39\x46\xaf\x18\x89\x5a\xd3\x35\xa1\x6e\x2a\x19\x13\x60\x66\xd7\x53\xd4\xc9\x3b\xbb\x91\xb4\x8e\x4a\x11\x87\x94\x83\xec\x71\x15\xb8\x3e\xa6\xcf\x4a\xe9\xfc\xcd\x5b\x57\xad\x27\xff\x5b\xfa\x50\x2d\xe9\x8b\x50\xeb\xbd\xaa\x5a\x34\x75\xf9\xe2\xa7\xac\x67\x97\xfd\x76\x04\x62\xda\xc2\x3a\x12\x84\xaa\x0a\xa9\x6e\x24\xe9\x31\x53\x50\x6a\xf4\x13\x03\x68\x02\xd5\xf7\x8b\x54\x4c\x6b\x39\xed\x59\x8f\xdc\xab\xda\x86\xae\xd4\x17\x1f\xb8\x1b\xe9\xe9\x1b\x6a\x61\xe5\x7d\x34\xca\xb1\xba\x8a\x4d\xa2\x97\x0e\xde\x79\x2d\x6d\xb9\xf8\x99\x5e\x0f\x85\x68\x1f\xa9\x6a\x4d\x5d\x65\x44\x23\x50\x54\x15\x99\xe4\xc3\x8a\xca\x7c\x80\xda\x8c\xdd\xff\xfc\x5b\x81\xfb\xaa\x87\x45\x83\xe0\x7b\x92\xb4\xf2\xd7\x4b\x1f\xfc\x7d\x24\xe6\xf7\x21\xd3\x29\x42\xbe\xfa\x5e\xe1\xc3\x2c\xe0\xa3\x17\x33\xf1\xa4\xe7\xe6\x19\xd3\x32\x3f\xcf\x85\xf4\xd5\x88\x79\xf3\xe8\xaa\xfa\x35\xfc\xfb\xda\x59\xaf\x9b\x70\x5a\x7a\x99\x32\xba\xf6\x92\x1b\xbc\x52\x74\xdc\x1d\xc2\xa3\x3e\x2d\x41\xe5\xb2\xd4\xd9\xf7\xb2\xce\x3e\x05\xbf\x2a\x5e\xf2\x2c\xae\x21\x88\x2f\xd9\x49\x7a\x77\xe6\x70\x71\xbe\xfe\x3f\x76\x69\xeb\xfa\x22\xae\x29\x2f\xcc\xcf\xf9\xd2\x0c\x98\x04\x46\x33\x87\xdd\x27\xee\x0a\xff\xb2\x2e\xa7\x51\xeb\x29\x26\x18\x06\x87\xf3\x0d\xe3\xb3\xad\x90\x83\xd3\x9d\x63\xbb\x44\xa0\x06\x1b\xd8\xf8\xc9\xc9\x0f\x9e\xe9\xde\x67\x87\x8b\x42\x56\x68\xaa\x5a\x69\x5f\x1d\xe8\xca\xe9\x47\x9c\x56\x1a\x7c\x6a\x6c\xd5\xbe\x0f\xa7\xee\xef\xfb\xbf\x4a\xb4\xb4\xa8\x07\x4c\xf6\x05\x56\xd4\xc3\xa2\xa4\x4b\x99\xf4\x83\xda\x2e\x60\x7a\xaf\x39\x98\x19\xfa\xa6\x57\xa1\x8f\x28\x01\x8c\x09\x13\xcd\x68\xc5\x0d\x4a\x0d\x8b\x69\x1c\x45\x8b\x9a\x1c\x60\x87\x7f\xae\x58\xe5\x9c\xe8\xe3\x3c\xe6\xf0\x61\x1c\xf7\xe0\x65\xf1\xca\xfd\xca\xe6\x31\xcd\xe4\x22\x29\x5d\xa5\xed\xe8\xe3\x85\x1d\xc2\x2f\x3d\x41\x86\x17\x5f\x44\x33\xf4\xc2\x9c\xca\x2e\x0f\x48\x2b\x1f\x2c\xdf\x90\xcf\x3e\x33\x3d\xdf\xdd\x0b\x77\x66\xce\xbf\xba\xd3\xf2\xef\xfe\x7f\xec\xce\x04\xf7\x08\x50\xd0\x05\x7f\x69\xa0\x7e\x2b\x7c\x5c\xb8\xd2\xea\x00\xb8\x41\x13\x4d\x24\x48\x9c\x3b\x5a\x38\x88\x51\xff\x47\x41\x8e\xf8\x45\xb0\x37\x06\x15\x39\x6f\xa1\x34\x2e\xc8\xa0\x6e\xf7\x4b\x79\x2b\x07\xff\xc0\xb4\x34\xd2\xa4\xf5\xa0\x42\xf8\x5a\xe1\x4c\x46\xe5\x32\x1c\xb7\xd7\xd7\x15\x45\xc2\xcf\x05\x3e\xcc\xe9\xfb\xec\xd0\x8d\xf7\xff\xa1\xcb\x3f\xfb\x90\xd0\xad\x63\x8b\xce\x5e\x3f\xd4\x35\x6f\x45\xa6\x1e\x51\x6f\x55\x12\x08\x8b\x59\xf8\x7c\x65\xfb\xa8\x61\x30\xde\x71\x09\x64\x1d\x75\x71\x06\xce\xe5\xd6\x0c\xbd\xd4\x3e\x47\x6c\xe7\x03\xc0\x02\x8f\x3d\x2e\xb8\x4d\x6e\x5d\xf0\xde\xa3\x1c\xff\x54\x48\x46\x10\x7b\xd8\x6e\x69\x02\x10\xdb\x21\x4f\x00\x97\x17\xf0\x62\x28\xc2\x3e\xb0\xa4\xd8\x3f\x07\x80\x72\x2f\x71\x3d\x3d\x1c\x88\x7f\x32\x61\x42\x5a\xc4\xcc\xfb\x35\xbf\xae\xe1\x91\xb6\xfe\x7b\x89\x9e\x4c\xfc\xa4\x6a\x65\x71\xc2\x3f\xa8\xf9\x3c\x33\x85\x01\x45\x59\x22\x99\x59\xad\x15\xde\x6e\x0b\x87\x5d\x4d\x5a\x1d\x19\x83\x73\x66\x36\xde\x0d\x9d\xdd\x8a\x03\x7d\xa6\x16\x89\x05\x79\x09\xa3\xc3\x2e\xd1\xb7\xba\x1f\x4c\x0e\xa0\x81\xbb\x5b\x28\xf8\xb5\x91\xed\xac\x8c\xb0\xe3\xf0\xcb\x8a\x37\xfa\x8b\x74\xcf\xe2\x03\x6b\x99\xb7\xd3\xb2\xe2\x3c\x98\x2f\xda\x55\x50\xcf\x47\xa8\x1d\x27\x03\x2f\xfb\x6c\xeb\xee\x8e\xf8\xd9\xb5\xf8\xb0\xc7\x3f\xaf\xd6\x76\x10\x54\xd7\x1d\xcb\x62\x1b\x22\xa0\xcf\x6b\x43\x1a\xe5\xb3\xee\xb9\xd4\x07\x8e\x49\xa8\xae\x9d\x2b\x04\xe4\x8c\x23\xd4\x42\x59\xe7\xbc\xf4\xea\x19\x8f\xa3\xcd\xe8\x06\x45\x31\x2d\xac\x06\x2b\xbd\xf7\x21\x6a\x1f\xfc\xed\x0c\xb6\x86\xca\xf3\x76\xed\xd4\xf7\xb6\xc5\xe1\x1b\x1a\xfe\xdd\x9d\xe9\xaa\xba\x07\x30\x24\xa9\x9a\x1a\x60\xee\x19\x5a\xcb\x63\x72\x0c\xca\x45\x3b\x7f\x4b\x55\xb1\x5b\xc7\x67\xe9\x0d\x1a\xbe\x2b\x9b\x14\xb4\x76\x64\xf0\x16\x9f\xf5\x20\xb1\xd8\xa7\x22\xd2\x60\xc7\x00\x9e\xe5\x79\x5c\x73\x03\x73\x8e\xef\xa5\x8a\x52\xe9\x3e\xe8\x61\xfd\x3a\xb2\x8b\x89\xd5\x13\xf5\x6b\x63\xe9\xb5\x50\xa4\xb7\xd7\x18\x80\xe9\xd7\xe3\x97\x6a\x6d\x92\x99\x1b\x91\xf9\x52\x25\x42\x9f\x14\x8c\x20\x8e\x70\xf1\xe0\x15\xea\x7c\x42\x70\x63\xf1\xfd\x57\xc2\x12\xc5\xc8\x7c\x9f\x85\x5e\xb5\x56\x4c\x45\xf9\x39\x74\x5b\x19\xd1\xf1\xf7\x7a\x0a\xbd\x9f\xd5\x18\x85\xc3\x55\x5d\x47\x03\x7e\xaa\x6e\xad\xf7\x8e\xdc\x0f\x69\xe8\xc0\x2e\xd0\x81\x62\x77\x5b\x3c\x43\x6d\x78\x37\x58\x6e\xf8\x35\xab\xa4\x71\x57\x54\xb8\xc0\x1a\xca\xcb\xc6\xe7\xb4\x72\x18\xdb\x4e\x6f\x3d\x18\xc8\xdd\xe1\xfb\x73\x41\x17\x45\xdf\xa7\x32\x94\x72\x92\x35\xb4\xf4\x07\xa0\x47\xe7\xfd\xd5\x1b\x50\x76\x3e\x2c\xd8\x26\xf8\x85\x3d\x20\xd6\x9e\x18\x13\x67\xa6\x80\xaf\xf6\xde\x82\x5a\x9f\x25\x0a\x39\xea\x56\x32\x32\x96\x0b\x73\x61\xaa\xe6\x9e\xde\x04\x38\x93\xf8\x75\x8f\x55\xab\x58\x5e\x71\x03\x59\x58\x58\x0b\x0f\xad\xe9\xf7\x9f\x2c\xd5\xf2\xf1\x4a\xc8\x5f\xbf\x00\x78\xe4\xf4\xd1\x20\x53\xed\xeb\x71\x6e\x7f\x4c\x93\x1b\x09\x5b\xc3\xa1\x47\x75\x0f\x5b\xb6\x8a\xd7\xfc\x88\xa2\x80\xf5\x42\xc5\x02\x9d\x45\x37\xfd\xa8\x89\x8f\x0f\x30\x9c\x16\x44\x1d\x4d\xde\x0e\x23\x0f\xfa\x3e\xec\xca\x9a\x9a\x23\xc0\x39\xf8\x98\xc3\x84\x77\xbf\x68\x6c\xa1\x03\x14\x1a\x0d\x1e\xc8\x2c\x3c\xd9\x96\x29\x68\x10\xb6\x09\xbb\xda\xfc\xb5\xce\x2d\xdb\xd0\xbf\x94\x75\x3d\x25\x39\x14\x5c\x6e\x0a\x5d\xae\x10\xfa\xf7\xbc\xf5\xcf\xad\x64\x81\x51\xf7\x56\x54\x83\x4f\x0b\x5b\xe3\x55\xd4\xf7\x2b\x74\xbc\x77\x0e\x7f\x12\x54\x17\x7f\x7a\x8c\x32\x96\x96\x3e\x0e\x3e\x8c\xa3\x1a\xa6\xe7\x5e\x5d\xe2\x42\x54\xeb\x76\xa6\xda\x45\xe2\x19\x03\x0f\x55\xfa\xef\xec\x66\x98\x1e\xcd\x73\x5e\xf0\xe6\x1c\xf2\xca\xea\xa3\x91\xbb\x07\x65\xbb\x7d\x76\x85\x34\xef\xc1\xc6\x37\xbb\x82\x1a\xd4\x73\x5a\x19\xba\xdf\xff\x86\x5d\x16\x52\x11\x0a\x26\x18\xfb\xb2\x40\xaa\xb1\x66\x8d\x5f\xdd\x32\xd1\x43\x4d\x70\xd8\x6a\x0f\x8b\x45\xe2\x80\x0b\x3d\xda\xee\x7e\xab\x14\xbb\x8a\xb5\x7a\xdd\x93\xe6\xc0\xda\x87\xdc\x72\x8c\xfa\xab\x3d\x7c\xae\x62\xa8\x77\x01\x0e\x15\x12\x99\x5c\x67\x7c\xe3\x25\xa4\xde\xec\x6b\x96\x32\x74\xd5\x15\xfa\x0e\xdd\xba\x35\x42\xd9\x14\x44\x0f\x2e\x8d\x2c\x82\x39\x5b\x01\xfb\xa2\x19\x73\xbf\x06\x0b\xc5\xd8\xdd\xfc\x0b\x75\x6d\x11\x8d\xcf\xe8\x54\xf3\xda\xf7\x22\x57\x63\x2b\xcb\xa2\x0b\xc4\xad\x75\xeb\xe2\x42\xd6\x76\xc7\xf2\x46\x55\x81\x39\x71\x38\x49\x04\xff\x73\xfd\x47\xad\x77\xec\xf6\x2b\x22\x73\x53\x9c\x4f\xfa\x0d\x6d\xbd\x35\xeb\x9c\xbd\xf3\xc7\xff\x52\xe6\x20\xac\x8f\xc5\x6a\x2f\x0d\xa7\x6b\x5b\xac\x59\x23\xbe\x14\x4e\x0d\x12\x64\x57\x8c\xfb\xcf\x75\x42\x17\x35\x7b\x46\xd2\x59\x14\xcb\x58\xd8\x0e\x43\xaf\xf6\x13\xf0\xf2\xb6\x07\x1f\x54\xbe\x7a\x71\xf8\xc4\xb3\xc8\x5a\x81\xbf\x8a\x20\x4b\xd4\x90\x93\x2f\x3e\x44\x9a\x14\x54\xd0\x10\x22\xf0\x17\xb6\xa8\x34\x94\x5d\x19\x21\x20\xbd\x76\x0c\x1c\x74\x89\x42\xb6\x51\xcf\xdf\x15\x58\x70\x0d\x61\xbc\xcd\x4b\xaf\x60\x08\x81\xfb\x8c\xe2\x61\x84\x60\xd6\x48\xda\x87\xbd\x08\x96\xff\xfd\x3f\xe7\x5b\xe7\x73\xfa\x71\x2b\x55\xd7\xe0\xf7\x31\x22\x92\xdd\x78\xb5\x84\x60\x85\xe9\x30\x76\xbf\x0e\xac\xeb\xd0\xbf\x24\x05\x7a\xb2\xfd\x24\x0c\x59\x16\x5b\x10\xf9\x66\xdf\xf7\xf0\x7a\x97\xd9\x68\xa8\x23\x2b\x8a\xfd\xe2\x43\x10\xd0\x1c\xb2\xe0\x75\x31\x86\xcb\x73\xf4\xde\x05\x07\xdf\x10\x1e\x06\x5e\xc8\x21\xd8\xaf\x3f\xeb\xfa\x73\x9c\x97\xd3\xec\x11\x34\x94\x53\x0a\x8c\xfe\x63\x5e\x6c\xb7\xfb\x25\xde\x7e\x9f\x81\x15\xe3\x41\x1e\x69\x50\x2b\x31\xe5\x5d\xac\xec\xfc\xd3\x5b\xed\xa2\x9b\x96\x17\x87\x45\x94\x8d\xff\x9d\x18\x71\xb5\xa3\x1c\xcc\xbc\x7d\x3b\x2c\xf6\xea\xb1\x55\xd5\xd9\xf2\x61\x96\xdb\xfd\x2f\x69\xd8\xeb\x83\x53\x57\xc0\x06\xd8\x57\xd9\x32\x35\x15\x18\x5b\xda\x61\xf6\xf6\x5e\x33\x15\x3b\x8a\xb1\xed\x7e\x39\xab\xa8\xb5\xdd\xe7\x98\x1a\x3f\xf3\x07\x3f\x45\xc9\xb8\xb3\x76\x9f\x62\x37\x34\x56\x1a\x8b\x0f\x7c\x18\xdf\x9f\x3f\xb7\xab\xdd\x20\xfd\xaf\x66\x8f\x46\x0b\xf7\x6e\x8a\xfc\xfb\xf6\xef\x9c\xe4\x07\x03\x84\x3a\x32\x45\xa5\x10\xb7\x17\xd9\x0d\x54\xf5\xd6\x21\xe7\x46\x64\x90\x50\x12\xe3\xc1\x92\xea\x89\xdb\xc9\xab\xd7\xfc\x7d\x13\x53\x7a\x22\xaa\x9d\xd7\xe0\x55\xd0\xf5\xb0\xb6\x1a\xb9\xe2\x66\xf1\x30\x52\xe2\x6e\x6c\x68\xe2\xa3\xe2\xdb\xbc\x7c\x18\xb6\x4e\x48\xf7\x3a\x31\x8b\x11\xdd\xdc\xd7\x45\xdb\x42\xe0\x33\xbf\x55\x63\x90\x08\xcb\xe0\xdf\xfd\xd5\x48\x75\xc8\x44\x78\xf4\xe2\x9f\x4c\x3d\xe2\x1a\xe9\xc9\x84\x7c\x9c\x61\xb0\xdc\x89\x33\xa0\x41\xb1\x7b\xbe\x8a\xc9\xa9\xa9\x79\xb6\xee\x29\x23\x6a\x78\xf1\xc9\x87\x5e\x1a\xb5\x6b\xc2\x6a\x51\x7f\xcc\x17\xf3\x1a\x13\x3d\x70\x5e\x7a\x7a\x96\x22\x08\xa4\xe4\x2a\x18\x52\x79\x71\xa9\x7b\x96\x75\x0c\x8c\x8f\xed\xf2\xc1\xd5\xbe\xb0\x95\x54\xba\xb3\x7e\xd2\xf0\x85\x5a\x22\x1b\x0b\x82\xba\x66\xa6\x9c\x28\xff\x51\xcc\x5a\xa7\x2d\x97\xbe\xf2\x00\x23\xdd\xa1\xae\x5c\x5b\x28\xd2\x56\x77\xe2\x08\xdd\x5b\xcb\x61\xab\xfc\x30\xcf\xae\xa4\x74\x70\x8b\x3e\x73\x3b\x65\x09\x3c\x01\x5a\xd1\xfa\x97\x3e\x58\xe9\xe5\xbb\x97\xf6\x89\x31\xb3\xc4\xd9\xdb\x45\x4d\xab\xc8\x25\x5d\xa4\xdc\x84\x7c\xaf\x4f\xe5\xad\x98\x77\x77\x2d\xae\xae\xb6\x0f\x2e\x5b\x15\x98\xd7\xdd\xca\x0f\x05\xad\xff\xee\x83\x02\x79\x15\xde\x1d\x94\x21\xc1\xf7\x6c\x15\x14\xd6\x48\x30\xb9\x62\xce\x4f\x95\x02\x69\x24\x6c\xc8\x1a\x3f\xfb\xcf\x29\xf8\x75\x5f\x6f\xc6\x59\x56\xc4\xa8\xc5\xdc\xc7\x24\x09\x55\xce\xc6\x17\x33\x62\xdd\x32\x9a\xd1\x19\xb6\xdc\x63\x17\xb5\x7a\x0b\x09\x7e\xf6\xe3\xb8\x90\x73\x9d\x25\xe4\x67\x44\x88\x18\xc5\x0e\x4e\x97\xc8\xe3\xd6\x70\x36\x65\xaf\x1b\xc0\x49\xc8\xc7\x17\x4f\xf7\x63\xe4\xd8\x70\x8c\xc5\x0b\x04\xae\x88\x56\x44\xaf\xdb\x57\x41\x73\xf0\xe4\xef\x2b\x03\x23\xce\x88\x73\xa4\x78\x21\x3e\xc3\x0c\xa9\xaa\x79\xaa\x90\xdd\xdb\x9c\xee\x45\x74\x76\x55\x8b\xbe\x91\x53\x2f\x81\x1d\xe5\x4f\x8a\xd1\x1a\xdc\xb8\xd0\xdf\x97\x41\x36\x8f\x0f\xd1\xeb\xa6\x30\x08\x24\x9b\x1d\xe4\xdf\xda\xc2\xff\xef\xdf\xff\xfe\xb7\xc6\x41\x84\xc4\x6e\x77\x9a\xbb\x91\x9b\x76\x6b\x89\x7b\xbb\x54\xe0\xf7\xea\x8f\x70\xd8\x25\x74\x34\x3e\xc2\x07\x50\xab\xd8\x62\x06\x8e\xb6\x2e\x83\xe8\xb3\x43\x7f\xd7\x0c\x7c\x89\xd3\x52\x9c\x11\x05\x3e\x85\xa6\x23\xa2\x61\x11\x27\x1f\x60\xc4\x8b\x77\x69\xf2\x4b\xdd\xa5\x9d\x37\xcc\xd1\x53\x21\x3c\x47\x06\xb8\x58\x59\xcc\xc1\x68\xcb\xf8\x07\x03\x24\x21\xe4\x53\xd8\xe2\x02\x29\xcb\xc8\x07\xe4\xfb\x3c\x95\x14\x42\x93\xd2\xaa\x67\xd6\x61\xb0\x7b\x53\x10\x61\x4a\x2a\x9a\x95\x4d\xa7\xfa\x28\x0f\x81\x69\xcc\x96\xd6\xc8\x0d\xdd\x10\xb0\x47\x61\x5b\x85\x05\x2f\x62\xf5\xae\x42\x8f\xfa\x86\x61\xc4\x05\x1a\x89\x6b\x15\x7a\x67\x7f\xf8\xc0\x5e\xeb\xfa\xa2\xa6\x65\x9a\xed\xd8\xd6\x76\x5c\xfe\xfb\x67\x7b\xe6\x86\x70\xc7\xa5\x6d\x05\x32\x24\x18\x5a\xe8\xa7\x21\x8d\x67\x17\x99\x81\x18\x58\x35\x98\xb1\x29\xe3\x0f\x10\x0c\x27\x86\xf1\xb2\x4f\x87\x18\xd7\x79\x4d\xd7\x01\x9f\xcb\xe4\x9f\xca\xe4\x85\x42\xca\x4a\xde\x45\x0a\x8c\x50\x6f\x2e\xeb\x98\xfb\x2f\xc5\x10\x59\x71\x58\xb9\x16\xc3\xcc\x0c\xd0\xbd\x17\xdb\x53\xc4\xff\x7f\x6e\x7f\xa2\x88\x40\xfc\xa5\xa5\xa7\x1a\xcf\x5d\x32\x73\xb9\x05\xba\xeb\xcf\x20\x3e\x24\xce\x6c\x1d\xde\xd9\x93\x5c\x6f\xb1\x2c\x9d\x71\x5b\x51\x64\x1a\xb0\x44\xb1\xbf\x34\x2b\xc1\x29\x2b\xb4\x55\xac\x6f\x86\xb6\xad\xf7\xd7\x10\x0a\x58\x0a\xee\xc4\x16\xb3\xa0\xd7\x5a\x78\x52\xb1\x3b\xcd\xec\x3e\x58\x8e\xd0\x97\x0f\x9d\xe5\x1d\xd3\x76\xab\x09\x0b\x1f\x33\xc1\x33\x3d\x0b\x4d\xd0\xb0\x5d\x64\x88\x79\x01\x99\xcd\x1c\x67\x44\x02\xd4\x4e\xa2\x95\x3d\x68\x5d\x5a\x53\xd6\x6a\xda\x86\x1c\x77\x31\x4f\x1a\x97\x4f\x91\xca\xb4\xfd\xbc\x3d\x65\x4b\x8e\xbb\x78\x99\xba\xd8\x56\x59\xd4\xcf\xbe\x95\x6f\xed\xb3\x42\x72\x6e\x31\x48\x26\xd7\xda\xf9\x6b\x7b\xc1\xc0\xdd\x5d\xe6\xe7\x56\x3e\x01\x84\x7a\x92\xd8\xe2\xb6\x26\xef\x7e\xc8\xf2\x8f\x42\x87\x0d\xea\x2f\x30\x05\x2b\xbb\xf1\xe1\x0d\xe9\x62\x18\xb4\x49\x46\xbf\x85\x2e\x5c\xcc\x03\x36\xc9\x7a\xee\xd5\x60\x36\x25\xcb\x9d\xc3\xe3\xd7\x97\xc5\x3f\xa4\x47\x9f\x55\x47\x55\x86\x9a\xa9\x71\x04\x21\xf3\x70\xfe\x65\xd1\x6c\x6d\xab\xe5\x21\x31\x15\xfa\x45\x4d\xf1\x27\x23\x3e\xbb\x09\x5f\x56\xf4\xef\x9e\x09\x18\x64\x65\xf1\x6c\x40\xcc\x76\xf0\x6f\x0d\xff\xb4\xbc\x6b\xe2\x77\x7d\x7e\x83\x30\xf2\xb8\xb5\x2b\x43\x73\x9b\x64\x68\x1b\xf1\x22\x2a\xdd\x3a\x6d\xed\xfb\xd7\xe3\x87\x76\xad\xb5\x5b\x9d\xf3\x75\x27\x96\x72\x0e\xde\xfa\x66\x95\xe1\x1f\xa2\x96\x76\xe8\x44\x55\x01\x8b\xfa\x5b\x9a\xe2\xea\xa1\xa7\xa1\xde\x9a\x87\xde\xa8\x24\x02\x24\x09\xa5\x52\x3e\x41\x5a\x2d\xab\x59\xe9\x33\xb2\xa4\xd8\x05\xd1\x96\x71\x1a\x1f\x72\x53\xb3\xbd\xab\x4b\x7d\x35\xc3\x86\xd9\x0f\x83\x1e\x1b\x65\x57\x4d\x2f\xf6\xab\x75\x23\x04\x7f\x20\xee\xe4\x14\x19\x34\xdc\xc5\xb5\x87\xe9\x25\x4e\x23\x64\x26\x50\xf6\x3e\xd0\x36\xf7\xef\x78\x82\x60\x6e\xd4\xb0\x1d\x35\xa4\x0e\x6a\xd8\x11\x0b\xe7\x93\x74\xb2\x34\x4d\xc4\xca\x6c\x40\x2d\x42\x43\xdf\x6c\x97\xbc\xaf\x9a\x31\xa2\x63\x59\x91\xc2\x6c\x0a\xcf\x89\x6c\x1f\x48\x4a\x65\x7c\xb5\x65\xb0\x96\xd8\xf5\xd8\x00\x3b\x26\x79\x59\x67\x40\xda\x7c\x55\xa6\xd9\x25\x5e\x5e\x91\x8d\xea\x13\x8b\x6b\xd8\x77\xec\x9e\x12\xfc\x72\xd4\x5d\x57\x88\x08\x4c\x43\x33\x82\x76\xb1\xb4\xce\x8a\x85\xbd\x22\xb4\x78\xf0\xc8\xa0\xb7\xfe\xd5\x61\x3d\x45\x65\x0c\x7b\x7f\xec\x2b\x55\xdd\xd9\xeb\x99\xcf\x94\x1a\x13\x0f\xb5\xd8\xe7\x63\x83\x7d\xa1\x89\x7a\x9d\xef\x17\x1f\x1a\x86\x2c\x7f\x9b\x0c\xf7\x30\xf8\xa1\x8e\x74\x2f\xe6\x4c\x4c\xd0\x35\x39\x31\x7e\x2b\x51\xbb\xd8\xd6\x81\x0b\x3f\xc9\x78\x7d\x20\xe9\xc1\x10\x94\x9c\x77\x86\xac\x0f\xba\xf1\x21\x6b\xbd\x39\x8e\xc7\x55\xbd\x44\x2d\x2d\x54\x10\xd0\xea\xab\xf1\xf7\x4c\x4d\x3b\x1a\x78\x22\xab\x39\x63\xc3\xa1\x32\xf9\x9f\xdb\x59\xd6\x8a\x16\x43\x2b\xc0\xb8\x72\x90\x75\x66\x20\xe9\xc5\xc4\x0d\x07\xd8\xcc\x60\x6a\x7e\x90\x8a\x51\x7d\x77\x84\xc5\x59\x05\x49\x00\x9c\x7a\x2f\xd9\xaf\x01\xe7\x5b\x8f\x94\x28\x27\x86\x1a\x3c\x07\xdb\x67\x65\x8e\x55\x64\x67\x70\xb8\xb4\x45\xf4\xb6\x4e\x5a\xb3\xb2\xd4\x33\xc5\x47\x0a\x5e\x64\xd0\x4e\x59\x83\x44\x23\x2b\x86\x91\x63\x9a\x01\x72\x29\x33\x39\x89\x9a\xc1\xbf\x50\x8c\x64\xa0\xd4\xed\x81\xb4\x37\x54\x78\x06\xd6\x8d\x41\xd0\x37\xd4\x80\x80\x44\xcd\x89\xaa\xc7\x48\x89\x9b\x2d\x6a\xf2\xfe\x71\x9f\x9b\x6f\x95\xce\x4a\xe0\x20\xf3\xc9\x53\xcb\x8d\x56\x1a\xbf\xc3\x20\xf1\xd1\xa5\x66\x16\x57\x4c\x78\x1c\x35\x0b\xb3\x2d\xb9\x0f\x28\x82\x68\x7c\x53\x3f\xad\xc1\x7f\x2f\x75\xc3\x78\x3e\xbd\xd1\x36\x7b\x4c\x1f\x26\x72\x97\xa2\xc1\x6d\x56\xbc\x90\x12\x59\xb3\xf4\x42\xfa\xab\xa0\xd6\xc7\x76\x17\x71\x5e\x89\x12\xba\x7d\x40\x04\x5a\xd1\xb9\x4d\x41\x1e\xb0\x4d\x4f\x33\x37\xea\x6f\xc5\x7e\xa7\x1b\x78\x14\x23\x00\xea\x3e\x48\x3a\xea\x5f\xe9\xd5\xdd\x2d\x04\xee\xcd\x27\xe2\xa3\x0e\x46\x0c\x44\xee\xe7\xdd\xc5\xad\x4c\x3c\x14\x24\x3c\xb4\xdc\x3f\x2b\xbd\xb6\x82\x35\x14\x86\xd3\xcc\xe6\x1a\x1a\x9a\xed\x73\x65\x38\xf1\x61\x27\xf2\x9e\x4f\x75\x14\xb7\xde\x85\x38\xc9\x61\x3a\x18\xe9\x01\xf2\x28\x06\x0f\xd3\xbe\x74\xbb\x2c\xbf\x2c\x02\x08\xcf\xba\xe1\x86\x6e\x0f\xbe\x6f\x9e\xe8\xe1\x95\x52\x3c\xbd\x80\xf1\x8a\x89\x3f\xec\x78\x53\x5d\xb6\xf4\x31\xd1\xee\xd6\x7f\x13\x5c\x0c\xea\x2a\xfe\x18\xa3\x24\xba\x0b\x50\x3c\x03\x5c\x25\xa0\x12\xc2\x4b\xd2\x5c\xd3\xc6\x4d\x48\xca\x4f\x81\xd6\x19\x70\x3a\x91\xad\x27\x20\xec\x2c\x85\xce\x85\xc6\xc5\x1e\x62\x0d\x05\x33\x77\x41\x9e\x60\x16\x61\x8a\x84\x4f\xc3\xb8\x5c\x0b\x3e\x57\x95\xb1\x2f\x56\x4a\xf5\x7d\x0e\x93\xae\x4e\x77\x53\x68\xfd\x76\x86\xe7\x9d\x83\xdc\x6c\x5a\xdd\xfe\xa4\x81\xf2\x9f\x72\x23\x01\xe8\x43\xca\x5a\xc1\xa6\xbc\xc8\x32\xf3\xa9\x94\x25\xdc\x89\x6b\xd9\xe7\x99\xfd\xe0\xd5\xd8\x65\xd7\x33\x23\xd7\x5d\x86\xd6\x70\x33\x77\x9d\x0c\x28\x50\xdc\x34\xd3\xbd\xc4\xa7\xad\x33\xf4\xd8\xf3\x3a\x9f\x02\xab\xea\x55\x16\x35\x8e\x4f\x79\x69\xb4\x51\x1c\xd5\x7f\xfa\xcf\x2d\xf7\xea\xb4\xcd\x56\x69\x44\x54\xd7\xf2\x0f\x06\x5d\x0b\x1d\x0d\xfb\x39\x92\xad\xbb\xf9\xce\x8f\xdd\x8a\xfc\xe4\xbc\xa2\x24\xc2\xe4\x39\x6d\x5f\xec\xa1\xfc\xb9\x76\x8a\x06\x6e\xc9\x20\x3f\x1d\xa4\x3f\x9d\x7b\xac\xe6\x75\x6e\x07\xcf\x47\x69\xf5\x06\x5d\x9d\xcb\xa3\xa9\xde\xf7\x56\x9d\x34\x42\xf9\x2b\x6b\x7a\x62\xf0\xb3\xf4\x88\x97\x8b\x2b\x0d\x54\x66\x56\xff\xb9\xa5\x63\x55\x5f\xe7\x6f\x06\xbe\xeb\x48\xd6\xa0\x4b\x2d\xef\x96\xdb\x67\x73\x91\xe3\x2a\xa6\xa1\x0a\xfa\x07\x87\x8e\x86\xa7\x44\x0e\x58\x78\x37\x1c\xda\x81\x79\xed\xda\x18\xb4\x2f\xba\x31\x0b\xad\x62\x61\x35\x0a\xaa\x02\x07\x03\x6b\x97\xa6\x68\x9e\xee\xb2\x1c\xf7\xe0\x42\x2c\x0f\xaf\xa2\x3f\xa1\x7f\xd2\xbe\x07\x45\x22\xca\x87\x84\xa6\x0a\x3d\x79\xcb\x05\x48\xc6\xdc\x1a\xc9\x09\x15\x4d\x47\x46\x45\x80\x99\x83\x87\xb2\x38\x24\xa9\xa3\x59\x80\x84\x05\xd1\xb6\xc9\x44\xf7\x41\xd1\x40\xef\xce\xec\xc9\xe5\xc2\xde\x45\x24\x49\x85\x2f\x05\xf3\xe2\x02\xf9\xdc\x63\xcf\xa5\x31\x60\x05\x47\x5f\x71\xf5\x62\xe1\x4d\x7e\x1f\x48\xff\x2b\x66\x95\x8b\xa8\x3e\xa4\x1b\x82\xf2\x8c\xcb\x49\xec\xb1\x70\xf9\x7d\xdb\xd4\x21\xf9\xae\x2f\x3d\xd0\x7b\xd9\x62\x12\x28\xda\x19\xb2\x5d\x62\x33\x3b\x6f\xbd\x56\x9d\x8a\x6c\x92\x56\x2f\xdf\x45\xcc\x10\x06\xbc\x77\xd1\x37\x68\xa3\x15\xf7\x27\x6b\xab\xa6\x22\x8b\x2b\x1f\x54\x5e\x6a\xd4\x84\x29\x0d\x1b\x4b\xed\x8f\x52\x6c\x76\xc7\x6c\x5f\xef\x7b\x3c\x19\x50\xe1\x94\xa9\x29\xb3\x0c\x25\xb2\x6f\xd8\x52\xfa\x9c\xd6\xdb\x22\xfb\xf6\x9a\xa9\xee\x01\xca\xf6\x5a\x9f\xd6\x62\xfa\x77\x5f\x48\x1c\x51\xf0\xbe\x0f\x55\x3a\x3d\x7e\x2d\x86\x2f\xaa\xd4\x49\x2a\xe1\xf7\x81\x66\xc5\x2d\x42\x38\xdd\x70\x3f\xa7\x92\x03\x32\x86\x5f\x92\x12\x80\x48\x1d\x78\xd2\x59\x3e\x8b\xec\x3b\xbe\x4e\x77\x12\x6f\xe5\x0a\x90\x97\x75\x0e\xfc\xd6\x70\x6b\x6b\x7d\x41\x65\x9f\xbf\xf8\x4f\xf6\x50\xf6\xa6\x99\x8b\xa6\x92\x8e\xdc\x79\x9a\xbb\x44\x16\xdf\xe6\x76\x7f\x61\xa6\xef\x26\xcb\xaf\x5a\xfa\x5e\xad\x4f\x41\x24\xa4\x8a\x97\xbb\xe4\x48\xaa\xa8\xef\x3e\x46\x3a\x47\xcf\x7c\x00\x46\x4e\x92\xbe\x51\x23\xb9\x96\x76\xb7\x6c\x6d\x6c\xd3\xe2\x7d\x79\x05\xdd\x48\x17\x2f\xe4\xc6\x11\x2b\x9c\xda\x47\x03\x4d\x5a\x64\x17\xba\x3b\x05\xdc\xd7\x95\x4e\xaf\x90\xff\xbd\x37\x89\x2b\x02\x63\x28\xa2\x1b\x43\x60\x6a\x76\x1c\x6d\x51\x38\xfb\xe5\x78\x44\xb7\x93\xb0\xcb\xd9\xe8\xd1\x95\xcd\x0d\x7b\xc5\x2f\xab\x46\x8a\x45\x8b\x18\x41\x89\xe6\x7e\x1a\x78\xf1\x36\x37\x9a\xf4\x65\xe2\x8e\x4e\xf2\x34\x0c\x2d\xfa\x2b\xda\xbc\x28\xd0\xf8\x22\x91\xd6\xd5\x22\x53\x91\xbf\x53\xbb\xc6\xfe\xca\x41\xe2\xee\xf7\x0e\x30\xf0\x02\xaa\x6b\x3b\xc2\xc5\x03\x76\x62\xce\xb0\x2f\x37\x9a\xf3\x9a\xc8\x99\xa0\x0b\x8e\x55\x49\x44\x2e\x6c\xb6\x36\xdd\xe8\x94\xff\x7e\xea\x15\xf9\xbd\xe2\xad\x1a\x77\x2b\x63\xa5\xec\x57\xab\xe0\xb9\x81\x57\xcb\xa7\x88\xc9\xb9\xc6\x95\x18\xf1\x18\x98\x99\x56\x5c\x6c\xee\x96\xdf\xd7\x83\xce\xfd\x82\x1d\xe3\x42\xe1\x81\xdd\x6a\xea\x1a\x53\xf6\xe8\xb3\x7a\x45\x5b\xe2\xd7\x91\x4c\x9b\xe2\x70\x9a\x01\x0a\xeb\xc4\xcc\xba\xdc\xd8\xde\x8f\xa2\xd1\xd2\x61\x58\x76\x8d\xa8\x52\xa0\xa2\x1e\xdc\x60\x10\x0e\xa6\x3b\x50\xfc\x2e\xe2\x4c\x47\x6b\x99\x56\x42\xab\xff\xe4\xd0\x5c\xa1\x5d\x1d\x0b\x57\x4f\xfa\x06\xa5\x97\x46\xf2\xc4\x3f\x99\x59\xbb\x30\x0e\x0d\x5e\x75\xd8\x0e\xe5\x10\x95\xf0\x64\xf5\xda\x58\x60\x56\x52\x6e\x99\x46\x5f\x95\xe6\xbf\x13\xd7\xd9\x3a\x5a\x3f\x03\x9b\x77\x7e\xf5\x00\xc9\xb0\x84\x73\x3c\xc0\x98\x96\x45\xff\xd0\xe5\x15\x72\x70\x5d\x86\x96\x2f\xff\xf7\xbf\xaf\x70\x11\x65\x6a\x4d\x61\xa9\xf5\x70\x35\x5b\xee\x43\x37\x8a\xec\xf0\xbf\x2d\xd4\x4b\xbc\xd0\x75\x56\xa2\xff\x04\x32\x4e\xca\x22\x41\x14\x89\xdf\x90\xcd\x8b\xb6\x61\xff\x93\xd5\x2e\x23\x49\xe0\xee\xe5\x03\x08\x9e\x52\x8f\x95\xf1\xf6\x84\x17\x0f\x2f\x9e\x49\x3b\x21\x18\x8a\x99\x6c\xd2\xa4\x10\x6f\xbd\x13\xb2\x08\x40\x64\x0a\x69\xfe\x93\xe9\xc1\xdd\xb8\x0b\x53\x9f\x8f\xe5\xe5\xc7\xd1\x2b\x5f\x84\x89\x1d\x1e\x45\xfa\x16\x7d\xcc\x33\x3a\xa9\x5f\x5d\x0d\x46\xbc\x98\x7c\x08\x7a\x64\x10\xb7\xd6\xba\x31\xa2\x7c\x6b\xdf\x8c\x40\x2e\x36\x18\x86\xc0\x56\x90\x3d\x20\xee\x2b\xbb\x4f\xb2\xee\xae\x10\x98\x1d\x6c\x8a\x61\xf1\xf0\x92\x34\x6f\xd7\x8a\x94\x94\xf9\x8b\x79\x05\x68\x63\x51\xfd\xd1\x1a\x06\xe5\x18\x8c\x8c\xd2\x3c\x94\x66\x7e\xe9\x74\xe5\x8d\xa1\xd8\x3c\x9a\x9e\xde\x4d\x5e\x1f\xad\x6f\x68\x72\xf4\x43\x75\x5e\x78\x99\xbe\x78\x97\xba\x15\x68\x0b\x85\xc5\x0d\xf7\x4f\x8d\x0e\xb7\x6e\xb7\xa3\x71\x14\xdb\xc8\x5a\x1f\x7b\x44\xa0\xc6\x26\xff\x2d\xa6\x7b\x51\x84\x14\x9d\xc1\x33\xca\xcc\xe1\xdd\xad\xf6\x7d\x97\x56\xdc\x11\xb7\x90\x81\xfb\xed\x0d\x8e\xba\x80\xc8\x3f\x34\x28\x05\xa0\x48\xf6\xa7\xcf\xe1\xbe\x80\xf3\x71\x06\x1d\x81\x94\x04\x4d\xa8\xdd\xf0\xe0\x60\x66\xf8\x03\x8b\xb8\xc6\x45\x38\xf0\x4e\x2b\x05\x54\xaf\x38\x54\x9f\x7a\x04\x89\xc9\x6b\x92\xaa\x2c\x62\x29\x34\xd0\xb2\x07\xbf\x7b\x74\x76\xb7\xfe\xd5\x3d\x18\x56\x35\xd2\x4c\xae\xe3\xfd\xc9\x45\x71\x5b\xd1\x56\xf0\xba\xfa\x25\x69\xce\x57\x1f\xa4\x18\xea\xa4\x26\xa4\xaa\x9f\xda\x7e\xc8\xbb\xc6\x7c\xbd\xf0\x80\x0e\x66\xd4\xef\xeb\xa5\xed\x38\x1f\x5d\xb3\x83\xff\xc9\xd6\x37\x78\xa5\x7f\xbd\x06\x8e\x26\x48\x8f\x2b\xa4\x34\x7b\x4d\x51\x59\xc4\xe5\x68\xc0\x30\x7a\xff\xc6\xfa\x1a\x69\x43\xfc\x3d\xe3\xbe\x9b\x38\x5c\x60\xa5\x8d\xbb\xf7\x92\x0f\x9f\xeb\x4b\x27\x8d\x8c\xef\xa3\x92\x45\xdd\x4a\x79\xb0\x76\xce\x88\x72\x1c\xe4\xd9\x3a\xb9\xca\x83\x5f\x3b\x81\xec\xf7\xed\x0a\x55\xb3\xc4\x7a\xa1\xaf\xbb\xc1\xc1\xf9\xfe\x6d\xd5\xb0\x21\x84\xd6\xb4\xab\xe7\x88\x0b\xd9\x22\xe1\x85\x87\xa5\x27\xf3\x18\x02\x50\xef\x06\x4e\x84\xde\xaa\x0b\xe9\xf9\x9b\xcd\x83\x79\xb0\xea\x52\x6a\xaa\x6d\x3c\xa6\xce\xdd\xca\x8d\x12\x2f\x8a\x83\x23\x91\xac\x42\xdc\x15\x96\xa2\x7a\xe0\xd9\x0d\x12\x9b\x55\xea\x9b\x94\xc4\x64\xa9\x3a\x53\xfd\xda\x97\xea\xc3\x38\x8a\xa9\x6a\xed\x17\xb0\xf6\x05\xcb\x09\xf1\x1a\x61\x12\xd8\x66\x93\x08\x25\x04\xd9\x19\xeb\xb1\xba\x37\xe1\xbd\x4c\x5c\x8d\xc4\xcf\xcf\x4b\x4b\xf1\xce\x53\x53\x61\x4d\xf3\x2d\x32\x55\xf7\x02\x3a\xc6\xba\x4b\xba\x4a\xb0\x67\x03\x39\x73\x9f\xd4\x43\xac\x94\x7f\xd3\x43\x9f\x94\xda\x0d\xc1\x50\xfa\x8e\x3a\x36\xd0\xef\xf6\x83\x78\x3d\x7d\x17\xa1\x48\x28\x92\xb7\x82\xc2\xc1\xb2\xef\x63\x21\x3a\xb3\x58\xd6\xd4\x40\xe3\x77\xbd\x53\x90\x46\x5a\xd6\x99\xaa\x72\xed\xf5\xf1\x5d\x92\x95\x92\x1e\xe9\x53\x5c\xb5\x2f\x0d\xf5\xd1\x87\xbe\x01\x22\x98\xa4\x56\x96\x07\x25\xba\x51\x83\x2c\xc8\xa5\xad\x49\x86\xc2\x74\xd7\xda\xd7\x6a\x78\xb0\x4a\xf9\xe2\x97\x16\xda\x1b\x75\xa4\x5c\xc4\xf6\x39\x80\xc6\xcb\x08\xfd\xca\xe5\x09\xac\x43\x73\x11\x02\xde\xb5\xaa\x1a\x17\x54\xfe\x3e\x9c\x43\x2f\x1a\x17\x8d\x8d\x81\x52\x6c\x17\x88\xc5\x16\x3a\x16\x66\x93\x00\x94\xe0\x04\x08\x9d\x45\x78\x7a\x31\x0f\x96\x87\x7c\x07\xc1\x7f\xf1\x38\xf2\x4e\x96\x3f\xf3\xfa\x4b\x1a\xa2\xbc\x99\xbd\xf1\x5b\xc5\xb5\x35\x5a\xe3\x08\x12\x4c\xc1\x9b\x87\x16\xdd\x1f\x98\x57\x84\x60\x2f\x2b\xbb\x88\x38\x1f\xf1\x16\x09\x4d\x23\x65\x9b\x1a\xc5\xbc\x47\xc4\x6e\xd4\xf2\x53\xbf\xb1\xe5\x24\x19\x5c\xa0\x90\x82\xac\xd2\x53\xb6\x15\x90\x4f\x6a\xe7\xf0\x6a\xf9\x18\xa5\xea\xd2\x7d\x4e\xd4\xb7\x78\x0d\xbe\x78\xd9\x6b\x4e\xa8\x16\xaa\x20\x3b\xfb\x1c\xd5\x0c\x66\x0b\x06\xd3\x07\x5b\xe3\x95\x5a\x0d\xba\x53\xf7\x10\xd7\x77\x76\xec\x76\x41\x56\x75\x32\x7e\xcd\x4a\x8f\x29\x66\x63\x2e\x03\xaf\x6b\x4e\x10\xaa\x10\xdd\x45\x00\x9f\x59\xd3\xe1\xcb\x89\x9e\x02\x8a\x6c\xc5\x97\x00\x2f\x7e\x57\x79\xf9\x04\x4f\x9c\xf6\x76\xe8\x96\x99\x03\x75\x9c\x83\x5f\x19\x6c\x55\x8a\xa8\xfd\xe9\x4f\x78\xfd\x17\x89\x14\x84\x72\xab\x4c\x06\x98\x71\x97\x2d\x2c\x74\x4f\x11\x3b\x89\xbc\x36\x8e\x7f\x13\xbc\xdd\x28\xcb\x6a\x19\xcd\x2f\xcc\xcc\x0b\x80\x31\xe7\x02\xc6\x51\xf5\xcb\xc0\x8b\x37\xdb\xcb\x33\xdd\x6e\x5b\x62\xb1\x80\x0f\x72\x5f\xc5\xec\xe0\x05\xdd\xf1\x2b\xeb\x8e\x6a\xfd\xdd\xa5\x1c\x1e\x55\x3d\xea\xb6\x93\x58\xdb\xe9\x92\x99\x69\xf1\x21\x68\x42\xc9\x4c\xa1\xee\xad\x91\xee\x62\x7c\x9e\x66\x2b\xf7\x98\x3e\x0d\xab\xce\x61\x92\x50\xb0\x6d\x90\xd8\xe2\x29\xc7\x90\xb5\xf3\x7e\xc8\x31\x82\x11\x41\xcc\xaa\x7e\xf9\xa2\x67\xd5\xea\xbd\xf2\x89\x2f\x11\xd2\x8f\xa3\xae\x5e\x16\x0f\xc3\x2a\x0f\x8a\x71\x5b\x2b\x51\x7d\x6d\xaf\x4f\x2e\x74\x25\xd7\xfb\xa7\xb8\x81\x8f\x6e\x47\x72\x3c\xf7\x45\x67\x26\x9c\xbd\x63\x54\x39\x30\x81\x5f\x20\x52\x2a\x2e\x9a\x90\x1b\xee\xf6\xc3\xa7\x1f\x9d\xd5\x4f\x68\xf2\x8d\x80\x8d\xa2\x0f\xce\xe8\x3e\x77\x17\x35\x38\x89\xd6\xfc\x76\x1c\xb5\x08\xd9\xf6\x7a\x9d\x8e\x84\x60\xbc\x76\x69\x5b\xbc\x77\xf4\x11\x42\x17\xa1\xe1\xab\x0c\x24\xac\xa7\xab\xe1\x36\xe4\xb6\xf8\xb7\x4a\x89\x25\xd3\xf5\xd2\x64\xf0\x0f\x93\x87\x98\xfb\xc8\x7f\x50\x68\xa8\x10\xfb\x9b\x39\x8e\x79\xa0\x55\xa6\x39\x57\x1d\x39\x42\x79\x1a\x42\x29\xfe\xae\xc2\xa0\xae\x45\xf5\xd8\x63\xc8\x14\xd4\x84\x74\x47\x97\xe9\x0f\x5e\xdc\x69\x0e\x21\xac\xdf\x2a\xcb\x73\x8b\x56\x5a\x83\x4f\xa0\x08\x4c\x12\xba\x31\x1c\xb0\xbd\x7f\xae\x39\x87\xd9\xae\x10\x52\x97\x67\xd2\xde\x09\xd5\x15\xc1\x09\x18\xda\x50\x6c\x50\xa9\xa5\xb1\xc5\x09\xdb\x5e\xd9\xfe\xbe\xb2\x3d\xb9\xae\xe8\xbe\xdc\x73\xa9\xf7\x8f\xbc\xc2\x68\x96\x17\x31\x75\x17\x71\xd6\x0f\x11\x87\xb3\x65\x29\x9d\x74\xf5\x6e\xe6\xfb\x1e\xad\xaa\xc3\x0f\x10\xe1\x23\x26\x2b\x13\x24\xae\xba\x87\x11\x0d\x99\x4f\x32\x40\x87\xbe\x36\x34\x01\x2b\xdc\xa3\xd1\xa6\x38\xd3\x4a\xe6\x99\x6c\xd7\x96\x02\x1c\x23\x2f\x3d\x66\xae\x8b\x26\x00\x44\x7f\xf3\xe8\x52\x11\xe0\x07\x92\x90\x5f\xd9\x75\x20\x5f\x7b\x4c\x82\x70\x2a\x36\xcb\xb2\x92\xfb\x81\x99\x5b\xf1\x07\xc3\xe4\xef\xda\x17\xfe\x45\xaf\xbe\x81\x5d\xd0\x14\xc4\x64\x9b\x76\xab\x81\xff\xde\xb4\x78\x8b\x67\xe1\xd1\xca\x9d\xa5\x96\x77\xc3\x9d\x58\x36\xf3\xb7\x9a\x65\x58\xbf\xde\xf7\x0a\xbd\x25\xf7\xd0\xaa\xd1\x5f\xfc\xa6\xc0\xc9\xd1\xa2\x87\x20\x65\xd9\x7d\x93\x2c\x51\x01\x7d\x2a\xf0\xab\x6e\x26\xf4\x21\xa0\xf4\x10\xa7\xa7\x63\x05\xe3\x0d\x33\xf3\x1c\x55\x23\x46\x61\x7a\x2b\x47\x89\x7d\x73\xb9\x27\xbb\x2b\x39\x0f\x5a\x94\xa2\x2c\xdc\x91\xb3\xc4\xe7\xa6\xe2\x3c\xf9\x4f\xa3\xda\xba\x4a\xfb\xc0\x39\x40\xdd\xe7\xe8\x90\x19\x67\x5e\x67\x0e\x83\x5e\x6f\x87\x20\x68\x37\xb1\xd5\x0c\xa5\xc9\xbd\x24\x3d\x64\x41\xc5\xa9\x91\xb5\xb7\xed\x92\xc3\xaa\xc5\xde\xcb\xfc\x1d\x47\x0f\x12\xed\xce\xdd\xf8\xe7\x9e\xaa\x81\x6d\x11\xf8\x18\x28\x5d\x4a\x20\x81\xa2\x24\x5c\x77\xbf\x66\x71\x8e\xb5\xd2\x36\x45\x50\xa8\x6d\xb4\x21\x07\x36\x30\x5c\x1e\x98\x41\xdb\x82\x29\xa6\x41\xb1\xad\xcc\x1c\x30\xf1\x2f\x64\xf9\xe9\x2d\x24\x8d\x97\xe8\xbf\xff\x14\x6b\x61\x00\xba\x2a\x99\x9b\x99\x43\x6d\x58\x40\x5a\x1b\x60\x44\x02\x8d\xce\x0d\x44\x44\xef\x76\x81\x58\x14\x4a\x6e\xf4\xdd\x2b\xd8\xb3\x3c\xb1\x45\x57\x3f\xd2\x53\x42\x35\xd6\xad\x61\xa2\x7a\x3f\x4e\x7c\xc6\x48\x86\x34\x77\x76\x29\x9e\x50\xd7\x47\x26\x13\x7c\x24\xa7\x77\x5b\x4f\xc0\xae\xbc\xf9\x39\xa1\x5a\xfa\xf4\x41\x85\x99\xed\xe0\x43\x1d\xbf\x9e\x67\xa7\xbf\x5f\x6c\xcb\xfd\xee\x80\x7e\xa3\x51\x54\x73\x33\x5c\xa3\x6e\x79\x4c\x77\x51\xe6\x21\xce\x69\xec\xc7\x93\x2c\x48\x4e\x6c\x5d\x45\x17\xb7\xc3\x03\x4a\xb8\xfe\xb5\xbd\x86\xed\xd0\xea\x75\x84\xc4\x59\xb7\xa2\x69\x66\xd7\x55\x97\xea\x00\xc8\xad\x24\xc0\x15\x73\xfc\x8a\x2b\xad\x8d\xfd\x7c\x1f\x40\xad\x67\xcb\x85\xa3\xb7\xa2\xa5\x4d\x7d\x3c\x79\xb4\xf7\x4e\x25\xa6\xb7\x6d\xbd\xb8\x8d\xa7\xfb\x6d\x7e\x42\xe1\x20\x6e\x57\x1c\x55\x6b\xf7\x32\xe4\xd8\xda\x61\xa4\x71\x6d\x49\xc6\xa1\xf0\xf8\xd0\x69\x5d\x9e\x38\x93\x8a\x45\x85\x43\x62\x34\x64\xff\x41\x76\xc9\x30\xa9\x2b\x92\x53\x58\xac\x94\xc1\x31\xc8\x5e\xee\xae\x51\x7f\xa9\xa5\xa9\x7f\x81\x95\x0e\x69\x3a\xa2\x9a\x90\x19\x9f\xd7\x26\xad\x95\x2e\xf7\x70\x7a\x47\xd0\xa0\x2f\x10\x40\x00\xe5\x89\x55\xde\x75\x71\xf6\xaa\xab\x3d\x36\x1a\xf7\x47\x5b\xed\x4c\xc4\x88\x66\xdf\xfe\xc9\xdc\xb3\x01\x6d\xf3\x16\xf5\xde\x1b\xab\x8d\xf7\x75\x8c\xe4\x6f\x23\xa0\xe0\x31\x48\xac\xaa\x10\x40\xd9\xe5\xef\xd5\x47\x18\x51\x54\xbc\x0e\x2c\x1f\x7a\xfa\x4e\x5a\x85\x6c\xa4\x1f\x51\xc0\x0f\x1f\xbc\x4b\xbe\x1b\xb6\x67\x74\xbc\xad\x33\xde\xe9\x22\xbd\x14\xb5\x2d\xa0\xbd\x0a\x30\x34\x2a\x18\x4e\xa9\xf5\x24\xef\xe0\xba\x57\x0d\x26\xf5\xd8\xc6\xe1\x7e\x2b\xbf\xa8\x45\xda\x87\x2b\xdb\x9c\x0b\x16\xb1\xc1\xdf\x1f\xe4\xd6\x83\x00\xb0\xf0\x99\xf5\x07\x1e\x78\x59\x4f\x29\x97\xe3\x1a\xe6\x74\x6c\x5f\x2e\xb0\x1b\xe9\x60\x57\xa7\x96\xb8\x2e\x1e\xb3\xb5\xdd\x1d\xae\x73\xfd\xdd\x9a\x64\x34\x92\x25\x17\xa3\x16\x85\x46\x39\x05\xac\xcf\xd3\xf7\xa3\xe9\x52\x95\x2d\x7f\x20\xbf\x2e\xb0\xa3\x73\x64\xb4\x8c\x9a\x5f\x16\x29\x3c\x13\xe8\x3b\x08\x48\x4e\x3b\x52\x55\x1d\x64\xdf\x6f\x25\x20\x32\xb4\xeb\x7c\x1f\x16\x60\x06\x94\x8d\x2a\x69\xaf\xf4\xc7\x5d\x15\x9e\x69\x57\x63\xf5\xbd\x5d\x23\x5a\x3a\xf6\x67\xe7\x9b\xb3\xee\xde\xeb\x8f\x7e\xeb\x8a\x5c\x37\x70\x0f\x72\xd3\x66\x26\xa7\x73\xf3\x98\xc3\x56\xd9\x34\xc6\x78\x95\x00\xa8\xc7\x10\xb6\x91\xb0\x98\x0b\x95\x11\x98\x49\xd6\xb7\xac\x83\xa7\x68\xed\xb3\x92\x39\x16\x7a\x6b\xe0\xc8\x39\xe3\x45\xa8\x9e\xb8\x11\x17\x9a\x6b\x7c\x9b\x59\x28\x05\xec\x7d\x9f\xc2\xaf\x53\x25\xe0\x94\x5a\xf1\x39\xb8\xab\x4f\x56\x81\x09\x81\xb3\x5b\x94\x08\xa9\x93\x65\xef\xca\xae\x67\x45\x7d\xf8\xe0\xda\x4d\x13\x6d\xad\x81\x46\xde\x37\x1a\xd2\xc5\x23\x44\x87\xa9\xe2\x21\xae\x7e\xd3\xa2\xb0\xe5\xe6\xe7\xf6\x52\xcb\x16\xed\xfe\x7f\x96\xb9\xf6\x3f\xea\x01\xa9\x46\x80\xa2\xdf\x1a\x34\x14\x7e\x7e\x53\x18\xd8\x89\xc2\x01\xe6\xa9\x5a\x06\xde\xb7\x91\xfe\x90\x11\x3e\x87\xc7\xc1\x2f\x7b\xef\x78\x5e\xf3\xfc\x81\xbe\xb6\x8b\xd8\xeb\xa7\x91\xcc\xdc\x0c\xb5\xd8\x99\xb9\x8b\x3e\x3b\xe0\x7c\x1e\x8b\xe4\x6b\x58\x94\x9b\x82\x9d\x42\x2f\xa7\x8c\xdd\x3d\xf7\x18\x1e\x60\x41\xd0\xf8\x63\x97\x9f\x2a\x57\x1d\x7a\x0a\xf3\xb3\x68\x59\xf8\x9d\x81\x73\x2d\xba\x1a\xd3\xd1\x83\xf7\x70\x32\xfd\x0f\xbb\xf6\xb3\x68\x33\x6e\x0f\x71\xc0\xa8\x7f\xf7\x0a\xdc\x35\x05\x00\xe9\x55\xcc\xc3\x59\x44\x96\xcd\x80\x6a\x22\x0e\x7b\x84\x73\x32\xee\x73\x80\x31\xea\xd3\x67\x33\x47\x1a\x91\xe1\x54\xf5\x53\xeb\x18\xe0\xdf\xd7\xa9\x0f\x7e\x0d\x0d\x42\x61\x8f\x77\xba\x6a\x0b\x7e\xc0\x91\x51\x8c\x9c\xa0\x32\x9d\x19\x77\x1d\x03\x2f\x0d\x84\x25\xca\x77\x35\x9b\x57\xa1\x8a\x67\xa0\xa3\x8f\x64\x63\xa3\x13\x18\x99\x11\xc1\x3a\x92\x04\x33\x8b\xb2\xf9\xaf\xe3\x14\x18\xe1\x6c\xbd\x44\x5b\x97\x0f\xb2\x5a\xdf\x40\x55\x0b\x22\xdd\xb6\xd6\xa6\x03\x97\xc0\x0f\x0b\xd2\x43\x12\x10\xb6\x60\xbf\xcc\x7d\x91\x51\xef\x84\x7e\x31\xa6\x32\xd9\xe8\x6e\xf3\xb8\x98\xc1\xbf\x48\xab\x75\xdf\x69\xf3\xe7\x0b\x2c\xc6\x57\xb9\x91\xda\x4c\x39\xa8\xfd\x4e\x74\xfe\xb5\x5a\x58\xea\x3a\xa6\xd0\xdc\xe2\xba\x15\x13\x7d\xc0\xfb\x0a\x87\xbf\x75\x87\xc3\x21\xf3\x7c\x85\xf1\xe1\x2e\x3d\x7a\x08\xc8\xb4\x32\x2d\x87\xca\xdf\x45\x9e\xf8\x3e\x44\xc0\xae\x1b\xdd\x79\x35\xbf\x2a\x70\x2f\x85\x8a\x72\xd9\x58\x16\xa8\x5c\x81\x81\x7c\x20\x44\x90\x7e\x58\xdc\x36\x20\xfc\x17\xb6\x6e\xcd\x3f\xe7\x31\x3b\xd5\x12\xae\x22\x3d\x3b\x69\x1d\xb0\x90\x58\xdf\xf3\x10\xbc\xd7\x92\xe7\xd2\xeb\x4e\x18\xe5\x7f\x07\xda\x0d\x54\x6e\xa7\x6c\x65\x37\xa0\x5d\xef\x56\x7f\x12\x8c\xa6\x79\x01\x68\x8c\x0c\x05\xa7\x07\x78\x87\xc0\x1a\x20\xb8\xce\x5b\xcb\x51\x06\x95\x37\xb1\x9c\xc2\x0c\x6f\x63\xe7\xae\x6e\xa5\x8b\xd6\x27\x39\x47\xf7\x04\x6f\x94\x4d\xdd\x4a\x21\xbd\xbb\xd5\xea\xd5\xd3\x20\x31\xdd\x3c\x78\xae\xd7\x57\x94\x1d\xf5\x93\x7d\x4a\xe3\x86\x58\x57\x6c\xcd\x98\xb8\xf5\x08\x1d\x69\xdf\xa2\x5f\xf8\x5b\x1f\x3b\xcb\x4a\x24\x92\x27\x06\x9b\x11\xf7\x01\x61\x4c\x32\xc4\x9b\x2e\xd8\x59\x80\xef\x48\x38\xfd\xec\xab\x24\x24\xb6\x93\x3d\xbb\xc2\xd6\x04\x7f\x7d\x37\xe4\x98\xc2\xfb\x05\x02\x5e\xa5\x28\x8d\x51\xd6\x55\x54\xba\xaf\x8a\x0a\x30\xe2\x2b\x5a\x03\x1e\x3c\x49\xed\x56\x2e\x1a\x5d\x14\xbf\xb4\x73\x1c\xb9\x47\xa3\xd5\x5a\x78\x6b\xd1\xcc\xa2\x75\x37\x2b\x1c\x08\xf1\x0f\x46\x8c\xd2\xd0\x72\x66\x5e\x70\x4e\xb1\x4a\x01\xfa\x6b\x33\x44\x5a\xca\xce\x45\x45\x85\xf0\x6e\xb9\xf2\x95\xe1\x03\xf5\x76\x0b\x70\xa6\xde\xb6\x4c\xe8\x06\xc5\x83\xf8\x9f\x81\x47\x2c\x62\x2e\x0f\xc3\x86\x00\x47\x79\xb8\x73\x6f\x66\x12\xd0\x27\xef\x65\x3b\x1e\x40\x76\x1f\x8b\x56\x31\xd0\x54\x7b\x51\x98\x8f\x97\xd8\x61\x04\x6f\xc8\xd5\x42\x33\xb9\x06\xde\x3e\x56\x2b\x3c\x52\x29\x60\xfa\x9b\xda\x21\xc8\xa4\xda\x9c\x39\x57\xee\xcf\xef\x8b\x69\x5a\x11\xae\xd7\xc7\x0f\x84\xbc\x70\x71\xf3\xff\x47\xd7\xbb\x2d\xc9\x8a\x23\x5b\x80\xff\x52\x36\x76\xde\xd8\x5d\x75\xba\xa6\xa6\xfb\x73\x1c\xe1\x80\x32\x84\xc4\x76\x49\x41\x46\x7e\xfd\x18\x12\x01\xf8\x52\x9c\xa7\x36\xcb\xde\x95\x19\x01\x92\x5f\xd7\x85\xa5\x15\x8b\x7f\x06\x34\xc4\x10\x94\xeb\x8c\x29\xaf\xfc\x41\xd0\xa5\x17\xf2\x80\x7f\x0d\x39\x95\x51\x12\xd2\x1d\xf7\x5e\xfe\xfe\x94\x62\x9e\xa6\xe6\xe8\xf3\x77\xb7\x90\xa7\xe9\x83\xb6\xd5\x9e\x2c\xf1\x69\x6d\x95\x83\xfa\x4e\x9d\x3e\x48\x2a\xa5\x28\xd8\xfa\xdd\x14\x87\xce\xf1\x6b\x41\x7a\x37\xdf\xf2\xae\x06\xf4\x2e\x21\xf4\xaf\xca\x7e\x80\xb3\x49\xce\x32\xcc\x76\x57\x47\x11\x70\x3c\x43\x15\xe2\x02\xde\x5a\x99\x96\xce\x56\x59\x7d\xce\x4c\x0e\x50\x40\x05\x4f\xb6\x04\xaf\xe0\xca\xc5\x9e\x0c\xd3\x19\xfb\x21\xfc\xe8\xc9\x7f\xd1\x34\x6a\xaa\x82\xae\xb7\xfa\xbb\xbf\xfc\x20\xc0\xc9\xec\xad\xf7\x7a\xa9\x23\xd3\x31\x27\xbd\xca\x34\xe7\xf4\xdc\xb1\xaf\x18\x63\xc4\x00\xb2\x44\x18\x38\xc4\x44\x23\xb8\x47\x1d\xdd\x3b\x48\xcd\xcd\x79\x61\x35\x88\x7d\xbe\xcb\xf5\x4b\x01\xd7\xf3\x07\xf3\x5c\xcf\x5b\x74\x7b\x39\xab\x87\x21\x2c\x91\x0e\xeb\xcf\x1b\xf6\x7b\xf9\x24\x50\x71\x59\x31\xdd\x3e\xc0\xd3\x2a\xac\x55\xf6\xc9\xba\x3f\x1a\x1c\x39\xd0\x08\x58\xcf\x8f\x2f\x1c\x7a\x1d\xee\x9d\xdf\xa4\xaa\x6b\x9e\x01\x1a\x91\x2f\xe4\x9e\x8d\x96\xee\x60\xf7\x03\x87\x1b\xe1\x78\x4c\x3c\x6e\x4e\x47\x3f\x3f\x70\x74\x79\xb1\x53\x03\x98\x29\x56\xdc\x11\xda\x60\x51\x5b\xd2\xec\xf9\x7b\xc5\xcd\xd4\xc0\x0d\x14\xff\x30\xf8\x02\x06\xde\xd2\xa0\x5c\xb5\xe7\xd7\xfe\xfc\x26\xaf\x95\x04\x53\xad\xf0\x74\x30\x9e\x83\xc0\x36\x73\xa1\x37\x4b\xe1\x5c\x13\xfd\x4a\x43\x63\xb9\x69\x52\x57\xb1\x21\xf8\xbe\x2b\x5b\xbe\xb1\xab\x6e\x93\x8f\x1d\x10\xe8\xbc\x58\xc7\x0d\x5e\x7e\xb0\x71\x68\xe5\xce\x7a\x12\x61\x38\x67\x86\xdf\x92\x96\x37\x19\x1d\x16\x93\x5b\x57\xdd\x2a\xfd\x89\x96\x0c\xeb\xea\xb8\xd9\xb7\xec\xdd\x57\x54\x9e\x0f\x71\x26\xf3\x00\x9c\xeb\x1b\x67\xd4\xb9\xb3\xcb\x3e\xcf\xfa\x9e\xed\x1c\xd6\x75\x79\x81\x2f\x3f\x07\xe1\xc3\x16\xf7\xaf\xdb\xed\x5b\xa8\xa8\xbb\xfe\x75\xc7\xf8\x1a\x34\x9f\x7b\xb2\xfe\x2a\xc9\xcc\x64\x1f\xe1\x19\x1f\x6a\xaa\xf9\x6c\xe1\xb9\x7b\x99\x2e\x4a\x8f\x34\xae\x01\xe8\x92\x3d\x19\xcd\x7c\xec\x59\xc9\xc2\x3c\xe8\x70\x79\xbc\x56\x84\x9e\xbb\x31\x48\x87\xd4\x07\xf2\x76\x44\xdf\x47\x0b\x94\x81\x41\x6f\x13\x6c\xd8\xa8\xa1\x28\x6c\x7b\x01\xa5\x52\xb5\x84\x3e\xa0\xcb\xc0\xfc\x1a\x94\x8a\x22\xf9\xd7\xac\xf5\x42\xfb\x10\x1e\x0f\x66\x94\x65\x36\x2f\xe3\x82\xee\x01\x66\xfa\x81\xc5\x4e\xa4\x17\xdd\x3f\xd3\x61\x07\xaa\x46\xc8\x84\x62\x39\xd9\x17\x51\x44\x88\x0d\x33\xb9\xc5\x6a\x80\x8b\x24\x3d\xda\x77\xb6\xd4\x51\x0e\xc0\x32\x65\xbf\x00\xed\x41\xf7\x9f\x5e\x81\x18\x05\x08\xa0\xfc\xa4\x06\xcf\x55\x14\x2a\x90\x75\x34\x06\xf8\x7b\x6b\x6e\x80\xe0\x79\xfd\x0a\xb3\x52\x54\xea\xb3\x73\x41\x69\x02\x2e\x94\xa5\xa2\xf7\x2f\x24\x77\xc9\xd8\x5d\x4c\x2f\x40\xe1\x3f\xb2\x66\xa1\x17\xa6\x3f\x08\x20\xfb\x21\x1a\x5a\xd5\x3c\xca\xdb\xf8\x20\xaf\x68\x73\x0f\xb1\x63\x3a\x04\x27\xfe\x73\x26\x39\x89\x7b\x04\xec\xde\xbc\x7a\x7d\x89\x66\x8b\xe2\x23\x25\x26\xd8\x16\xc9\x55\xb0\x58\x56\x93\xad\x1e\xe3\x73\x55\xfc\x21\xea\x49\x15\x43\xbf\x54\xc6\x5b\xc3\x9a\x9d\x1e\xd1\x17\xba\xab\xda\x28\xe5\x65\x39\x26\x73\xe7\xa3\x33\x4b\x16\xa1\x97\xaa\xb2\x4a\x9b\x9e\x1b\x89\x91\x49\xd0\x17\x71\xc9\x29\xe3\x29\x2a\x5a\x76\x8d\xe2\xd5\x2a\xf9\x9a\x79\x5c\x49\xa4\x38\xe4\x36\x31\xb2\x40\x67\x3f\xa0\x07\x8d\xcb\x49\xdf\xee\xcd\xa6\xe3\xf4\x9f\xe7\x71\xcf\xe8\x29\x0f\xa0\xfa\x94\x27\x94\x49\xb3\xab\xe3\x4e\xb3\xff\xd7\x55\xc8\x46\x5d\xc8\x14\x1b\x69\x88\x72\x61\x60\x69\x0d\xad\xd9\x4f\x4e\x7f\xde\x3d\xce\xf4\xa4\xd7\x12\xc5\x11\xf2\x2d\x46\xfb\xe7\x15\x0e\x9c\x4d\xf7\x20\x38\xee\xad\xe3\x98\x25\x75\xb4\x74\x0b\x29\x65\x97\x84\x9d\x2c\x4d\xa7\x2a\xc2\xfd\x35\xec\x99\x0a\xab\xbe\x6a\x01\xd7\x8c\x57\x14\x84\xf0\x90\x51\x69\x5a\xb9\x03\x02\x75\x01\xfb\x28\x55\xd5\x42\x10\x0b\x6c\xdd\x49\x96\x90\xf4\x3a\x32\xe6\xbd\xdc\x9f\xc9\x17\xfd\x85\xeb\xc7\xc5\x2c\xaf\x3a\x25\x5e\xc3\x7c\x13\xd4\xa3\x71\x74\x1f\x82\x4d\xbf\x0a\x8a\xf9\x96\x0a\xd7\x80\x6d\x68\x50\x33\xf8\xc9\x31\x35\x53\xef\x8d\x14\xd9\xda\x94\xcc\x7d\x47\x0d\x7c\xbd\xe5\x2f\x2f\x08\xda\xe1\x91\x7e\x8b\xfe\x09\xc1\xfd\x0b\x3a\x4a\x93\x31\xbc\x36\x3d\xe6\x19\x48\x5a\x13\x80\xc2\xe5\x07\x64\x6f\x5f\xdc\x4a\xee\x91\xa8\xf6\x87\x40\x9f\x59\xa9\x25\xe1\xce\xcc\xda\x78\x46\xf4\xd0\xac\xac\xfe\x1b\xa9\xea\xbd\x42\xc4\xb5\x51\x6e\xaa\xa0\x95\x1c\x35\x5f\xcd\xdb\x69\x4e\xc6\x65\xc5\x3a\x31\xe2\x45\x45\xaf\x81\xa5\xf1\xc6\x9d\xed\x34\xb3\x74\x00\xf3\x13\x80\x69\xa6\x43\x99\xf3\x56\xf4\xe9\x88\x1f\x44\xeb\xdb\xdf\xf7\x82\x91\xe5\x89\xbc\x8b\x02\x42\x05\xa0\xd1\x56\x8c\xd3\x7d\x17\xc9\x29\xef\xcd\xe2\xad\x7b\x3f\xc0\x14\x63\x6e\xba\x81\xc9\x51\xd4\x54\xd0\xfd\x99\x74\xae\x31\x13\xbb\x90\xa5\xe7\x85\x78\x5c\x76\x75\x57\x65\xc0\xf4\xe8\xf8\xc9\x20\x3d\xe0\x51\x79\x2c\x86\xda\xb6\xdd\xba\x20\x85\xaf\x27\x3f\x05\xa7\x61\xc6\x65\xae\x06\xbf\x37\xc2\xe0\xc4\xee\xad\xc6\x9e\xc5\x90\xa0\xb6\x77\xf9\xab\x65\x20\xd6\x3d\x0b\xa1\xad\x99\x5f\x1c\x3d\x0d\x80\xe4\x46\xa7\xf7\xdb\xfb\xab\xca\x8d\x57\x00\xfb\xea\x71\xd5\x20\x2e\x52\xba\x55\xdc\x97\x48\xa4\x94\x9c\x77\xd3\x9b\x2b\xc9\x3a\xaa\x58\xc6\x7e\x42\x4c\x7b\xcf\xaf\xe0\xd5\x78\x73\x0c\x92\x5e\xdd\x7b\xf9\x72\x56\xe4\xce\xde\x1f\x73\x7a\x09\x79\x0f\x12\x50\x5d\x4f\x42\x5f\x9e\x67\xdd\x0b\x78\x7e\x1d\xb3\xfa\xf7\xa7\x7b\x85\xdc\x3d\x7c\xd8\xba\x6d\xd6\x83\x0e\x13\xbc\x71\x19\xc5\xad\x56\xeb\x1f\x70\xc1\xc9\xa5\xf9\x59\x23\xc6\x65\x06\x35\xb6\xe4\xbc\x3d\xe8\x68\x90\x6e\x59\x80\xab\xcf\xfd\xb4\xc0\xef\x95\xce\x3a\xa7\x17\xdd\xd9\x17\xa2\x46\x84\x3b\x53\x38\xda\x48\xb7\x38\xaa\xde\x6b\x7a\x9d\x66\x94\x57\x89\xf9\x20\x25\x9f\x09\xfd\x50\x6d\x86\xa3\xb2\x97\x97\x53\x40\xcf\xcc\xaf\xec\xee\xef\x9a\xbf\x8b\xb4\x21\x70\xa4\x48\x02\x0c\xa4\xcb\x93\x85\x9a\x4c\x7e\xa9\x7f\x13\x5b\xc2\x85\x1d\xb1\xc5\x5f\x48\x9e\xd0\x9c\xc5\x2c\x4f\x7e\xe9\x85\x6f\x75\x1b\x0a\xb2\x60\x8e\x7d\x4b\xf8\x40\xcb\xba\x47\x7b\xcd\xf3\xac\x9e\xec\x7f\x68\x11\x6c\x5e\x8a\x36\xbf\x8a\x3d\xd6\xe9\xda\x6a\x9b\xad\xb8\x35\x54\xfe\xef\x9f\x67\x48\xa1\x45\xc3\x4c\x0f\x31\x2b\x2d\x4f\xb4\x96\x46\xb3\x41\xfe\xec\x75\x01\xee\xf9\xc9\xe3\x49\x1d\xd8\x68\xc5\x79\xca\xca\x27\x6a\xb6\x12\xe7\xb9\x7a\xf4\x5f\x4d\x91\xab\xf5\xea\x59\xc2\x1e\x2b\x56\x54\x2f\x88\x94\x1a\x29\xff\xec\x47\xeb\x9b\x22\x76\x60\xaf\x60\xd1\xbc\xec\xbd\x3f\xc5\xc6\x75\xd4\xee\x21\x4b\x8f\xda\x84\x92\x82\x2d\xcc\x76\x40\xf1\x58\xb1\xe3\xde\x0e\xa9\xe4\xf6\x64\xec\x93\x25\xa0\x51\xe6\x68\x07\x76\x36\xbd\xba\x25\xf8\x43\x44\xea\xcc\x01\x4b\x06\xb9\x9b\x79\x3f\x27\x61\x08\x0a\x7e\x30\x65\x37\x56\xe9\x64\x95\x69\x0e\xee\xe6\xf9\x99\x87\x5a\x5f\x9c\x5d\x46\x28\x48\xc9\xf3\x49\x1e\x8d\xdb\x47\x4d\x0a\x04\xd3\x79\xa8\xfc\x9e\x2c\x1f\x6e\x44\x96\x07\x74\x99\x65\x1f\x73\x24\xab\x8b\x6e\xed\xb2\xd7\x82\xa8\x7b\x60\xec\x7e\x67\x72\xcd\x72\x3a\x85\x15\x36\x56\x12\x7e\xd8\x69\xdd\xf1\xea\xf7\x3e\x83\xc7\x25\x95\x9f\xb2\xa6\xa2\x30\x8b\x63\x82\xf9\xcd\xf2\x2a\xd6\x1c\x50\xbe\xad\x2b\x53\x6c\x66\xe1\x8e\x9e\xed\xfc\x27\x8c\xa9\x6b\x0d\x35\x37\xeb\x16\x4e\x4a\x02\x2f\xaf\x3d\x68\xee\x3e\x2d\x1f\x95\xf1\xf9\x95\x67\xc1\xc8\x5f\x1d\x7e\xd1\x8c\x60\xe6\xc8\xfd\x5b\x07\xfd\x7a\x57\x29\xe6\x87\x1a\x5e\x38\xfe\x26\x3f\x68\x46\xfa\x5e\xa7\x5b\x15\x1a\xa7\xcc\x00\xec\xe9\x1d\x99\x47\xb7\xcd\x48\x17\x9d\x79\x6f\xad\x61\x2a\xcc\x12\xa1\x26\x99\xb2\x8f\x33\x28\x99\xf4\x21\x4c\xda\xa0\xed\x23\xbf\x47\xec\xa2\x4b\xc1\xaf\x10\x79\xd5\xbc\x33\xe6\x85\x8a\x77\xcf\xc5\x1e\x6a\x85\xee\x7c\x81\x3b\xa8\xc1\xb7\xc9\x7b\xe9\xa0\xea\x96\xea\x21\x19\x3c\x86\x95\x98\x18\x95\x17\x5d\x08\x91\x61\xa0\x1f\x8a\x25\xa9\x52\x62\x9c\x39\xcd\xda\xef\xcd\x05\x3f\x75\xce\x3e\x9b\x19\xed\xd7\x07\xf4\x54\x01\x82\xfc\x1f\xcb\x18\xb1\x3d\x3d\xad\x58\x00\x87\x35\xdc\xeb\xb7\x20\x9d\xf5\x31\x37\xd0\x34\xeb\xef\xfe\x4a\x67\x03\x9c\x44\x09\xa2\x45\xe3\x4e\xa8\xe7\xad\xb2\x4c\x4a\x7b\x87\x29\x02\xe4\xd5\xd8\xd5\x62\x9f\x1f\x0d\x44\xcf\x63\x03\x85\xa5\x22\x0b\x47\x47\x4f\xd7\xfd\x90\xe3\xa8\xa7\x90\x85\xe1\xd3\x14\x82\x70\xea\xc6\x50\x7f\x70\x0b\x73\xed\x7f\x55\x7b\x2f\xb8\x4d\xb0\x5c\xd9\x82\x3c\xba\xd5\x11\x6a\x5a\x4e\xa4\x34\x08\x4c\x90\xa1\xfb\xa0\x73\x40\x63\xda\x1b\x1d\x01\x10\x6b\x64\x37\x5a\x3d\x71\x4f\x56\x63\x92\xfc\x7e\x99\xd2\xcc\x0e\x44\x0c\x57\xf6\xd6\x58\xe7\x2c\x6c\x7c\x3c\x8f\x2d\x05\xa5\xde\x5d\x54\x83\xa0\x44\x9d\x70\x33\xc4\x5b\x1d\x59\xb0\xc3\xcb\xbe\x6e\x8d\x74\xe1\xc1\xbe\x12\x70\x2e\xe1\xa4\xa1\x85\x13\x99\x0f\x22\xf1\xe4\xd6\x99\x7a\x6e\x20\xe1\x02\x55\xe8\x58\xf4\xd8\x10\x7a\x50\x9a\x38\xf5\xed\xf2\x0d\x6f\x7c\xbe\xc1\x4a\x6c\xd0\x6f\x95\x4d\x70\xa4\xb4\x45\xf1\x9b\x5a\x1f\x4f\x9e\xec\x99\x75\x29\x37\x24\xa7\x92\x64\x96\x66\x9d\x20\x36\x3e\x10\xec\xe5\x5c\x50\xc3\xb8\x99\x44\xa9\x2f\xfb\x64\x3b\x13\x8a\x21\x80\xbe\x3b\x89\xbf\xa9\x55\x44\x0f\x39\x39\x00\x8d\xde\x05\xb6\x01\xbf\xf2\xad\x18\x51\x21\x8b\x0f\xfe\xed\x18\x7b\xc5\x6b\x8a\x6d\xb8\xa3\x05\x61\x34\x65\xdc\xf4\x87\x76\x1f\x87\x8c\x36\x0a\x37\x83\xa7\x35\xc4\xc6\x86\xcb\xb0\x4f\x88\x46\x9e\x72\x8b\xdd\x36\xb9\x57\x8d\xca\x5e\x53\x37\xe4\x94\x98\x58\x03\x9a\xc6\xe0\x95\x5d\xdf\x89\x32\x45\xee\xba\x6b\x75\x73\x56\x74\x1d\x0c\x22\xa1\xff\xa4\xae\xc9\x6b\x54\x86\xf3\x7b\x66\x04\x5d\x01\xa1\x1e\x67\xf4\x95\xa4\x77\x84\xf1\x4b\x11\xec\x77\xb6\xe6\x61\x01\x67\xe9\xe3\x9b\xcd\x7c\x01\x4b\x6d\x2f\x84\xc5\x49\x15\x55\xbe\xa9\x33\x5f\x87\x0f\x53\x4c\x71\xec\xfe\x43\xf1\xcc\xaa\x4c\x4e\x63\x54\x40\xfb\x99\xd2\x4c\xc2\x91\x5d\xf3\x04\xc7\xee\xb3\x77\xe9\x16\x04\x6c\xb7\x8b\xcc\x89\x42\x95\xd1\x50\xac\x8a\x1b\x19\xc2\x8f\x43\xe8\xd5\xb2\xc9\x8a\x76\x56\x8d\xb3\x4a\x50\x56\x1f\x6a\x26\xe1\xae\xcf\x58\x90\x7e\x65\x57\xbb\xde\xf3\x2f\xad\xd6\xf1\x1a\xb5\x19\x23\xa1\xf7\xc4\xc3\xee\x57\xfd\xd0\xac\x3d\xcb\x6b\x56\x9b\x89\x32\xf7\xde\x82\xa4\xb9\xd9\xc9\x08\xc7\x14\x30\x86\xf1\x6a\xd7\x99\x3c\xbc\xc6\xbe\x52\x37\xce\x25\x83\x85\x99\xf7\xc0\xbd\xce\x9a\x3d\xc5\x19\x9a\xef\x4a\x92\x29\x3a\x42\xf0\x29\xde\x82\xc8\xd7\x2d\x71\x9d\x51\x04\x8c\x30\x8e\x91\x15\xc6\xbb\xcf\xc3\xb4\x97\x1e\x16\x47\x08\x5f\x41\xa7\xc7\xfc\x2b\xfe\x8a\xbf\x54\xe3\x1c\x7f\xad\xbf\x94\x8c\xe3\xaa\x4d\xce\x0a\xe1\xb7\x7b\x78\x0b\x85\x40\xdc\x1f\xb8\x5a\xc9\x15\x67\x40\x1c\x32\x7c\x1b\x10\xca\xa8\x2b\x09\xc0\x60\xda\x69\xee\x21\x17\xd6\x83\xd9\xf4\x86\x7a\x92\x43\xa2\xb5\x63\x0b\xc0\xe3\xfe\x2f\x0a\xb5\x2e\x69\x70\x07\xd4\xe9\x73\x58\xb8\x8b\x73\x58\x71\x2d\x58\xe6\x2f\x41\x91\x2e\x14\x9e\xb4\xe2\x1c\x2a\x26\xae\x41\xc0\x45\x52\xc6\x36\xd6\xa3\x34\x64\x2f\xf6\x50\x7f\xbe\x71\x80\xd4\x19\x1f\x85\xfd\x8f\x45\xcd\x51\x77\xd0\x64\x2e\xf4\xf3\x5c\x92\x1c\xac\x88\x47\x2b\xdc\x68\x92\x93\x73\x04\x94\xe7\x64\x85\x41\x4d\x6e\xbe\x9b\x0c\x0c\x56\x0d\x54\x67\x92\xa1\xfb\x34\x2e\x0c\x9e\xdf\x86\xa9\x57\x59\x30\x9c\xda\x22\xd7\x10\x61\x9a\xac\x9a\x68\xa6\xe5\x7e\x99\x4a\x79\xb1\xd8\xd6\xe0\x6a\x2f\x4f\x40\xd0\xc6\xfa\xbd\x0a\x87\xe8\xe3\xde\x86\x3b\xff\xbd\x34\x9b\xe9\x57\x93\x9d\x4d\x28\x2f\xa4\xcd\x50\xe2\x10\xf6\xb4\x16\x77\xd0\x6b\x34\x54\x48\x05\x8a\x5b\xe6\x93\x80\x1f\xa1\x61\xe7\xde\xdb\xbe\x6b\xb7\xd8\x0f\x19\xa2\x3c\x99\x59\x75\x91\x5f\x2f\x65\x97\xb5\xd7\x24\x05\xae\x7c\x31\x11\xec\x60\x4d\x76\xf0\xb2\x17\x3a\x66\x21\xb7\xe6\x56\x31\x31\xbf\xb2\x60\xa9\x59\x42\x86\xfa\x26\x9b\xe5\x62\x69\xae\x45\x85\xd7\x2c\x66\x46\xc2\xfd\xa1\x80\x01\x93\x69\xe3\x1a\x6b\xf9\xf2\x3e\x01\xe4\x4a\x3f\x3f\x6a\x51\x2a\xd9\x82\x1e\xb6\x75\x34\xb0\x5b\x67\xab\x1e\xce\x16\x0e\x8d\x8c\x13\x0f\xf7\x00\xa5\xf6\xc1\x7e\x83\xd5\xa7\x41\x5b\x2c\x7a\x86\x86\x68\x34\xe4\x5e\x82\x51\xdb\xa5\x86\xc4\x42\xc3\x31\xb2\xbd\x12\x72\x92\x60\x5b\xe3\x67\xf6\xd1\x83\x2b\x47\x6f\x07\x98\x3d\x4c\x52\xac\xda\x75\x93\x4a\xb1\x84\x70\x18\xda\x4c\xfb\x21\x50\xcf\x27\xb8\x01\xf6\x4d\x86\xf6\xda\x5d\xee\x40\xd1\x75\x2f\x99\x21\xc7\x15\x04\x12\x7e\xf7\xb0\xf9\xc3\xa4\xed\xd6\x0c\xcc\x2e\x2f\x3d\xbf\xed\x28\xce\x39\x7a\x3d\x8c\x17\xee\xde\x9a\x79\xa9\x13\xf8\xab\xc1\x07\xad\x4c\x32\x77\x29\x80\x35\xe8\xbf\x34\xe7\x09\xd0\x88\x0b\xd6\x46\x8b\x2d\x4e\xb9\x17\x3a\x7b\xaf\x06\x09\x98\x4f\x75\x37\xab\x55\xdd\x1b\xe0\xa5\x0b\x7e\xc0\xf6\x91\x23\xad\x4c\xca\x83\xd5\x90\x04\x67\xfd\xa1\x32\x73\x4b\x06\xdd\xdb\x09\xed\x1a\x0f\x28\x4e\x3c\x6d\x88\xb3\x1f\x6c\x9c\x40\xfd\xa3\xe7\x19\xac\xd8\xc9\x54\xb3\x5b\x1b\xfc\x87\x0c\x6e\xa3\xea\x01\x62\x96\xb7\xaa\xe2\x4d\xb9\xae\x43\x3a\x55\xec\x4c\x90\xf5\xaa\x86\xaf\x4e\xc0\x7a\xc8\xd0\x26\x17\x93\xc7\x57\x17\x13\xf5\xd6\xdd\xfc\x7f\xce\x57\x28\xc3\xa2\xfd\x0d\x0a\x66\xa8\x71\x42\xf2\x9a\x94\x41\x5e\xcf\x01\xec\xe3\xae\xbc\xcf\x7e\xb2\x9e\xb1\xe3\xdc\xeb\x93\xee\x8b\x56\x55\x9e\x3f\x68\x23\x6f\xe3\x6c\x15\xc6\xe4\xfb\x5b\x69\x8e\x3d\x2d\x6f\xba\x23\x17\xbe\x5c\xfb\x1a\xed\xad\xbd\xc1\x52\x4a\xd4\xa7\x27\xcf\x3d\x43\x94\x99\x61\x84\x9a\xa0\xe0\xd2\xa1\x09\x1b\x5e\x9e\x16\xab\x36\xd4\x33\xbb\xb5\x89\x7b\x0b\x25\xfa\xf9\x51\x8f\xdf\x31\xa9\xbf\xeb\x78\xb2\xa9\xd1\xd4\xab\xb6\xb4\xb0\x3f\x76\xa3\x55\xca\x13\x6e\x62\xd1\x0b\xa3\x83\x3e\xf3\xf7\x75\x56\x12\xfb\xee\x69\x1b\xad\x83\x81\x4d\x18\x50\xe5\x76\xf8\x30\x69\x0e\x7d\x85\x26\xa1\x3c\xab\x53\xbc\xb5\x13\x42\xfa\xf7\xf5\xe1\x86\xb7\x3a\xc2\xed\x1d\x16\xd2\x4b\x97\x5e\x4a\x10\xe4\xf0\x49\xc6\x40\x70\x98\xcb\xc0\x9a\xe7\x5c\x85\x5f\x2b\x40\xa3\xef\xc7\x5e\xad\x34\xc2\xa9\x65\x8e\x0e\x11\x5e\xcc\xde\x1a\x36\x34\xa2\x42\x9a\x23\x04\xd2\xa9\x27\x9f\x7d\x85\x6d\x36\x53\xf9\xa3\xe7\x02\x61\xbb\xfa\xec\xcf\xcf\xa7\xd4\x29\xd8\x29\x3b\xf9\x43\x9d\xf3\x7c\xae\x87\xcc\xce\x65\xa7\x50\xd9\x26\x17\x51\x11\x46\xb7\xa1\x52\x86\xae\x54\x7b\x1f\x27\x3b\x26\xac\x79\xe7\x97\x16\x63\x0c\xdf\x80\x55\x37\xb3\x7d\x90\xd7\x8a\x73\x51\x7b\x45\xf1\x5e\x83\x1f\x62\xba\xd7\x00\x92\x1c\x47\x90\x74\x34\x39\xa6\xb0\x74\x0b\xa8\x4f\xdc\xf4\x71\xd4\xfb\xf9\xa4\x49\x59\xfa\xa8\x6e\xaf\x19\xff\xd0\xd6\x7c\x24\x73\x63\xbd\x38\xd8\xc1\xc3\x6f\x6c\x54\xa1\x86\x90\x1b\xc1\x9e\x5e\xb8\x55\xd2\x78\x6b\xc3\x5f\x7b\xd0\xa2\x67\xf7\xc6\x57\xfc\x7d\x7f\x63\x38\xc9\x59\xf6\xc0\x84\xc9\xd9\x3a\x56\x6b\x8f\xd2\x73\xe3\x9c\x6e\x12\xeb\x8f\x8a\xf4\xbc\x2e\x73\x48\xd5\xd9\xea\xc3\x9d\x21\xc3\x0b\x55\x28\xca\x7f\x6f\x40\x7e\x70\xf4\x34\xc2\x85\x3e\x03\x97\x64\xd8\x5b\x5f\x25\x1d\x75\x78\x99\x2a\xca\xa1\xaa\x71\x43\x4e\x73\xcf\xa5\x24\xb8\xf5\x27\x12\xab\x21\xf0\xf9\xcf\x4a\xeb\x3f\x86\x2b\xa5\x9e\x7f\xf3\xd9\xab\x7a\x62\x6e\x20\xac\x8e\x87\x97\x5e\x52\x3f\x83\xcb\x3e\x31\xca\xdf\x50\x6a\xa6\x25\x55\x1c\xb8\xf1\x79\x19\x1d\x81\xd2\xab\xa3\x84\x8e\xb2\x38\x6f\xd8\x1b\x9e\x17\x6b\x40\x1f\xc7\x5e\x03\x63\x87\xec\xeb\x54\xf1\x5c\x0b\x0f\xd6\xef\x57\x63\x56\x15\xa3\xc1\xfa\x3a\xcd\x12\x96\x3e\x34\x1e\x2d\x97\xe2\x2f\x36\x9c\x55\x4d\xb1\xc1\xe8\xec\xe9\x05\x87\x9f\x26\x2c\xfd\x9b\xf5\x73\x03\x4e\xb4\x30\x1b\x6e\x65\x6c\x87\x80\xbb\x5d\x57\x9c\xd6\xb5\x41\x27\x6f\x71\xd1\x84\xb7\xaa\x84\xac\x46\x31\xf4\x64\xd7\x55\xa5\xfb\xa8\x0b\x09\x1a\x62\xaa\x0b\xf0\x5b\x28\xbd\x26\xe0\x17\x4d\xf9\x28\x39\xfe\x51\xa5\x8c\x1e\xd8\x8d\x42\x0b\x6f\x41\x54\x79\xf4\x22\x09\x65\x61\xa1\xb2\x91\x06\xb1\x15\x07\xd0\xb6\x18\x1a\x5b\x93\xbf\x6d\x2f\x20\x11\x9b\x2e\xd9\x26\x5c\x02\x45\x4f\x5a\x7e\x37\x05\x4f\xae\xc9\x4d\x33\xf9\xa1\xdb\xf6\x48\x87\x2b\x6f\x19\x82\x7b\xd1\x43\x2b\x3f\x45\xa0\xb8\x95\xc6\x0e\x54\x76\x43\x96\xfd\x3d\xe9\xde\xf3\xa1\x86\x86\x45\x14\x47\x47\x8f\xef\x4e\x03\x4f\x0d\x16\xca\xab\xad\x3c\xcc\xbf\xaf\xc5\x52\xf6\x6c\x01\x70\x65\xaf\xf1\xe8\x19\x2b\x3d\x25\x65\x24\x52\xe0\x13\xfa\x0e\x56\xab\xf2\xd6\x08\xcb\x26\xdb\xde\xec\x72\x9c\xf4\xe4\x87\xed\xb1\x05\xbb\xa4\x10\x6a\xed\x75\x59\xca\x33\x55\xdd\xa5\xc5\x7a\xd8\xf8\xa0\x90\x89\xb1\x7c\xcf\xb3\xb4\xd0\x10\x72\xb7\xd0\x5c\xe7\x4f\x57\x2a\x4c\x73\x31\x93\xbb\x43\x32\x86\xd6\xe8\xb3\x88\xb4\x22\xb5\x69\x60\x63\x31\x13\x56\x62\xb3\xae\x40\x7d\xa4\x63\x3f\x72\xaf\x55\x0b\xb2\xa4\x43\x12\xe5\x18\xb2\xe0\x5c\x46\xe3\x0f\x34\x10\x2a\xf4\xad\xac\xc1\x07\xaf\x1a\x5a\xde\x2c\x60\x78\x6c\x12\x52\x33\x93\x69\x6d\x91\x65\xef\x79\x38\xc5\xee\x12\xd7\x3d\xef\x48\xda\x6b\xcb\x49\x23\x67\xb2\x1c\xe2\x21\x7f\x5f\x57\x5f\x14\x62\xcf\xd3\x5b\x9e\xee\x72\x54\x08\x82\x63\x99\x81\x7b\xf6\x58\xdf\xed\x35\xb2\x55\x80\x0e\xca\x69\x0e\x02\x15\x38\xbd\x75\x47\xff\xb9\x42\x1a\xe8\x13\x6f\xe4\xa0\x54\x5a\xbb\x3d\x62\x67\x44\xb3\x0e\x76\x41\x64\x11\x79\xb5\xa0\x19\xd8\xd9\xbe\x29\xb3\x0f\x95\xb8\xae\x01\x20\x05\x1f\xa6\x39\x6b\x55\x97\xba\x2c\x6e\xec\x61\x5f\x1a\x6d\x39\xd1\x30\xd3\xa8\xa8\xae\x13\x5d\x9a\x85\x97\xd7\xa8\xb1\xce\xa2\xca\x2d\x0d\x83\x6d\x5c\x69\x6f\x20\xa9\x06\xb9\xba\xcd\xec\x16\x6c\x91\x4b\x96\xd1\xb9\xdb\x26\x13\x16\xa0\xbc\x38\xa5\xc4\xda\x0b\xff\xcc\x9e\x9f\x6a\x6d\x16\x0a\x22\x44\x6b\x28\xb1\x09\x3e\x2c\x55\x35\xe8\xcf\x0b\x6f\x67\x82\x1f\x2d\xe2\xbb\x46\xd2\x5e\x33\xd7\xa5\xfb\x1f\xa5\x4e\x12\xc4\x73\x4a\xaa\xb0\xe6\x55\x2c\x3f\xe1\x6d\x0d\x16\xa5\x82\x4f\x8f\xbc\x26\x81\x1b\x72\x26\x7f\x98\x24\x0f\x36\xce\x4c\x92\xb8\xa1\x44\x6d\xf7\x2f\x5f\xc5\x9d\xf5\x60\xe0\xc9\x32\x08\x8d\x09\xf0\xb9\xba\x75\xdd\xf3\xcf\x84\xe1\xb4\xaa\xca\xc6\x36\x0a\xe8\xb1\x23\x77\x29\x28\xbe\x01\xfb\x54\xc0\xe9\x9e\xb3\xba\xd5\x1e\x64\x95\xd3\xde\x80\x6b\x8f\x8a\x1c\xd5\xb0\xef\x90\x39\xc1\x10\x13\xfa\x78\x08\x98\x28\x65\x20\x43\x2b\xee\xd2\x63\xd0\xd0\x48\x43\xb2\x72\x4b\x67\x59\x75\x9b\x5a\xec\xc1\x42\x04\xe9\xa2\x4d\x95\x19\x03\x6b\x38\xfe\xc2\x94\xee\x57\xaa\x77\x99\x3b\x73\xc8\xbb\x9e\xbf\x62\x0e\x8e\x23\x69\xab\x49\x57\x51\x18\x57\xef\x94\xe6\x90\x41\x38\x64\x2f\x05\xd5\x59\xdc\x82\xe8\x72\xab\xe8\xaa\x97\x5a\x14\x36\x5a\xa6\x65\x67\x46\xea\xd5\x24\xa7\x8c\x1f\x90\x96\x57\xfa\x65\x6d\xfc\x41\xeb\x2c\x61\xb0\xd1\x92\xae\x47\x85\x90\xb4\xa9\xd2\xfc\xd7\xd2\xab\x4b\x32\x36\x20\xd7\x29\xdb\x44\xc0\x68\xc5\x29\xdd\x41\x40\x44\x5b\xea\x3e\x6a\xb0\xb4\x5d\x9a\x17\x37\xd1\x38\x6a\xe0\x12\x3c\xa4\x43\x45\xf8\xfe\x3a\xb7\xba\x1f\xbb\x7a\x81\x43\x6e\x44\x9d\x41\x81\x7d\xed\x7e\x83\xba\x08\xc9\x57\x32\xd0\x8b\x84\x47\x16\xf6\x83\x76\x7a\xee\x1d\xe9\xdb\x01\xd2\xf9\x45\xd2\x09\xa6\x1c\xc2\xbf\x4f\xe4\xd7\x4d\xa4\xa7\x59\x68\xfc\xce\x04\xe0\x00\xeb\x70\xc3\x51\xce\x2b\x03\x9a\x6b\xae\x84\x80\xeb\x77\xdb\x2a\x19\x02\x14\x75\xca\xb1\xae\x39\x2e\x6f\xc2\x41\x89\x00\x49\x25\xf1\xb6\x13\x98\xcd\xc6\x41\xeb\x83\xec\x2d\xb6\xc6\x4a\x73\x45\x8b\x13\x3a\x33\xa6\x0f\xc0\xbf\x71\x0f\xe7\xf7\xc7\x46\x43\xa8\xf4\xd0\xbf\x6e\xcd\xb3\x03\xf2\x03\x66\x9e\x42\xaf\xc8\x2b\xd8\x0c\x2c\xec\x61\xeb\x3e\x93\x86\x03\x2d\xfb\x55\x83\x36\x68\x61\x81\x82\x7a\x0b\x61\x50\x0a\xc4\x95\xec\x79\xbe\xd4\xb0\x72\xe7\xc0\xfc\x3c\xfd\x5a\xb7\x3b\x16\x26\x32\x75\x87\xf6\xea\x15\xb4\x5c\x5f\x5b\xe2\xeb\x1f\xad\x89\x97\x1e\x9d\x43\x90\x6c\x62\x7d\x37\xa3\xd2\x75\x35\xba\xac\x4f\xf7\xea\xb4\x80\xb7\xb7\xf0\x60\x19\x8c\xaf\x25\xcc\x94\x94\x15\x69\x5c\x83\x4f\xe4\xb9\x71\xd5\xe8\x85\xa6\x09\x2f\x90\x0c\x5e\x7d\xde\x68\xfd\xe4\xb8\xab\x83\x62\xf5\x5f\x87\xb7\x26\xff\x39\x86\x50\x92\x2b\xa7\x40\xfe\x75\x8e\x8a\x84\xc8\xc9\x8a\x1a\x9e\xcd\xa8\x6e\x3e\xc3\xe0\xd9\x2d\xd0\xb6\xb7\x75\x2d\x2b\xe2\x12\xaa\xbb\x05\xcc\x97\xf5\x1a\xf6\x66\x88\x23\x89\x16\x56\xe9\x0f\x41\x9c\x4b\xc1\xb8\x48\x01\xe8\xe8\xf2\x21\xb6\x08\x63\x43\x97\xd8\xd5\x00\x70\x8f\x80\x65\x78\xee\x82\x1f\x14\x10\xbd\x0c\xb4\x10\xab\x96\x1b\x59\xda\x13\x5f\x02\x51\x76\x23\xe1\x72\x46\x9a\x92\x1e\x79\xe0\xf3\x5e\x7a\xc1\xd6\xec\x8b\x1a\xfd\xb2\xb5\xe2\x04\xc0\xc6\x90\xfc\xb0\x59\x0d\x7c\xb0\x83\xc6\x3c\x70\x83\x58\xef\x0b\xee\x1e\xc9\x3c\xb4\x44\x25\x6f\x93\xc2\x43\x0d\x3d\xad\x61\x1f\x51\x44\xe1\x52\xb2\xd6\xd4\x94\xe6\x9b\x5f\x32\x40\x3a\x52\xb5\xc8\x7e\xeb\xab\x7f\xc0\x07\x71\x9c\xec\xc0\x3c\xa4\x42\xcd\xae\x8a\x62\x6f\x8f\x58\x2b\x09\x97\x34\x04\x5d\xfd\xa1\x49\x7f\xbb\x19\x60\x69\x07\x71\x6b\xea\xfe\x5f\xbd\xe8\x52\x9c\xe3\xc8\x4f\xf6\x5d\x31\xb8\xbc\x2b\xba\x38\xdc\x8a\x34\xdf\x34\xe4\x24\xa8\x77\xb6\x77\x10\xf0\xe0\xba\x62\xdc\xfa\x41\xf7\x10\xc4\x62\x95\x14\xed\x95\x80\xfa\xd6\x03\xe4\x74\x30\x6f\xc1\x02\x74\xd8\x6c\xea\x56\xe5\x84\x5e\x9d\x4f\xc4\xa9\x99\xab\x3b\xe6\x67\xb7\x5a\x2f\x3e\x12\xf8\x5e\x45\xde\x8a\xbf\xc6\x7d\x56\x1b\xfa\x37\x57\xed\xf6\xcb\xc7\xea\x4e\xdd\x38\xd6\x10\x72\xfe\x5e\x38\x86\xe7\xa1\x69\xae\xc7\xfd\x8b\x0e\x71\xb6\x2b\x9e\xa6\x03\x58\x72\x01\x16\xb8\x11\x5c\xad\x04\x20\x2c\x06\xa7\xe0\x86\xb8\xd8\x22\xfd\x72\x41\x10\x8a\x79\x8f\x26\xb3\x24\x66\x7d\x86\xf7\xf8\x33\xbc\xea\x1a\xe0\x6c\xc1\x79\x3b\x8c\x9b\x90\x16\xf5\x0c\x1f\xfa\x9d\x98\xfb\x2c\xbd\xfe\x38\xa0\x7c\x65\x4c\x91\xf4\x98\xb4\x5a\xf1\x62\x87\xee\xaf\xff\xfe\x7f\x7f\x42\xf7\x24\x0b\x6b\x56\x47\x2b\x61\xc5\x17\x50\xe3\xfd\xa3\xfd\x41\xad\x00\x9d\x98\x71\x9c\x37\x87\xf5\x40\x1a\x5c\x72\x50\xef\x31\xff\x35\xf9\x5e\xf8\x90\x3f\x38\xd3\xb2\x04\x03\xa6\xd2\x05\x44\x7a\xb5\xdc\xff\xbe\xdd\xee\x34\x07\x51\x6c\xe7\x98\x08\xd5\xc6\xfa\x2c\x4c\xd9\x08\x01\x1d\x5b\x8f\x2e\xe3\x1a\x60\x48\xbf\x1c\xae\xb1\xff\xb9\x7e\x8f\xbc\x94\x94\x3e\x05\xb4\xa8\x96\x92\x37\xf4\x5b\x17\x32\xba\x40\xb3\xf2\xb4\xfa\x14\x14\xef\xba\x03\x91\xfa\xf7\xf5\xc3\xb8\xd8\x77\xf7\xf8\x8f\xfe\x0b\xaa\xa4\x0f\x1d\x49\x6a\x0c\x65\xd2\xa1\x50\x71\x0e\xcc\xbf\x13\x42\x90\xa3\x11\x6a\x10\x15\x21\x27\xd0\x6b\xdf\x82\x3c\x62\x6a\x3d\xfc\xf7\xf7\xa1\xcf\x4a\xc9\xae\x8d\x92\xa1\x0f\x8a\x1e\xf0\xf0\x01\x42\xfe\x7b\x73\x75\x18\xa2\x9c\x25\x76\x0e\x2f\x54\x2a\xa7\x94\x66\xbe\x2b\x71\x90\xdf\x7b\xbd\x35\xb8\x30\xa1\xb2\xab\x39\x26\x18\xd7\x41\xfa\x9d\xed\x08\x73\xe8\xec\x3f\xe8\x20\xc5\x8d\x09\xe4\x3d\x82\x43\x47\xab\x82\x3b\x3f\xc7\xe9\xef\x63\x53\xc8\x59\xfa\x42\xd2\xc0\xf0\x41\xf6\xd4\x05\x82\x6e\x07\x30\xf0\xc2\x69\x4d\xe6\x7e\x75\x2a\x77\x44\x3f\xb6\x3d\x7f\xa0\x68\xba\xda\x21\x65\x49\xa8\xfa\x15\x59\xd9\x53\x6f\xc1\x3d\xf7\x62\xe0\xfe\x33\x1f\x64\x0c\x4e\xeb\xa9\x1c\x01\xf4\xea\x51\xab\x80\xcd\x51\xfc\xdd\xa2\x56\x0d\xc1\x08\xb7\x2d\xc6\x71\x8d\x1a\xe6\x92\x5d\xb2\x2b\x5c\x97\xd1\xf6\xc2\x6f\x81\xe8\xb3\xf6\x0a\xbe\x1b\x6c\x34\xc2\x1f\xac\x1d\x16\xbb\xd8\x96\x12\x42\x78\x0d\xc9\xd9\xb8\x90\x6f\x9a\x64\xeb\x5b\x7c\x2f\xaf\xc5\x4c\x14\xfe\x50\x0a\x1b\xb0\x20\x57\x32\x86\xee\xfa\x93\x3d\x65\xf0\xcc\x0f\x4f\x54\x53\xed\xd5\xaa\xd8\x73\xb7\x31\xab\x05\xc7\x6c\xfd\x40\xdb\x7d\x50\xc6\xef\x89\xef\xdf\xb7\x07\xf2\x0c\x8d\x18\x29\x1d\x87\xef\xaf\x0b\x41\xf9\x24\x44\x63\x08\x1f\x90\xfa\xfb\x4b\xff\x9d\xad\x77\x6a\x89\x3d\x58\xef\x01\xdc\x71\x38\x63\x5c\xf3\x0d\xd7\x78\xf7\xf5\x84\x68\xd0\x95\x53\x6e\x65\x53\x39\xae\xe0\xcd\x92\x54\xd5\x5f\x60\xa4\x11\x15\x92\xaa\xa2\x6e\x83\x3f\x14\x5b\xe3\xda\x05\x09\xcd\xcb\xf2\x82\xa0\x76\x30\xcb\xa0\xd7\xb4\xa9\xc5\x7b\x1d\x84\x64\xe8\xbe\x0e\x6f\xb2\x6b\x69\x73\x60\xe9\x4f\x94\xb0\x3f\x5c\x08\xd1\x94\x95\x96\x3e\xbb\xb7\xbb\xf7\x05\xe9\x54\xa1\x8e\x64\xb1\x1a\xe5\x21\x7b\x31\xab\x4f\x74\x25\xcf\xdd\x6b\xc3\xca\x7f\x56\x8f\xf1\xfa\xd1\xd9\x93\x05\x37\x74\x23\xc5\xb9\xb9\x98\xfb\xc1\x73\x6a\x69\xe0\x69\xe1\xd6\x0a\x8b\x26\x16\x84\x7e\x47\x13\x10\xf1\xb1\xac\x8e\xbf\xa1\x72\x63\x19\xf4\xb8\xbf\xd8\xd9\x62\xc6\x81\xd5\xa0\x2d\xb8\x98\x13\x4d\x09\xb2\x68\x92\x17\x98\x5a\xa6\x90\x51\x1b\x97\xaa\xf0\xd5\xdd\x11\xc1\x87\xe7\x87\x01\xf2\xcb\x38\xf4\x55\x29\xbe\x74\x5d\x18\xbb\x34\x73\x47\xda\x57\xe9\xc9\x1e\xdc\xeb\x1d\x66\xb3\xc1\x92\xbf\xf7\x4e\x62\x87\x01\xbe\x71\x65\xb4\xba\x86\x61\x93\x66\x61\xee\xb0\x39\x2b\x5b\x55\x7d\xa6\xc2\x62\x16\x35\x23\x1c\xc2\xe4\x14\xeb\x31\x85\x65\xa1\x18\xd4\x3c\xc2\x7e\x50\x1d\xa0\x84\xa7\xa2\xa8\x09\xe9\x3b\x8d\x84\xab\x10\x86\x8e\x3c\xb9\x17\x58\x8b\xfe\xb0\x84\xae\xfa\x46\xe8\x7b\xb6\xa8\x81\x77\x24\xd0\xe3\x5b\x42\x0a\x8d\x30\x7b\x5c\xf2\x34\xe1\x4c\xe2\x7b\xcf\x24\x1f\x3c\xe3\x9e\xec\x0f\x19\xa9\xbf\xaf\x1f\x8e\x2e\xb7\x6d\x8e\xa1\x34\x07\x67\xef\xc8\xd5\xad\xbf\x4f\x3a\x96\x60\x3d\xb7\xd4\xa4\x32\x07\xd3\xbf\xc8\x57\x17\xda\x33\xda\xee\xcd\x66\x54\x88\x95\xe3\x05\x5f\x05\xaf\x91\xbc\x36\x48\xe4\xbe\xb8\x05\xc1\xe7\xdc\xac\x9f\x1a\xf6\x9a\x1c\x13\xe6\x4b\x5e\x39\x75\xc5\x1c\x5a\x47\xf8\x40\x43\xef\x02\x94\x9d\x92\x7b\x4d\x0f\x4d\x76\xcd\xae\x05\x2e\x9e\xc6\x55\xaa\xca\x4c\x55\xc4\x4e\x8f\x45\x83\x47\x31\x84\x12\x28\x92\x66\xaf\x0a\x72\x9a\x8a\x67\x68\x2f\xc5\x77\x02\xd2\x6e\xa2\x75\x86\x6f\xfc\xa4\x84\x13\x41\x09\x7a\x96\xb4\xf7\xbe\xaa\x86\x99\x32\x89\xf5\x56\x61\xe4\x47\x97\xbf\x75\x88\x90\x30\x41\x7d\x59\x16\x5c\x30\x49\x4f\x5b\xe8\x06\xde\xab\xb8\x0e\x64\xd9\x58\x34\xd3\xd8\x07\x5f\x17\xf0\xaa\x26\xcb\xd3\xa4\xc9\x14\x56\x9c\xd5\xb0\x4f\x17\x0e\xc1\x92\xab\xe0\x0a\x40\x30\x22\x69\xc6\x6f\x83\x8d\x09\x2d\xdf\x57\x7a\x14\xe4\x80\xd5\x88\x35\xfd\xde\x46\x7a\x33\xac\xff\x7b\x61\xef\x85\xa0\x48\x8d\x2b\x09\x2c\xa0\xb6\xd0\xa0\x77\x16\x33\xb1\x02\xb6\x15\xdb\x34\xf5\x76\x12\x3d\x11\xce\x5e\xac\x1c\xa0\xb3\xdd\x53\x7e\x7b\xb5\xf7\xcf\xba\x37\x20\xaa\xfc\x91\x2f\x05\xb1\x76\x21\xab\xf7\xf2\xa2\x4d\x3b\x50\xae\x41\x92\x90\x26\xe3\xac\x8e\x5e\x3a\x0a\xf1\x77\xc2\x1e\x6c\xb2\xa8\x65\x5f\x3c\x95\x20\x2f\x1f\xbe\x02\xe7\xc3\x74\x51\xad\x86\x07\xc9\x53\x11\x53\x23\x0d\x1b\xe8\x19\xa2\x7a\x62\xc7\x4f\xd4\xb1\x4c\x73\x58\x48\x2d\x6d\xaa\x22\x34\xc6\xf1\x7e\x86\x27\xea\xec\x62\x3f\x4c\xd2\xf2\x2f\xaf\x98\x4c\x95\xa4\x7f\x75\x9d\x24\x8f\x9b\x1d\xdc\x6d\x4d\xc2\x24\x66\x2e\x46\x8f\x03\x3f\xd9\x85\x15\x91\x3a\x83\x30\x54\xcc\x7b\x1b\x35\xb3\x80\x4b\x67\x7d\xce\xf7\x9f\x7c\xe5\xc1\x1a\x8b\x22\x6e\xc1\xa7\x51\x42\xd0\xe6\x58\xa3\x63\x75\x8b\x67\x92\xb7\x60\xed\x35\x7e\x2a\x1f\x4f\xe3\x59\x6b\xbf\xb2\x37\x21\xef\x4a\xe6\xc6\x49\x68\xf0\x59\xb3\x85\x01\xf3\x52\x0d\xec\x53\x67\x9f\x47\x9a\x79\x3f\xbf\xb7\xff\xe9\x39\x01\xef\xc9\x0f\xcd\x3a\x5a\x1a\x80\x87\xf0\x01\x05\xba\xe1\xa5\x2e\x1e\x42\x76\x8a\x43\xf5\x5a\x3e\x89\xca\x54\x20\x88\x9e\xbd\x92\xc4\x19\x59\xbf\x46\x8a\xa0\xa2\x56\x67\x4b\x94\xb2\xf2\xaf\x60\x03\x18\x8f\x53\x2c\x00\xcf\x68\xf1\x3b\x50\xa9\x64\xe5\xb2\x86\x0f\x80\x85\x2e\x25\xfc\x47\x15\x00\x88\x64\x62\xb5\xa2\x61\xc1\xad\x41\xb7\x58\x08\x91\x2d\xf6\x91\x84\xfa\xee\xb2\x15\xbf\xc6\x1d\xcd\xdf\x3e\x54\xc0\xa0\xb4\xe9\x49\x54\x12\x37\xb4\xf4\x62\x07\x35\xa9\x7c\x38\xd6\xee\xe8\x81\xf7\x12\xae\xce\xde\xff\xba\xf5\x9c\x4e\x5f\xc9\x21\x37\x6c\xf5\xc5\x9a\x99\x95\x63\xc3\x48\xcf\x86\x43\xf9\xf0\x4c\x1a\x11\xcb\x6a\x60\xf4\xc0\xcd\xe3\x10\x0c\x3c\xfd\x35\x34\xb6\x4e\x14\x8d\x58\x90\x68\x29\xfd\xda\x05\x20\xd8\x8b\xba\xb7\xf5\xd3\xb5\x53\x82\x05\x75\xf1\x76\x9f\x5b\x55\x5b\x09\xae\x14\x23\xaa\xc8\x10\x8b\x56\x04\xae\x21\xa1\x17\xd2\x34\x2a\x81\x38\xb2\xbe\x4b\xe4\xb0\xb5\x3f\xbc\xdf\x6f\x4f\x74\xe8\x16\x0d\xd6\xf2\xe1\x8d\x3c\xbe\xf4\xf6\x12\xeb\x88\x10\xc4\xbe\x21\x56\x37\x28\xd2\x43\x1f\xe0\x0f\xa0\xb9\x4b\xaa\x42\x7d\x05\xff\x9e\xe5\xbf\x7f\xff\x23\x0c\xa4\x87\x28\x92\x07\xee\x62\x62\x6d\x10\x5c\xbe\x67\x54\xd0\xdf\xbd\x09\x68\x2c\x4e\xe2\x87\x47\xb1\xd2\xab\x95\x2a\x4f\x66\x6e\xd6\xa1\x33\x07\xb4\x10\xae\x20\xb9\x4f\xf6\x6e\xef\x79\x0d\x8a\x02\xd8\xca\xed\xd0\x9d\x0c\x89\x82\x46\x38\xdb\x0b\xa7\x04\x84\x03\x93\x4b\xf7\xa9\x1a\xa9\xe0\x1e\x71\xa3\x89\x15\x99\x93\x4c\xcd\x93\x56\x03\x6d\x9d\x26\x8f\x3f\x9d\x22\xa7\x1b\x47\x79\xb0\x88\x8c\x84\x8f\xf0\xe0\x43\x81\xf1\x7a\x63\xf7\x34\x38\xd8\x83\x30\x76\xc5\x8f\xbd\x68\xd0\x20\xfd\x13\x1b\xf4\xa9\x77\x51\xcf\x3b\xa9\xc0\xea\xc6\x6e\xcc\x6e\x3c\x41\x04\xe7\xa1\x34\x76\x76\x8a\x8a\x1b\xc3\x98\x9a\x60\x68\xc9\x04\x63\x34\xd0\xa7\x0c\x3c\x31\x1f\xec\x9d\xa7\x6a\x3d\x67\x8a\x5d\x0f\x7e\x8d\xc7\xc6\x45\xf3\xcd\xf6\x92\x74\xd4\x0b\x91\xdc\xef\x75\x24\x7a\xb0\xb0\xb7\x53\x63\x77\x73\x58\x28\x0b\x06\xdb\xad\x31\xf4\x29\xff\x0c\x4e\x50\x91\x6f\x85\x0d\x83\xa1\xb5\xf0\xaa\x10\xff\x90\xd7\x52\x80\x98\x80\x0b\xd2\xf9\xbe\xed\xef\xf7\x06\x08\x70\x05\x43\xf0\x15\x68\x78\x8d\xea\xbc\x87\xbe\x29\x89\xd5\x2c\xd8\xfd\x4b\xcc\x94\x95\xb6\x64\xc1\x82\x68\x44\xaf\xd7\xe2\x5b\xc2\x8e\x7d\x99\x2b\x61\x22\xae\x2e\x1c\x0d\x12\x71\x25\xd9\x6f\x76\x07\xa3\x84\x62\xa0\x86\x8b\xda\x6c\x53\xd2\x48\xfe\x3a\x78\x59\x5a\x9c\x49\xf6\xa3\x30\x03\x20\xd3\x4f\xc7\x0c\xf3\xaa\x8c\xfc\xd7\x61\x47\xa2\xaf\x6c\xd3\x02\xf6\xd1\xa8\x31\x4c\xc2\xed\xc2\x4a\xe2\x2c\x7d\x00\x9f\x0c\xc1\xe4\xd6\x26\xf3\xad\x98\xd5\xc5\x57\x4c\xbc\x00\xc4\xa8\x86\xb0\xbf\x6e\x58\xfb\x98\x50\x7b\x88\x12\xcb\x1b\x8b\x71\x45\x83\x40\xe8\x90\x59\x07\xcd\x7a\xc4\x9f\x82\xbc\xfa\x10\xd4\x4d\x7b\x84\xfd\x7c\xdf\xc7\xf9\x42\x5e\x05\xf1\x55\x42\x99\xb0\xe9\xa6\x31\x3c\xd4\x89\x58\x42\xab\xa9\x52\xf9\x16\x24\x50\xd5\xf8\xe0\x7b\xd2\xd7\x3d\xce\x27\xc1\xe8\x9f\xfb\x81\x36\x57\xb5\x77\x86\x96\x8d\x07\x98\xf8\x3e\xec\xd2\xb3\xb8\x57\x67\x9c\xc6\xc9\x90\xeb\x59\x14\x7e\x1c\xcd\x88\xd9\x3d\x34\x72\x3d\x2c\xbd\x5d\x0f\xca\xcd\x59\x60\xda\x69\x3e\x13\xf8\xa5\xa5\xc0\xf2\xba\xeb\x3d\x16\x29\x40\x23\x76\x6d\x84\x45\x60\x6a\xb8\x9f\x71\xa3\x2a\x86\x5a\xa6\x07\x70\xfe\xe1\x29\xa8\xc1\xca\xb0\x8a\x05\x62\x94\x4d\xac\xbf\xef\xc1\x55\xbb\x78\x38\x00\x4f\x2f\x16\xb7\xd8\x84\x1e\x57\x49\x4f\x04\x4f\x33\x5a\x95\x69\x87\x3b\xaf\x42\xc8\x46\x40\xeb\x07\xdf\x08\x8a\x6d\xd5\xd4\xeb\xef\xfb\x63\x7a\x9b\xb9\x9f\xb3\xe0\xb9\xda\x2d\x9d\x0f\x77\xb1\xde\x76\x1f\x81\x89\x66\x0e\xc2\x95\xc6\xa4\x23\x4d\x51\xa5\x1e\x55\x57\x44\x9f\x4e\xe5\x90\x17\xfe\xb9\x07\x45\xa6\xbc\x57\x1e\x6a\x27\x5c\xb0\xc9\x0b\x02\xdc\x29\x46\x3b\xf9\xa6\x0e\x4a\x42\xf0\x5e\x7c\x11\x30\x8d\xa8\x00\x9c\xa5\x0f\xab\x68\xb7\xae\xf8\xb0\x0e\x35\xae\x04\x73\x40\x95\xc9\xa9\x85\xdb\xc5\xc2\x8f\xf7\xe7\xda\x93\x3c\xe2\xa0\x2b\xe8\x58\x46\x2e\xb7\xc1\x99\x99\xd9\x05\xb5\xac\xd9\x2b\xa2\x87\x6e\x0d\x57\x12\x6c\xb4\x0f\x7f\x32\xcc\x63\x03\x47\x7d\x8d\xc9\x4e\xda\x87\x36\x0f\x19\x19\xa4\x45\x08\x50\x0b\x4f\x09\x1b\xdb\x8e\x41\xa2\x09\xe3\xa8\x73\xee\x30\xe8\xe6\x23\xc6\x37\x92\x00\xff\xdb\x87\x85\xe1\x8b\xbc\x00\x0c\x37\xd8\xb8\xbf\x10\x04\x2a\x86\x54\xb1\x19\xd7\x56\x52\xaa\x14\x75\xc3\xe5\x7d\xd2\x6a\x81\x5b\x92\xd5\x5e\xbf\x90\x0f\x54\xe2\xf1\x36\x15\xe7\x81\xbf\x6e\x5d\x40\xcc\x48\x3f\xb4\x86\xe1\xb3\x1a\xf2\xc3\x69\x6b\x7d\x9e\x80\xf5\x60\x2b\x5d\xb6\x3f\x2b\x4b\xed\x19\x55\x59\x43\xfb\x8b\xbf\x7b\x63\x19\x17\x22\xaa\x4c\x87\xc7\x31\xd9\x3d\xcb\x9e\x8a\x87\x07\xd8\xe3\xe3\x97\x8a\x49\xee\x60\x7d\x5e\x83\x9f\xa2\x0f\x05\xf1\xae\xee\x67\x1a\x7c\x54\xe9\x7f\x70\xd6\x7d\x38\xc5\x20\xa4\x7c\x43\x70\x11\x2f\x6b\x10\xc8\xb9\x93\x1d\x71\x9e\x7e\x0f\xb3\xe1\xe1\x68\x0e\x8b\xfa\x96\x8b\xf5\x9e\xf7\xd2\x52\xdb\x46\xa4\x98\x5f\x41\x33\xc9\xfb\x43\xc0\xe0\x3a\x8d\xa3\xf5\x0d\xbc\x74\x60\xff\xeb\x5e\x97\x85\xcd\xbf\x05\x8a\xcf\xb6\x2c\x1b\x03\xaa\x5a\x93\xe3\x65\xd5\xab\x49\xad\x60\xb2\xac\x21\x62\x82\x2c\xfc\x2d\xdd\xec\xfa\xd8\xb3\xa8\x59\xcd\x93\x5c\xe6\x8e\x86\x81\x07\xb4\x39\x1d\xc2\xc2\x07\x82\xe2\xfc\x52\xbc\xf4\x12\x90\x49\x19\x6d\x42\x58\xf1\x94\x29\xbd\x15\x1a\xaf\x51\x30\x4c\x91\x43\x4e\xee\xa8\x36\x4e\x94\xe0\xac\x7d\x96\x24\xa6\x6d\x06\xa2\x41\x9d\xa1\x34\x74\xae\xe2\xd3\x84\xad\x9f\xf5\x97\xa7\xe7\x75\x3a\x0e\x83\x1f\x3d\x1c\x78\x0b\xce\x42\xa4\x58\xac\x91\x10\x4d\x58\x01\x31\xf8\x73\x74\x79\xb7\xd2\xf2\xb0\xde\x46\xab\x40\x07\x93\xbd\xe5\x75\xbc\x71\xd5\xea\xaf\x09\xe3\xc7\x49\x5f\xef\xa2\x69\xf8\x1f\x7b\xf5\xf3\xb6\x2f\xbd\xb5\xcd\x86\xfb\xa0\x6f\xd1\xb2\xb0\x18\x8b\xcb\x32\x09\x59\xeb\xe9\xd0\xe1\xe6\x72\x13\xee\xb7\x8d\xc4\x7b\x1d\x3a\x77\x77\x53\xaf\xab\x9d\x0e\x6f\x2d\xd2\xeb\x67\x04\xb0\x75\x4f\x85\x9b\x71\x5e\x7c\xc9\xf1\x03\x35\x23\xfb\x32\xa6\x05\x41\x07\xff\x49\x9a\x76\x72\x14\x7d\x15\x38\x3b\x0f\xe3\xcb\xb7\x53\x41\xe1\xa1\x1b\x31\x09\x6f\xd6\x9b\x99\x01\x45\xf0\x86\x1d\x69\xd4\x91\xb0\x49\xdd\x42\xd6\x61\xef\xe2\x9c\x9d\xf8\xcd\x49\xbc\x3d\xa6\xa4\x40\x01\x55\x06\x06\x81\x38\x2b\x89\x8d\x58\xd4\xc5\x8b\x2a\x7b\x29\x5c\xcc\x28\xe0\x99\xd8\x1e\x0e\x25\xb7\x90\xe7\x3f\x0c\x11\x2d\x4d\x42\x0b\x60\xe1\x7b\x9b\xd4\x5e\x3d\x9a\xa0\xea\x79\x4e\x12\xcc\xcc\xcb\x7e\xf6\x20\x4b\xfa\x2e\x85\x0e\x28\xb9\xa2\x56\x39\x41\x5c\x78\x6a\x39\xaf\x72\xb8\x11\x71\x0b\x0a\x27\x85\xf0\x77\x16\xf3\xe7\x53\xda\x5b\xf0\x70\xd7\x70\xf3\xc1\x77\x06\x30\xf3\xab\xbb\xfa\xd6\x7f\x6e\xef\x31\xbe\x4d\xe5\xff\xbc\xe5\x85\xd3\x92\xf0\xfe\x1b\x1c\x88\xe4\x9d\x88\xc1\x7b\x30\x2a\x17\x59\xd7\xbb\x95\x41\x70\x1f\x6c\x7c\xda\x27\xef\x2d\x73\x57\xbd\x36\xd8\xe3\xf0\xb7\x6a\x7f\xb5\x3e\xe3\xaf\xfe\xc0\xc8\x9d\xd8\x3a\x12\x1e\x33\xdc\xe3\x2a\xd8\xa3\x7f\xe3\xe9\xea\xad\xb4\x1b\xe3\x96\x71\xfa\x47\xb0\x5b\x88\x0a\x3b\x6c\x7d\x0c\xee\xc9\xde\x68\x65\x77\x3b\x62\xac\x3f\xc1\x84\x06\xa9\x14\xc6\x64\x5c\xd0\x15\x13\x47\x7d\xa6\x1e\x47\x38\x3c\x67\x4a\x23\xce\x0d\xe3\x86\x94\xb4\xc1\xc6\x86\xf1\x79\x18\xae\x37\x30\x95\x35\x44\xcf\xd2\x95\x97\xff\x46\xf4\x5f\xbd\x6b\x71\xee\xd0\x8f\x54\x9a\xd1\x4b\x5d\x54\x75\x7b\xb2\x86\x45\x94\x35\x8f\x3a\xbf\xec\x1a\x8c\x48\xd1\xb7\x04\x98\xd1\x7a\x27\xff\x7a\x9e\x1a\x43\xdf\x98\x48\xf4\x76\xed\x77\xd6\xe9\xab\x98\xf5\x92\x74\xdb\x5e\x96\xe8\x3a\xe8\x2b\xec\x17\xe1\xfe\xf5\x1e\xea\xbb\x86\xd9\xf6\xad\xe5\x6e\x9c\xcf\x29\xdc\x05\xd7\xce\x5a\x26\x31\x9a\x90\xa0\xe6\x3c\xfd\xc3\xbb\x72\xa7\x60\x26\x23\xc7\x0e\xed\x0c\x62\xdf\x86\xef\xdd\x70\xa9\x45\xab\x87\x6b\x33\xd1\xf2\x07\xfe\xf5\x1f\x4c\xd8\x50\xe1\x8c\x20\x64\xb3\x8a\xd5\x58\xef\x7a\x80\xb1\xe4\x23\xe7\xba\x67\x70\x79\x01\x42\xa5\xf5\x47\x3f\x71\xae\xd0\xd9\xb3\x90\xeb\xd6\x2c\x58\xb3\x4a\x71\xba\x01\xb1\xf6\x10\x0f\x6b\xc3\xf3\x8e\x0d\x61\xf3\xa8\x02\x5c\xd8\x49\xea\xdc\xb2\xae\x06\xb2\x73\xa8\x35\x1f\xaa\xe2\xf9\x49\x3b\x95\xdc\x8b\xce\x70\xd3\x5e\x2a\x69\xde\xcb\x46\x71\xae\x6e\xb1\x5a\xcb\xc7\xd3\xcf\xbd\x6e\x6d\xa6\xb7\x23\xc5\xd4\x6b\x97\x1c\xfe\x66\x83\x4c\x05\x13\x2e\xa6\xe8\xff\xde\xcf\x66\x40\xfa\x9a\x23\x6d\x02\x3f\x87\xb2\x05\xb8\x81\x86\xa5\xfa\xf7\xe9\x2c\x54\x44\x63\x55\xc4\xda\xcb\xa0\xf4\xc6\xc4\xdc\x34\x7d\xef\x11\xb8\xe7\x3d\xad\x6a\x27\xf4\x20\x61\x7e\x0d\x02\x1f\x8b\x06\xfe\x9d\x1b\x20\xc5\x14\xc2\x70\x33\xe3\xbb\xc5\xa9\xa1\x2a\x8d\x1c\x1b\x69\x94\x3f\x2d\x73\x5d\x14\x32\xe7\xc1\xa6\xed\x80\x08\x9e\xd3\xa8\x3d\x70\xf9\x85\xad\x46\x45\x16\x96\x19\xae\x1e\x7c\xf3\xe4\x56\x5d\x51\x6d\x94\x1f\x3c\x29\x90\x62\x4e\x34\x37\x48\x9d\x38\xdb\xb5\xcf\xd6\x21\xe3\xa8\xb7\x53\xf7\xa6\x68\x9e\x5f\x85\xc4\xeb\xcf\xb6\xd8\xe1\xad\x16\x79\xa1\x28\xdf\xc2\x60\x68\x6f\xf0\xcd\x43\x33\xcd\x15\x7a\xda\x88\xc2\x37\x4f\x12\xdb\x30\xba\x8e\x76\xbd\x73\xb6\x0e\xbb\xf5\x80\xd2\xfa\x89\x8e\x71\xd9\x85\xe7\x5c\x96\x0c\xc2\xd4\x7b\xa9\xa8\x81\xea\x8e\xf7\x40\x30\xab\xd5\xd6\xbc\x07\x7a\x85\x22\x70\xc5\x90\xd0\x77\x4e\xc3\x22\x08\xdc\x53\x56\xc7\x14\xb3\x60\x75\x1c\x24\x6a\x7c\x8d\xb0\x79\x19\xa8\xe9\xa9\x34\xfd\x6a\x96\x64\x34\x76\x7d\x66\x37\x69\x53\xbf\xe3\x39\xc3\x83\x02\x84\xec\x62\x8c\x63\x07\x31\xda\x15\xbc\xa8\x8e\xad\x92\x66\xc9\xf7\x66\x92\x8c\x51\xe0\x07\x93\xdf\xf3\xa5\xf3\xfa\xbf\xb8\x17\xf0\xbb\x8f\xc1\x0d\x56\x27\xe0\xd5\x9a\x07\x6b\x7a\x43\x70\x61\x54\x5f\xf6\x3d\x8e\x81\x72\x90\x67\xab\x0d\x78\x2a\x0d\xb3\xd5\x16\xc7\x43\xb4\xcd\xcc\xce\xcc\x00\x9c\x9d\x83\x0b\x86\x32\x4a\xd3\x7b\xac\x2f\x97\xe0\x13\x3f\x59\x86\xfb\xf4\x63\x1c\x83\x7a\x86\x61\xeb\x74\xa2\xa8\xcc\x7b\x75\xd3\xab\x60\x4c\x37\x04\x07\xd6\x7c\x7b\xaa\x6e\x1d\x80\x63\x99\x46\xb2\x5a\x90\x4c\xf9\xb2\xbe\xf9\xcf\x2d\x29\x01\x5f\xe6\xfb\xfe\x78\xc7\x20\xbd\x05\xe5\x01\x1d\xe4\xe2\xcc\x7a\xda\x33\xb9\x3c\xa1\x1e\xcc\x7e\x3b\x04\x18\x0e\x99\x5c\x37\xb3\xa4\x9f\x6e\xb6\x4e\x3b\x8e\x57\x99\x76\x90\x07\xf1\x83\x52\x55\x70\xd6\x0f\x68\xd9\x79\xa8\x3e\x5c\x5f\xd9\xd8\x15\xe4\xf5\xe9\xd4\xdd\xf9\xf3\x0a\x08\x65\xf5\xaa\x1b\x49\xde\xa2\x04\x50\x76\x98\x43\x44\xf0\xc8\xd9\xf3\x0c\xec\x16\x6d\x66\x44\x6e\x0c\xd5\xe6\xee\x2a\xfc\x56\x9b\x3e\xa1\xff\xd6\x42\x9c\xd6\x28\x09\xb4\x90\x1a\x83\x1c\x9a\x56\x57\x94\xa8\x10\xb3\xab\xae\x2d\xce\x7a\x03\x42\x5e\xc6\x0c\x7c\x96\x99\x0a\xb2\x18\xd5\xac\x1b\x01\x2b\x43\xcb\x9a\x01\x6f\xbd\xd7\x9c\x7b\x10\x2b\xff\xf2\x56\xd1\x96\xf9\xd8\x15\x97\xec\x84\xfd\xfb\x14\xf6\x5c\xde\x21\xd0\xec\x5c\xcf\x9e\xe7\xa9\xee\x40\xf5\x08\xb4\x0c\xfa\x45\x37\x9e\xcb\x56\x4c\xb7\x75\xc3\x3c\xec\x7f\x18\xcb\x3d\xb1\x7e\xea\xe2\x4c\x2b\xf6\xf6\xd9\x3c\xa2\x46\x4a\x97\xad\x76\x63\xdf\x73\x10\x5d\x74\x40\x01\x6d\xb9\xec\x8b\x6f\x1a\xe0\xd0\xc7\x62\x8d\x11\xb5\x64\x6b\x80\x0e\x25\xa6\x16\x79\xe3\xc3\xa9\xcf\x76\x56\x27\x7b\x61\x09\x1b\xd6\xb7\x68\x51\xd2\x6f\x69\x0c\x7b\x2b\x08\x6a\xe9\x10\xc2\x2a\xc5\x5f\x53\x59\xa8\xb7\xb3\x1a\xa8\x35\xc0\x7a\xae\x26\x89\xff\xdc\xbe\xb5\x1c\xd8\x2f\x75\x24\x1e\x56\x0b\x48\x05\x6f\x0a\xbe\x22\x38\xbb\xf7\x34\x70\xfc\xf7\xc7\xae\x27\x77\xd6\x7d\xb4\xd4\x2b\x0d\xb2\x53\xb0\xfd\x92\x9e\x43\xb7\x84\xa1\x45\x43\x0d\x36\xae\xc7\x1c\xea\x9a\x76\x2c\xab\xc5\xc1\xda\x4c\xeb\xfa\xba\x3f\xeb\xa7\x0d\x9f\x15\x4e\x8c\xd8\xa5\x71\x8d\xf2\xbc\x99\xb0\x00\x27\xc0\x65\x3b\x60\x46\x95\xc5\x36\x72\xa4\xc2\x65\xb8\xaa\x45\x2c\xbc\x3f\xdd\x50\xcf\x89\x67\x18\xba\xf1\x22\x7f\xbf\x13\x0c\x65\xab\x73\xc9\xd1\xae\x9f\xfb\xf4\x52\x32\x2b\x25\x9d\xba\x80\xf9\xf3\x6a\x58\x5a\xbe\xea\x61\x59\xd4\x54\x4f\xa7\x32\x3f\x8c\xc7\x35\x03\xe5\x61\x9d\x83\x44\x38\xd9\x11\x07\x13\xfb\xef\x1f\xba\x27\xb5\xec\x12\x23\x07\xba\xf3\xac\xe0\x7f\x5e\xc2\x4a\x4d\x6c\x95\x30\xbe\xf5\x55\xf4\x65\x62\x37\x76\xa9\x19\xa4\x04\xcf\xfb\x4f\x13\xe8\xdf\xef\x0d\x22\x7b\x9c\x2b\x1c\x2b\xef\x8b\x43\x19\x82\xd2\x13\xdb\x38\x56\x2f\xac\x1b\x93\xb5\x4a\xe2\x40\xaf\x3d\x37\x32\x90\x0b\xf9\x5c\xd8\x5f\xba\xaf\x89\x72\x7f\x8b\xa9\x6a\x48\xdd\xe1\x02\xc8\xca\xa9\x2d\x3a\xf0\x91\x8b\x9f\x63\x29\xbd\xae\xd3\x3e\xbd\xd6\xa4\x17\x39\x86\x1d\x6a\x64\x29\x47\x55\x7e\x42\x8a\x37\x7b\xbe\x98\xd4\xb6\x7d\xb5\xdf\xea\x97\x26\x72\xaf\xc6\xd5\x4b\x89\x5c\x4c\x2c\x60\x3e\xdb\x73\xd4\x95\xc3\x10\xf6\xee\x27\x74\xe5\x7f\x07\x76\xf6\x09\xa4\xd4\xd5\x69\x3d\x92\xbd\x8b\x82\x94\x35\xd3\xa0\x20\x89\xd3\x9e\xad\x9a\x51\xfe\xb4\x37\x4e\xd8\xb8\xbb\x10\xd1\xb2\xba\xea\xd4\xaa\xac\x5e\x99\x85\xf4\x07\x8c\x9c\xba\x94\x3f\x28\x30\x2c\x2b\x02\xd9\xc2\xbb\x02\xbb\xad\x1e\xc3\xfb\x7b\x5e\x4d\x97\xc3\x43\xbd\xd8\x1a\x13\x54\xc6\x0e\xb3\x2e\x86\xa5\xdb\xb4\x06\xea\x5e\x08\xc2\x2c\x66\x25\x49\x64\x54\x3c\x28\x9b\xb0\x06\x35\x30\x70\x4e\xf1\xe0\xa6\x9f\x20\x6b\xcb\xfd\x5b\x1c\xf7\x7d\x37\x5f\x28\x5c\xfe\x15\xb2\x78\x72\xff\xaa\xce\xf3\xf7\x6b\x33\xdb\x1f\x54\xc9\x7b\xb3\xdd\xd5\x31\xce\xc3\x30\xab\x62\x4f\xab\x53\x64\x5f\xb7\x96\xa8\xb2\xb0\x04\x1f\xc9\x17\xb8\xc5\x35\xc6\x73\x19\x9b\xe6\x72\x03\xd4\x37\x7d\x79\x25\x22\x12\xdc\x52\x52\xb2\xaa\x0b\x64\xe2\x6e\x60\x1f\x96\x0f\x2b\x1c\x2b\x41\xa3\xcc\x27\xd9\xe3\x30\x8c\xe6\x3e\x6c\x89\xd7\xb0\x9a\x00\xc8\x4a\xb8\x13\x94\xe8\xad\x9f\x71\x5d\xb7\x52\x86\x76\x13\xc1\xcb\xe5\xa5\x17\x42\xee\x4d\xa5\xaf\xc3\x40\x0e\xbc\xec\x82\x61\xf2\x6f\x69\x56\x68\x27\x69\x60\x6d\x20\xb7\x04\x49\x13\x4d\xdc\xf5\x64\x10\xf4\x63\xbd\xe7\x18\x92\x02\xf7\xfa\xfc\x8a\xea\xed\xd9\xbd\xe3\xd4\x72\x37\x21\x46\x28\x9f\xeb\x00\x4d\x0f\x7f\xe8\x79\x57\x68\x97\x30\xda\x74\x6b\xe2\xcf\x68\xa9\x60\xc4\x33\xc5\xc8\x7e\x64\x37\x68\xa3\x97\x38\x13\x26\xbd\xc2\x10\x36\x76\x6d\x81\x00\x05\xb6\x89\x9a\x34\x42\x15\x00\x79\xd1\x14\x36\xe6\xc1\xf1\xa0\xbc\xeb\xd2\x2c\x4c\xd0\x23\x4b\x58\xde\x15\xee\x39\x24\xe3\xcb\xe6\xfe\xa2\xff\x2c\xeb\x07\x32\xc1\x98\x34\x4b\x59\x02\x88\x39\xf1\x57\x40\xe0\xc8\x48\x09\x9d\x88\x8b\x7e\x10\x7c\xa1\xfb\xe2\x76\x35\xae\xbf\x5f\x8a\xb9\xdb\x2b\x10\x3b\x76\xb6\x5b\xb3\x73\x5d\x9a\xed\x5e\xce\x73\x17\xc6\xb1\xb3\x1a\x7e\xcc\x8c\xac\x70\x9f\xc8\x7a\x84\xa3\x55\xfd\xb1\xa6\xa5\xed\xed\xfd\xbc\xac\x33\xf1\x20\xf7\x70\x30\x65\x3b\xa0\x23\x41\x56\xff\xc2\xcc\x24\xe7\xde\xe2\x8e\x8f\xfa\x9d\xad\xb3\x3f\x40\x41\xa9\x16\x81\x5c\xe8\x22\x5c\x0c\x40\x91\xe9\xb6\xb7\x15\xcd\x8f\xf7\xc8\x9d\x1b\x42\xc6\xca\xb4\x07\xa1\xae\x51\x22\xda\xa3\x3c\x8a\x75\x52\x6d\x3b\xff\xbe\x30\x34\x0e\xf0\x88\xd9\x18\xe5\xbe\x2b\xc5\xae\x15\xf1\xc0\xee\x49\x95\x21\x79\x2d\x57\x7b\x54\x61\xdf\x8e\x5d\xd5\xfb\x77\x79\x26\xd1\x0f\x22\x31\x99\x99\xb5\x1e\x80\x91\x9f\x97\xbe\x74\xbd\x3a\xa3\x4b\x90\x01\xf8\x5c\xa3\x95\x98\x9a\xa1\xdf\xd3\x9a\x64\x35\x13\x38\x68\xc7\x80\x82\x18\xbc\x24\x20\xce\x62\xd2\x57\x55\xb9\x2b\x61\x2e\x3c\xa0\x41\x3d\x0d\x4f\xf2\xa8\xa1\xf0\x54\xe5\x06\x0d\x45\x0f\x1f\x2e\x53\x6f\x8b\x4f\x60\x17\xdb\x1a\xd8\x04\x79\x7b\xc0\x43\xa5\xf0\x24\xb1\x28\xd8\x4d\x22\x33\x93\x3e\x1f\x38\x12\xd8\xa8\x81\x15\xa4\xf2\xdf\xfc\x73\x51\x0e\x8a\xa6\x3e\xe9\x9d\x18\xfb\x56\xf5\xb8\x67\x3f\x94\x22\xec\x52\xf9\xdc\xfa\x00\xfa\x53\x7b\x22\xad\x2a\x93\x57\x9c\x69\x65\x4f\xed\x5d\x9e\xb1\xf2\xd4\xfe\x50\xa8\xe4\x42\xda\x83\xdd\x93\x90\x69\xb7\x85\x03\x9b\x5f\x5a\x85\xfb\x69\x35\x72\x86\xca\x46\xde\x69\xaf\xf3\xde\x3e\x10\x15\x4c\xdf\xdd\x1d\x08\x7c\x1e\x75\x67\x9b\xfd\xfc\x1c\xee\xda\x14\x4f\xf6\x49\x9b\x32\xaf\x02\x72\x2d\xef\x6a\x5d\xd7\xea\x9e\x61\xc2\x20\xd6\xe3\xc7\xe2\x6f\x5a\xba\x55\x78\xa5\x16\x55\x50\xfc\x9a\x74\x81\xe6\xf2\xa4\x90\xe6\x8e\xfc\x30\x02\xa3\xf5\xc9\xbe\xe2\xbd\x34\x18\xa0\xb1\xec\x74\xac\x49\xd1\x15\x2b\x8f\x05\x6b\xdf\xef\x8f\xbc\xf9\x6c\x8f\xa2\xe5\xad\x76\x34\x1c\xba\x81\x64\x2b\xb0\x3a\x00\x13\x96\xe1\xf3\xeb\x1e\x05\x2a\x46\x25\x8c\x49\xa3\x83\xb3\x74\x65\x3c\xda\x0d\x68\x55\xb5\x05\xdf\x39\x30\xba\xbe\x18\x2c\x50\xb6\x19\xab\xb9\x40\x9a\x52\x2d\xa1\x96\x74\x7f\x5d\x5f\xa6\xcc\xae\xd4\x2a\xaa\x67\x3b\xf1\x87\x8c\x03\xcf\x50\x8f\x19\x9d\xf5\x8d\xfc\x10\x0d\x83\x9e\x96\xa7\x99\x40\xeb\xef\xa7\x9b\x8b\xb2\x79\x31\x43\xbe\x11\x48\x1a\x8e\xfd\xc2\x83\xc5\x89\x04\x89\x90\x6f\x5d\x57\x0f\xa1\x84\xb7\x62\xc1\xbf\xaf\xea\xc5\xe9\xfd\xbf\x58\x8d\x13\x3a\x21\x34\x57\x78\x24\x2d\x18\x91\x4d\xb7\x27\xa4\x3b\x2e\x46\x78\x69\x01\x36\xec\xba\x48\xe0\x2e\x1e\xe7\xc6\xcd\xd6\x4a\x71\xe3\x45\xb8\xd0\xdb\xba\x1d\xbd\xdb\x8f\xcd\x8e\xde\x3f\x1e\x7e\x4e\xef\x1f\xec\x7f\x44\xef\xe6\xfa\x30\x93\x85\x35\x03\xda\x7b\xac\x48\x99\xf2\x6f\x6d\x8e\xbf\x2f\xba\xdd\x02\xf8\x61\xc3\x83\x7a\x5a\x93\x80\xf8\x57\xb1\x6e\xdd\xaf\x77\xe3\xd5\x9b\xc7\x51\xc7\x3a\xdc\x4c\x7d\x71\xcc\x56\xe3\xf3\x8d\xcb\x03\xc2\xf3\x1a\x80\x42\x2b\x35\xda\xbb\x10\x23\x0c\x9f\x0b\xe3\x80\x60\xff\x3c\xe5\x56\xea\x96\x1c\x77\x23\x2f\x04\x7b\x21\xb1\x0b\x77\xff\xbe\xa7\xdb\x7b\x07\xbf\xc7\x5a\xea\xb3\x1f\x10\x08\x34\x08\x03\x59\x89\x15\x9e\xce\xa8\xf7\xb4\x59\xe7\x6c\xb1\x0d\xbf\xa9\x9d\x4e\x88\xbf\x9f\xc3\xc2\xa8\x00\x92\xd8\xb9\x82\x8a\x3e\x2f\xfe\x16\xba\x15\x84\x20\xac\xa1\x59\x25\xd4\xb1\x24\x97\x73\x9b\x51\x16\x2d\x28\x42\x2e\x31\xf8\xc6\x9d\xe2\x0e\x5b\x98\x73\x84\x33\xee\xd6\xef\xdb\x95\x3b\x67\x81\x90\xff\xd6\x15\xef\xfb\x1e\x70\x12\xfa\xd6\xfd\xce\x30\x6e\xdc\x53\xe2\x07\xc3\x60\xd9\xc3\x3e\xee\x2a\x6b\x6a\xb8\x79\xb8\xcd\x41\x32\xac\xc9\x8c\x65\xdd\x00\xfd\xce\xb5\x03\xbb\x7e\x4f\x7c\x99\x19\x75\xb3\xd6\x32\x00\xd6\x87\x13\x58\x11\x83\xfd\xb6\xdc\x79\x12\x13\x54\x09\xb1\xa2\xd9\x42\xa2\x6f\xd6\x85\xec\x7e\x10\x30\x85\xad\xab\x84\x6f\xbb\xa0\xdc\xf6\x3a\xe3\x40\xfc\x51\xd6\xb9\xb7\x3f\xf9\x0a\xb9\xf1\x6e\x38\xac\x30\xae\x45\xce\x52\xf2\x96\x86\x4e\xbb\x77\x41\x05\xea\x2a\x36\xb2\x9f\x0f\xd3\xc1\xab\x6f\xc9\x03\x9a\xac\x44\xa7\x14\x80\x6c\xe5\xf1\x9c\xa9\xea\xcb\xab\x4e\x2f\xe4\x69\x06\x64\xc5\x9a\x7d\xa3\x35\x39\x93\x1f\xf4\x81\x9b\xf7\x70\x3a\xbe\x51\x7d\x57\xd9\x47\xa7\xbc\xf9\x35\x46\xf3\xdc\xee\xc4\x91\xdb\x74\x28\x00\x35\x06\x65\x82\x22\x61\x9d\x0f\x3e\xb2\xd7\x3a\x94\xdb\x03\x21\x25\x14\x35\xf7\xf8\xe2\x11\x1d\xd5\xc3\x95\x31\x40\x02\x95\xfd\xd3\x4a\xf0\x27\xbd\xe3\x8e\xd7\x71\x39\xb6\xca\x52\xab\x84\xb7\xbf\xef\x79\x9a\x2a\x29\x5a\x57\x62\x03\x5a\x31\x93\x14\x3e\xa1\x9a\x73\x23\xa4\x06\x82\x3d\x59\x89\x6b\x63\xf6\xba\x17\x6d\x49\x0d\xd1\x1a\x47\xd8\xf5\x04\x56\x9e\x13\xfb\xa7\xaa\xa2\xd7\x4c\xf7\x5f\x51\x9c\x3b\x9f\x76\x60\xc4\xce\x1e\xcc\x17\xdc\x32\xbc\xd5\x95\xae\x6e\x8d\x7f\xb9\x5f\x6a\x64\x97\x75\x26\xf8\xc9\x02\xd6\x00\x28\xe7\x2e\x59\xff\x64\x2f\xde\x6f\x52\x90\xf7\xb6\x56\xb8\x11\x8f\x9a\xc9\x39\xdb\x67\x39\x12\xf5\xff\x5e\xf1\xc8\x31\x20\x85\xb6\xfa\x46\x2f\x79\xda\x83\xbc\x21\x9f\x16\x07\x75\xcc\x76\xbd\x91\x74\x17\xd0\xbe\x3a\x4a\x4f\xfe\xc7\x52\x9d\xc5\x9e\xea\xb8\x75\x14\xcb\xed\xc2\xbd\x0a\x45\xfe\x47\x5d\x37\x50\x2a\x5a\x20\x76\x58\xb7\xda\xa4\x6c\x22\xf7\x77\x56\xc0\xf3\xdd\x7b\xe0\xa5\xdf\x9d\x73\xb4\x46\xdc\x28\x86\xa0\x35\xb0\x1c\xfa\xff\x7d\x05\xfb\x96\x94\x3d\xaf\x4c\x0a\xe6\xd1\x59\x5f\x60\x6e\x7a\x07\xfc\x24\xeb\x3e\xc0\x54\xaa\x67\xb3\x72\x85\x7b\xf5\x62\xa1\x90\x51\xa1\xdc\xf1\x13\xc7\xfe\x66\x96\xe0\x2d\xe2\x47\x84\xbb\x89\x16\x68\x23\x85\x29\xdf\x3f\xb2\xcf\x82\xeb\xe3\x47\x10\x8d\x0e\x13\xfe\x08\xb1\x19\x6c\x4f\xc6\x28\x8d\xa4\x19\x62\xc6\x71\xe1\x80\xda\x62\x57\x6c\xd0\xd3\x2f\x33\xdc\xa7\x23\x2d\x06\xbd\xb7\x60\xb2\xe9\xf2\x12\x21\x3f\x9b\x63\x64\x7e\x45\x8d\x62\xbd\xa4\x98\x0a\x87\x50\x8a\x4d\x16\x54\x84\x1e\x9e\xd6\xd5\xde\xab\x89\x29\x64\x81\x19\x6e\xb5\xc4\x53\x0f\xe1\xeb\x5e\x26\x16\x40\xb8\x1e\x07\x6e\x85\xb7\x7a\x8f\x97\xc2\x63\x33\xc9\x9e\x6d\x4f\x2a\x31\x3f\xc3\x87\xd9\x15\xaf\xdc\x8e\x30\xd2\xfc\x46\xd3\xff\x7d\x5d\x1d\xe4\xef\xa6\xe2\xfd\xa9\x02\x3f\xcc\xfa\x07\x1b\x8b\xc7\x2e\x64\x33\xa7\x76\x50\x71\x0f\x28\xba\x9a\xdd\x7f\x6d\x44\x7d\x2e\x90\x72\x68\xb4\x1e\xa2\x5d\xd4\x96\xec\xe2\xe6\x9f\x1a\x1e\x4c\xba\x57\xdb\xd8\xb9\xee\xe1\xc3\xe6\x21\xf4\x8d\x4d\xb7\x36\x84\x7b\x29\x39\x93\x3c\xcb\x8a\xef\x2f\x75\x02\x21\x8c\xec\x15\xcd\x53\x57\x3e\x73\x48\xdd\x1e\xbf\x15\xc7\x6e\xb5\x38\xe3\xa4\x74\x78\x2a\xdf\xbe\x6e\x9c\xf3\x38\x3a\x5c\x0d\xf6\x12\xfe\x0f\xaf\x0f\x47\x66\x66\xdf\x4b\x56\x81\x7f\x21\x49\x16\x08\xfe\xbf\xf3\xbb\x73\xb8\xea\x78\x77\x88\xda\x5f\x70\xdb\x63\x40\x78\xfd\x1e\x83\x9c\x02\x12\x77\x9c\x9a\x73\x2c\x9c\x13\xa2\x64\x23\xa7\x06\xb7\x29\x21\x2c\x00\xe6\xfa\xa0\x45\x55\xec\x2e\x9b\x72\xa9\x0f\x84\xaa\x22\x53\xe1\x7a\xab\xa3\x1a\x5e\x4a\xb4\xa6\x47\x01\xd8\x98\xfb\x68\x87\xc6\xa5\x29\x2e\x16\xc3\xca\xbc\x24\x0d\xec\x60\x8a\xa0\x49\x99\xa7\x19\xcc\x80\xf6\x50\xfd\xb8\x17\x1f\xfb\xd1\xdc\x42\xb8\x6f\x1d\xa8\x2c\x6c\x14\x6a\x79\x95\x50\x45\xba\x71\xe3\xc0\x07\xc6\xe0\xaf\xfb\x68\xf8\x7e\xce\x3e\xe2\x1d\x92\xf5\xf0\x94\x0f\x05\x97\xfe\xd5\x5d\xbc\xbc\xdb\x8d\x1b\x95\x19\x26\x83\xda\x77\xc1\x96\xdd\x7f\x90\x65\x02\x9f\x08\x14\x7d\x34\xa1\x28\xee\xeb\x58\x13\xb6\x5e\x4b\xb9\xc4\x8d\x39\xa1\x67\xba\x5d\x56\x17\x04\xd1\x4b\x9e\x16\xdc\x31\x0d\x6f\x17\xc5\xf3\xdc\x04\xec\x59\x91\xd1\x28\x3c\x30\x2f\x8d\x44\xea\xa7\xb1\xbe\xd9\xff\xad\x4f\x16\x70\x05\x89\xbe\xbb\x38\xb3\x03\x4e\xc0\x4a\x6d\x03\x2e\x38\x55\x9a\x2a\x40\xf7\xb6\x7d\xd2\x10\x16\x17\xfc\xd4\xa1\x50\x26\x0b\x3a\x61\x5b\xff\xfc\xc0\x52\x65\x53\x14\xbc\x00\xac\x1d\xe4\x30\x23\x38\x2f\xea\xd8\x62\xc3\x36\xb6\x3f\x73\xc8\xf7\x06\x3e\x88\xd3\xa6\xed\x45\xb9\x3c\xa9\xa1\xd4\x81\x57\x8b\x3a\xf5\x86\x0d\x5a\x95\x98\x68\xc2\xe1\x07\xa5\xb9\x47\xa6\x72\x2e\x03\x61\x80\x57\x85\x97\x09\xa9\x11\xac\x39\xe4\x49\x1b\x44\x6f\x79\x78\xaa\xe5\x2d\x15\xb7\x5d\x4a\x7c\xc6\x61\xdb\x62\x1f\x33\x29\x2f\xd3\x93\x13\x7e\x96\xa8\xd7\x24\xea\x09\xd5\x89\xe3\xac\x9a\xc6\x73\x66\x77\x0b\x0f\x66\x7e\x6b\x14\xdf\x1a\xc1\xc2\xc3\x39\x55\x05\x3e\xda\x35\xa0\x87\x6f\xa9\x85\x8b\xb8\x2f\xcc\x79\x7f\x92\x32\xa9\xb4\x07\x53\xf9\xb6\x82\x23\xe4\xb8\xdc\xdc\x9b\xff\x50\xdc\x33\x9a\x7c\xc0\x6a\xae\x8a\x65\xe2\xe4\x0a\x0c\x38\x8c\x1b\xee\xf5\xf8\x1c\x0a\xfa\x5b\x67\x43\x92\xa1\xf3\x38\x2e\x98\x1c\xc5\xd8\xc5\xc4\x34\x91\x73\x80\x44\x00\xb6\x4c\xb2\x93\xc4\xa6\xc8\xc1\x4d\x4d\xc1\x78\xb4\x62\x17\xd6\xa4\xa6\xbd\x7a\x90\x90\x51\xc4\x5a\x76\x56\x2f\x5e\xab\xdc\x10\x8c\xdf\xb4\xcd\x63\x69\x49\x5a\xda\xe3\x52\x6c\x2c\xef\xeb\x14\x57\x59\x0c\xdd\xff\x29\x44\xb2\x2c\x77\x45\x30\x57\x26\xdb\x08\x92\x58\xa8\x81\x6b\x46\xd2\x7e\x41\x3d\xbd\x27\xb5\xe7\x0b\x1f\x06\xb4\x6e\xdd\x2b\xd1\xec\x8f\xbb\x76\xbe\x37\x3b\xcd\xdd\xcb\xd6\x0d\xf9\x35\x79\x0e\x79\x3f\xc3\x0d\xd0\x3f\xc1\xd6\xa3\xfe\x11\xb8\xfa\x9e\x5d\xd4\x8d\x62\xad\x13\x1a\xfe\x81\x9d\xbc\x9e\x38\x57\x52\xf1\xbf\x80\x29\x2c\x65\x7c\x00\xb5\xc8\x81\xc5\x45\x34\xb2\x6c\xd6\x77\xc7\x20\x32\x2a\x50\x95\x99\xbd\x35\x24\xdc\x09\xbf\x29\x74\xe7\x85\xf9\x9d\x09\x21\x63\x05\xcd\x5d\x4c\x06\x71\x09\xb4\x3a\x6a\xf3\x46\x9e\x80\x39\x17\xfc\xd3\x36\x4a\xa8\xad\x9a\x13\x83\xf0\xab\xb0\xf7\x0a\x60\x43\xc6\xf0\xaa\xa5\x3f\x8c\x64\xac\x92\xe2\xa4\xe4\x55\x4b\x1f\x3c\x1c\x53\x8a\x0b\x31\xac\xe1\xbc\xfb\x67\x69\x10\x6a\xd9\xe7\x08\x40\xa3\xb5\x3c\xb3\xa2\x37\x04\x0d\x48\x6e\x6a\xc4\x3d\xf4\x6c\x6f\x12\xf8\xed\x04\x83\x14\x4c\xf1\x95\xb4\x38\x6d\xae\x5e\x34\xaa\x65\x9b\x58\x3b\x00\x5c\xca\xab\xff\xdc\x8b\x09\xaf\xf1\x82\x27\x2b\x19\x00\x0e\x4b\x54\x46\x22\xc6\xa2\x9c\x7a\x51\xb6\x01\xc8\x38\xb4\xf0\x8f\x3c\xdf\x09\xa3\x6b\x88\xa9\x53\x73\xff\x2d\xb8\x51\xa9\x60\x94\x85\x89\x7a\xc9\xaf\xa8\xb4\xc6\x7f\x36\x6d\x87\xc2\xc2\x7a\x8c\x4d\xdd\xbf\xff\xfd\xe7\x7d\xba\x33\x8e\x01\xb8\x7f\x73\x76\xc0\x1e\xae\x52\xbc\x9f\x8a\x1e\x75\x08\x28\x5a\x34\x99\x71\x80\x5c\x2d\x21\x02\x03\x97\x09\x1e\x33\x02\x6d\x24\x8c\xaa\xd5\x73\xd8\x8b\x83\xce\xcc\xcc\x0f\x94\xa1\xea\xb3\xf4\x9a\xec\x6b\x42\xad\x7a\xfe\xb9\xbe\x29\xce\xfe\xd6\xb0\xd7\x5c\xd0\x87\x16\x06\x75\x44\x05\xfa\x8d\xa4\x7b\xda\x37\x32\xe0\x1c\x4d\x64\xc4\xc7\xf0\xf7\x47\xe6\xe2\xc2\x32\xb1\x74\xc5\x87\x09\xc4\xdc\x0a\xa0\xd9\x7a\xd3\xd2\x74\x22\x1b\xe1\xd4\xad\xf4\x6a\x85\x5a\xc8\x3d\xf4\x6f\x59\xc1\xaf\x64\x0e\x70\xda\xac\xff\x82\xb3\xb0\xd9\x38\xe3\x96\xad\xb4\x88\xea\x2f\xcd\x15\x52\x7c\x63\xdb\x0e\xbc\x78\x3b\x22\xbb\x97\x62\x92\xb0\xce\x28\x6a\xc0\x13\xbe\xee\xbd\xd2\x19\x40\x10\x25\xba\xa7\xd7\xe8\x90\xbd\x96\x48\xd6\x10\x4a\x30\x3e\x79\x2e\x75\x98\x43\x40\x67\x84\x91\x77\xe4\x05\x36\x9a\x55\x04\xf5\x5e\x55\x4c\x45\xe1\xb4\xe1\x47\x2c\x76\x68\xde\x45\x71\x5d\x47\x14\xc1\x9a\x17\x35\xa0\x58\x0b\x2f\x6b\xc0\x47\x70\xd8\xd4\x29\x1b\x9e\xbd\xaf\xac\x0e\xd6\x7f\x28\xc4\x7d\x3d\xa6\x0d\xc2\x79\x6c\x76\x3a\xa3\x58\x1e\x3a\x33\x17\x2a\x93\x3a\x0a\xc2\xe3\x58\x67\xb1\xe7\xf3\x9c\xc1\x6f\x6f\x89\x77\x8a\xeb\x12\x50\xc3\x74\x62\x59\xba\x8d\x64\x04\xbd\xce\x99\xd6\x15\x14\xe9\xcc\xbc\x91\xa4\xfb\xc6\x2d\x6e\x41\x86\x11\xa8\x8d\x2d\x11\xb1\xcf\x7e\xe0\x28\x6a\xbe\xcd\x8e\x4d\x92\xb0\xd0\xe4\x39\x81\xad\xa9\xa1\x65\xed\xd9\xb9\x54\x87\x30\xe7\x5f\x2b\x32\x0a\x11\x05\x17\xb6\x4a\x15\xc6\x18\x52\xe6\x38\xc2\xad\x60\xcb\x1a\xf6\x1c\xd7\xac\xd3\x52\x6b\xfb\x55\xd6\x56\x08\x9d\x32\xc1\xb9\x4f\x36\x19\x71\xbb\x6f\x00\xf7\xce\x60\x0c\xce\x06\x08\x43\x2e\x80\x97\xda\xdc\x07\x85\x0a\x0f\x0f\x8e\x87\x10\xef\x1d\x35\x51\xd7\x3c\x17\xa5\xdf\x4f\xb8\x6c\x96\x70\x1f\xe9\x0c\xf4\xb4\x4a\x55\x8b\x74\x8f\xf6\x24\x34\x40\xd9\x6b\x94\x10\xd1\xf0\x36\x91\x5b\xf2\x60\x81\x8d\x12\xf5\x54\x60\x63\x46\x29\xb4\xd1\x05\xb1\x5a\x9c\xe7\x4b\xf9\xcc\xf2\xb7\xc9\x12\xa1\x75\x5a\x80\x2b\xbe\xe4\x56\x88\x90\xbf\xbb\xb8\x82\x98\x1c\x6b\xd3\x87\x3a\xb7\x88\x6a\x0d\xfa\xe5\xbf\xee\x32\x4f\x21\xa4\x19\xa8\x60\x87\x1a\xd7\xe1\x5f\x0b\x35\xf0\x29\xff\x79\xcb\x13\xee\x61\xb9\x2a\x9f\xa2\x95\x10\x56\x11\x23\x49\x27\xd4\x78\x9e\x57\x82\xae\x9e\xd3\x9f\xcb\x47\x2c\x0d\xb3\x87\x39\x82\x9d\x7c\x90\x66\xf9\x7e\x48\xf8\xa9\xee\x7b\xb1\x43\x81\x90\x40\xd1\x88\x78\x63\x4e\x5e\xa9\x33\x16\x55\x68\xb8\x53\x31\xd1\xc2\x62\x0d\x69\xed\x78\x8f\xbb\x12\x2d\xf2\x36\x58\x28\x03\xf6\x2a\x17\x8b\xda\x7b\x9c\x9a\x5c\xe8\x3f\xb2\xe6\x2a\x14\x1b\xe7\x6f\x71\xaf\xb9\x11\x1f\x30\x14\x91\xee\x3f\x14\x3a\xd6\x3e\xc2\x23\x2b\xa8\xef\x93\xd5\x56\xa7\x0f\x2f\x85\x3c\xa9\xc8\x44\x7e\xe2\x20\xf2\xb2\x21\xbb\x97\xcf\x2f\x3d\x54\x7d\x30\xa2\xad\x0a\xd5\xa4\x92\x77\x00\x07\xa0\x38\x94\x33\xf9\x09\xb6\xc2\x45\x8d\xfb\x8d\x83\xba\x41\x29\x01\x06\x63\xc2\xfa\x92\x46\xb8\x77\xcc\x82\x6b\x88\xb7\xab\x4f\x97\xbd\x5e\x34\x54\x13\x9b\x8e\xa7\x49\x2f\xf0\xcb\x46\xa0\x1c\xb6\xff\x5e\xac\xc0\xa5\xcf\x7b\xb9\x03\x17\x03\x62\x88\x1c\x75\xc5\x35\x97\x7c\x83\xa8\xae\x65\xa5\x71\xda\xd7\x6b\x46\x79\xff\x13\x69\xed\xc1\x41\x78\x2b\xc3\x6b\xb4\xce\xa3\xa2\x7a\x7c\x6b\xdb\xce\xff\xf3\x8b\x24\xff\xb0\x8b\x0f\x25\x94\xe5\x82\x1f\xa0\x45\xb0\x60\xa3\xe3\xc2\xcb\xdf\x67\xb3\xe6\xe5\xd1\x92\xa4\x2a\xf5\x00\xb3\x36\x44\x36\xcd\xb4\xd6\x04\x5f\x90\x3e\x30\xb6\x56\x9c\x05\xed\x4f\x25\x82\xb1\xb9\x8a\x88\xdc\xcf\x8d\x0f\x9f\x03\xd8\xc6\x6f\xf4\xdb\x75\xb0\x85\xa3\xd7\x18\x8d\x71\x2f\xa5\xba\x2a\xb8\x0c\x5b\x0b\x3f\xb9\xd0\xd9\x43\xc4\xe0\x42\x9e\xd2\xa8\x0f\x71\xac\x0a\x84\x70\xb4\x8d\xf5\x29\x44\xbd\x69\xfe\x9d\x63\x5b\x3b\xf7\xe4\x4d\x11\x0e\x8f\x6a\x30\xc5\x34\x8c\x04\xbc\xe9\x63\x57\x7d\x2d\x04\x69\xe5\x6e\xe1\x05\x4a\xee\x93\xd5\xf5\xf7\x0d\xda\xf9\xae\x8e\x6f\x5c\xa9\x42\x96\x86\x45\x4d\xa1\x70\x36\xdb\x0c\xa9\xf2\x15\x98\x0f\x87\x0e\x62\xe0\x1a\xd6\x6a\x0d\xac\x95\xf6\x6d\x9a\x0f\x65\xb8\xf3\x16\xed\x85\xaf\x55\xaf\xc2\x04\x3f\x34\x58\xae\x2c\xa9\x7b\xd3\x7e\x35\x92\x3d\x2b\xa6\x19\x0d\xb4\x2a\xcb\xee\x55\xc2\x80\x0c\xd5\x02\xd3\x01\x62\xfe\x2a\xc1\x34\x2b\x36\x8b\xb4\x32\xbd\x81\x3a\x60\xc6\x4d\xdd\x75\x8d\x5a\xf4\x43\xc1\x24\x5a\xd6\x43\x11\x1a\x5f\x9f\x2a\x0f\xf5\xfc\x82\xc1\x4f\xdd\x7e\xb2\x0a\x7d\x45\x67\x5a\x9a\xf6\x3b\x08\x83\x02\x65\x2a\x11\x5f\xcb\x3a\x57\xb2\xcd\xf9\xa3\x3c\x0c\xec\x35\x57\xd2\xc8\xb1\xf1\xbb\x78\x40\x63\x31\xb4\x56\x72\x1f\x15\x01\x61\x00\x29\xf7\xde\xec\xab\x02\xa8\x9e\xd0\x6b\x47\x6c\x0c\xc5\xcb\xa3\xf3\xdf\x7a\xe7\xed\xd4\x2a\xf7\x62\x2a\x46\x28\x24\xea\xa3\x90\xcb\xf6\xf6\x7a\xb2\x89\xc5\x97\xa1\xb8\x9e\xeb\xbf\x80\x18\x58\xe6\xfc\x2a\xf2\x91\x79\x7c\x00\x4b\x16\x2d\x72\x4e\x4d\x84\xe3\x5e\xd1\x26\x3e\xe0\x1e\xf7\x8e\x7e\xe8\x36\xeb\xb1\xc6\x36\x61\x11\x1a\xd0\x5b\x96\xc5\xa6\xdc\x56\xee\x45\xd0\xad\x91\x5f\xf4\x41\x4d\x76\x4f\x68\xb8\xaa\x22\x4b\x61\xa4\x1e\x28\x5b\x1f\x13\x78\x03\x18\xa3\xc8\xd8\x45\x19\xb9\xdb\xe3\x80\x35\x19\x36\xaf\x55\x59\x47\x7d\x14\x47\x31\x22\x44\xcc\x2e\x55\x09\xf2\x09\x12\x1c\xfc\xab\x19\x89\x52\x8c\x8a\x2c\x36\xf6\xca\xe4\xb2\xc0\x3b\x3e\x48\x18\x8d\xc2\xbc\x77\x16\xdd\x62\x96\xbd\xf6\x53\x2f\x62\x6f\x60\x3a\xeb\xc7\x3d\x42\x35\x0b\x8c\x92\x3d\x61\xd2\x21\xdc\xfa\x31\x0b\x6b\xcc\x55\xb4\xee\xc9\x52\x8c\x54\xf5\x7b\xf3\x09\x95\xea\xab\xb7\x93\xd5\x68\x64\xe1\x3d\x0c\xe0\x18\x88\x62\xe4\x5e\x31\x08\x9c\x03\x02\x43\xa1\x67\x68\x4a\x7f\x39\xa3\x16\xfb\xbc\x91\x40\xb0\xd4\x51\xf6\x83\x7c\x30\xa5\x68\xe4\x43\x73\xea\xc2\xd8\x95\xd0\xaa\x7e\x3e\x39\x8e\xfd\x4b\x0b\xb9\xaf\x4c\x90\x21\x7a\x92\xb0\x34\x15\xde\x12\x7c\xad\x8e\xef\xcb\x75\x78\xec\x16\xa9\x62\x16\x8b\x49\xb1\x3d\x2d\xd6\xd7\x05\xc2\x95\x16\xc6\xc3\xe2\xe1\xaa\xeb\x7e\x7e\x60\x3a\xd3\x5b\xcf\xa9\x2b\xd8\x9d\xa6\x24\xd2\xb7\xe9\x97\xfd\xa5\xac\xc5\x57\x6a\xb9\x2e\xb3\x9d\x66\x94\x74\x3c\xf0\xa9\x1a\x6b\x13\x10\x9f\xda\xff\xd6\xd3\x55\xff\x46\x69\x01\x78\xef\x39\xaa\x2e\xb5\x9f\xf5\x7a\xda\xbf\x45\x20\xff\xad\x2b\x72\xf4\x37\x1a\x3f\x60\xc0\xfa\x4a\xcf\xb8\x69\xed\x55\x26\xbe\xeb\x8a\xe6\x5d\xeb\xac\x2c\x69\xce\x4a\xc0\xf0\x5c\x90\xdc\xdc\x57\x82\x1f\xba\xfd\xb1\x00\xf0\xe5\x60\x05\xdd\xff\x71\xf1\xbf\x82\xb1\x9b\x5d\x56\x1e\x6c\x73\x3b\xd7\xc3\xa0\x1c\x80\xfa\x2b\x5a\xda\xbf\xd5\x1d\x11\x30\xaa\xc5\xbb\xbc\xd7\xaa\x99\x4b\xd0\x69\xbe\x9d\xd0\x54\xb6\x06\x17\xb0\xcc\x55\xab\xbc\x37\x32\x57\x72\x36\x19\xe5\x94\x69\x79\xcb\x8e\xdc\x66\x18\x45\xd0\x50\xfd\xfe\x62\xd8\x1f\xef\x4f\xc7\x53\x9c\x9f\xd6\x39\x06\xf9\x78\x0d\x68\xbd\x5c\x81\xf5\x2f\x64\x85\xeb\xd9\xff\xe4\x03\xc2\x7b\xf7\xd7\xff\xfe\x5b\x61\xf3\x1a\xf9\xac\xe1\x90\xb5\x3e\x17\x49\xf4\x72\x8a\x12\x67\x84\xfc\x23\xce\xa0\x45\x46\xd1\xd2\xbf\xee\xf0\xad\x8a\xaf\x85\xb9\xda\xef\xcc\x3d\x9b\x20\xff\xea\xc5\x26\x5d\x37\xef\x81\xb2\xa2\x8e\x6f\x7d\x27\x7a\xb1\xcf\x34\xb8\x60\xee\x4d\xf4\x18\x4e\x1f\xcf\xab\x03\xab\x7d\xe9\xad\x86\xaf\xea\x04\x2f\xfc\x9e\xd0\x4d\x9a\x60\xa8\x33\xc1\xa9\x36\x3e\x24\x85\xa0\xee\x51\xc4\x11\xf6\x99\x05\x7b\xa8\x27\x71\xaf\x41\xf9\x54\x1b\xad\x3c\xd5\xde\x50\xc3\x3e\xe2\x89\x8a\xf3\xc3\xde\x7d\x25\xc3\xca\xca\xcf\xe0\xa5\x8a\x5d\x27\x4c\x85\xda\xf7\xdf\xdb\x44\xf9\x83\x9a\x53\x7a\xbf\xdb\xbb\xc2\xed\x9b\x3f\x73\xff\x87\x8f\x4d\xb9\x83\x3b\x2e\xc6\xac\xb3\x06\x67\x59\x21\xdf\x1d\x88\xd0\xbf\x6f\x09\x30\x9e\xbb\xe3\xbf\xef\xed\x8b\x68\xce\x7d\x49\xd4\xcb\x07\xd3\x08\xa1\x63\xa8\x78\xb5\xca\x00\x48\xfe\xee\xca\x18\xfb\xce\xff\x1b\xed\x37\x26\xb6\x21\x84\x41\x83\xf0\x38\x6a\x73\xce\xa7\x15\xd2\x23\x1a\x47\x65\xd1\xec\x51\x1d\x21\xf8\xbd\x48\x28\xc3\x79\x78\x08\xab\x58\x03\x53\xbf\x52\x44\x55\x61\x44\xac\x0e\x66\x42\x85\xe6\xf0\xb4\x9c\xba\xde\x85\xfb\xeb\x2d\x44\x5d\x87\x7c\x97\xb7\xd1\xf0\x35\xce\x43\xf9\x93\x32\x4a\xc3\xce\xe2\x36\x50\xb2\x0b\x4d\x80\xca\xd8\xd6\x3b\x1c\x65\x7e\xe9\xd4\x5a\x2c\xfb\x54\x5d\x11\x1e\x04\x45\x85\x57\x8c\xb7\x3e\x94\xd0\x75\x01\xb5\xc8\x3c\x0e\x08\xf0\x59\x9a\xf0\xc4\x7e\x80\x67\x63\xc6\x55\xcf\x40\xbf\x23\x3d\x41\x8e\xb9\xea\x2a\x21\xbe\x00\x0d\x25\x8b\x4f\x3c\x14\xc3\x85\x7a\x05\xb1\xe5\xe7\x1c\x7f\x9f\x58\x70\xbb\x68\xb4\x91\x79\x70\x24\xaf\x48\x74\x91\x66\x12\xb0\x78\x1d\xac\x66\x08\x6e\xf1\x13\x3e\xdc\x84\xae\x12\x91\xec\x38\x46\x25\x02\xe5\x16\x4a\x4a\x7b\xe1\xa9\x89\xa4\x05\x2e\xb9\x0a\x66\xc6\x55\xb8\x2b\x0b\x04\x14\x47\x28\xe5\x18\xdf\x2f\xb0\x5d\xfc\xb7\x36\x14\x50\x42\x16\xdc\xfd\x73\x2f\xd6\xb2\x99\xad\x12\x0d\x2b\xcf\x18\x06\xc5\x05\xe8\x19\xc1\x6f\x36\xb1\xe0\x56\x52\x7c\x0f\xf8\xd4\xc5\x36\xfa\xe7\x27\x66\x5c\x55\xa0\x4f\x96\xde\x01\x04\x75\xb1\x50\xbf\xfb\x9c\x12\xaa\xca\xa6\x4d\xd7\x45\xe9\xb5\x5e\x0d\xf2\x99\x0b\xcb\x54\x04\xe0\x16\x3d\x4f\x9c\x1a\xb1\xe3\x43\x65\x76\x03\x9d\xca\xc4\x7e\xaf\xc3\x95\x6c\x41\x31\x85\x5a\xc2\xd0\x62\xf6\x7b\x1e\x0f\x81\xc4\xf3\xfe\x3a\x9e\xf6\x3f\x77\xbf\x80\xc5\xdf\xfd\x5e\x21\x98\x60\x1e\x89\xac\xe2\xcf\xc0\x00\xc0\xcc\xfc\x14\x1e\x39\x1f\x85\xc4\x55\xcb\x1e\x2c\xbe\xf7\xef\x4a\x61\xf3\x18\xc0\x0a\xda\xac\x1b\x0e\x7d\x0e\x90\x9f\x7b\x2b\xb9\x5d\x88\xe4\x39\xf7\xac\x58\x1e\xb1\x71\xc3\xeb\x0a\x2a\x0c\x90\xdb\xce\x4e\x93\x85\xf1\xce\xa1\x8b\xdd\x8d\x0e\xad\x97\x56\x09\x3e\x64\x6f\x5a\x92\x47\x22\xd7\x0c\xe6\x96\x90\xec\x07\xbb\xe4\xc1\x46\xf6\xc5\x08\x11\x2b\xcd\xa2\xfc\x09\xf0\x0b\x72\xdc\x39\xa6\xc8\x3d\x69\x05\xae\x99\xe9\x69\xe1\xa4\xf3\xf7\x89\x80\xb8\x3d\xac\x4d\x51\x0b\x06\xb6\xf1\xa7\x81\xab\x6b\x40\x1d\xf5\x1a\x2b\x1d\x13\x2f\x0b\x32\xe4\x63\x59\x7b\xe8\x1a\xb8\x04\xf2\x66\xc2\x39\xf0\x13\x3c\x30\x62\x36\x26\x2f\x00\x1d\x1c\xf2\x7a\x89\x66\xdc\xfe\x4e\xd9\xf3\xe1\x53\xe4\x6f\x24\xc0\xd5\x33\xda\xd9\xbd\x6a\xc1\xc2\xa1\xe8\xb6\xcd\x68\x0b\xda\x93\x24\x57\x0f\xfa\x75\xfb\xdc\xfa\x49\x31\x92\xa5\x1b\x98\x0e\x15\x2c\xf5\x72\xc8\x0f\x9f\x5f\x90\x0f\x36\xc2\x9a\xc8\xf1\xa1\x20\x70\x42\x4b\xa8\x51\x39\x5d\x67\xa1\x88\x33\x7a\x33\xb3\xbe\x43\x95\x3e\xa0\x5f\x9a\xb1\x3e\xbd\x94\x56\x68\xac\xfa\x14\x7f\xfd\xef\x55\x45\xd7\xaa\xf5\x12\x19\xd0\x12\xf5\xdc\x55\x57\x84\x7a\x92\xff\xb9\xa1\xde\x44\x09\xf1\x07\xd1\x17\xd0\x05\x56\x00\x98\xec\x47\x2a\xa6\xab\xb8\xbf\x5f\xb9\x28\x38\x23\x6c\xbb\x00\x52\xaa\x44\x4c\x77\x12\x1c\xd4\x53\x71\x94\x82\x06\x3f\x15\xe9\x47\xd5\xb5\x24\x96\x1f\xe5\x1a\x3b\x85\xa7\x7e\xbd\xe9\xa5\x07\x4b\x45\x07\xe8\xc3\x0e\xa9\x06\xcb\x3a\x1f\xfe\xfb\x12\xd3\xdd\x8e\x7f\x77\xfe\x68\xd2\x55\x07\xc5\x9e\x63\x0a\xea\x43\xcd\xfc\x1a\x08\x6c\xb3\x72\x33\x7e\x1b\x9b\x09\xc6\xcc\xd6\x03\x07\x6c\x0e\x08\x9c\x2f\xea\x8a\x05\x6f\x8e\x02\x9d\x84\xf6\x1e\x57\xea\xff\xfb\x52\x50\x09\x79\xd5\xfc\x69\x26\xcc\x31\x7e\xc8\x28\x9b\xcd\xd4\x87\x41\x8f\x60\xa4\xb7\xda\x17\x61\x24\xd3\x70\x85\x26\x0b\x5c\x96\x2f\xec\x2f\xc8\xfb\x90\x3f\x84\xd7\xa2\xf0\xce\x08\x9f\xae\x86\xb4\xa8\xa4\x46\x71\xee\x46\xa7\xe7\x12\xce\x3a\x32\x7a\x97\x7d\xff\xda\x0f\x02\xb8\x71\x2f\x04\x28\xe0\xb9\x26\xd5\x97\x22\xa2\x95\x52\x43\xc7\xeb\x62\xb8\x8e\x22\x71\xe6\x41\x5e\xad\xe9\xd3\x5b\xde\xfc\x46\xcf\x48\x59\x1a\xa9\xcb\xa2\x17\xaf\x3a\x20\x9f\xef\xe6\x15\x9b\x96\x6d\xf3\xaf\x72\x45\xce\xa2\x38\x23\x96\xb9\xd0\xde\x71\xb5\x3b\x00\x00\xcf\xed\x0d\xc9\x8f\xd2\xb0\xe0\x25\xdc\xeb\x71\xbf\x17\xf4\x7d\xe3\xb8\x1a\x73\xff\x9e\x25\x05\xf4\x65\x68\xd4\x69\xca\x8f\x70\x8a\x18\x56\xd5\x4e\x32\x6c\x24\xf6\xfb\x70\x68\xe4\xff\x7d\x15\x19\xe6\xd0\xc8\x3b\x0b\xb4\x03\x3c\x75\x15\x34\x05\xeb\xa4\xfe\x49\x81\xe6\x74\x0d\x5b\xb4\x6f\xae\x8d\x09\x01\x7a\xa3\x27\x0b\xd8\x7b\xd5\xae\x56\x7d\x91\x55\xc2\x98\x71\x90\xe3\x8f\x01\xca\xdf\x37\x30\x24\xb9\xc1\x2a\x58\xc5\xcc\x31\x42\x9b\xb0\x05\x19\xba\x63\x03\x84\xe8\xce\x03\x6f\x77\xfe\xca\x43\xed\x5f\xef\x6f\x6d\xb2\x3f\x0c\xe2\xb2\xab\x58\xe7\x08\x14\x6d\xf6\x3f\xe2\x48\xeb\xfa\xf4\x39\x96\xb9\x2e\x24\xa5\xe9\xf0\x50\xba\xc4\x19\x4c\x63\xa2\xe2\xc3\xb3\x29\x06\x4a\x84\xdc\xf4\xe2\x22\xcd\x36\x3c\xc2\x1d\x81\xd2\x13\xdc\xab\xb2\xf1\x2d\xad\xa6\x0e\x0c\xc2\x08\xf5\x29\x52\x49\xb8\x23\x75\xfc\xfd\x06\x04\x5f\x27\x87\xc4\x85\xa4\x24\x86\xcf\x82\x5f\x7f\xd5\x02\x78\xb8\x17\xe9\xc7\xe4\xe1\xfe\xa3\x47\x10\x0d\x15\x7f\x3b\xbd\xa3\xe3\xfd\x60\x63\x9d\x58\xeb\x5a\x39\x80\x63\x40\x74\x2d\x70\x87\xeb\x88\x5d\xd7\x8c\x6e\x26\xa7\x7c\x93\x46\xd2\x5b\xa5\x44\x0f\x6e\x51\xb6\x4d\xc7\x62\x48\x8a\x41\xe0\x7f\x6f\x10\x43\xfb\xb4\x43\xc3\x3b\x67\x8a\xaf\x2e\x85\x0e\xc0\xa2\x05\x62\x57\x8b\x3f\xfc\x2f\x62\x12\xf6\x53\x9a\x91\x22\x14\xe7\xac\x5b\xc4\x3a\x08\xbb\xc2\xce\x29\x18\x7b\x6d\xee\x50\x91\x7e\xe1\xd4\x99\x60\x9d\xda\x49\xee\xbd\x52\xa3\xa1\xd0\xf0\x13\xbd\x56\x25\x3d\x69\xe1\x57\x55\xd3\xf7\x16\xe7\x6c\xb5\x16\x85\x9f\xba\xbd\x47\xc9\x8a\x7f\xb7\x16\x47\x15\x65\x7c\x99\x66\x5e\xb8\x5b\xab\xa7\xe4\xf5\x38\xa7\xd7\x9a\x2c\xf9\xae\x0f\x4a\xd2\x87\x55\x58\xf8\x02\x8c\xe3\xc3\x3a\x54\x82\x3c\x54\x90\x80\x67\xab\x43\x49\xe9\xd9\x0f\x45\x99\xab\x77\x49\x8d\x29\x8c\x3f\xce\xe7\x0d\x5f\xa1\xaf\xec\xde\xdc\x91\xb3\x20\x2d\x35\x87\x34\x65\xa0\x41\x3a\x3b\x21\xbe\xc5\xfa\x32\xdd\xc3\x39\xa0\xb0\x09\x3e\xda\x41\xff\x52\x35\x80\xd9\x0a\x33\x49\x5d\x00\x50\xd0\x29\x0a\xa1\xf5\xcb\x5c\x16\x0f\xbe\xcc\x5e\x1b\x8b\xdc\xfd\x7c\xeb\xdd\x4e\x0a\x6b\xe7\x43\xd2\x0f\xe8\x2e\x64\xaa\x72\x03\x2d\x2b\xd9\x09\xe6\x03\xc5\x77\xbd\x59\x84\x15\xdd\x52\x15\x1e\x23\x2f\xb6\xfb\x68\xe9\xb2\x11\x38\x93\x97\xfe\x42\x97\x60\xc5\xe6\x0f\x77\x24\xbe\x28\xbf\x5e\xc5\xe9\xd4\xec\xa7\x4b\x26\xc4\x6d\x10\xf9\x87\xe4\x35\x1d\xb2\xb9\x67\x44\x7f\x17\xc5\x57\x9a\xe3\xc1\x22\x28\x96\x7a\x16\x39\x2e\xbd\xee\xf9\x48\x7b\xe4\x4e\x24\xbe\xf5\x74\x58\x33\xa2\x30\xe6\xe0\x43\xb3\xb6\x76\x5c\xf4\x1a\x35\x15\xf2\x4d\x5e\xbe\x50\xbb\x9b\x65\xa7\xb5\xe4\x60\xc6\x57\x25\x1a\xde\x29\xe9\x4a\x36\xce\xb1\x58\x4f\x1d\x9a\x7e\x2b\xdc\xbf\x8d\x7a\x6e\xbb\xd2\xab\x54\x1f\xba\x38\xbe\x09\x19\x77\x97\xcf\xb9\xfa\x4c\xb3\xea\x41\xac\x3c\x2b\x6c\xa5\x0b\xbe\x9b\xf3\x10\x95\x7f\x55\xf0\xdc\xc5\xa4\x2d\x3e\xb3\xb7\xc1\x37\x3d\x54\x05\x0d\xea\xdc\x20\x3a\x46\x3c\x2b\x94\xe5\x7e\x8c\x2c\x88\xa9\x4d\x52\xf1\x1d\x17\x31\xd5\x7a\xbb\x3f\xb6\xdc\xc0\xe5\x2a\x03\xf7\xbc\x54\x67\xa8\xc8\x30\xf1\xde\x1e\xf7\x28\x3b\xb6\x87\x40\xf8\x19\x1c\x86\xe7\xbd\x55\xbe\xd7\x9c\xdb\x7c\xff\x2d\xd9\xdd\x81\x98\xb7\x9d\x90\xc6\xcd\x8e\xf2\xad\x67\x3b\x5f\xdc\x0a\x7f\x6e\x21\x0c\x87\x5f\xec\x29\xcf\xf1\xfd\x49\xb7\x62\xb0\xdc\x07\x25\x3c\x2a\x74\x1f\xcd\xfe\x90\x47\xca\x90\x61\xff\xc1\xb1\x6e\x65\x79\x58\x3d\x09\x26\xeb\x24\x00\x0e\x78\x5e\x14\xfc\x66\x10\xfe\xae\x4d\xc3\xdf\x57\x55\xb4\xa0\x8b\xb7\x6b\x94\xc0\x9e\xba\x42\x8c\x64\xe6\x7b\x1b\xb3\x07\xa6\x01\x20\x14\xbd\x28\x28\xc7\xa4\xd5\x38\x43\x16\xdb\xc8\xe1\x56\xf9\x3a\x75\xe3\x15\x23\x6b\x3b\x56\x32\x57\xda\xd9\x42\xeb\xd0\x7f\xf0\x26\xbd\x6e\xd3\x37\xb1\x89\xbb\x21\x6c\x40\xe7\xc8\x66\xee\x3c\x33\x6c\xba\x16\x33\x09\x6d\x5d\x43\x97\x32\x02\x13\x9a\xb7\x60\xde\xa2\xfe\x51\x08\x8f\xa8\xfd\xe8\xa2\x76\xc1\x1d\xb8\x0f\x42\xf3\x9d\x33\x94\x8b\x42\xc2\x65\xe5\x0c\x25\xdf\x01\x0d\xc3\x63\x1f\x1e\x9a\x6c\xb1\x04\x90\x52\x1a\x0b\x2f\xcd\xc4\x26\xa3\xd7\xe1\x87\x2e\xb2\x82\xb3\xcf\x9a\x8f\x2f\x8d\x89\x9b\xe8\xfd\x45\xeb\xcc\xd3\xac\x41\x72\xe9\xa5\x53\xe8\xe8\x68\x3f\x55\x6a\x31\x5d\x2c\xf3\x06\x28\xbd\x5f\xa4\xe0\xf1\x19\x9d\x4b\xde\x71\xa3\x23\x44\x5e\x5a\x3f\xd6\xcf\x06\xfb\x96\x08\x9b\xa2\xaf\x62\xc6\x09\xa8\xa4\xde\xb6\x05\xcb\xcc\x04\xdb\xe9\x85\xcc\x43\x3b\x0b\xf8\xb8\x22\x36\xc2\x58\x31\x1f\x2c\x3c\xa6\x56\x4f\x6f\x95\x97\xda\x3f\xaf\xfb\x91\x8f\x89\xa5\xd6\x36\xe7\xab\x4e\x09\xa5\x0a\x0c\x49\x70\x65\x1a\x7f\xd5\x9e\x2c\x7a\xbe\x1f\x1a\x37\xfe\x87\x0f\x06\x97\xe2\x15\xeb\x6e\x70\x87\xbc\x57\x4d\x45\xc2\xb0\x11\xd5\x53\xdb\x3b\x69\x70\x20\x3c\x64\xd3\x98\x0f\xae\xad\x20\x55\xb4\x29\xe3\xcf\xf6\x56\xf9\x5c\x5e\x5c\x42\x32\x15\x80\xee\x74\x59\x32\x01\xd7\x6a\x6f\x5a\xb5\x87\x64\x9f\xa1\x5f\xab\xcb\xea\x8b\x6f\xba\xf7\x2f\x89\xd7\xb5\x05\xf0\xee\x49\x4c\x97\x10\x05\x96\xa2\x23\x85\x8d\xd1\x3a\xee\x0e\x1c\xad\x7e\xd5\x4e\xed\xc5\x0b\x63\xf0\xd2\xc2\xbe\x96\xbd\xbd\x2a\x29\xb3\x73\xc0\xd1\x28\xa6\x42\x00\x37\x32\xfb\x71\xd0\x21\x68\x04\xa7\xb6\xa4\x34\x51\x86\x57\x2d\x1c\xaf\xad\x5d\x43\xe8\x1c\x38\x1a\xb1\x30\x3b\x2f\xb8\x3e\x1c\x5c\x0b\xe1\xf3\x9a\x39\xda\x04\x28\xed\x98\x28\x71\x17\xc6\x8e\x0b\x3d\x0e\xce\x96\x5e\x40\xe8\xbc\x69\x39\xf6\x34\xb0\x26\x26\x90\x1f\x54\x70\xa9\x0a\xb5\xf7\x43\xd2\x1f\x11\xf6\xef\x6b\xce\x73\x58\x5d\x96\x52\xb8\xe9\x6a\xec\x85\x55\x7d\xff\x17\xbf\xf3\x07\xf2\x71\x5e\x57\x07\xd4\x47\x87\x5d\x35\xcc\x36\x56\xd6\xce\x10\x15\xa3\x01\xfa\x5d\x4c\x40\x9f\x5c\x2a\xb0\x55\x3d\xd8\xc1\xdd\xcb\x07\xd2\x01\xf6\x60\x38\xe2\x06\x45\x77\x29\xe7\x8c\x42\xe1\x3c\x14\x95\x26\xcd\xc2\x05\x49\xdd\x03\xdb\x64\xef\x64\x63\x50\xdc\xfb\xaa\x71\x64\xb4\x64\x7f\xd9\xda\x3c\x5b\xd6\x4e\xcb\xa0\x99\x09\x2d\x2d\x52\x10\x8c\x51\xa5\xe5\xff\x00\xe3\x8f\x29\x2b\xd4\xd1\xc0\x4b\x30\x9a\x62\x26\xc1\x3c\x0e\xaf\x6f\xb5\x60\x6e\x73\x9d\x01\xf0\x76\xd5\xf7\x7e\x17\xd5\x57\xae\x4b\x6d\xcc\xa6\xb7\xb3\xe0\x25\x28\xe4\x1c\x68\xd4\x30\x35\x46\xec\x06\x00\xed\x23\x59\x79\x75\x09\x80\xc5\xa3\x30\xbe\x85\x27\xef\x4f\xa9\x2b\xde\xb4\x4d\xf5\x48\x31\x52\x76\xea\x17\xfb\x63\x06\x73\xee\xf6\xff\x47\x81\x13\xcf\xfd\x21\x64\x4e\x95\x98\xf3\xcf\x0f\xec\x58\xc2\x08\xfa\xf4\x73\x9e\xea\x5a\xf1\x32\x4b\xb4\x29\xc5\x3e\xcb\xa4\x82\xcf\xe8\x98\x53\x27\xbc\x3a\xde\x5b\x35\xec\x5b\x46\xf2\x89\x22\x18\xed\x7f\xb5\x5f\x34\x7a\xa6\x07\xf6\xc4\x0e\x15\x00\xd6\xec\x62\x73\x0c\xa5\x95\x91\x7a\xd8\x65\x85\xa7\x6c\xcf\x37\x76\x0e\xc6\x9e\x2c\x9d\x99\x5b\x16\x57\xd9\x06\xc4\xd4\x46\xc2\x1b\x53\xea\xfa\xcd\x83\x8d\x45\x31\x1e\xce\x43\x75\xbe\x20\x60\xc3\xed\xed\xb4\xde\x1c\x2f\x0b\x1c\xd5\xec\xef\x5e\x4a\x57\x6a\xb7\xbe\x1b\x98\x57\x5d\x71\xdb\x09\xf1\xd7\x7b\xa4\x67\x8f\x7a\xec\x07\x17\x10\xf6\xa1\x53\xb6\xce\x85\x04\x30\x9a\x62\x4e\xf9\x9e\xd6\xab\x77\x99\x7f\x7e\x9a\x21\xc5\x24\x16\x40\x0b\x85\x9d\xff\x87\xf2\x69\x87\xff\x26\xae\x4d\xfd\xdd\x3b\xe6\x26\x17\x91\xb2\x57\x9c\x73\xd9\x74\x83\xae\xb7\x6d\xfc\xf6\x8a\x05\xe5\x7d\x26\xb9\x52\xc9\x25\x37\xb9\x93\x71\xb4\x26\xbb\xd4\xa5\xd0\x1d\xec\x4f\xf5\x9a\x87\xe0\xca\x53\x44\x16\x76\x12\x7a\xb2\x0f\x5a\x40\x21\xda\x65\x75\xb0\xa6\xa0\x35\x66\xd7\x40\x3d\x51\xe2\x74\x8f\xaa\x20\x44\x57\xa5\x81\xf5\xfb\x3c\x89\x75\x17\x34\xea\x9e\x3d\x47\x94\x61\xbe\xc9\xbd\xdd\xdf\x42\xcc\x85\x54\xd7\x6c\x57\x8d\xc0\x65\x29\x51\xd1\x81\x8c\xf2\x1e\x65\xe1\xca\x26\x66\xed\x40\x9e\xd7\x94\xb5\x56\xd4\x57\x56\xd3\xed\x4a\x51\xdf\xcb\x86\x95\x2b\x9a\xeb\xd6\x96\x55\xf6\x9a\x4a\xb4\xf4\xea\xc6\x2a\xa1\x73\xab\xf9\xea\x62\x01\x18\xb5\x65\x17\xfc\xe6\x6b\x43\xb1\xd7\x1f\xfe\x65\x97\xbe\x08\x89\xd7\x58\x85\x7a\x8c\x96\x32\x35\xbc\xcd\x9e\x80\x4f\x63\xbd\x21\x31\x6d\xa5\xbb\xe4\xf8\x96\xa1\xbe\xe6\xb0\x85\x46\x03\xa1\x0a\xd7\x8e\x69\xbc\xbb\x6b\x58\xff\x6c\x10\xad\x6f\x94\x0b\x2c\x36\x35\xf3\xfc\x9a\xb9\xab\xe5\x42\x55\xc4\xc9\xd6\x4f\x5d\xad\xd9\x71\xa9\x22\x76\xe0\xb8\x90\x05\x0d\x09\xa6\x21\x26\x09\x8d\x3a\xf1\xa9\x63\x7d\x65\xe8\xb1\x0d\xe4\xd9\xcf\x2c\x01\x64\x86\x5d\x5e\x16\x4e\x48\x4c\x18\x5d\x6b\x43\xb0\xbf\xc2\xfa\xc1\xdf\x6a\xb1\xf7\x57\x99\xf8\x6d\x74\x77\xce\x76\xf7\xe0\xef\xfe\x00\xc6\xb1\xe6\x76\x3a\x74\x15\xad\x5b\xed\xee\xc0\x42\xe9\xfa\x0a\x63\x3e\x7f\x97\x14\xc1\x8d\xf4\xc5\xc3\x0e\x9e\x35\x90\x7e\x0c\xc1\xad\x12\x82\x22\xd1\xf5\xf7\x64\x3d\xb0\xe3\xa9\x91\xee\xad\x52\x84\x58\x9a\x06\x0d\x34\xef\xc9\x7f\x97\x8d\x84\xaa\xd7\xd0\x34\x0d\x15\x9b\xee\xba\xa5\xd7\x5c\xbe\x2e\xf0\x2e\xe5\xd3\x30\xd4\xde\xf2\x6a\x55\xca\x1a\x58\x45\x75\xdb\xbf\x74\x36\xeb\xf3\x14\xc1\x8b\xa7\xdd\x35\x5a\x3f\x64\xd8\x27\xff\xce\xd4\x98\x16\x54\x39\x05\xdd\x29\x30\xc9\x21\xc5\x7c\x3e\x02\x36\x73\x52\x6f\x9b\x10\x3a\x1f\xb7\xbb\x0c\xd9\xf5\x14\x32\x02\xad\x1e\x81\xa3\x96\xd5\xe7\x27\xfb\x79\x2f\xa6\xa1\x72\x7f\x9b\x21\xc4\xfb\xea\x3d\xcd\xf0\x0c\x63\x1e\x2b\x54\x1b\x26\x2c\xd1\xcc\x8e\x4f\xaf\x82\x33\x68\xd7\x49\x61\x86\x85\x77\x80\x8e\x76\x1c\x6d\xc3\xb5\xd9\x3b\x2e\x80\xf1\x27\x09\x4a\x6d\xd7\xd0\x8a\x61\xbe\x07\x83\x20\x1f\x64\x2b\xe2\x3b\xaa\x96\x2e\x84\x9c\x8c\xce\xd6\x2e\xcf\x83\x5e\x33\xa5\x0f\xea\xc0\x7b\x2b\x15\x0a\x9c\xee\xaf\xd3\x11\x61\xb3\x03\x68\x9b\x38\xe4\x80\x7d\xe5\xde\xba\xa6\xbb\x0c\xe6\xb1\xe2\xae\xb1\x88\x83\xe2\x38\xcc\x56\xf1\x85\xbb\x52\x40\x52\xbe\x61\x2b\xcb\xea\xf8\x5b\x67\xd0\x22\x30\x2d\x65\x94\xd4\xda\xe9\x9f\xd6\xa6\xd7\x06\x20\xc7\x14\x96\x16\x80\xc4\xd0\xbb\x17\x0f\xbc\xa0\x21\xe0\xfb\xc5\xd6\xcb\xa8\x9b\x61\x7a\x6c\x27\xa4\xea\xe4\x51\x4c\x08\xd7\xab\xeb\x01\x7c\xbd\x8b\xf5\x41\x6c\x7a\x75\x61\xf3\x38\x72\xd1\x2d\x19\x99\x0b\x82\x77\xd3\x8e\xd0\xda\x71\xab\x2b\x5a\xeb\x6a\xb1\xb8\xc0\xc0\xa4\xf8\x67\x69\xee\x92\x73\x5d\x19\xa9\x41\xa0\x0f\xbd\x4d\x79\x8f\x0c\x6a\x0f\xe2\x70\x16\x4c\xb6\xc1\x86\xf0\x62\x3b\xc9\xf0\xfd\x8d\x04\x36\xc1\x87\x45\x27\x99\x2f\x96\x1c\xc9\xf1\xa2\xfb\xd9\x43\x5d\x1b\x46\x86\xb3\x75\x0e\x6c\x5f\xd9\xd7\xaa\x5b\xdd\x92\x86\x05\xe2\xb8\x30\xcb\xef\xff\x9d\x8b\xf6\xa0\x27\xde\xe2\x54\x58\x70\x07\x5d\x94\x1d\x2f\x1a\x5e\x96\xc5\x22\x3a\x62\xe9\x4c\xbc\xbf\x86\xe0\xb9\x5b\x34\x08\x99\x38\xa6\x99\x61\x2b\xd8\x8b\x35\x8f\x58\x50\x85\x7b\xbc\x07\x94\x40\x55\x77\xc7\x50\x63\xa1\x93\x60\x7a\x74\x1a\x12\xc3\x31\x12\x38\xfb\x8c\xd6\x83\x4c\x1e\xb9\x9e\x25\x35\xce\x5c\x80\x73\x05\xe1\xd9\xbd\xdc\x32\x7b\xb5\xa7\x1e\x11\x7f\xef\x55\xab\x45\xd7\x67\x13\x7c\xb5\x07\x24\x50\x06\xee\x0a\xf0\x0b\xc5\xad\x26\x61\x2e\x99\xeb\xaa\x8e\xf6\x97\xd1\x81\x9a\xdc\xd3\x0a\xfd\x04\xc7\xdd\x21\xc8\xda\x1d\x65\xd0\x15\xe1\xf6\x26\xf0\x2d\x0d\x03\x24\x97\x19\x19\x80\x33\x35\x63\x8e\xe8\x08\xe5\xd9\x16\x96\xbd\x96\x18\xaa\x88\xc5\x7f\xaf\xfd\x22\xbb\xf9\xde\x14\x46\x4f\x6b\x9c\xb5\x07\x98\x31\x16\x5d\xa4\xb8\xdb\x08\x14\x44\xc2\xc3\xd1\x2f\x3d\x81\x47\xfa\xf0\xe4\xca\x20\x1c\x5b\xde\x99\xd1\xc7\x8f\xd6\x8a\xac\xb5\xc1\x77\x9f\xec\xc8\x39\x1a\x72\xda\x6c\xdb\x84\xc2\x19\x6d\xde\x95\x0f\x45\x0e\x59\xaf\x49\xbf\x58\xa4\xae\x3c\xce\xef\x3d\xa3\xf4\x6b\x6a\xb6\xd1\x31\x76\x1f\x1c\x55\xe7\x1c\x7b\x40\x6b\x89\x9d\x82\x40\x82\x7d\xb2\x78\x9d\x39\xab\x68\xd1\x51\xbd\x5f\xc9\xfc\x41\x8e\x16\xfa\x09\x4a\xa2\x3c\xe5\xd5\x6a\x5e\xdc\x82\xb6\x97\x7b\x0b\x4b\x5a\x19\x79\xef\x76\x62\xa2\x4f\xe2\x92\xba\xce\xb4\xd3\x9c\xea\x6e\xeb\x03\xf8\x27\x21\x38\x79\x2b\x5a\x2e\x6a\xec\x17\x5d\x5e\x10\x87\x5c\x24\x50\x8b\x5d\x9c\xde\x79\xce\xb4\xf4\x2c\x8e\x34\xc5\x6e\xb4\x4e\x03\x48\xea\xec\x70\x0e\x19\x84\x8e\xfd\x83\x5f\x8a\x5e\x92\x60\x8d\xf7\x60\x3f\x1c\x48\x96\xb3\x54\x3b\xaf\xc8\x05\x88\x3a\x74\x55\x2f\xcc\x78\xb6\x88\x02\xac\x5e\x10\xe5\x59\xfd\xf5\xe7\xed\xa0\x4d\x52\x50\x10\xa1\xd1\xf0\xa9\x9f\xe4\x26\x7c\x5d\xb3\x37\xce\x51\x8b\xe2\x29\x08\x64\x41\x05\x6a\x82\xbc\xd6\x99\x71\xb8\x9c\x05\x8f\x5f\x0a\x02\xca\xb5\x9e\x14\x35\x8d\x52\x92\xc6\xe2\x54\xf8\x6d\xea\x79\x83\x1a\x3c\x1b\x35\x8a\x82\xb5\xd4\x35\xa5\xcb\xf2\x50\x93\x62\x49\x71\x09\x39\xcd\x6a\xf7\x10\x74\x92\x2f\xb3\xdd\x27\xc2\xd9\x07\x01\x2f\xb2\x92\x32\xd1\xcd\x4f\x6c\xd4\x32\xec\xa5\x63\xa0\x54\xd4\xef\xc2\x02\xf1\x7a\x59\x1b\x2d\xd9\xc3\x8c\x1c\xcb\x96\x75\xca\x6e\x54\x7b\xfe\x02\xd9\xd5\x49\x87\x1a\x39\xf4\x22\x76\x1c\x4f\x29\x83\xff\x34\x55\x9d\x8e\x19\x7e\xea\x68\xa3\xc6\x31\x31\xf7\xf1\xc3\xc5\x3a\xc8\xd0\x11\x0e\x3c\x8c\xd8\x4b\x9f\xba\x4a\x2b\x07\x54\x30\xf7\x77\xea\x44\x91\x56\xd4\x25\xdc\x20\x61\xc5\x8b\xba\x92\xef\xca\x7f\xab\x31\xbf\x6d\x4d\xe4\x39\x6d\x41\x1e\x9d\x67\xdd\xd4\xd2\xb2\x04\x6f\x33\xc8\xae\xf9\xa4\xf6\x8f\x97\xbc\x42\x23\x60\xb0\xd8\xa1\xfb\xeb\xbf\xff\xfd\x53\xff\xd2\xf4\x3f\xca\xee\xad\x28\x17\x33\x4e\xcc\xfb\x7e\x50\xba\x33\xdb\x41\x81\xf9\xcf\x59\x11\x90\x0c\x56\x49\x78\xac\xc0\xd0\x1f\x9d\x16\xfc\x2a\xb3\xa5\x6e\xf2\x2d\xd5\xa1\x97\xe0\xcd\x6c\xc1\x64\x8f\x0e\xf9\xa1\xeb\x2d\x1a\xc9\x16\x8e\x52\x34\x41\x70\xfa\x9e\x58\xc6\x36\x1b\xd6\xc9\x09\x22\x12\x4d\xf0\xa3\xf5\x0d\x34\xc7\x8a\x2e\x11\x7b\x92\xbd\xa6\xe8\xe6\xdc\x6c\xef\xbf\x7e\x7d\xdd\x9d\xf3\x07\x36\x42\x4a\xa1\x7b\xbf\x27\x07\x4b\xf8\xca\x0c\xbe\x70\x8a\x10\x07\xf9\xa4\x98\x70\x18\x9b\x0e\x11\x3e\x5f\xf7\xff\xd8\xbf\xe0\xc2\x25\x08\x38\x9a\x0c\xd5\xa0\x18\x7b\x47\x9e\xa0\xd9\xed\xc3\x86\x9d\xd8\x14\xdc\x30\xd3\xb2\x28\xb8\xec\x7c\x6a\x9c\x5d\x92\x0f\xe4\x35\x50\xce\xbc\x8c\x0b\x71\x0d\x62\x3d\x77\x04\x6f\xa7\xac\x6a\x1b\xee\xaf\xc9\x2e\x65\x01\x6c\x61\x98\xd9\x6b\xa4\xd5\xe9\xe4\xab\xd3\x0e\xbd\xf7\x95\x37\x05\x7e\x7e\x92\x05\xc8\xf6\x44\xb5\x84\xbf\xbe\xf5\xcc\x34\xb4\x48\x99\xfe\x4c\xc4\xd7\x1b\xab\xbb\x31\x8c\x76\x26\xac\xc1\xbd\xf4\x13\xda\x4b\xa6\x0c\x92\xfb\xfd\x7e\x02\x48\x2f\xa1\x0e\xba\xf7\x7f\xce\xe2\x09\x84\xf5\x22\xfb\x5f\x8a\x1d\xbb\x86\xd8\xee\xa8\x66\x92\x09\x06\xbb\x33\xbb\x55\xef\x49\xa5\x14\x88\x7d\x8e\x8a\x47\x52\x69\x9b\x0c\x66\x03\xef\x22\x50\xe7\x94\xe0\x06\xc9\x9a\xec\x63\xb2\xf4\x2a\xde\x8c\x8b\x51\xf1\xf4\x3d\xc2\xd4\x41\x71\xff\x24\x00\xe7\x2f\x13\xf2\x2a\x0f\x7b\xb6\x1c\x61\xaf\xec\x1a\x02\x48\x1f\x52\xda\x0b\x1a\x58\x41\x5b\x6f\xf5\x06\xe1\x47\x6b\xca\xd1\xfe\xe6\x93\x82\xc4\x4c\x37\xb8\xc7\x59\x0f\x1a\xe1\x12\x0a\xd5\x2f\x2f\xe0\xed\x14\x1a\x39\x8b\x34\x0b\xca\x56\x96\xbd\x6a\x09\x34\x5a\x5e\xe6\xc9\x52\x46\xc5\xb8\x0e\x49\x1c\x53\x63\x62\x37\xd8\x18\x6f\x67\xfc\xfd\xe3\xc7\x4c\xee\xf7\xfd\x22\x92\xf4\x75\xe1\x0b\x5d\x5f\xb3\x74\x29\x98\xab\x46\x5c\x2a\xae\x59\x57\xb0\xc2\x86\xdc\x07\xec\xf0\x81\x04\x51\x9f\xc5\x36\x98\x94\x98\x84\xd7\x14\x1e\xd6\x03\x46\xf5\x2b\x0f\x13\xce\xe9\xfc\xa4\x7a\x04\x7a\x70\x2c\xda\x2d\xb7\x9f\x3e\xc9\x50\xbd\xd3\xff\xbd\x0b\x0e\xaa\x4f\xfc\xc8\xe2\xe8\xa1\x77\x77\x5f\x79\xaf\x22\x40\x84\xcd\xbd\x27\xc8\x67\x0a\xff\x4e\xc2\x0b\xb8\x13\xd4\xd2\x18\x58\x5a\xae\x71\x29\xa9\x2f\xd9\xc3\x86\x69\x55\xc2\x82\x8e\xee\x0a\x86\x45\x03\x52\x51\x96\x2b\xca\xeb\x5d\xea\xaa\x12\xe4\xb0\xd6\xbd\x1a\x8b\x02\x63\x53\x7d\xec\x91\x2a\xff\xb9\xd7\x77\x07\x12\xef\xbf\x57\x22\x2a\x91\x4d\x07\x8b\xbd\x93\x9b\x0e\x3b\xaa\x33\x76\xfa\x64\xbb\x54\x6c\x29\x9a\xe7\x61\x0d\x2c\x70\xd7\xbd\xbf\x7e\x52\x46\xbf\x8c\x3d\xde\x6b\x0d\xee\xdf\x99\x9c\x1d\xd1\x78\x6f\xb0\xac\x01\x11\xee\xb5\x47\xa1\xd7\xd1\xa4\xdc\x42\xc2\xca\x92\x02\x58\x2c\xf6\xec\x95\x46\xd8\x40\xee\x7e\xef\x5b\xa2\xee\x68\x59\x0c\xa3\x5d\xde\x43\xad\x9f\xec\x52\x8a\x63\x8c\xec\xc2\x61\x65\xa4\xe0\xaf\x3c\x88\x6a\xeb\x13\x0b\x6b\xf9\x58\xef\xac\x62\x48\x6d\xff\xcf\xfd\x58\xf0\x66\x15\xa8\xb0\x8c\xe0\xd5\xb9\x91\x89\x3f\x89\x97\xf9\xd1\x02\x6e\xf2\xb4\x2a\xe8\xc0\x7d\x7c\x74\x59\x57\xfa\x21\xa6\x4e\x38\x7d\x40\x06\x31\x3d\x7c\x6b\x35\x1a\x9a\xec\xb7\x5a\x86\x2b\x55\xae\x99\x52\xab\x20\xb1\xd0\x63\x14\xad\x0c\xf5\x6b\xd8\x37\xd2\xfe\x42\xd6\x1d\x5b\xe1\xfb\x12\xaa\xb1\x13\x16\x0e\x32\x91\x3f\xe4\x46\x75\x6e\xf6\x43\xb8\x2a\xa4\xbf\x2e\xaa\x84\xa6\x7e\x97\xa1\xb4\x96\xc9\x25\xd7\xeb\xf2\xba\x6e\xc9\x00\x50\x69\x4c\x5e\xdb\xbf\x4a\x79\xfa\xa5\xcd\x6d\x97\xbe\xf4\x0a\xf7\xdf\xdf\x0b\x57\x10\xc9\xa5\x4e\x27\x7b\x82\x80\x0a\xaa\x2a\x16\xdd\xe4\x89\xae\x97\xfc\xe4\x16\x1d\x3b\x66\x5d\x1a\xf7\x92\x53\x0b\xbb\x6f\x25\x51\xe2\xba\x97\xc5\xf1\x90\xee\xbb\x90\x6e\x9a\xfc\xe9\x2b\xa1\xeb\x5e\xde\x0d\x31\x41\x4f\x39\xb2\x0b\x7a\xcf\xb6\x04\xd9\x3f\x2a\x9a\x8d\x09\xd9\xa3\x73\xbc\x69\x8b\x9e\xed\xf6\x6d\x76\x3a\x12\x6a\x3d\x3d\x21\x31\x2a\x23\xa8\xfd\x59\x09\x94\x06\x4e\xa9\xa5\x1c\xe7\x05\x66\xe7\x12\x8c\x09\xe5\x8e\xde\x5a\x22\x77\x38\x29\x37\x3b\x69\x8e\xa5\x2b\x3b\xa5\x0a\x6e\x17\xf2\xc9\xde\x42\xdb\x50\x46\xae\xea\x81\x5b\x46\x7f\xc1\x52\x3e\x03\xf8\x14\xc6\xa3\x90\x6c\xe9\x7b\x55\x03\x95\xba\xea\x8c\xf0\x44\x7b\x36\x59\xc9\x60\x71\xfa\xb1\x41\xd5\x45\x4b\xa0\x65\xd1\x53\x8c\x38\x5b\xc4\x34\x94\x99\x2d\xde\xbe\x51\x4a\x9b\x99\xb0\xf6\xf4\x7a\x0d\x19\x37\x80\xb3\x90\xd8\x9f\x5f\x0a\xbb\x4e\x23\xa4\xd3\x97\x16\xa3\x5b\x8c\xb7\xe3\x7d\x80\xf0\xb0\x18\xa0\x46\x3d\x11\xdd\xdb\x14\xcd\x55\x88\xb9\xaf\x02\x37\xa9\xe1\x2a\xc2\x16\x28\xe0\x29\x5e\x85\xbf\xf2\x00\xda\x28\xc7\xc2\x18\x51\xb3\x34\x39\xee\x56\x6b\x0e\x9a\xe9\xfb\x86\xe7\x5f\xf1\x57\x57\x94\x35\xb5\x10\x4b\x71\xda\xe8\x1e\x17\x9d\xef\xcc\xb3\xe8\xe1\xb9\xb2\xf0\x24\x90\xbc\x23\x4a\x58\x8f\x3c\xb0\x60\x43\x3b\x64\x86\x23\x99\x63\xac\xf6\xb6\x37\x74\xe3\x07\x30\x12\x99\x87\x0f\x9b\xe3\x61\xc2\x66\xf8\x87\x25\x34\xe5\x7a\x83\xb0\x8b\x20\x02\x12\xd9\x0e\x4e\xd5\x5c\x93\x15\x37\x8a\x45\x18\xdf\xb7\xa9\x7a\x8c\x60\xf2\xc8\x6f\x57\x79\x9c\x2f\xb1\x58\xb4\xff\x30\xbf\x33\x6b\x35\xed\xc1\x1e\x91\xf4\xac\x0d\xec\xa8\x85\x4b\x36\xc2\xf7\x39\x14\xc5\xce\x8e\xba\xc5\xfa\x1c\xff\x45\xdd\x5f\xdd\xdb\x59\xe5\x2a\x7f\x82\x74\xa5\xb2\x81\xc5\x59\x1c\x93\xb6\x4c\xd2\xc2\x09\x7b\x58\x54\x25\x5b\x71\x17\x31\x92\x7f\x68\x50\xcf\x76\x0c\x82\x51\x70\x56\x53\xaa\x02\x80\xce\xfd\x07\xe8\xef\x24\xb4\xf2\x28\x59\x0b\x9b\xec\x2d\xb3\x4a\xae\x34\x0c\x1f\x0c\x31\x6c\xe4\xd6\xf0\x02\xab\xa9\xf9\xb5\x06\x23\xad\x9a\x45\x10\xd6\x53\xd6\xfa\xe8\xb5\xba\x82\x1e\xdb\xe4\xb5\x95\xd7\xe6\x34\x87\xc1\xaa\xb7\x66\x02\xba\xb4\xac\xb3\x75\x76\x5d\xeb\xdd\xb8\x52\x94\x90\x55\x7a\xba\x03\x09\x50\x9a\x6b\xc7\x27\x5d\x64\x8a\x08\x6f\xc1\x6f\x3e\x58\xf2\x04\xd8\x65\x11\xb8\x16\x12\xa2\xe6\x87\x84\xfc\xad\x01\x48\xdc\xf5\x81\xa3\x76\x06\x29\x42\xc4\x30\x92\xae\x42\xeb\x3e\x35\xc3\x29\x92\xe0\xac\xfa\x24\x6b\xce\x91\x57\x95\x0f\x16\xb2\xfa\x9e\x6d\xcc\x0f\x86\xb9\x77\xf1\xa7\xbd\x87\xc8\x27\x4f\x28\x9c\x3c\xf0\x5b\xc8\xe7\xe6\x6a\x20\x6c\x27\x8f\x15\xa6\xf5\xc5\x56\x45\x05\x80\xc9\x2a\x92\x92\xb0\xf5\x33\xc9\x90\xd4\x44\x80\xdf\x80\x0a\xfd\xfc\x0b\x08\x0f\x66\x35\x92\x7b\xd8\x2e\x7b\x6b\x1e\xad\x8f\xfa\xbf\x98\xa4\x69\xd9\x71\x56\xdd\x17\xdd\x97\x46\x59\x35\xb8\xdc\xae\x1b\x98\x1f\xb8\xad\xd9\x1a\x24\xcb\x8f\x52\x55\x0a\xd2\xcc\xf3\x4f\xc0\x93\x5e\x76\xb1\xe6\x71\x2f\x76\x82\xb9\x34\xbb\x66\x18\x99\x7d\x4d\xb4\xc3\xbd\x8c\x2c\x7f\xb2\xb3\x1e\x15\x2e\xe6\xbc\xac\xb3\xa8\x4e\xec\xc4\x46\xea\x75\x39\x03\x62\xe9\x70\x34\xfb\x03\x86\x04\xee\xb2\x5f\x3f\xff\x29\xc5\xd9\x86\x76\x16\x96\xe3\xac\xf7\xef\x3f\xc4\xbe\x78\x91\x5c\x87\x35\x1a\xa3\xda\x1d\x1f\x61\xcc\x5b\x4c\x79\x74\x79\xbc\x59\xcc\x98\xb9\x0c\xef\x0f\x0e\xab\x5e\xdb\xda\x25\xb2\xd3\x6e\x36\x85\xd8\x70\x60\x59\x50\xcc\x5d\x67\x74\x6f\x42\x16\xf4\x88\x3b\xb4\x23\xf5\x94\xac\xa0\x13\x87\x3d\x3f\xe9\xc7\xfa\x08\x51\x5f\x83\x21\xa3\x00\x5f\xf4\x61\xd3\x68\x24\xc2\x45\x6d\xa4\x06\xaa\x57\x19\xab\x10\xa6\x3c\x4a\x14\x94\x42\x15\x15\x88\x46\x9c\x83\x2e\x24\x9a\x79\x51\x06\x0f\x0b\x0f\x2d\x35\x5a\x82\x46\x7a\x0f\x99\x5c\x67\x08\x31\xef\x86\xf6\x2a\x4d\x01\x8f\x8a\x3b\xf8\xac\xa5\x30\x5f\xb4\x90\xad\x2e\x7d\x57\xd4\xf1\xde\xde\x27\xdb\xe5\xdd\x6e\x30\x6b\xfb\x18\x38\x36\x72\xe0\x5b\xce\x75\x87\x03\x96\x12\x3d\x7b\x58\xf2\x92\x73\x5d\x35\x33\x38\x2f\x94\xf5\x31\x49\x5e\x1a\x88\x62\xf6\x35\xb3\xfc\xf5\xe7\xfb\x27\x7e\xd0\x3e\xd7\xc5\xe7\xa5\xeb\x75\x9d\xb0\xa7\xf7\xbd\xb8\x84\xad\x46\xc8\xc9\xe7\xa5\xc7\x0e\x60\x92\x3c\x4c\x16\x1b\x4f\x43\x7a\xdd\x37\xd9\xbd\x32\xb5\x30\x24\xfb\xe0\x23\x29\xec\x1b\xd0\x50\x12\x5e\xc2\xdb\x11\xf1\x7a\xf8\xce\x82\xe4\x51\x79\xfc\xdd\x49\xc5\x41\xc1\xd3\xc5\x3a\xbd\xcb\xcc\x7e\x6b\x5c\x96\x0a\xdf\xcf\xa1\xb5\x56\xf6\x69\xb6\xfe\x81\x61\x23\xf4\xce\x3e\x11\x37\x97\x02\xe0\x63\x99\xbb\x31\xc8\x27\xe1\xc2\x90\xd3\xda\xb4\x8e\xc2\x65\xc9\x80\xf5\xb2\x6e\xe0\x57\xc7\x5e\xdf\x5e\x95\xc0\x17\x76\xce\x8e\x2e\xc3\x47\x3b\xe8\x09\x8d\x14\x7b\x16\x85\x26\xec\x2d\x78\x6c\xd3\xf7\xf7\xb7\x9a\xe2\x05\xbd\x17\x2c\x50\x64\x8f\xc3\xd7\x35\x38\x6b\x5e\xdd\x61\xbe\x0c\xa5\x22\x17\xfc\x9c\xfa\x23\xf7\xdf\xf8\xd0\x29\xba\x4e\x21\x55\x70\x2a\xa1\x51\xa7\x81\x90\xa5\xdb\x73\xa0\xce\x15\x84\x9a\x4b\x27\x1e\xbd\x7b\x6b\xb9\x02\xf9\xa3\xde\x8a\x13\x26\x71\x6b\x14\x38\x75\xea\x27\xbd\xe4\x15\x46\x06\x7e\xd0\x52\x75\x78\x29\xcb\xcc\x32\x2e\x0d\xb6\xda\x90\xbe\xf9\x34\x15\xa5\xf8\xb2\x59\xea\x06\xee\xd5\x9f\xf9\xb2\x8d\x49\xa9\xcb\x1a\x52\x99\xfd\x67\x6c\x1e\x7f\xaf\x88\x0f\x60\xf1\xa4\x68\x4c\x6e\xd2\xdd\xdf\xde\x34\x40\x70\x5d\x58\xb4\x32\xc8\x18\xc2\xf0\xe9\x8c\x0f\x62\x47\x54\xf8\x6b\xe6\x76\x69\xb6\x32\x74\xa7\x7a\xc9\xf9\x26\xf8\xc9\x1e\xa7\xda\x2b\xbd\x5c\x20\xd4\xdc\xba\x57\x9a\x56\x14\x90\xb4\x28\xe7\xd9\x68\xee\xd3\xcf\xcd\x3a\x67\xc2\x5d\xb9\x73\xb0\xf1\xa3\xc9\xdc\x1c\x12\x6b\x91\x86\x81\xe4\xa1\xa3\x5d\x4f\x82\xbb\x4b\xe1\x68\x66\x1e\xb2\x6b\xc2\xcb\xc2\xbe\x31\x85\x4b\xd6\x3f\x9a\xbd\xaf\x0f\x02\x5a\x7b\x7b\x36\x9f\x10\x6e\xf7\x64\xd4\xad\xb0\x31\x89\xed\x1b\x45\xb5\xf5\xd0\x6c\x54\xaf\x31\x83\x96\xc5\x38\x42\x2f\x7b\x62\x0b\xf0\x0e\x3b\xbb\x44\x05\xe3\x29\x04\x71\x74\x49\x2a\x4e\xbb\xb8\x6b\xea\x85\x1e\x8c\x20\x78\xd0\x60\x98\xaa\x53\xf9\x4d\x01\x85\x3e\x18\x7b\x72\x32\x73\x07\xe2\xd3\x05\x99\xaf\x02\xfd\x7e\xe7\xf6\x9f\x42\xbd\x3c\x06\x39\x40\xd7\x7f\xdd\x6b\x5f\x46\xdb\xd5\xad\xd8\x07\x28\x68\x10\x8d\x80\x87\x27\x1f\x16\x72\x78\x49\x42\xfc\x9d\x6d\x52\x63\xf7\x92\xc0\x57\x12\xa3\x05\xf4\x8b\x9a\x0c\xf6\xe6\xfb\x83\x2b\xe2\x78\x02\x57\xdb\xc6\x97\x0a\x9d\xce\x05\xd3\x8e\x79\xdf\x0a\x13\x58\xdc\x0b\x19\x5e\x71\x8b\x3d\xd2\xf1\x2c\xce\xb2\x62\xdd\xeb\x07\xfb\xe4\xae\x8a\xb0\xeb\x66\x00\x9f\x64\x40\x2e\xb1\xcb\x46\x81\x2c\xc8\x04\xf7\x82\xa1\x56\x31\xf1\x50\xb0\xac\x41\xb2\x2f\xf5\xdd\x15\x62\x15\xe4\xa3\x48\x7b\xa9\x73\xf2\x45\x9e\x13\x80\x4a\x1a\x3a\xf5\xd3\x46\xab\x36\xd6\x35\xc6\x7f\xc2\xd7\xd7\x62\x0a\x99\x20\xc2\x4f\xd6\xb7\x2c\xee\xd9\xcd\xa6\xf6\xa9\x2f\x21\x56\xd8\xef\xb9\xc2\x3d\x5a\xb2\x4b\x84\x31\xbf\x3b\x87\x3f\xaf\xe3\x13\xd1\x2e\xed\xa0\xce\x61\x9a\x78\xa1\xcd\xc6\x5b\xee\x35\xa0\xeb\x03\x65\x55\xcf\xef\x41\x01\x61\x96\xd6\x41\x7c\x72\xb6\x67\xf9\xe8\x8c\x26\x84\x19\x6b\xb4\x3d\x42\x1a\x9d\xb6\xf5\x9a\xc9\xb6\xa3\x67\x89\xed\x33\x7b\x92\xb1\xee\x5c\x6a\xff\x73\x7d\xe4\x06\x11\x92\x52\x1d\x03\xff\x7d\xff\x0e\xb8\xcf\x7d\xf9\xa1\xa8\xb0\xaa\xbf\xf1\x10\x5a\x34\x18\x6f\xd8\x0b\x7f\xac\xcb\x23\x04\x99\x55\xec\xb3\x34\x61\x6c\xa0\x8f\xea\x8f\xd9\xe7\x45\x49\x65\x49\x59\xfa\x0f\x47\x62\x8f\xd9\xd9\x85\x1c\x01\x39\x47\xdb\x42\x0f\x50\x61\x63\xdf\x2c\x81\x93\x64\xb0\x20\x7a\x53\x31\xd4\x55\xb0\xcb\x92\x7d\x51\x08\xf8\xa0\xd2\x6f\x66\x79\x45\xf2\x69\xe6\x45\xef\x8d\xf6\xb2\xa6\x69\x2e\x33\x68\x5a\xb4\xa2\x55\xc5\x4c\xba\x91\x52\xaa\x94\x54\xe5\x36\x75\x28\xb0\x5f\xf3\xc3\x5f\xf4\x6b\xf9\xa5\x17\xca\x23\xc7\x18\x10\xa1\xc0\xdc\xad\x01\xb0\xda\xfc\x24\x24\xfe\xed\x0d\xcb\x1a\x5a\x20\x54\xa1\x75\xaa\xa2\x69\x6f\xd7\xc6\x72\x04\x6e\xd7\xfa\x5c\xf1\xbc\xff\x30\x7b\x82\xd1\x47\xd1\x2a\xd1\xe5\x38\xec\xe4\xe3\x4c\xab\x15\x25\x67\x5e\x11\xb4\x63\xd0\xc0\xe9\x68\x97\xec\x12\x79\x6e\xce\x82\x0f\xbe\x5b\x39\x49\x70\xac\xdf\xcf\xd3\x4a\xca\x0c\xfb\xbb\x14\x7c\x58\x90\xd9\xb3\x70\x1d\xd5\x5e\x29\xf3\x18\x5e\xfe\x7d\xaf\x84\xe6\xf7\x8c\xe2\xfa\x36\xbd\xda\x06\x86\x7e\x0f\x3c\x40\xc8\x2e\xa8\xca\x4b\x7a\x71\x08\xfa\x1f\x94\x59\x8b\x2e\x67\x9d\xde\x4e\x52\x50\x18\x86\xb7\xee\x3a\x32\xcc\xf3\xf0\x46\x46\x9e\x3b\x3d\xe5\x64\x54\xdc\x74\x3e\x89\x2f\xe5\x0f\x52\x2c\x2b\xa5\xc6\x5e\x66\x2d\x7b\xf5\xfb\x04\x61\xe0\x6e\xe2\xbd\xd4\x82\xf5\x6e\x1f\x8a\x38\x4a\x84\xd4\x5b\x22\x98\x46\x7a\x92\xd4\xcb\x71\x2f\x23\x62\x22\xff\x43\x2a\x18\xba\xb1\x1b\xd8\x90\xae\xd3\x7f\xe7\xe0\x07\xd2\x72\x95\xaf\x95\x80\xa2\x5c\x85\xaa\x7e\x30\x29\x15\x24\x90\xe6\x88\xe4\x65\x0f\xe0\x50\x5d\x37\x42\xd5\x31\x31\xbb\x6e\xa4\x5e\x0a\xa8\x1e\x0a\x45\x47\x08\x69\x88\x21\x8b\xe1\x31\xbb\x46\x15\x0e\x65\xe4\x47\xc7\xdf\xb6\x6f\x84\x73\x07\x36\x82\x12\x70\x45\xf1\x03\x95\x49\xff\x50\xee\x4e\xf7\xc5\xb9\x5a\x45\x93\x85\xa9\x65\x69\xfe\xdf\xd2\xcf\x70\x7d\xdf\x02\x1c\x90\x5f\x2b\xf9\x51\x05\xea\xc1\xa8\xf9\x80\xcb\xe6\x81\x78\xd9\x3a\x04\x51\x21\xdb\xef\xb1\x30\xb8\x46\xc6\xd7\x0d\x9b\x5e\xaa\xf7\x59\xf4\x08\xa2\x2c\x15\x31\xaf\x66\x86\xf7\x67\x17\x6e\x40\x1e\xd5\x00\xdd\xea\x27\x3a\x50\x83\xaa\x5a\xac\x7f\x29\x54\x07\x49\x0f\x81\x47\xd2\xbc\xf1\x5b\xd0\xe7\x16\x95\x4d\xc3\x8b\xaf\x9e\xd2\x8d\x9c\x43\x2c\xc3\x44\xfb\x84\x77\x5e\xa0\x17\x0b\xd4\x4d\x83\xb6\x13\x0c\xa6\x78\x23\x02\xec\x39\xce\xb4\xf4\x50\xe7\x50\xcf\x4e\x89\x1d\x14\x2b\x3f\xc0\xe8\xa5\xd3\x01\xee\x52\x88\x26\xb5\xd6\x4d\x61\x18\x1c\x3c\xa3\x90\x54\x8d\xbe\xb7\x0b\x17\x88\x0f\x2e\xc2\x1a\xce\x9a\xec\x82\xe1\xd0\xc4\xe8\x08\xb6\x2c\x41\xf3\xd1\x07\x1e\xb9\x51\x20\x12\xde\x13\x9d\x66\xa9\x93\xcf\x35\x8e\x9d\x11\x79\x7a\x2d\x7f\x68\xc4\xc1\xd3\x3e\x75\xbe\x2c\x87\x7c\x81\xb2\x3c\x37\x3a\x10\x1b\x49\x43\xe0\x26\xf3\x3b\xdb\xbd\xd8\xd4\x11\xc2\x73\x53\xf1\x55\xdb\x45\x5d\x6a\x2d\x6b\x0a\x2a\x7a\x79\x96\x27\x94\x85\x3d\xd3\x53\x25\x22\xe1\x4f\x8e\x67\x33\x0f\xb8\xd8\x14\x9e\x9a\x18\xe1\x38\xcd\x80\xb6\xa5\x18\x35\x5e\x60\x6f\x2b\x18\xeb\xc2\x30\x36\xd2\x49\x83\x8d\xa5\x75\x02\xf6\x0b\x45\xcb\xf2\x41\x20\x36\x31\x22\xea\x8a\x22\xdb\xaa\x93\x7c\xf5\xaa\xd3\xe7\x19\x9f\xf9\x70\x63\x91\xdd\x6c\x2b\xec\x03\x5e\xa1\xb1\x13\x0c\xb0\xc8\x53\xaa\xb9\xff\xb2\x3e\x2a\xb7\x48\x3d\x71\xc7\x20\x31\xea\x75\x29\x10\x13\x6f\x24\x49\x03\x10\x8b\xa1\x01\x7c\x72\xeb\x29\x29\xed\x3c\xfe\x5e\x59\x6c\x03\x6f\x3d\xea\x41\x34\xfe\xe2\x34\xbf\x5c\xdb\x03\x34\x08\x51\xeb\x34\x12\x79\xcf\x80\x50\xfc\x3f\xc9\x67\x9f\x35\x04\xed\xa9\x70\x23\xc5\x95\xbc\x72\x47\x6f\xdf\xea\x19\xdc\x13\x61\x3c\x41\x3c\xf0\xc9\x93\x99\x47\xcb\x8a\x01\xb6\xd7\x53\x4a\xfa\x6a\x26\x21\xb3\x77\x97\xba\x50\x58\x83\xb3\xc9\x9a\x16\xd1\x3e\xdf\xc1\x20\x31\xf7\x4f\xd6\x93\xb7\xc1\xc6\x9e\x9d\x65\xb5\xf3\x49\xf4\xdd\xac\xb3\x37\x1e\xb4\x6e\xa7\x84\xa2\x03\xa4\xaf\x8f\xfd\xa2\x06\xdb\xda\x0e\x8a\xf3\x31\xde\xb8\x4e\x9d\xb1\xe9\xd5\xed\x81\x2d\x22\xe3\x4d\x38\x59\x70\x12\xdb\x82\x07\x75\x5b\x33\x93\x73\xdc\x60\x4c\xfa\xe2\x13\x0d\xc9\x33\xfe\xce\x84\xda\x95\xb3\xad\xda\x84\xff\x5c\x25\x45\xc6\x11\x0c\xc5\x98\xa9\x71\x18\xf7\x3a\x99\x1e\x92\xe8\x89\x97\x35\x08\x94\x82\x5f\xb4\xe8\xf8\x53\xe4\xac\xf4\x15\x49\x73\x4c\xc7\xd4\xe9\x3a\xad\xae\x95\x85\x98\xc9\x07\x1d\xcf\xc6\x20\x6c\xa8\xa9\x16\xc9\xe9\xbc\xe2\x92\xe6\xed\x38\x16\xaf\x88\xf8\x91\x9d\xeb\xea\x01\xbe\xde\xe0\xef\x1e\x87\xb1\x50\xc9\xf8\xc3\x62\x06\x51\x7e\xeb\x2a\xe1\xfb\x83\xc6\xe8\x62\xe3\x67\x59\xd7\xf8\x00\xd0\xdd\xbc\xdf\x08\x44\x5e\x96\x03\x30\x66\x14\xc8\xce\xeb\x07\xed\xa3\xc7\x1c\xee\x4f\xe0\x8b\xeb\xaa\xed\x8c\xc8\x8b\xb7\xb1\x59\x8e\x0d\xe4\x2d\x3b\xfd\xa0\xc6\xd4\x39\x26\xc4\xee\x2c\x76\x78\x83\x14\xcf\xb8\x61\xfd\xe1\x89\x78\xee\x12\x48\x03\x6f\x05\xf3\x42\xf6\x8e\xb6\xe6\x0b\x51\xdf\x90\xdf\x06\x1b\xa7\x8c\x9c\x35\x9c\x2a\x96\xc2\x69\x40\x85\xd6\x38\x53\xaf\xeb\xae\xb2\x08\xa8\xfe\x0f\x16\x87\x71\x61\xe9\x35\x6e\xee\xbb\x51\x3a\xda\xa4\xe5\xc8\x0e\xad\x6c\xfc\x4a\x42\x3e\x58\x95\xb4\xcb\x02\xbe\xf5\xd8\xe9\xf6\x46\xdc\x82\xd9\x6e\x95\xfd\xc4\x83\x72\xda\x6d\xa9\xaf\xe8\xf2\xb2\xe2\x38\xe9\xc0\xe1\xdc\x66\xe7\x83\x55\x00\x38\x19\x94\xe1\xc3\xca\x05\xf1\x5b\xb9\x70\x1a\x4f\x1a\xb4\xda\x88\x67\x3b\x38\x1d\x9d\x4b\x18\xd2\x81\xe3\x90\xf7\x70\xda\x4a\xcd\xe5\xb7\x5f\xef\xf5\xdb\x48\x8a\x09\x34\x64\xa5\xa6\x86\x28\xd2\x43\x4d\x23\x46\x93\x9e\xd4\x2f\x55\xb3\x48\x1f\x8c\x5c\xb4\xca\xfe\x73\x65\x97\x56\x7d\x92\x16\xf6\xcd\xae\x45\xf8\xe5\x83\x1b\x14\x42\xd8\x90\x2e\x28\x8a\xb0\xc7\x11\x35\x2f\x69\x5f\x4f\x4e\x61\xba\x0d\x09\x7d\xd1\xfd\x66\x6d\x24\xa5\xdd\xd3\xad\x91\xd8\x27\x03\x3b\xf4\x92\xec\xbb\xe6\xfd\xfb\xdf\xc3\x52\x4f\x68\x48\x7a\x06\xe1\xad\xa2\x06\x39\x24\xe4\x1b\x3b\x79\x85\x60\x8d\x73\x76\x00\x81\xf2\x01\xd4\x9e\xc2\xe3\x01\x56\x5d\x5c\xcc\x72\x11\x13\x3a\xb0\xb6\x3d\xd8\x28\x1d\xba\xc5\xe7\xef\x2e\x03\x16\xa0\xfa\xec\xe5\x40\xa3\xda\x32\x20\xd0\x67\x92\xb0\xa5\xf9\xa3\xa5\x7d\x0a\x6b\x17\xf7\x9e\x17\x20\x8d\x65\xe2\xac\x92\x49\xd8\x8f\x54\x37\xf0\xb2\x6a\x16\x42\x4c\x0c\x49\xe6\x35\x2a\x31\xef\x62\xed\xed\xb5\x1f\x0e\xc5\x08\x4c\x88\x0d\xed\x16\x52\x00\x69\x76\x35\x9d\x4b\x73\x10\x45\x5c\xab\x7e\xb8\xeb\x0c\xbd\x7b\x0c\x6e\x40\x05\xe5\xaa\xed\x6a\xa1\x30\x64\xd7\xa7\x51\x2d\x8b\x0b\xa5\x55\x11\xf3\x78\x03\x49\x83\x60\x82\x23\xb0\xde\x61\x21\xa4\x94\x8e\x6f\xbd\xf0\x0b\x40\x66\x27\x7c\x71\xe7\xf0\x44\x45\x26\xa3\x98\xe5\x24\x53\x31\x61\x68\x24\x98\xb2\x7f\xb2\xd5\x18\xd4\x8d\x1d\xdf\xd7\xe1\x4f\x64\xf8\x6e\x65\x77\xac\xef\x05\xa5\x54\xb5\x08\xf4\xf7\x74\x81\x15\xa7\x24\x6b\x84\x69\xc1\xd0\x17\xe0\xb5\xae\x66\xfa\x6c\x1e\x73\x15\xc1\xb9\x8c\xb8\x82\xd4\xea\xf5\x22\x45\x5d\x9a\xa4\xa0\x0d\x1c\xb2\x2c\xac\x11\x1a\x4b\x98\x69\x59\xd4\xac\x6d\xa0\x44\x5d\x4c\x8d\x49\x0e\xc9\x14\x3c\xe5\x7b\xda\xf5\x39\x41\x93\x62\x66\xa0\xce\x7f\xfd\x92\x5f\x4a\x70\x5a\xcc\x7e\xa8\x74\x99\x27\x42\x87\xbb\x38\xcc\xbf\x94\x90\x81\x29\xe6\xd5\xea\x2a\x14\x17\xca\xfb\x84\xa8\x17\xd4\x03\xad\xe5\x89\xfa\xd2\xf5\xbf\x43\x70\x74\xb1\x28\x81\x0f\x96\x53\x5e\xc0\x11\x6e\x4d\xe0\x08\x0a\x78\xcf\xba\xd4\x3e\xfc\xb9\x74\x73\x66\x63\x2b\x7a\x51\xbc\x0d\x54\x59\xb7\xa7\xa4\xdf\x99\x06\xc9\x6b\x93\x86\xd6\x56\xd7\x62\x25\x9f\x7e\x18\x30\xf2\x94\x52\x56\x1b\xb6\xc2\x7e\x19\x2d\x1a\xa0\x86\xa8\x7d\x65\x8f\x3d\x4d\x83\x16\xb6\x22\x01\x6c\x58\x58\x26\x6e\x24\x93\x58\x58\xa1\x83\x63\xe9\xc8\x05\xa5\xc6\x16\xa4\xb6\x3f\x55\x56\xde\x0b\xe8\x89\x0f\x33\xa4\xf3\xd5\x16\x27\x0f\x4c\x92\x23\xcc\xe8\xcd\x9c\x6f\x27\xe6\x59\x74\x81\xbd\x46\xb5\x44\x44\x07\x0e\x92\xa3\xad\x4e\x34\xe7\x1a\x3a\xfb\x45\xa7\xc2\x13\x66\xaf\x47\x06\xbf\xb3\x5d\x51\xd1\x35\xb2\x1b\xbb\x63\x4a\x0b\x88\x22\x47\x3d\x66\x93\x81\xfa\x1e\xdf\xf3\xb9\x28\x3e\xbb\xef\xa5\x27\xc1\xba\x6f\xaf\x24\x07\x1b\x0f\xff\xf1\x66\xa6\xce\xa9\xe6\xd7\x3f\x21\xbc\x2a\xbc\xc9\x5a\x74\xbc\x00\xab\x62\xca\xa2\x18\xce\xa9\x0b\x2f\x35\x91\x6f\x0a\x9d\xa2\x68\x98\xd4\x6c\x61\xa5\xac\x64\xff\x59\x20\x9f\xf4\x12\xbc\x8e\xd0\x71\x95\x6c\xb8\x5b\xad\xe7\x6e\x84\x3d\x62\x1f\xff\x35\x16\xf4\xc6\x35\x22\xab\x60\xe3\xeb\x91\x4c\xec\x38\x36\xba\x02\xfc\x4d\xcb\x8a\x92\x93\x73\x70\x04\xd3\xe4\xa4\xa8\x50\x03\xff\xce\xa4\xd7\x69\xe9\xa9\xbe\x5e\xf1\xeb\x41\x29\x58\x07\x2a\x67\x73\x48\xc5\x76\x28\x45\x80\xb3\xba\x45\x05\xd3\xd8\x54\xd6\x45\xae\x06\x10\x61\x6f\x11\x88\x7b\x63\xaf\xdf\x82\xfe\xeb\xe3\x70\x3f\xd7\x7d\xe0\x59\xde\x43\xa1\x4b\x9a\x34\x99\x79\x0b\x4a\xce\x63\x0a\xd5\x5e\xe3\x9a\x00\xd0\x10\x34\xe8\x29\xfd\xf2\x4a\xd7\xe1\xee\x5f\x79\x06\x94\xba\xe2\x38\x1b\xdd\x55\x6f\x71\xc3\x52\xf4\xcd\x81\x54\x7a\x58\x5a\xdc\xa4\x01\xcd\x64\xfb\x5e\x0b\x92\xf8\xc9\x91\x1f\x9a\x37\x1d\xd3\x6b\x01\x12\x56\xf5\xdb\xef\x82\xef\x46\x6e\x61\x39\xf5\xef\xeb\xc5\x50\x7c\x80\x6f\xc9\x52\xdc\xb0\x15\x31\xc9\x81\x60\x25\x08\x0b\x99\xdc\xfa\x1c\x44\xaf\x77\xa9\xb4\x71\x04\xdd\x92\xe2\x09\xf7\x24\xfd\x21\x45\xcf\x9f\xcf\x82\x64\x66\x41\xfb\xc7\x81\x57\x09\x31\x6a\xb6\x6c\x58\x08\x8e\xb9\xe5\x0e\xb2\x64\xac\x23\xaf\xb3\x61\x0b\x9b\x2f\x52\x5e\x00\xb2\x39\xdb\xe5\x1b\xbc\x20\x38\x1c\xac\x2f\x2f\x44\x34\x6f\xa4\xb3\xc7\x1c\x0e\x45\xba\x53\xa4\xc8\xeb\xc2\x49\xc8\xe2\x41\x9f\xf7\x8a\xb9\x75\x00\x1a\xe8\xae\xce\x98\x82\xb6\x10\x3b\xcd\x15\xce\xc3\xee\x72\x2a\x1a\x25\x07\x0c\xb0\xa9\x1a\x0b\x1a\x17\x75\x25\xca\x5e\x42\xf3\x8a\xc5\x2e\xdc\x25\xbb\xe0\xca\xac\xc1\x42\x4d\xee\x58\xeb\x5d\xf9\xe1\xe3\xde\x64\x8a\x77\x60\x9a\x0f\x2f\x55\xab\xc6\x24\x0d\x7e\xee\x8b\xc7\xb1\x22\xe7\x4f\x85\x86\x3d\x69\x6e\x36\x72\x37\x3a\xfa\x20\xb1\xb5\xa2\x17\xd1\xd3\x7a\x7e\xa1\x37\x5d\xd9\x0d\xf5\x12\x82\x62\xaa\xd7\xc2\xe6\x43\x59\x4a\x79\xca\xf1\xae\x55\x60\x28\x9b\x1c\xd1\x97\xab\xd5\x72\x5e\x1d\xa9\x5d\xe9\x5e\x33\xf9\x64\x81\xc9\xe2\x08\x39\xd9\x6f\xef\x29\x07\x26\x9d\x82\x56\x77\xd2\x45\xcb\x93\x26\x9b\x44\x16\x3d\x75\x59\x90\xba\x2c\x65\xac\x1b\x17\x00\x90\x9a\x5f\x9a\xc4\xf2\xff\xd3\xf5\xae\x5b\x92\xea\x46\xdb\xe0\xbd\xec\x59\x33\xff\x68\xdb\xef\xbb\xc7\xcb\xbe\x9b\x09\x44\x00\xea\xd4\x81\x0e\x49\x99\x95\x75\xf5\xb3\x90\x48\x20\x1e\xd5\xf7\xcf\xbb\xdd\x55\x9d\x09\x52\x1c\x9f\xc3\x37\x05\x36\xca\x55\x13\x9b\xbc\x31\x82\xc9\x6a\x2a\x5e\x6d\x3a\x37\xa6\x43\x46\xf3\x3c\xaf\x9b\x34\x8b\xe8\xbf\xaf\x4f\x83\xc8\x94\xc3\xca\x59\x7d\x66\xc7\xcf\x86\x94\xbe\x39\x9b\xfa\x18\x1e\xaa\x5a\x5e\x1a\xde\xf3\x76\x5a\x47\xdc\x9a\x1e\x34\x52\xfd\x2d\x38\x3d\xde\x1f\x87\xa9\x2b\x76\xdf\x9b\xa1\xaa\xeb\x74\x48\xcb\x69\x96\xcd\x58\x31\xd9\xdc\xbc\x6a\xae\x42\xb6\xe0\x46\x25\x14\x49\xac\xfc\x9e\x4b\x58\x69\xdb\x2c\xb0\xec\x6c\xcc\x88\x48\x5d\xc0\x21\x70\xbe\x86\x30\x17\x21\x03\xe8\x30\x29\x5f\x6e\x32\x9f\x17\xe8\x6c\x30\xeb\xa6\xe7\x0b\x1b\xe5\xae\x2b\x69\xdc\xe7\xd9\x4e\x13\x4c\xcd\x66\xb1\x0f\xa2\x90\x06\x47\x61\x39\x06\xd7\x7f\xab\x9f\x6a\xe3\xe9\x9b\x46\x6a\x25\x7d\xde\xab\x8e\x57\xd4\xf4\x3a\x77\x7f\xcc\xe3\x39\x97\xbd\x92\xee\x5d\x10\xf1\x7c\x65\x5c\x40\xa2\x36\xfc\x2c\x43\xf3\x03\x4a\x3b\x95\x1e\x88\xce\xa8\x4e\x3e\x93\x85\x40\xb2\xd2\x37\xc9\x04\x63\xa1\xd5\x92\x36\x83\x0f\x66\xf5\xe0\x22\x58\xad\x12\x9e\x44\x0e\xe1\x1e\xca\x09\x34\xbf\xc0\xa3\xdd\x9f\x00\xdd\x33\xf7\xd9\xb9\x57\x51\x72\xd4\x62\xce\xe5\x85\x5c\x34\xb7\x65\x11\x3d\xfc\xb6\x95\x08\x80\x2c\x5c\x3b\xcf\x56\x29\x9b\x25\xfb\x58\xb5\xff\x12\x69\x8e\xef\xc8\xc2\x61\x52\xd5\x30\x55\x39\x77\x95\x16\x05\xe5\xa1\x72\x53\x59\xea\x7a\xd3\x29\x76\x0d\xab\x8f\x93\x9d\xed\x0f\x00\xd0\xbd\xb2\xad\x8b\xfd\xa8\x52\x61\x3c\xc7\xc4\x77\xd1\xe5\xe6\xd4\x80\x59\x55\x3b\xbb\x9a\xf2\xfb\xde\x76\xfb\x7c\x0f\x7b\x73\xd1\x44\xd1\x59\xc8\x3a\x90\x16\x99\xac\x6a\xc1\x7c\x09\xda\x1f\xfa\x65\xf3\x3a\x09\xbd\x92\xd6\xf3\xd3\x66\x8f\x12\xfd\x18\x03\xcc\xb9\x1a\x42\x13\xca\x3a\x47\x16\xa4\xf6\x66\xeb\x50\x4f\xa6\x26\x32\xa5\xed\x5f\xf7\x88\x5a\xda\x7f\xa2\xd0\x99\x22\xd7\x58\x67\x1b\x60\x18\xcd\xf4\x63\x58\x0a\x0f\xf9\xd5\x44\x8c\xf5\xfd\x38\xf4\xde\xcf\xd9\x09\xbd\x5d\x14\x4d\xdf\xc8\xdf\x1c\x46\x2a\xea\x58\x8d\x4d\x4d\x0e\xca\x27\xd8\x44\x39\x92\x58\xcc\x7a\xe7\x33\x99\x46\x2d\xb2\x8c\xe0\xfa\xed\x3e\x8f\x79\xda\x10\x40\x01\xc2\xb9\x21\x20\x91\xd6\xc7\x18\x3e\x53\xfd\xf3\x2f\x5a\xf1\xa4\x6d\xbe\xcb\xa8\x1e\xc7\xbb\x64\x7a\xa8\x25\x32\xc1\x9c\x7e\x2e\x61\xb1\x7f\x29\x43\xeb\x4d\x3f\x14\x32\x8d\x6a\xaa\xe3\x0a\xd3\xf3\x3d\x64\x29\x9a\x36\x46\x23\xb9\x18\xf8\xff\xe0\x38\xbc\xd7\xec\x5d\x21\x01\x7b\xeb\x5f\x9a\x80\xbb\x91\x79\xe0\x3a\xba\x21\x7e\x74\x56\x7a\x51\x5a\x7f\xa9\x97\x36\x15\x37\xac\x44\x93\x62\x5d\x8e\x31\x65\x2d\xfd\x39\x1f\x7a\x31\x97\x42\xef\xf3\xb3\x8c\xbd\xe9\x82\x6d\x9d\xd6\xe8\x61\x52\x8e\x08\x86\x85\xc3\x87\x27\x70\xc5\xbb\xc3\x8e\xf0\x0a\x89\x36\x67\x55\x73\xd8\x90\xad\xb7\x13\x80\xa2\x84\xf7\x4a\xa9\x1b\x2b\x64\xfa\x1a\xf8\x8b\xfd\x06\xa0\xa7\x66\x28\xfa\x97\x32\xb5\x0f\x79\xfd\x69\x10\x56\xe4\x4f\x59\x1b\x0a\xe1\x1a\xe0\x92\xbc\x07\x17\x3b\x7e\x8c\xff\xa0\x51\xce\xf6\xec\x4f\xa1\x4f\x1e\xbd\x8f\x11\x5e\x21\x3d\xad\x53\x76\x77\x46\xde\x09\x68\x38\xc9\xac\x2f\x92\xfc\xad\x59\x9f\x95\x42\x44\x8e\xf4\x1a\x68\x13\x1e\x84\xe7\xa8\xff\xf4\x43\x9e\x1b\xfe\x57\x9f\xad\x10\x8d\xeb\x06\x29\x25\xc7\x61\x8e\xa6\x26\xc5\x33\xe9\xd7\x85\x59\xea\x3e\xfe\x30\x7f\x88\x1f\xe7\xc7\x02\x4a\xe1\xff\x81\xe2\xe6\xcd\xc3\x06\xa7\xb4\x50\x5f\x4c\x2e\xaf\x7a\x13\x7e\xe8\x5c\x9c\x72\xdb\xec\xe2\xd8\x3c\x1c\xce\xf9\x6a\x8c\xce\x4e\x0b\x32\x1e\x22\x6c\x69\xed\xeb\x7e\xa5\x1f\x1c\xa6\x66\xdb\xfc\x9f\x2b\xfc\xa6\xa4\x0f\xe1\x58\xd0\x59\x6e\x8d\xc2\x71\x11\xda\x56\x98\xb8\xb6\x58\x74\xfd\xa0\x4c\x56\xcf\xef\xc6\x51\xf3\x71\x3f\xc7\xeb\x4a\x6a\x6e\xbc\x87\xc6\x26\x7e\xd0\xf9\xe7\xa6\x45\xa8\x42\x1b\xd5\xbf\x9e\x23\x48\x16\x15\xd1\x2d\x4b\x95\xca\xd8\xbf\xdf\x12\xd4\xdc\xc1\x48\x54\x1a\x58\xe6\x57\xfc\xe5\x7f\x8d\x77\x39\x3c\x1a\xe3\x93\x07\x7a\x32\x92\x86\x7c\x0c\xbc\x1f\x7e\x0c\x58\x8e\x17\x72\x03\xe7\x15\x8c\x14\x1f\xeb\x6f\x55\x26\x5b\x4f\x4b\x2f\xf4\x57\xc2\xa9\xd3\x76\xef\xea\x17\x41\xb3\x9e\xbd\x09\x51\xad\xe6\xcc\x50\x59\xd1\x38\xd9\xde\xba\x63\x4f\x71\x0f\xed\xca\x4a\xd2\xe8\x20\xd7\x67\x7b\x55\x21\x3f\x6d\xf9\x61\x63\x5d\xfe\x6b\xcb\xea\x3d\xfd\x77\x46\x6d\xf3\x0f\x40\x84\xe9\x7e\x64\x9b\xc0\xa0\xed\x5f\xb0\xa3\x89\xc3\x58\x44\x0d\x6d\x54\xb6\x27\x47\x49\x61\xc2\x17\xdc\x1c\xd0\x3c\x5b\x51\x10\x3c\xb3\xee\xcd\x97\x8b\x8b\x96\xfb\x37\x0f\x6e\x70\xd8\x5b\xab\x85\x2b\x40\x47\xe6\xd1\x0b\xe3\x27\x72\x36\x1d\xb0\xfb\x13\xcf\x32\xfe\x86\x6a\x3b\xc4\xbd\x7a\x47\xf5\xba\xa2\x46\xe6\x12\xb1\xe3\xae\x14\xae\xfc\x42\xaf\x03\x13\xfd\x76\x0c\x6d\xee\x61\x75\x16\x4e\x70\x17\x3d\xcb\x21\xcc\x7b\xd3\x5e\xb5\x7b\xbb\x01\x60\x3d\xad\x10\xb6\x49\x1c\x1d\x7b\x15\xf3\xa6\x8d\x8c\x72\x99\xa9\xfc\xa6\x4f\x5d\x73\xd3\xf4\xac\xba\x50\x00\x91\xdf\xfb\x78\x1b\x66\x21\xe1\xa9\xaa\x80\x67\x89\xc9\xc4\x4d\x13\x1d\x84\xa9\x93\x6d\x8e\x2f\x96\x6a\x8b\xa8\xaf\xd5\xb3\xc3\x08\x96\x10\x08\x4a\xc6\xa5\x8e\x00\xcf\x3e\x2b\xa5\xc2\x3f\xae\x7c\x63\xc9\xcd\xfd\xfd\xd2\x9f\xa2\xbd\x33\x04\x05\xbe\x27\x42\xa2\x5c\xe7\x4a\x18\xc5\x01\x2b\x69\xa3\x47\x45\x44\xdc\xa2\xc8\x4a\xf9\x90\xef\x39\x77\x36\xe4\x0a\x5e\xfe\xaa\x5a\xaa\x62\x06\xc3\x3a\x83\x97\xe5\x7e\xe8\x72\x94\x73\x76\x7b\xc5\xc8\xad\x1c\xe3\x81\x73\xaf\xef\x6c\x95\x47\xb8\xe5\xe7\x9c\xd7\xa6\x02\x7c\xad\x9f\x40\x05\xbd\x9e\xfd\x31\xc2\xf0\xe1\xb9\xb7\x05\xde\x76\x44\x05\x6a\xe0\xe6\x2b\x29\x85\x2e\x05\x57\xe9\x2d\x1e\x72\x1c\x8e\xff\xa9\x1e\x35\x37\xeb\x62\x94\x6d\x1b\x69\x84\x18\x41\xce\x6a\xb2\x9a\x0d\x2c\x19\xf8\xef\xd2\x86\x28\x97\x38\xf4\x47\xc3\xee\x07\xd2\xeb\x1e\x08\xc2\xb7\x55\x09\x74\x8b\x92\x29\x45\xa7\x66\xeb\x93\x7d\xde\xff\xdd\xe2\x47\xe1\xb6\x80\xba\x4a\xdc\x58\x69\x50\x49\x79\x88\x49\x05\x3f\x43\xfa\xb7\x9f\x4a\xf7\xaa\x30\x72\x6c\x67\xeb\x02\xfb\xf9\x1e\x45\x75\xaa\x6c\x58\x54\x8f\x48\x48\x3d\x4c\xb9\x09\x3a\xde\xee\xfc\x5c\xba\x81\x74\x25\xf2\xfe\xb0\xc4\x3d\x24\xbf\xd5\xa5\x09\x4e\xb7\x98\x17\xd9\xf0\xbc\x45\xee\xde\x0d\xee\x15\x35\x02\xf2\x6a\x2e\xee\xfc\x4e\x6d\xb8\x5c\x70\x74\x18\xc9\x9f\x1d\xc7\x85\x1a\xab\xeb\xc9\x49\xf5\x43\x11\xb9\x15\xd5\x4d\x64\x64\x82\xb9\x13\x35\x57\x5d\xe0\x16\xde\xc4\x98\xfe\xe7\xce\x69\x33\x24\x02\x24\xcf\x64\x58\xf0\x9d\x38\x35\xdc\x19\xe3\x4b\x81\x68\xac\xf7\x76\x91\x8e\xa7\x54\xc6\x8d\x63\x20\x98\x9f\x4f\x36\x92\xf9\x01\x9d\x1f\x25\x83\xa0\x95\x7c\x44\xd1\x6e\x81\x7f\xa9\xe0\x94\x0e\x38\xdd\x00\x84\x02\x77\xc4\x5b\xb3\xd6\x21\xc0\x65\xc8\xb1\xf7\x48\x3a\x27\x1e\x66\x01\xaa\x97\x60\x58\x0b\xbc\xc8\xd9\xfb\xbb\x2c\xca\x0c\xad\xc2\x22\x32\x6c\x39\xf3\xa1\xb8\x7c\x55\xac\x87\x21\x47\xaf\xc4\x5b\x14\x4a\xea\x14\xd3\xf9\xeb\x3e\x90\x95\xb8\x48\x53\xf7\x52\x67\xd1\x76\x2b\xda\xaa\x70\x73\x0f\xa0\xec\x78\x5b\xf7\xee\x73\x4f\x6e\xe4\x7a\x6b\xe6\x17\xf4\x17\x95\xfa\x25\x6c\x0e\x25\x89\xdb\xd8\x1a\x7b\xce\xc3\x3c\x40\xa0\x82\xee\x35\xdf\xf8\x27\x7c\x80\x4f\x46\x8d\xd6\xc2\x4f\x2b\x1b\xe1\x99\x94\xcd\xe9\xa8\xe6\x87\x15\x99\xc9\xda\xef\x69\xad\xec\x75\x9d\x45\x29\x37\xfb\xf9\xfa\xf8\x6e\xe6\x22\x61\x2e\x09\x4c\x6c\x84\x73\x04\x07\x93\x43\x03\xa3\x15\xe5\xe7\xfb\x9c\x2a\xe1\x43\xe0\x03\xbf\xe8\xad\x44\xcb\xeb\x36\xbc\x04\x5c\x82\x8f\x1f\x57\x5f\x75\xf1\x7a\x27\xae\xfd\xb1\xf4\x36\xac\x87\x24\xec\xfd\x63\x97\x50\xcd\xf1\x3b\xc3\xf0\x1f\x1c\x87\xf6\xe2\x2e\xdc\x83\x8b\xf5\xec\x26\x6d\x48\x1f\x92\xad\x26\x06\x68\xd8\x4f\x8a\x05\x5f\xd1\x58\xaa\xc4\xa8\x64\x65\x88\xd2\xde\x98\x28\xbe\x31\xab\x6f\x5b\xff\xb6\x72\xff\xfb\x2e\xa7\xa6\x87\xc0\x5e\x2b\x00\x95\x80\x8e\x6e\x24\xa3\x6e\xa2\x71\xe2\xd6\xd4\xba\xef\x87\xf8\xd7\xfc\x0b\x7e\xe5\x0f\x62\x46\xfb\x0d\x38\x13\xaa\x0a\x83\x76\xd3\xad\xf0\x23\x5c\xb6\xc9\x37\xa0\xd3\x01\x26\x02\xc8\xed\xe9\xe7\x7f\xbb\x79\x13\x03\x79\x49\xeb\xbf\x2f\x54\xa6\x0e\x6a\x95\x8e\x51\xdc\x65\x4d\x22\xc7\xba\xe4\x3f\x57\xc6\x4b\x9e\xf4\x93\xd0\xfb\xef\x71\xbc\x93\x01\x0e\xcb\xa8\x03\x74\x73\x29\x0f\xa5\x64\x27\xc4\xda\x96\xd1\xfd\xd0\x74\x6d\x31\x9d\x62\x78\xe7\x3f\x92\x95\x0a\x4c\x4c\x86\x44\x63\xf9\x02\x23\xeb\xb4\xa7\xea\xad\xb6\x62\xbe\x0c\xf2\xdd\x4b\x5e\x0f\xb0\xf5\xc5\xa0\xc6\x85\x5c\xda\x6c\x08\x9d\xb1\x0a\x29\xda\x75\x45\xc5\xec\x07\xbe\x06\x3c\xac\x73\x6d\xe0\x17\x8f\xa3\xc2\x25\xac\xec\xac\x89\x5b\xee\x38\x01\x88\x5f\x3f\x2a\x0c\xec\x4f\x57\xdd\x92\x8f\xbc\x14\x35\xa0\xda\x53\x80\x66\x66\xa4\x5f\x6a\x86\x5f\xf5\x90\xb4\x60\xc3\xf2\xde\x34\x8c\xa3\xc9\xfb\x29\x90\xee\x1a\x91\x35\x5d\xf6\x1a\x36\x17\x34\x7a\x33\xd1\xfb\x38\xd9\xfc\x1e\x0c\xe8\xde\x92\xb7\x58\xd2\x37\xb8\xcf\xe9\xae\xf3\xf7\xf5\xe7\x40\xd3\xa9\x02\x05\x3a\x7d\xb8\x51\x89\x40\x6f\x2c\xa9\x7c\x18\xd5\x57\xea\x63\xd3\x09\xb1\x67\xd2\x6e\xf7\x4b\x8b\xec\x37\x8b\x51\x32\x1d\x4c\x7c\xe5\xfa\x4b\x2e\x76\x83\x32\xbd\x62\x57\xf6\xf4\xda\xb9\x46\x48\x99\x67\xa7\xbb\x8d\xcd\xee\x1f\xf2\xfe\x42\xb4\x41\x47\x65\x56\xf0\xa4\xac\x2b\x1a\x21\x54\x83\xe1\x85\x87\x8d\xde\xf8\x31\x5f\x75\x4d\x42\xdd\x5e\x3f\xfe\xc6\xf5\x97\x79\x87\xbc\xaa\x4e\x21\x6e\x20\xc7\x92\xeb\xfb\xbb\x44\x34\x52\xd4\x3d\x4c\x6e\xcb\x98\x4b\xf0\xd5\xf7\xae\x22\x73\x94\x85\x95\x5d\x2e\x28\x34\x65\xb1\x46\x3f\x8e\x43\xfd\x5e\xf7\x3f\x88\x6a\x7a\x8b\x12\xb4\x8d\xde\x1e\x69\xf2\xf2\x27\x9a\x8a\x83\x54\x5c\x23\x3a\x1f\xa4\xf1\x2b\xb6\xcc\xf3\x6d\x65\x78\xcd\x4f\xf7\x03\x3f\xe4\x55\x62\x03\xbb\xdf\x66\x3d\x5a\xc7\x6c\x05\x5c\x08\xc3\xea\x6a\xb5\x1a\xbd\xb4\x91\x74\x13\xe1\x22\xc0\x12\xda\x4b\x33\x55\x42\x85\x18\x2a\xa9\x41\xaf\x30\x38\xf3\x1e\xdf\xf4\xa1\xa3\x90\x6a\x87\xf6\x61\x1a\x5f\x6c\x24\x8d\x2d\x28\x6f\xbd\x17\x6a\x76\xfb\xf7\x37\x1a\x48\x24\xbe\xf4\xc0\x4d\xb8\x4a\xb4\x76\x03\x13\x53\xc5\x7e\x35\x7c\xce\x38\x6d\x27\xf0\x9b\xd5\x59\x2f\xe9\x27\x1e\x76\xca\x28\x6b\xd7\xb0\xcc\x2f\x78\x8e\x0e\x81\x42\xd7\xcc\x1f\x48\x96\x5d\x9b\xb8\xc7\x5b\x3f\xa2\xc6\x4d\xaa\xdb\x20\xcd\x62\x08\x54\x74\xd6\x8e\x4f\x16\xe1\xbd\x1e\xec\xac\x6d\x66\xfc\x22\x2b\x09\x0f\x79\x6f\x5b\x60\x17\x19\x43\x81\x0c\xbf\x91\xe4\x00\x3b\xff\xca\xdb\x45\xda\x09\x19\x89\x29\x0d\x79\xe5\xa1\x1b\x3f\xf0\x97\x81\xb9\x90\x70\x35\x6e\x82\x6f\x5f\xb1\x90\xad\xc1\xbc\x2a\xc8\x67\x71\x9d\x16\xaf\x4d\xb5\xff\x85\xb5\x63\xa0\xef\xef\x7b\x28\xa8\x4a\x37\xea\xc4\x47\xf0\x16\x59\xf6\xce\x1f\x80\x63\x7b\x3f\x3f\xac\xac\x35\x3e\xeb\xd7\xf5\xa4\x17\x48\xf9\x05\x82\xde\xd6\x8f\xe4\x28\x80\xb4\x48\xd3\x36\xba\x8f\x6a\xf3\x2a\xa5\x5b\x5a\x4e\x08\xe7\x9b\x98\xe5\xc3\x97\xbc\x18\xd9\x24\x98\xfc\x2b\x68\xcd\x9c\xcd\xc8\xbf\xaf\x08\x57\xd5\x59\x3d\x80\x14\xf7\xdf\x80\x25\x5b\x66\x99\x1d\xf0\xf2\xda\x43\x7e\x77\x32\xc2\x93\xd5\x6b\xdb\xf9\x10\x99\xbb\x8e\x66\x1c\xe3\xd4\xa9\xc7\xd6\xc9\x19\x56\x58\x0c\x63\xba\x78\xe7\xb5\x90\x9b\x69\x70\xf4\x54\xdf\xea\x30\xa4\x52\xbf\xe4\x23\xeb\xa8\xd1\x1a\x06\x4c\xa0\xbd\x4d\x9d\xde\x9c\x8f\x9f\x59\xd0\x6d\x34\xcb\x83\x43\x8f\x30\xab\x07\x3c\x29\x8e\x20\xf6\xf0\xa2\x6a\x54\xec\x34\x4c\x47\x65\xfc\x38\x15\x83\x5d\x4b\xe5\x92\xae\x8a\x37\xb9\x96\x30\xa1\x02\x7c\x0c\x69\xb3\xf0\x87\xa7\xc3\xf0\x95\xc1\x79\x82\x6e\x94\xc9\x30\xa2\xe6\x0c\x6d\x7b\x2f\x03\x78\x31\xcf\xc9\x26\x3c\x8d\xb8\x55\xb7\xa9\x0e\x71\xee\xd1\x9f\x37\x35\xd3\x73\xce\xfe\x29\x76\x82\x6a\x37\x50\x9a\xe8\x6e\x4e\x73\x10\x23\x4e\x30\x57\xdd\xa0\x7d\xa4\x02\x53\x1f\x12\xf0\x75\x64\x96\x9f\x50\xd8\xa6\x4e\x9b\x7f\x42\xaa\xb6\xd5\xe2\xe0\x2d\xa2\xc6\x5e\x3d\xfd\x2c\xc4\xd7\x30\xd5\xf5\xa0\xb6\xab\x88\x6e\xf2\x14\x86\x87\x9d\x26\x8d\x71\x7b\xa7\xcc\x1e\x93\xc0\x56\xe4\xa7\x08\xf7\xd3\xfa\xe4\xf8\x8e\x7d\x97\x30\x32\xc1\x3a\x67\xa5\xa4\xab\xcb\x39\x7a\xad\x71\x5a\xa9\x31\xc5\xcd\x9a\xab\xee\x58\xf7\xb8\x9f\x8b\x70\x53\x9b\xf9\xf5\xfa\xa5\x7e\xaf\xf8\x83\xd2\xf7\xdf\xab\x31\x3e\xf6\x9c\x7f\xdf\x8e\x60\x77\x68\x60\x74\xf0\xa7\x58\xd1\xba\xcc\xd5\x22\x1a\x1e\xd5\xde\x59\x42\xc7\xf9\x93\xea\x73\x5b\xb5\x82\x67\xf2\xfe\x8d\x7b\x53\xc2\x59\x69\x59\x2e\x8e\xb0\xed\x95\x98\x78\x38\xe4\xc9\xd5\xaf\xa3\xf2\xd1\xca\xf8\xf7\x95\xbe\x6c\xee\x98\xbc\x66\x65\xda\x86\x0f\xe6\xe9\x9e\x63\xea\xc2\x04\xf8\xc3\xfc\x4a\x87\x6e\xe6\x35\x73\x8d\x0b\x01\x93\x7f\xb2\x3d\x93\xa8\x4e\x4b\xe0\x5a\xec\xe7\xf8\x0b\x18\x0a\xd6\xfd\x20\x18\x80\xa1\x7e\x12\x1b\x1e\xd0\x0b\xec\xdf\x0c\xc1\x64\xc4\x12\xdb\x20\xfd\x96\xb2\x5d\x31\xdd\x55\xac\x2d\x40\x26\x0d\xc8\x7e\x7e\x6a\xb3\xf3\x67\x8f\xe3\x76\x42\xcc\x68\x59\x84\x9e\x58\x11\xd5\x95\x9f\x35\xd0\x25\x18\x76\x9c\xb2\xdc\x07\x50\x3e\xde\xc7\x67\x52\x41\xe7\xea\xe6\x90\xd5\xa6\x8c\xde\xea\x0d\xa8\x8f\xc1\x1e\x5a\x63\x57\xfd\x58\x87\x89\xf0\x91\x9e\x96\x73\xa0\xbb\xd6\xdc\xcc\x9c\x1d\x03\x72\xa8\x8a\xa2\x7c\x84\x93\x6f\x8f\x4c\xf3\xc6\xcf\xc2\x24\xb6\xb5\xdb\x35\x1b\xb7\x9d\x96\x2e\x4b\x04\x8b\x87\xc6\xea\xeb\x80\x36\x59\x37\xcd\xa4\xe2\x43\xd5\xb6\xe0\x9c\x3b\x3b\x64\x32\xa6\xf8\x6a\x72\x86\x74\xef\xa4\xe4\xb3\xd5\xd7\x41\x0b\xbe\x0c\x8b\x2e\x98\xae\x8e\xb8\xa2\x7e\x91\xed\x85\xa5\x0e\xfd\xa1\xee\xcf\x03\xc3\x21\x3a\x59\xf1\xea\x7b\x4c\x9d\xc3\xe9\x9f\xc2\xda\xf0\x63\x23\xe4\xcc\x9f\xf9\xe1\x26\xfa\xac\xb5\x15\x39\x2c\x28\x8c\xf7\x99\x1c\xc1\x4d\x8c\xe5\x7d\x6f\x25\x1e\x14\x78\xbc\x8f\xe7\xab\x0a\x82\x22\x6f\xbc\x28\x1b\xe5\x36\x31\x37\xdf\xa1\x6b\xed\xa8\x15\xa9\xdf\xfc\xcc\x25\xad\xdc\x10\x00\x67\x1f\x99\x33\x87\xa9\x5b\x50\x34\xff\x03\x60\xb2\x6d\x1c\x56\xb6\x5e\xf7\x97\x9c\x3e\x40\x8a\xf3\x87\xf9\x45\xcb\xde\x19\x30\x75\x83\x86\xc3\x9b\xc6\x37\xc1\x77\xfd\x6f\xda\x25\x54\x74\x24\x42\xb5\x46\x61\x64\x71\x57\xd9\x2e\x9b\x0b\x2e\xd6\x68\xcf\x95\x56\x61\x73\xf5\x04\xbf\xda\xdd\x22\x72\x5e\x91\x2f\x8c\x15\x53\x7c\xa7\x0b\xb3\x15\x19\xe2\x5c\x1b\x13\x1f\x3b\x2b\xcf\xc0\xc9\xd0\x86\xf3\x9f\x39\xbe\x9c\x7e\x58\x13\x9b\x3a\xf4\x1a\x7c\x27\xd9\x43\xe1\x7d\x48\xb5\xdd\x47\xcd\xc5\xb3\x0c\xda\x9d\xf3\xf6\xc0\x1c\xca\x31\x6d\x3f\x58\xc4\x56\xa1\x5c\x38\xef\x49\xbf\xb1\x89\x29\x4c\x2d\xf5\x9d\xb7\xce\x15\xc6\x11\xd7\x1a\x81\x63\x13\x83\xc4\xa7\x9a\xb2\x70\xca\x34\x3a\x7b\x0e\x4a\xae\x7d\x42\x93\x96\x07\x68\x78\x09\xba\x49\x17\xae\xa0\x86\xce\x04\xa7\xf5\x3d\xa7\xea\xb1\x7d\xa0\xab\xe9\x83\x59\x03\xb4\x78\xf2\x45\x25\x6f\x6f\xd3\x9e\x60\x00\x19\x27\x0b\x67\x80\x19\xc5\x2f\x28\xc2\x6a\xb3\x70\x16\x83\xea\x14\x36\x8d\xe0\x0e\xce\x3b\x95\x6a\x74\x02\x9c\x92\x82\xcc\x33\x67\xfd\xa8\x56\xfe\xfe\x71\x4f\x48\x2b\x85\xa4\xd0\xee\x7b\xfa\x6f\x4e\x59\xea\x73\xd8\x00\xde\x87\x99\x43\x37\x59\x6d\xd4\xea\x43\xae\xea\x04\x6f\xec\x7f\x9c\xbb\x56\xf3\x70\x98\xc6\xb6\x9e\x13\x73\x25\xdb\x3b\x98\x42\x2f\x2b\xa7\x3c\x6c\x60\xe0\xf1\x8a\xf2\x48\x97\xec\xea\x39\x68\xfb\x58\x00\x75\x82\xd7\x73\x94\x05\xe5\x0c\x56\xc6\x66\x44\xea\xf6\x1c\x8c\x5d\xd9\xf1\x28\xd7\x3f\xf5\xb9\x96\x4f\x16\xdd\x65\x1e\xb8\x2b\x54\xf1\x48\xb9\x4c\xc0\x08\xad\x18\x94\x97\x9d\xf5\x5e\x99\x64\x7a\x41\xfb\xbe\x37\xb2\x38\xde\xe2\xd0\x47\xb7\x4d\xa8\x33\xbf\x25\x07\x1c\xb4\xc5\x11\xe0\x49\xaa\xa7\x88\x8a\x21\x15\x43\x3b\x64\xf9\x58\xb2\x5c\xcf\x86\x6a\x5b\xad\xd7\xcf\x49\x89\x6d\xa9\x7d\xe1\x1c\x05\x5a\xc9\xa5\xfc\x20\x3a\xcf\x66\xad\x00\xaa\xcf\x9e\xe1\xbc\xea\x6d\x33\x62\x54\xd7\x3e\x0a\x4d\x73\x14\x2d\x04\x59\x95\x79\x70\x00\xf9\xa1\x77\x5c\xaa\x6b\x1b\x09\x00\xc5\xe7\x92\xfa\xd7\xaf\xa5\x1a\x5f\x64\xad\x56\xa0\x58\x63\x40\xe0\x4e\x74\x16\x1f\x6b\x6e\xca\xdb\x98\x3f\xdc\x5c\x82\xe9\xe1\xf9\xcd\x1a\xb1\x2b\x2e\x08\xf1\x0d\x63\x91\x85\xd5\x6a\x8e\xa7\x97\xd5\xc8\xdd\x1f\x84\xec\xf9\xa1\x4d\xd2\x9f\x8c\x04\xe5\x0f\xe9\x77\xb0\xe1\x37\x48\xbe\x9a\x18\xc2\xaf\xae\x57\x9d\xac\x54\x1d\xdc\xa1\x39\x8b\xe8\x5d\xdd\x61\x7b\x73\x4b\x4b\xbc\x31\x88\x74\x84\x0c\xf3\xba\x5a\xd5\xaa\x2d\x18\xcd\xfb\xab\xfe\xa1\xe5\xdc\xa4\x6a\x80\xab\xd3\xf9\x8e\x45\x90\x63\x38\x47\xa9\x8b\x3e\xc0\x80\xad\xbf\xa6\x5f\xb0\x72\xad\xcb\xd3\x96\x38\x73\x05\x33\x68\x9c\x00\x4e\x9d\x1a\xfb\xa2\xdf\xdc\x04\x3d\x80\x69\xd7\x7e\x70\x91\xa0\x95\xac\x29\x0c\x6e\x57\xd5\x9b\xb6\xd0\x91\xd9\x4d\x15\x53\x65\xea\x4a\xd3\xb9\x7c\x96\xbe\xff\xbe\x8e\x23\x7c\x32\xd9\x14\x8a\xdd\xb8\x32\xaa\x9e\x62\xbd\xc3\x20\xb2\x35\x0f\xee\x10\x45\xc1\x56\x43\x8c\x8c\xeb\xfd\x27\x5b\xa7\xa3\x78\x27\x78\xa7\x55\xff\xab\x32\xb0\xa2\x00\x3c\x0a\x81\x12\xe6\xab\x23\x92\xb3\x56\x1b\x6c\x4e\x04\x58\xc5\x85\xfc\x6a\xef\xe9\x5a\x32\xb7\x75\xbd\xed\xb7\x3f\xc9\xac\x31\xa2\x1e\x5d\x85\x41\xf4\x93\xe9\x6a\xa9\xa0\xce\xaa\xd4\xa5\x12\x8c\xbf\xea\x7c\x13\xdc\x4f\xac\x18\xd7\x4f\xc4\x2a\x28\x48\xa3\xc5\x4b\x46\x37\xda\x22\x64\x28\x0e\xc2\x4b\x15\x89\xd0\x9c\xe6\x87\x0d\x5a\x9e\xa4\x21\x49\xfe\xf5\xcf\xdb\xa1\x7e\x36\x9d\x11\x72\x83\x8f\x92\xb1\x51\x18\x4f\x28\xe7\x05\x42\x8b\x47\x30\xb9\x66\xbb\xa1\x84\x6f\xab\xe4\x8c\xf7\xf4\x18\x8b\x58\x8d\x37\xce\xca\xb5\xd2\xa9\xdf\xd1\xe6\x60\xd0\x24\x1a\x4e\x19\x5d\x49\xcc\x5e\xb0\xe8\x75\x49\x55\xc9\xbf\xff\x41\x53\xf1\x63\x5d\x08\xac\x4e\x1d\xef\x0a\x0e\xd1\xab\xcc\x43\xa4\x1d\x87\xd9\x5c\x97\xe7\x7a\x34\x12\xb7\x2d\x06\x38\xc5\x9e\xc4\xaa\xa6\xf1\xf8\x7d\xe8\x81\x99\x41\xb2\x6d\x3f\x3c\x05\xdb\xba\xd7\x4a\x1e\x24\x9b\x1d\x75\x65\xb5\xe7\x2f\xab\x37\xad\xbf\x0f\x7c\xcb\xf9\xca\x9f\x14\xf2\x50\x65\x31\x55\xc3\xc1\x4b\xcc\xbd\xa0\xf2\x41\x63\x46\xa5\x89\x37\xb8\x0f\x09\xcf\x10\x2e\x7f\x57\x46\xe2\x3d\xeb\x7b\xda\xcb\x34\x7d\x23\x3c\x87\xc2\x5a\x3d\x55\x38\x90\x4d\x89\x00\xd6\xfd\xb4\x90\x2e\xca\x2f\x58\xb2\x4f\x95\x2b\xa5\x01\xcf\x89\xb2\x45\x6f\x67\x13\x25\x5e\xf3\xd5\x7b\x2b\xc0\x19\xdf\xaa\x44\xf3\x78\x45\x05\xba\xfc\xa6\xb7\x1a\xd7\xcc\x65\xe9\xf4\xd7\xaa\x6f\xba\x8b\x80\x6a\x14\x1b\xa5\x1b\x90\xee\xad\xa7\xb1\x6a\xa5\x5b\xb1\xd1\x06\xe1\x28\xf9\x15\x87\xca\x9c\xd6\x45\x71\x71\x40\x6b\x49\xbf\x4c\x9f\x77\x43\x54\x1a\x9c\x9e\xbe\x95\x7c\x51\x91\x70\xf0\xf3\xcf\x5b\x4d\xd5\x2d\x57\x2a\x77\xdf\x45\xf0\x7a\x60\xc2\x51\xc5\x0b\xec\x1b\x26\x9b\x7e\x92\x68\x35\xb8\xd7\xa5\xbd\xbc\x5a\xd9\xc2\xdf\x6a\xc6\xeb\xc7\x72\xf9\xec\x0b\x16\x3a\x30\xe8\x27\x20\xec\xc6\x8f\xbd\xfd\xb4\x73\x4c\x8b\xf6\xa1\xb7\x22\xec\xf8\x09\x73\xef\x1c\xe1\x65\xec\xef\xe7\x4f\x81\x9d\x82\xdf\x92\x45\x4a\x53\x9b\x7c\x60\x4f\xdb\x59\x7f\xa4\x6d\x6f\x4e\xba\x75\x51\xa7\x0b\xd0\x1c\x66\x17\xf0\x30\xc9\x6f\xc7\x41\x1d\xbe\x12\x0c\x4b\x06\x06\xab\xc4\xac\x52\xe1\x56\xdc\x66\x51\x28\x94\xc4\x63\x51\xf8\x8e\xa0\xba\xb4\xd2\x47\xe8\xf4\xd2\xdb\xdb\x3b\x7c\x05\xaf\x6d\x3c\xea\xeb\xa5\x7c\xf4\xf3\xf5\x57\x4c\x76\xe9\x0c\x9d\x0c\x09\x76\xfc\x6c\x0a\x4d\x20\xfe\x71\xf8\xc5\xa8\xe0\x2a\x80\x33\x58\xf7\x3a\xaa\xa2\xf2\xe1\x1f\xa8\x1f\xed\x8c\x19\xaf\xbb\x84\x4f\xaf\xb0\x1d\xe2\x13\xad\x12\x4c\x34\x8f\x4c\x16\xa6\x8e\x66\xb5\x99\x2b\x4c\x4e\xb3\x4a\x84\x3f\xbe\x7f\xb7\x03\x1d\x9d\x8b\xea\x0b\xcd\xb5\x73\x0d\xb0\xe4\xae\x7b\xef\x0a\x09\x86\x4c\x1f\xfb\x0d\x56\xc9\x62\x83\x36\x5b\x4f\xdc\x41\x2f\x2b\x38\x68\xb1\xcf\xce\x1d\x88\xbe\xec\x30\x16\x61\x52\xee\xe8\xc1\x1a\x12\x5a\xca\x5d\xd6\x65\xbf\x21\x29\x23\x9c\x27\x47\xd1\xe3\x14\x6d\xc0\x10\x62\x18\x7e\xd3\x46\x81\x41\x93\xe7\xd7\xf3\x97\xda\xa8\x7c\x77\xbe\x89\xf1\x35\xb2\x73\x30\x74\x6c\xb5\x13\x76\xd3\x0d\x56\x04\x20\x62\xd0\xe2\x39\x95\x89\x06\xb3\xc2\x7a\xbc\xb6\x6d\x1d\x24\x20\x67\x98\x87\x54\x66\x39\xea\xf0\x16\x3f\x42\x39\x46\xce\xe1\xee\xb1\xce\x00\x70\x96\x22\xbf\x7e\xdf\x49\xb2\x23\xd1\xf0\xaf\x7b\xae\x43\x4a\x09\xef\x95\xa7\x16\x46\x29\x55\xcb\x02\x8c\x3f\x62\xd4\x2e\x86\x9e\x7d\xfc\x80\xa9\x2f\xf1\x8c\xcd\x66\x72\x83\x58\x0d\x51\x6c\x23\x70\x13\x05\xc1\x10\x6a\xbc\xed\xd4\x7f\xee\xb9\xb5\x68\xf0\xe6\x5d\x2b\xff\x8c\xc7\xd1\xd9\x27\xe7\x6c\x3f\x8b\xf9\x0b\xb3\xe7\xed\x40\x21\xa0\x4e\x62\xc5\x90\xa3\x2a\x42\xe0\x07\x0b\x20\x8d\x7f\x20\x03\x6c\x0e\xc2\xe5\xde\x8e\x29\x8e\x45\x09\x2f\x76\xb8\x3c\x6b\xac\x28\x10\x5b\x12\xea\xa4\xce\x81\x33\x49\xa6\x81\xec\x7e\x30\x02\xcf\x7a\x17\x23\x71\x04\xbc\xdd\xe2\xb4\x2a\x99\xe7\x30\x75\x5a\x5f\x0f\xde\x9a\x5a\xc8\x5f\xba\x15\xd5\xc3\x36\x92\x07\x85\xa4\xde\x4d\x73\xa4\x80\x21\xe0\x93\x4e\x7c\xdf\x3f\xaf\x03\xfa\xe8\xd9\xa2\x49\x6d\x12\xad\xdf\x40\xa7\x91\xc2\xac\xce\x01\x2f\xb1\xab\x2e\x46\x0a\x46\xe2\x9c\xc1\x1c\xe7\x70\x11\x43\x59\xe1\x31\x61\x29\xee\x29\xa7\x92\xd6\xa6\x0d\x7f\xbe\x75\xde\x56\x72\xfc\xa5\xe6\x14\x42\x33\x29\x3c\xf9\xd3\xa6\x4c\xdd\x07\xfa\xad\xf4\xb7\xe4\x70\x6a\xd0\x53\xce\xf8\x1a\x4c\xd4\x95\xe1\x01\x4f\x80\xc9\xeb\x3c\x73\x2f\xc1\xd4\xa0\x1a\x4a\x3b\x72\xa2\xf5\xfe\xaf\xce\xec\xf2\x90\xed\xb6\x61\x05\xe4\xea\x5a\xb5\x97\xf2\xaf\x92\x3b\xab\x6e\x45\x79\x2a\x3f\xf8\x6b\x48\x2c\x53\xe7\xb9\xdb\xe8\x06\x1f\xd0\xfe\x99\xf5\x68\xbf\xed\xa3\x75\x20\xd5\x3e\x95\x94\xe5\x7d\x80\xa6\x3b\x44\x86\x29\xa0\xcc\x49\xa9\x20\x9b\x6e\xb6\x58\x51\x54\x93\x63\x36\x58\x0c\xaf\x24\x4f\x92\x69\x68\xdf\x45\xbd\xa7\x2d\xbd\xcd\x7a\xd2\x20\xcf\x1f\x88\xaf\x86\xb1\xfa\xd7\x6d\x42\x88\xd9\xa0\xa2\x77\x17\x96\xc1\x10\x2c\x81\xdf\x6d\x05\x7f\xe1\xf9\xf7\x30\x05\x6d\xcd\xca\x6e\x43\x79\xa5\xfd\xd7\xf5\x98\xcf\x91\x61\xd9\x4d\xad\x3b\xd6\x74\xfc\xf8\xf8\x20\x12\xcf\xe3\x9b\xe6\xbb\xee\xf6\xa7\xb2\x84\x7f\x71\xa5\x9f\x08\x26\x7b\x09\x51\x2f\xce\x70\x01\x3e\xef\x07\x97\xc3\xe1\x60\x70\x93\x54\x62\xab\xf0\x32\xe4\xad\x92\xce\x48\x2f\xe6\x56\x6d\x00\x4c\x32\x7d\x94\x05\x6f\x1c\x8b\xca\xd7\x07\xff\xa7\x91\x73\xd6\xa6\x45\x7b\x43\x92\x3a\x5d\x42\x1e\xeb\x12\xe7\xfc\x4b\xec\x37\xd8\xd2\xdb\x64\x0e\x67\xb1\x73\xe2\xb5\x41\x54\x4d\x87\xef\xc9\x75\xb4\x94\x77\xf2\x24\x45\xdb\x9f\x35\x36\xbb\x7e\x4d\x7b\xc2\xca\xa0\xf7\x5a\x15\xde\x2d\x8a\x26\x3b\x1b\xb8\xa8\x15\xd2\x67\x0b\x76\xe2\x6e\x85\xec\xa2\x00\xfc\x54\x9c\xfa\xf7\x7a\x6d\x29\x43\x20\x0f\x25\xa3\xaa\xd2\x4d\xac\x33\x57\x7d\xf3\x52\x1e\x7e\xbc\x43\x4c\xa7\xbf\xca\x35\x7b\xdd\x92\x1d\xf6\x72\x47\x35\x76\x30\xc1\x18\x79\x69\x88\x7a\x1d\x83\xa7\x67\x3c\x0d\xce\xfe\xbe\xd2\x67\xd4\xd5\xbf\x67\x17\x27\x21\x4d\x4c\x98\x9b\xa5\x1f\xe0\x0a\x85\xc1\x83\xa8\x4e\x6f\x00\xfe\x45\x7e\x73\x4a\x69\xe6\x9a\x21\x7d\xdc\x52\x06\x61\x13\x97\x80\x0a\xd9\x59\xf6\xd6\x0f\x4a\x35\x3b\xa9\xcf\x3f\x97\xbd\x93\x14\x1f\x45\xed\x2b\xf3\xd1\x25\x5c\xb5\x12\xb5\x8d\x2e\x9c\x98\xa7\xc5\x9d\xe5\x6c\xf3\xe0\x49\x16\x0b\xdb\x39\xfb\xd2\x2f\x12\x5d\x6e\xaa\x27\x9b\x66\x23\xb3\x04\x9a\x94\xf4\x4d\x49\x60\x70\x54\xb6\x9f\x68\x1a\x36\x3c\x39\x65\xad\x3c\xf4\x71\x84\x06\x83\xb6\xe8\x7d\x09\x56\x2b\xd1\xcc\x51\xb2\x65\xdd\x5f\x7d\x0a\xa2\xab\x4e\x94\x73\x1c\x72\x2e\xa8\x6d\x7e\xc4\x97\x35\xdf\xf7\x32\xbf\x98\xa6\x84\xd6\x7a\x55\x9d\x0c\x48\x86\xd9\x01\xdb\xc7\xd8\x6c\x4d\x94\x6d\xa8\x9c\x67\x35\x88\xdc\xab\x29\x15\x1d\xe1\xf8\xec\x85\xa1\xe8\x33\xa6\x20\x5c\x9e\x9c\xdf\xcf\xab\x36\x65\x70\x96\x46\x28\x94\xed\x9f\x12\x55\x96\xf6\x24\x9b\xb2\x0a\x7e\x53\x78\x28\x71\x95\xc6\xfb\xd5\xbf\xe5\x70\x35\x44\x2e\xb6\x79\xa0\x0e\xfc\x1c\xf7\x53\xf8\x93\x58\x09\xa5\x71\x7f\x93\x69\xc0\xed\x5a\x55\xcd\xcb\x56\x2b\xd0\x34\x16\x8a\x3a\xef\x7b\xb7\x9e\xa5\x2b\x28\xf6\xdf\x3a\x34\x3a\x25\x20\x45\x9a\xf2\x6f\x7b\xf0\xa7\xa4\xc5\xfe\xc1\xee\x6f\x7f\xac\x94\x6a\xb0\x6b\x33\x14\xa6\xda\x39\x4c\x00\x67\x13\xb2\x9d\xa3\xe4\x41\xc0\xbd\xd4\x5a\x8e\xcd\xdc\x5b\x6d\x35\x32\x9f\x36\x4d\x67\x71\xf3\xb5\x21\x2d\xd4\xba\x8e\x74\x42\x06\xd9\xf8\xb4\x1c\xd0\x8b\xff\x5e\x42\x88\x1b\x75\x15\x55\x63\x38\xa1\xb8\x5f\xc5\x03\xff\xa5\x64\xa6\xb8\x73\xa2\x49\x36\x75\x52\x6d\x6d\x24\x71\x46\x91\x45\x28\x4c\xbe\xd6\x6c\x88\x1e\x38\xd8\x47\x17\xab\x6a\x6d\x84\xc7\xff\xb9\xa6\x56\x7e\x8b\x11\xb0\x02\xd5\x40\x35\xc1\x78\x2a\xb7\x95\xd2\xf9\xfa\xbc\xdd\xab\x19\xed\xab\x7e\xec\xc8\x2e\xa5\x93\x4e\xa0\x9d\x64\x03\x30\xf2\xda\x61\x31\x3f\x7c\xb3\xcf\xc3\x9b\x54\x37\x28\xa8\xe3\x84\x19\xdb\x48\x75\xa1\xb9\x48\x5a\x45\xea\x6a\xf6\x1f\x4d\x04\x51\x89\xb2\x3d\xa9\x1f\x50\xe6\x28\x6a\x0a\x5c\xf5\x8b\x2c\x68\x0a\xd6\x91\x49\x27\x69\x26\x42\xf9\x74\x42\xb8\xc2\xdc\xc4\x63\xe6\xa9\x63\x07\xbd\x6c\x67\xc1\x74\x70\x27\x55\x23\xfb\xb2\x39\x74\x32\x99\x56\xc4\x22\xca\x3a\xe8\x7a\xdd\xd9\xe5\x27\xf7\xba\xf8\x0a\x33\x39\x5c\x1a\x3f\xf4\xa5\x5b\xe5\x68\x29\xae\xad\x89\x21\xb7\xe9\x29\xba\xf5\x1b\x83\xee\xca\x3c\x29\xc0\x31\x39\xd5\x58\xfe\x29\xd6\x3c\x86\xc6\x9e\x00\x0a\xa0\xc4\xa7\x45\x94\x05\x89\xfd\x8e\xda\x4f\x61\x25\xef\xb1\x2c\xbe\xa4\x4d\x3f\x7f\x2b\xba\xb7\xdf\x94\xad\xfb\xdc\xf0\xc7\x50\xda\x16\x00\x8a\x78\x0a\x65\xa6\x7e\xb8\x66\x56\x0e\xcb\xc8\xf7\x8d\x51\x75\xa4\xa1\xce\x59\x21\xe5\xe8\x00\x35\x4f\x7b\x10\x4e\xb9\xe8\xbd\x8a\xd7\x74\xc6\x0f\xc1\x5b\x7f\xc0\x26\x8d\x00\xbc\xed\xd9\x7e\xf1\x74\x21\x71\xcf\x03\x91\x68\x04\x8c\xbd\x1c\xc2\x47\xe7\x7f\x93\xa7\x49\x89\x17\xae\xb4\x6d\x9d\x30\x1f\x12\x08\x2b\x52\x17\x8e\xda\x58\xde\x7b\xb4\xfe\x4b\x53\x57\xf7\xaa\x41\x37\xb1\x9e\x02\x9c\xbf\x63\xef\x14\x4b\xea\xd9\x72\x1a\xc5\xb8\x70\xf8\xa5\x07\xad\x9e\x0f\xb5\x83\xdb\x50\xa2\xc8\x60\xba\x45\x6a\xd3\xdc\x4a\x4a\x0a\x67\x62\x56\xba\x37\xfb\x39\xd2\x97\x20\x40\x5d\x0a\x5c\xf4\xb7\xe6\xb1\x99\x95\xf6\xb6\x13\x8f\xf1\xa7\xf7\xd5\x07\xb9\x93\x23\x38\x26\x62\x98\xc7\xcd\x05\x8c\xb9\xe6\xec\x9f\xee\xf0\xbc\x77\x52\xd1\xf7\x6a\xc4\x41\x4f\x2d\x58\x23\x4c\x5a\xb0\x70\x8c\x39\x47\x3f\x38\xd8\x63\x36\x70\xda\xb5\x75\x4d\x76\x09\x0d\x62\xa8\x1b\xbd\x23\xfe\xdc\x17\x20\x7b\xbe\xe9\x08\x5f\xe4\xec\x1c\x25\x58\x1a\x12\x05\x35\x44\xa1\x5f\xe9\x5e\x36\x3d\x02\xe9\x32\xa3\x19\x81\xe9\x06\x2e\x15\xe5\x02\xf3\x5a\x8f\xf9\xe1\x79\x24\x58\x2b\xc2\x5c\x86\x6e\x10\x55\x0d\x6f\x8a\x6b\xbe\x9f\x1b\x05\xd4\x30\x7f\x8a\xbd\x58\xc8\xff\x7b\x67\x39\x36\xad\x42\x4c\xc3\x9e\x75\x31\x6b\x1c\xf8\xe2\xd9\xd1\x2b\x55\xbc\xbd\x2c\xe5\x8c\x13\xdd\x35\x3a\xdb\x3a\x98\xf3\xb3\x1c\xa2\x12\x9d\x2f\xcf\xa4\x3a\x45\x26\x51\x8d\x7f\x91\x94\x9b\x5f\xcd\xb9\xc3\xe6\xe9\x10\xc7\x3d\x33\xe9\x9e\xd7\x55\xc6\x28\xf7\xa5\x77\xca\xd6\xb9\xe1\x52\x9a\xd1\x27\xd3\x91\xf5\x3a\x03\xfa\xd8\x0b\xeb\x96\x90\x36\xa6\x07\x4e\x30\x37\xbb\x69\x9b\x14\xbb\xac\x5c\x2d\x21\x30\x3f\xa5\x9f\x18\x1a\xec\x1e\x8a\xa3\x1e\xfc\x43\x8d\x55\x26\x51\xee\x76\x39\xdc\x95\x9b\x85\x67\xd7\x5b\x7d\xba\xa6\x04\x7d\xbe\xe9\x88\x8e\x76\xef\x27\xe7\x7c\x8f\xb0\x13\xcf\xae\x13\x27\x94\xc2\x61\x64\x25\xc9\xb5\x77\x58\xab\x7e\xf0\xe4\xc7\xdf\xca\x49\xc8\x47\x82\x9a\xcb\x4d\x8a\xf0\xd3\x04\xe7\x66\xec\xe4\x27\x76\xbe\x4e\x5c\x6f\xea\x55\x0c\x63\x42\xc7\xc7\x22\xe8\x5f\xd7\x6c\xff\xad\x21\xcd\x2d\x78\xfc\xcf\x4d\xa7\x4c\xe9\x33\xef\xd9\x12\x7e\x25\x6b\x98\xa0\x8b\xfb\x75\x69\x34\xed\x33\xe6\x2c\x8e\x43\xc0\x41\x0f\x07\x5d\xf3\xef\xf9\xdb\x47\xb5\x67\xab\x18\xcb\x43\x3a\x4b\xd7\x33\xe1\xc0\x89\xaa\x09\x4d\x74\x48\xc6\xa9\x80\x3f\x3d\x7f\x6f\x82\xbf\xb0\x3d\x71\x65\x59\x6c\x5a\x31\xf1\x1c\xc9\x17\x61\x49\x0e\x88\x6d\x87\xc0\x9b\x4a\xdb\x62\x75\x42\x7d\x49\x0c\xcb\x14\x61\x1b\xc6\x5f\x5b\xec\x71\x0b\xaa\xed\x9c\x7f\x2d\x0a\x40\xc0\xfe\x40\xb1\xfe\xf7\xf6\x92\x96\x05\xa5\x31\x05\x0e\x9a\x70\x6a\xf3\x09\x5d\xcf\x0b\xf3\x37\xcf\x42\x0a\xc0\x3c\x57\x4f\xe8\xbf\x14\xdd\x17\x61\xa3\x66\xd4\xb8\xbb\x43\xee\xf5\xcc\xa1\x61\x05\x4a\x38\xe9\x31\x12\xe0\xc9\x24\xc6\xc4\x4f\x76\xf7\x30\x65\x4a\x1e\x21\x15\x54\x0f\x83\x0f\x32\xfe\xbf\x97\xd6\x41\x27\x19\xda\x74\x31\xe0\xdb\x96\x40\xcf\x68\x27\x0c\x41\x63\x03\x2c\x9d\x87\x6e\x6f\x2c\x6d\x8e\x5a\xa5\x2e\x19\xac\xfd\xd8\xf5\x43\x51\x4f\x75\x1c\x75\xaf\x07\x7f\xc7\xc4\xdb\x7a\xcc\x42\xcf\x5b\xd2\x0c\xbb\xef\xfb\x64\xa7\xed\xba\xa2\x43\xcd\xc0\x9b\x44\xa9\x3a\xd1\xdb\x7e\x78\xfb\x6e\xa1\xc2\xdf\x7f\x92\x70\x7b\x72\xd6\xae\x35\x47\xf9\x73\x9d\x9d\xd0\xb9\x6b\x57\x23\xe6\xde\xb2\xbb\x32\xe0\x2b\xfa\xa2\xad\x1f\x75\xc2\x2f\xd0\x8e\xcd\x31\xe6\x91\x9c\x1b\x3e\x9c\xda\x5b\x0e\xb3\x7e\x2c\x02\x37\x61\x7d\xb7\x14\x72\x0d\x34\x5d\x31\xb9\xf4\x98\x71\x3e\xec\x15\xae\x74\x55\x3e\x1a\xcc\xd7\xfe\x49\xc3\x9b\xfd\x56\x95\x7b\x4c\xe7\x05\x13\xfd\xe6\x00\x26\x92\x8a\x31\x47\x28\xbd\xee\x9c\x7d\xda\x9f\x84\x1d\x6c\x98\x2b\xa8\x5c\x7f\xbb\xfc\x01\x1c\x5f\x3f\xbf\xa7\xf2\x41\xa0\x78\xfe\x53\x50\x38\xab\x36\xea\x7a\xab\x5b\x26\x1b\x87\xa7\x9d\x9a\xf7\xd0\xb5\xbc\x84\x60\xef\x4b\x02\xd8\x5d\x13\xef\xd8\x40\x61\xd4\x44\x0d\x24\x30\x1d\x80\xb6\xce\xf7\x8f\x2d\x99\xba\x4e\xd9\xa2\xec\x9c\x21\x25\xee\xc8\x45\x62\x62\x53\x4e\x44\xd1\xb5\x81\x4c\x54\x77\xa3\x00\xc5\x34\x4a\x58\x38\xd0\x43\x43\xe6\xf8\x8b\x7c\x27\x5b\xe4\xed\x34\x64\x06\x4b\xe1\x51\x99\x01\xd4\x6f\x40\x1d\x2c\xdf\x86\x9a\x0b\x54\x66\xa1\xb4\x16\xd2\x6b\xa8\xb6\x42\x56\xe3\x8b\x18\xc1\x9e\x68\x76\xb1\xa0\x2f\x3f\x58\xf0\xb4\xf6\xa6\x67\x17\xae\x24\xa0\x76\xf5\x86\x86\xc3\xab\xb1\x24\x57\x14\x34\xa0\xf0\xf7\x46\x94\x4d\x6e\xa5\xe0\x29\xf1\x3f\x4c\x71\xd3\x93\xf7\x64\x22\x00\xc5\xc9\xd9\x04\xf2\x1e\xf5\xb6\xff\x50\x81\xcd\x8d\x02\x71\xfb\x86\x7e\xe8\x7c\x22\x9f\xd1\xe8\x15\xff\xef\x3d\xb3\xeb\x41\x79\x2c\x82\x55\x73\xdb\x01\x68\xeb\x58\x92\xd1\x66\x01\xa5\xbc\x45\x28\xa5\x41\x22\x38\x23\x4c\x5c\x93\xcc\x4f\xac\xe4\x40\xc7\x18\xfb\x6a\x65\xdc\x43\x5f\x3b\x4e\xbd\xde\x2b\xa9\x09\x1c\x19\x23\x3f\xb2\x43\xd7\x98\x6a\xc3\xa6\xc2\x43\x8a\x1d\x92\xfe\x45\x9d\xfe\xdd\x9e\xf1\xc2\xd0\x71\xe2\x03\xbf\xb6\xa8\x04\xa3\x37\xe9\xe8\x89\x37\xca\xd7\xfd\x8f\x7f\xd3\xdd\x20\xe5\x15\x23\x9a\x74\x08\x01\x79\xe0\x9c\xec\xea\xd7\xd1\x95\x14\x13\x27\x23\x76\x04\xbc\xdf\x8a\xc4\xfc\x8d\x8d\x3d\x1a\xcc\x2b\x3f\x37\x97\x64\xf5\x3c\x57\xbb\xe1\x44\xd7\x44\x91\xb2\xa1\x07\x6b\xc8\x7d\xc0\xcf\x1a\xb1\xf2\x9b\x53\xd1\x33\x93\x77\x67\x55\x63\xcd\x0a\x2f\x24\x1c\xc2\x06\x97\x3f\x45\x0c\xd9\x86\xb6\x04\xb8\x33\xc9\x0e\xcb\x6f\x8d\x7a\xdb\x43\x13\xe9\xfa\x6a\x8b\x01\xf0\x37\x39\x26\xd5\xda\x74\xf6\x13\x5b\xac\x8e\x89\xb8\xb6\xfe\x1c\x48\xd0\x82\x1a\xd3\x6a\x95\x6a\xca\x4a\xe2\x34\x83\x63\x0f\x8f\x09\x1e\xf5\x26\x55\xc4\x06\x32\xd5\x21\x5c\xdb\x21\xf8\x73\xe9\x75\x1a\xa1\xb1\x70\x30\xe0\x21\x59\x0a\xb6\xd6\x7b\xc9\xff\xb4\xd5\xa0\xfd\x12\x2b\xfc\x7f\xee\xab\x10\xb3\xc6\x4e\x04\x3a\x19\x21\xdf\x49\xc8\x3c\xd6\x18\x87\xb4\x9e\xf6\x31\xd7\x33\xa7\x85\xc2\xb0\x49\xfc\x54\xd1\xb7\x07\xf3\xec\x69\x58\xde\x4c\x7b\x8b\x91\xb3\xda\xea\x05\x15\x5e\x38\xfc\x8e\x58\x25\xd3\xf4\x9b\x70\xdd\xc2\xf3\xaa\x88\x05\x48\x24\xf3\x34\x19\xda\xee\x7a\x46\x55\xd2\x2a\xd0\xb7\xc5\x02\xfc\x2f\x2d\xd2\x49\x29\x06\x35\xa2\x7c\x52\x67\xde\x3f\x45\xe8\xc3\x84\x31\x99\x8e\xd1\x9d\xd8\xfa\x7b\xfd\xbc\x51\xb6\x50\xaa\xd5\x78\x5f\x81\x8a\x3d\x99\x20\x94\xad\x13\x89\xa5\xf0\x70\xe8\xce\x9d\x35\x7e\xcb\x9b\x45\xe8\x35\xac\x0d\x86\x7f\xe5\xef\x8f\xd9\xca\x45\x44\x3f\x3c\x78\x3e\x53\xa4\xb3\x81\xa0\x2c\xd6\xc7\x00\x06\xe7\x81\xad\xa6\x57\xc7\x8f\x6a\x05\x84\xe5\x71\x54\x6e\x40\x84\x0a\x36\xbf\x79\x3f\x39\x96\x45\x5d\xa8\x17\xbb\x3d\x69\xea\xb8\x44\x02\x5b\x3e\x57\xd0\x9d\xf0\x10\x17\x51\x93\xa3\x17\xc1\x17\xd5\x26\x00\x36\xdc\x46\xb8\xe7\x49\x67\xaf\xfa\x04\x92\x6c\x7b\xd1\xab\xea\x3d\xb7\xe0\x6e\xd5\xd1\xeb\xcd\x32\x98\x8d\x74\xd9\xd1\xf5\x46\x4f\x92\x04\x99\x9d\xf6\xf4\x62\x5d\x79\x5a\x1d\x0c\xaa\x92\x12\x2e\xed\xb6\x56\xd4\xe1\xc6\xab\x04\x1b\x9e\xc8\x1b\xf8\xd4\xbe\x6a\x41\x44\x29\x31\xf2\xd5\x46\xed\xab\x32\x0a\x9b\x87\x0d\x9f\x41\xf5\xff\xdc\xd2\x4b\x7d\x1c\x3a\xf7\x57\x34\xc7\xbf\xae\x29\x68\xd5\x3a\x39\x43\xf5\xca\x11\xe1\xf5\xc9\x90\x18\x0d\x3e\xf9\x61\xa0\x70\x12\xbc\xf5\x51\x0f\x9c\x57\xc6\xd1\xab\xc3\x4e\xb5\xc3\xcb\x48\xf4\x11\xb9\x47\x31\x47\x7b\x1f\xd5\x87\xc6\x0e\xd0\xd3\x85\xed\x10\x8e\xbd\x39\x31\x52\x5f\xa5\x7f\xa0\x8e\x2a\x7b\xd5\x98\xa3\xe0\xeb\xff\xb9\x27\x6a\x24\xa9\x44\xc5\xa5\x9a\x8b\x73\xc3\xfb\x80\x18\x7e\x7e\xee\x6b\xbb\x53\xeb\x4b\x8e\xc3\x86\x78\x1d\x22\x0d\xc7\xfe\x90\xf2\xd4\x5f\x5a\x0a\x09\xd4\x26\xe1\xd7\xef\x7e\xe7\x56\xad\x67\x7e\x98\x77\x55\x9a\x92\x12\xf8\xa9\x35\x17\x50\xb8\x4c\xf4\x73\x94\x8c\x1d\xbf\xb0\x89\x4f\x8c\x76\x95\xb6\xa7\xef\x49\x30\xb1\x3c\x59\x7e\xf8\x50\xe9\x94\x68\xbd\x02\x96\x3c\x2d\x68\x97\xff\x34\x4c\xd2\x4b\x61\x32\x95\x0c\xa5\xb7\x14\xf6\xce\x19\xbd\xce\x4a\xd4\x96\x3a\x6b\x8c\x2d\x2e\x5d\x35\x9b\x79\x38\xc2\xde\x35\x7c\x3c\x93\xaf\xb5\xe7\x06\xb0\xd1\x1a\x4d\x74\xf8\x9c\x5d\xdc\x36\x08\x2f\xc7\xe6\xf7\x72\xbb\x3c\x84\xe8\xce\x34\x52\x4d\xb1\xd4\x77\x7e\x54\xd9\xae\xb6\xa3\xff\x61\xca\x9b\xc8\x91\x58\x6c\x0e\xd2\x6a\x47\x52\x76\xf9\x8d\xc0\xd9\xd1\x52\xb4\x37\x91\xfa\x7f\xe8\x7d\x9f\xc1\xac\x1c\x0e\xfa\xd2\x6d\x96\xd0\xd5\xc6\x87\x30\x13\x3c\x99\xa7\x7d\xc2\xdc\xa7\x7e\xa5\xea\xf7\xa2\x47\xa1\xfd\x3b\x7b\x91\x24\x7a\xe9\xe5\x20\x93\xa0\x84\xcb\x4a\x6f\x55\xbd\x7e\xdc\xcb\xb0\xd1\xd8\x74\x37\x5c\x41\x53\xac\xde\x6c\x00\x53\xd5\x3f\x85\x6c\x67\xcc\x59\xc6\x2a\xc8\x07\x49\xa5\x2e\x5a\x81\xbd\x4f\x39\x63\x2d\xe6\x2d\x79\xab\xd4\x14\xc7\x2a\x2d\x0d\x08\x86\xdf\x64\xfe\x78\x7b\x87\x38\x2e\xe4\x9e\x14\x60\x5e\x30\xf1\x98\xc1\x6a\x79\x7a\x7f\xfa\xb5\xbf\xef\x51\xe6\x07\xdb\xf2\xad\xcc\x33\x8c\xa3\x52\x8e\x4f\x95\x3e\x9b\xc5\x22\xab\x79\x9c\xc2\xea\x1e\xb2\x38\x9f\xff\x7c\xeb\x73\xba\xcd\xf6\x70\x9b\xc6\x39\xaa\xc6\x40\x08\x85\x34\xef\xb5\x84\x3e\x50\x12\x5f\xac\xb5\xa1\x96\xda\x6c\xc3\x32\xbb\xae\x28\x2e\x53\xa8\x77\x49\xd5\xd7\xf9\x9c\xa2\x7c\x74\x74\x55\xb3\x32\xb2\x72\x06\xd9\xca\x7e\x95\xa0\x17\xaf\xfe\xbc\xb0\xcc\x14\xca\x0a\x03\x9d\x72\xe5\x67\xaa\x8f\xf4\xbb\x84\xdf\xf7\xfb\x33\xc5\x80\x3d\x81\xf0\xc7\xb7\xe3\xea\x02\xf6\x67\xc3\x2a\xcb\x1c\x00\xb5\xb4\x09\x13\xee\x22\xe6\x3c\xbc\xd0\xe4\x95\x53\x1e\xd1\x1f\xde\x45\xf3\x58\x1b\x96\xe6\x8c\x08\x64\x06\x2d\xbe\x18\xea\x0a\xf6\x36\x4f\xe6\x50\x82\xe9\xa9\x82\x9f\xc9\xe8\x5f\xca\x43\xfe\xf9\x44\xb8\x92\xd3\x6a\xba\xc2\xf9\x23\xff\x76\x1e\x84\x02\x06\x2a\x8e\xfc\x18\xb5\xe8\x51\x5d\xa2\x4f\xf6\x0b\x2a\x75\xfa\xea\xdd\xfb\x3d\x4b\x3a\xea\xf2\xff\x5e\x0a\x5c\x25\x65\xe6\x0e\x8c\xb5\x57\x92\xcd\xb4\xe6\x76\x2a\xa1\xc2\xa9\x7e\xb9\x34\x22\x7b\xea\x61\xdd\x56\x71\x34\xf7\x35\x99\xf5\x3f\xc2\xfa\x8e\xad\xeb\x15\x86\x43\x48\xef\x7a\x89\x15\xf2\xc2\x1f\xb0\x9b\x6b\x03\x6c\x03\x7e\x9a\xa4\xfc\x33\x97\x28\x23\x99\x95\x9f\xf7\x5f\xb3\x1f\x88\xb7\xfa\x4a\x93\x8f\x01\xd4\x10\x8a\x1f\x6d\x8f\xa4\xf7\x71\xd1\x8c\xad\xbc\x72\x18\x4a\x02\x22\xc7\x5e\xcb\xbe\x01\x14\xea\x62\x4a\x3f\x40\xc8\x12\x07\x0b\xe5\xed\x13\x2b\x90\x71\x6f\xb9\x9e\x0a\xc6\x49\x7e\xa4\x94\x68\x82\x1f\x6d\x3c\x33\xa0\xb2\xcf\xd2\x0d\xc8\x9b\x0a\x15\xfc\x6c\x31\xe6\x23\x5f\x74\x6b\x00\xaa\x10\x15\xee\x23\x7e\x74\x46\x1f\x39\xd9\x86\xc5\xf9\xfb\x7a\x3c\x6f\x6f\x27\xd8\xf7\x3b\x0a\x86\x92\x16\x36\xf7\x8c\xda\xe2\xf5\x54\xa9\xc2\xf7\x49\xee\x8c\xb1\x57\x85\x5a\x82\x4d\x6b\x57\xf4\xf1\x38\xa2\x32\x52\xd3\x9e\xfa\x61\xc4\xb3\x56\x07\x5d\xfd\x19\x2b\x71\xa5\xfa\x0e\xa4\xbb\xdd\x44\x43\xcf\xa3\x1d\x4e\xc3\xc8\xc0\x6a\xde\x37\x28\xf8\xb5\xee\xa7\xb1\xb8\x4e\xa1\xdb\x95\x80\x84\xc8\xb4\x1e\x55\xed\xbf\xd4\x50\xb9\x8e\xc5\x35\xc6\x68\x94\x43\x74\xe7\x1e\x74\x0c\x6f\xb9\x63\x27\x8c\x2e\x62\x04\x94\x12\x86\xa9\x59\x49\x5c\x4f\x8d\xca\x97\x9a\x6d\x94\x34\x83\x28\xa9\x91\x92\x3a\x31\xd9\xbb\x60\xfc\x99\x37\x4a\xd8\x5f\x60\xec\x0c\xb4\x6a\x2d\x55\x67\x00\xfa\xd4\x47\x57\x42\x66\xac\x95\x53\x49\xca\xa7\x67\x8c\x55\x0e\x58\xff\x6c\x36\x73\x3f\x92\x81\xc9\x8a\x98\xac\x66\x1d\x89\x94\x06\x7d\x5a\x63\xf7\x7c\x69\x50\xa6\x06\xd7\x29\x18\xed\x84\x62\x3c\x1f\xcd\xe8\x8e\x9c\x62\x83\x61\xa0\x87\x4f\xbc\x09\x12\x38\x0e\xe9\x70\xc0\xc8\xb3\x6a\xc0\xae\x4b\x69\xa2\x6c\x11\x77\x3a\xde\xa6\x95\x02\x5a\x24\x85\x18\xaa\x35\x95\xaa\xb9\xad\xa4\xdc\xed\x84\xd2\x26\xe8\xef\xfb\x59\x32\xe1\x27\xd8\x28\x4c\x64\x71\x81\x5e\xa7\xb6\x02\xcd\x57\x8e\xaf\xf4\xb0\x6a\xc8\xa4\x06\x0f\x9e\x96\x75\x8a\x25\xad\xac\x9d\x4b\xeb\xb1\xbb\x50\xea\xff\x7b\x97\xe5\xa3\x60\x40\x19\xf2\x37\x9f\xb0\xfe\x4b\xf4\xec\xf1\xc1\x44\x9f\xdb\xe1\x95\xfc\xd6\xeb\xc6\x78\xfa\x5c\xc2\xeb\x70\x14\x50\x85\xf5\x9e\x27\x8b\x85\xec\x21\x41\x02\xba\x4e\x5f\x79\x3c\x80\x1d\x67\x41\x6d\x11\x53\xeb\x1c\x37\x5c\xdc\x59\x62\x17\xeb\x3a\xfd\xed\x6c\x97\x2e\xca\xd6\x6d\x56\x9f\x42\x03\x3d\xf6\xcb\xa6\x78\x38\x42\xdf\x56\xd9\x8c\x79\x52\x92\x13\x73\x09\x13\xed\xaf\xb6\x6e\x66\x74\x83\x62\x6a\xf4\x83\xb6\x3d\xad\x07\x1e\x41\x4b\xf4\x4a\xaf\xbb\xb5\x08\x4d\x05\x76\x59\x7e\x4f\x3a\x1f\x33\xb8\x0b\xab\xdc\x4d\x8d\x4e\x68\xf8\x5e\xc0\x20\x8a\x99\x17\x6a\x23\xf8\x2b\x3a\x92\x28\x86\xf1\x5c\x40\xd5\xa3\x2a\x0b\xe9\xef\x41\xc8\x86\x4d\x25\x99\x0e\xcc\x6a\xc8\xb3\x44\x35\x61\xc8\x71\xe1\x8f\xdd\xce\xd5\xa3\x4f\xec\x43\x3f\x38\x18\x2f\x08\xe0\xed\x57\x3a\x3a\xde\xc2\xed\xf3\x69\x19\x36\x17\x23\xaa\x18\xd9\x94\x0a\x8e\x15\xa3\xd6\x7d\xb5\x56\x15\x30\x45\x72\xe7\xd0\xc6\x79\x0d\x7a\xff\xb6\xfc\x51\xfd\xca\xa3\x6b\x53\x1b\x40\xb0\x3b\x6d\xfe\x10\xee\x51\xfc\x46\xfb\x83\x28\x53\x35\xe2\xd9\x2a\xd6\x52\x7d\x92\xb0\xd8\xc0\x10\x2b\x1c\x59\xe4\xa3\xb3\xc3\x17\x69\x50\x93\xe2\x28\x5b\xe6\x02\x29\xaf\x8d\xfe\x00\x33\x91\x8b\x40\x8c\x0f\xef\xbb\x0e\xf5\x2c\xcc\x5e\xef\x39\xa4\x38\x1c\x4a\x56\x94\x70\x52\xc3\x5c\xbb\xac\x1a\x05\x50\x96\x40\xdd\xd8\x0b\x1d\x2a\x79\xc2\x01\xf9\xc6\xda\x8a\xc1\xc7\x89\x05\x7b\xd9\x1c\xb7\x8f\xcc\x21\x62\x29\x37\x2e\x0b\x2b\x69\x6d\x43\xb3\xba\x85\xd1\x98\x02\x16\x7f\xd1\xed\xf7\x0c\x04\x45\xd6\xd3\xdc\xe5\xdf\x57\xea\xff\x56\xf1\xb5\x8e\x27\xde\x9d\x9b\xaa\xef\xc1\x3f\xe4\x70\x0e\xbc\xbe\x37\x96\x03\x87\x8d\x5e\x5a\x36\xcc\x3d\x27\xb9\xca\x2b\xd6\xd3\x7b\xf5\x5e\x7b\x01\x04\xdb\x5d\xe3\x9a\x0f\xfa\x95\xd8\x73\x53\xc0\xba\xdb\xc5\xd4\xe5\x7e\xe7\x99\xa5\x4b\x29\x5a\xee\x8e\x55\x57\x1e\xd8\x1a\x18\x07\x49\xf1\xd5\x3c\x77\xfa\xe1\xaa\x94\x30\x29\x10\xcc\xb3\xc9\x5e\xfd\xa5\x66\x13\xb9\xa9\x0c\xe9\x52\xfe\xbb\x13\xdf\x7b\x35\x6f\xac\xcb\x36\x84\x03\x80\x51\xde\x6a\x11\x70\xa8\x89\xf7\x7e\x18\x55\x2d\xde\x94\xca\x41\x41\xe6\xac\x61\x09\x7a\x43\x32\xc5\x28\x9d\x30\xc1\x56\xcc\x83\x73\x56\xd6\x5f\x4d\x64\xa9\x33\xb1\x61\x7a\xc0\x55\xa7\xfc\x83\x2b\x54\x10\xa3\x2a\xfb\xaa\x20\x80\x1f\xdc\xb8\x98\x60\x87\x9d\x12\x07\x84\x60\xc4\xe7\xde\xe5\x42\x57\x56\x24\xbe\xd5\x5f\x8d\x81\x87\xb4\x6a\x9c\x02\xad\x9b\xae\x77\xe3\x2b\xdc\x8b\xe2\xe4\x90\xfc\xd1\xbc\xd2\x3b\x83\xe5\x60\xe7\xae\x0a\x3d\xe1\x52\x8b\x44\xcd\xb1\x3c\xfe\x1f\xc0\xbb\x5a\xc0\x25\x19\xc5\xa9\x36\xe9\x4b\x6f\x4b\x75\x46\x48\x96\x74\x85\x5b\x66\xd8\x68\xb5\x15\x5d\x2f\x46\x5e\x97\x21\x0a\x45\x83\xa9\x64\x73\x7b\x32\xb7\x30\x16\x23\x65\xbf\x98\xde\xe9\x5e\x7c\x8d\x47\xac\xbb\xf0\x85\x8d\x87\xae\x21\x66\xe5\xfb\xdb\xe1\xb4\xfc\x19\xdf\xfa\xe8\xad\x31\x37\x93\x63\xf5\xb3\x6b\x01\x48\x93\xb7\x0f\xf5\x81\x7a\x53\xab\xed\x84\x87\x5c\xa7\xb5\x85\xed\xce\x11\x84\xf6\x9b\xaf\xbb\x8e\xa8\xf1\x93\x29\x7a\xce\xab\x0d\xcb\x30\x47\x19\xea\x0c\x00\xba\x4a\xe1\x84\xd7\xe8\x11\xe3\x83\xc6\x22\x72\x9f\x43\xa7\x17\x6f\xc0\x50\xc2\x8a\x92\x0e\x5e\xf7\xe7\x1f\x7f\xb0\x02\x1c\xff\x29\xad\xe4\xbc\x6d\x7b\x83\x16\x2a\x4b\x06\xcc\xbe\x9b\x55\xa4\x06\xe3\x51\x98\x40\xa2\xc6\xdb\x69\xf0\x24\x3a\x56\x5a\x3d\x3d\xb1\xd2\x56\x67\x5a\xb0\x6b\x2f\x19\x8e\x2b\x7f\x61\xfd\x9f\x36\xa9\x2f\x4e\xce\x29\x6b\x46\xc7\x7b\x77\xae\x24\x59\x58\xf1\x7e\x7d\x14\x1e\x1a\x75\x0e\x81\xa3\xd1\x15\x1f\xa0\xb0\xad\x1a\xd2\x5d\x47\x1a\x05\x86\xca\xec\x47\x12\xa1\x94\xb0\xf1\xf9\xc9\xcc\xe9\x55\xe1\x44\xba\x72\xdf\x33\xe3\x6c\x1d\xca\xd6\xe5\x32\xd9\xa8\x0f\x2d\x8d\x16\x07\x67\x55\xd8\x4f\x99\x1c\xf5\x2a\x98\x97\x29\xca\x5d\x70\xd7\xd9\x15\x44\x16\x2b\x66\xbe\x7d\xe0\xff\xde\x96\xe3\x51\xf2\xb0\x14\x12\xda\x5b\x6e\x75\xa8\x57\xae\xf6\x89\xbc\x67\x48\xf4\x9f\xda\xdf\x2b\x13\xec\x0a\x5e\x3a\x52\xd0\x43\xdf\x68\xb2\x53\x1a\x6c\x35\x9b\x45\x5a\xed\x76\x79\xe6\x5d\xd1\x9c\x27\xbc\x66\xf6\x77\xd1\x66\x5c\x6b\x99\xee\x37\x96\xc6\x98\x51\x97\xcf\xb5\xee\xf4\x9e\x4c\x0e\x17\x47\xdd\x34\x4d\xdf\x6d\x40\x79\x35\x13\xcd\x70\x5f\x7d\x05\x43\xee\x40\xe6\xdf\x5a\x2b\x54\x7c\xfd\xc8\xf4\xa0\xbe\x47\x58\x70\xa4\x51\x05\x28\x75\x1b\x15\xa3\x77\x9a\x93\x28\xb0\xd3\xdb\xac\x79\x0c\x3a\x65\x50\xce\x36\x17\xbd\x48\xd9\x1f\x73\xbf\xb6\x16\xce\x7b\xa6\x5f\xf1\x34\x57\xa1\x6d\xd5\x0f\x34\x44\xee\xfd\x06\x3e\x0e\x75\x81\x5b\x00\xe8\x90\x78\x9b\xdd\xd4\xa7\xf8\x5d\x4d\xb9\xe7\x81\x9c\xab\xde\x43\x40\x65\x23\xc9\x2c\x43\xf3\xca\xb9\xef\xdc\x9b\x54\x73\x27\x93\x55\xf2\x47\x6c\xfe\x9f\x67\x0d\x55\xcb\x0c\x0d\x01\xad\x6a\x06\xaa\xd4\x4d\x0f\xdb\xe1\x8b\x5e\x1f\xda\xed\x99\x36\x63\xc9\x63\x11\x28\x23\x37\x74\x91\xfe\x48\x92\x25\x78\x4b\xcf\x6e\x6b\x15\xd0\xdc\xb9\x2a\xad\x75\xac\xdd\x40\x79\x65\xad\x25\x60\x56\xe6\x4c\xab\x1a\x65\x85\xc9\x94\x79\x46\xbb\x9b\xce\x67\xd3\x93\x75\x63\xfc\xd2\x5f\x41\xaf\xa3\x46\x32\x8f\x93\xee\x7b\x9d\x8c\x43\xf3\x04\x6a\x91\x67\xdd\xb4\x41\xed\xf2\x1a\xe3\x1b\x5e\x0d\xb4\xfd\xec\x37\x17\xdf\xba\x3d\x66\xb3\xb2\x43\xf2\xf6\x93\xe5\x55\x31\xdd\x30\xd8\xea\xa8\xc6\x1f\x40\x6d\x87\x2e\xf2\x62\xdc\x3d\x4c\xba\xd8\xcb\x39\xb3\xc0\x46\x75\x2c\x4d\xfa\xe5\x02\x0c\x46\xf4\x06\x4f\x25\xd5\x48\x81\xfa\x47\x27\xad\x0b\x54\xa4\x9d\x4b\xaf\x28\x79\x55\x31\x30\xd0\x39\x33\xbb\xba\x95\x1f\x1c\x48\x46\x0e\x93\x12\xf0\x49\xf4\x56\x73\x71\x5a\x0e\x75\xbb\x4b\xc4\xc9\xee\x77\x1c\x05\xb0\x12\x80\x7b\x3e\x83\xc8\xab\xfa\xab\xb3\x58\x3d\x9b\x13\x3a\x20\xdf\xe7\x64\xd3\x17\xe7\x8e\xd9\xf1\xcd\x76\xbc\x49\x64\xdd\x4b\x37\xc2\xa9\x57\x1b\x95\x68\xd9\xd3\x8d\x24\x1f\x22\x16\x0e\x94\x6e\xaa\x22\x64\xd4\xd0\xfc\x4c\x28\x48\x97\xaa\x38\x32\x81\x34\x2e\x29\xbd\xc9\x2c\xa4\x8b\xc3\x17\x88\xda\xfb\x98\x71\xaf\x5e\xed\xcb\xee\xdd\x7a\xa2\xaf\x3a\xee\x82\xdb\x9d\x57\x72\x9e\x14\x9f\x68\x8f\x68\x8f\xd0\x0d\xd3\x47\x5a\xc9\x2b\x19\x2a\xbb\x67\x6d\x83\x13\x5a\x5a\x02\xd2\xeb\x57\x5a\xe4\xd3\x2f\xff\x7d\xbe\xe4\x89\x65\xc1\x95\x84\x53\x5a\x5e\x93\x4d\xb5\x17\xd2\x44\x06\xf2\x1b\x42\x37\x1d\xf9\x66\x24\x74\x91\xa4\xa2\x4a\xd0\x21\xdb\xa1\xea\xb5\x23\xad\xc2\x6f\x4d\x23\xef\x5a\x94\x2d\x88\x01\x35\x12\x4d\xd4\x22\x5d\x94\x52\x3c\xf6\xa1\x80\xd0\x60\xe5\x67\x40\xf3\x6c\x5d\xbf\x37\x5d\xf6\x04\x74\x5f\xc1\x06\x7e\x41\x4b\x6f\xe2\x47\x42\xe0\x4a\xc7\x1a\x8c\xe2\xf6\xc2\x15\x19\x64\x36\xcc\x8e\x7c\xdd\xdf\xe8\x49\x91\xa9\x01\xf5\x07\xce\x5f\x15\xce\x1d\xea\xc4\x15\x63\xe4\xef\x08\x4b\xd9\x0a\xe8\xfb\xc1\xa2\x64\xdc\xef\x8a\xba\x3d\x8e\xc7\x8f\x38\xe0\x59\x91\x4d\xa8\xf1\xf4\x88\x8b\xa2\xd2\xcf\x0d\xa7\x7d\xbb\xcf\x17\x62\xe2\x3c\xc6\x2b\xc9\x86\x54\x24\xcf\x29\xa1\x24\x28\xc1\x85\xf0\xec\xa3\x55\x9f\xf1\xb5\xda\x5c\x3d\x93\x1c\xe8\xbe\xb9\x53\xfc\xe0\x1a\x75\x39\x1f\x03\x34\x13\x75\x48\x66\x37\x20\xf7\x54\x03\x74\xf5\x70\x30\xb1\xf9\xed\x90\x76\x57\x3f\x56\x7e\xd3\xca\x13\xab\x86\xa7\xca\x5e\xf4\x5a\x51\x6c\xb4\xb6\x6b\xca\xdc\x9b\x6d\x14\x90\x22\xa9\x4e\x8c\x6f\x8d\x96\xaa\x82\x53\x73\x14\xe4\xe4\x09\x7f\xe4\x3c\x11\x35\x63\x97\x75\x98\x9d\xc5\x04\x7b\x4c\x9b\x26\xa8\x4f\xcd\xa3\xd2\x99\xea\x83\xd6\x69\xfb\x7d\x7e\xab\x0b\x03\x1e\x26\xf6\x8a\x9a\xf1\xe4\x30\xf4\x9f\x21\xfd\x29\x4c\x8f\x6e\x14\xff\xd4\x53\x84\xdf\x9b\xbd\xfb\xc8\x52\xb6\x69\xae\xba\x22\xba\x7b\x26\x39\x86\x8b\xb7\x58\x3b\xd9\xde\x09\xfd\xd4\xea\x41\x0e\xff\x64\x8b\x1f\xc6\x28\x71\x7d\x4f\x62\xd1\x9a\x30\xa3\x75\x74\x78\x30\x6f\x30\x28\x9a\x8d\x6a\xe0\x29\x68\x06\x72\xfb\x37\xd4\x0f\x74\x37\x55\xc8\x74\xb0\xf7\x71\x6f\xfb\x95\x94\xac\xac\x55\xd7\xd3\xe8\xe3\x5a\x0d\x70\xb0\x6a\x4e\xc5\x63\x69\x88\xfb\xc0\x83\x3a\xfb\xc3\x9e\xa6\x6e\x14\x6f\xe8\xff\xcf\x9f\x7f\x53\xf8\x8e\x4d\xfa\xf9\x7a\x4f\xe4\x59\xad\x3a\xc8\x51\x7a\xdc\xfb\xe5\xb9\xed\xb7\xae\x1a\xa3\xea\xdf\x77\x10\xf9\xa6\x3a\xd2\x19\x2f\xe5\x8b\x2c\x71\x3e\xbe\x5a\x94\xc3\xbe\x3f\x58\xf3\x08\x04\x34\x07\x1a\x47\x3d\x62\x70\x7b\x75\x7c\xda\xf3\x9f\xb5\x7a\x8e\xd2\x69\x94\x3b\x7e\xda\x6e\x3a\xf0\xe8\xdc\x6f\xcf\xba\xe3\xf6\xde\xc0\x7e\x2c\xaf\x45\xd2\x44\xef\x7f\xa4\x12\x26\x05\x6e\xdb\x84\x53\x6a\x48\x88\x0b\x2f\x52\xd7\xf2\xa8\x8a\x64\x4e\x63\xd0\xbf\x6f\x6f\x74\xe6\x21\x47\xd1\xd2\x0a\xab\x55\xe3\xb7\xb7\x71\x80\x31\x9b\x5d\xef\x2c\x2e\x71\x6b\xf4\x7d\x70\x00\xcf\x20\xf2\x94\xb6\xf8\xe0\xf4\x8a\xa0\xa0\xcb\x5f\xed\xda\x75\x80\x79\x73\xb8\x88\xfc\xf3\x8c\x3f\x3e\x06\x05\xb8\x7a\xc6\x89\x9e\x16\x9f\x09\x0a\xc6\x7c\x46\x1d\xfa\x1f\xd8\xdf\xc6\xa4\x04\xa5\xf2\x5e\xdc\x33\x40\x2b\x87\x8a\xbb\x82\xc2\x61\x8c\x0a\xa2\x31\x92\xc3\x36\xdf\xb3\x59\xab\xbf\xe4\x58\xe4\xee\x69\xfd\x4d\x4b\x94\x74\x6f\xc8\x1f\xc5\xd3\xbd\x20\x96\x03\xb4\xf5\xf7\x85\xbf\x6a\x0a\x69\x78\xed\x3d\x67\x72\x43\x33\x76\x80\x2d\x90\x7c\x7d\xac\xe2\x3f\xe7\xd1\x86\x07\x07\x2a\x8b\x92\x7a\xa0\x92\x92\x26\x13\x6e\xd6\xe9\x61\xf1\xb3\xab\x04\x67\xf2\x7b\x55\xa3\x2e\xd5\xd2\x80\xdd\xd7\x44\x87\xdc\x30\xf6\xe4\x97\x2a\x4d\x93\xd0\x39\x68\x61\x39\x2c\x65\xff\x73\xa5\xf9\x67\xd7\x9b\x74\x27\xa4\xc2\x2f\x7a\xc6\xdd\x42\x29\x1e\x4b\xa3\xff\xde\xd6\xe2\xa8\xfb\x32\x47\x41\x6b\x63\x72\x0e\x98\x70\x55\x40\x71\x48\x2b\x81\x06\x57\x9c\x67\xee\xa0\x04\x8b\x15\x9a\xb5\x53\xcf\x4c\x29\x0f\x8b\x74\xcf\x70\x6f\xee\x6f\x41\xee\x88\x97\x08\xce\x98\xba\x12\x2c\xb0\x52\x58\xde\x6f\x5e\x71\x9d\x10\xca\xf4\xbb\x1c\xed\xe4\xf9\x00\x84\x04\x2a\xbf\xd7\xca\xfc\x0d\xe7\xc6\x4e\xdd\xb2\xcb\xac\xac\x27\xe0\xe9\x61\x3d\x14\x9d\xb6\xfa\x08\x74\x06\xc0\x4f\x68\xa9\x2a\xd2\xa4\x63\x40\x3c\xb0\x88\x5d\x62\x41\x82\x89\x54\x49\x3c\x98\x34\x1a\xcb\x21\xef\x19\xe8\x2f\xb5\xc1\xa3\xef\x37\x64\xf4\x90\xf4\x92\x65\xab\xe6\x05\xc2\xd3\x50\xed\x91\x75\xd8\x0e\x16\x81\x20\x12\x33\xf7\x6b\xdf\x92\x8e\xf9\xdb\x55\xad\xad\x04\xa3\x13\xae\x43\x41\x2c\xaf\xaa\xa6\x1b\x8c\x62\xb4\xe2\xa0\x27\x0b\x63\x55\x9b\x52\xe9\x6c\x7c\xc3\xa9\xe7\xd0\xb4\x41\xff\x79\x95\x7b\x79\xef\xf4\x50\x36\x89\x8c\xb1\x1d\xb8\xbb\xe2\x15\x67\x54\xd2\xfa\xea\x6b\xbf\xc3\x05\xfb\xea\x59\x9a\x7d\x04\x88\x49\x79\xde\x7b\x42\xc5\x0f\x27\xf1\xa7\xd2\xe4\x4d\x2e\xcc\x08\x7d\x9a\xfa\x2b\x29\xc5\x69\x82\x2e\x21\x19\x61\x2c\xf8\x1d\xfb\x45\xca\xa8\xa7\x93\xc9\xa6\xbb\xfe\xaa\x07\xd2\x22\x25\xa5\x93\x93\xa2\x67\x79\x5a\xe7\x54\xa7\xbd\x52\x3e\x86\x9e\xe7\x0d\x8b\xd8\xed\xec\x29\x88\xd5\x96\xe9\x84\x4b\x5c\xe9\x20\x67\x96\xf7\x8f\x34\xb0\xe8\x6c\xee\x98\xee\x9e\x12\x50\x46\xab\x78\xbb\x41\x36\x43\x8e\x65\x59\x8f\xa7\x71\x26\x2d\x29\x56\xb1\xf0\x6a\x9b\x96\x4a\xc7\x1e\x5a\xdf\x79\xf5\xf8\x59\xcc\x7b\x8d\x0e\x6b\x90\xe4\xa3\xae\x41\x1e\x14\x68\xa4\x37\xa5\xf5\x9e\x6b\xc7\x62\x0c\x75\x18\x07\xfe\x53\x4e\x72\xc1\x79\x58\xa2\x4c\x0a\x9a\x36\xd9\x56\x08\x9e\xff\x62\xa7\x60\x15\xf8\x49\x13\x0d\x5a\x95\x7c\x93\x68\x34\xaa\x72\xf1\x6a\x2c\x15\x7b\x5e\x70\x51\xcb\x94\x57\x8c\x13\xbe\x76\x63\xf3\xbb\x37\xae\xfa\x34\x86\xd7\xae\x79\xe2\xa6\x0b\xd9\xc5\x82\x64\x03\x69\x99\x8a\x71\x58\x7b\x07\xc4\xe6\x2e\x0b\x6b\x2e\xdf\x7c\xf3\x51\x9b\x78\x94\x68\xc0\xea\xdc\xfe\x7f\x4f\x25\x2c\xe2\x6c\xe7\xc8\x2c\x64\x9d\x44\x9a\x74\x67\xb5\x41\x2a\x65\x31\x78\xa8\x53\x66\x8f\x88\x1c\x43\x8e\xf5\x54\xc5\xfa\xaa\xdf\xde\x08\x54\xa7\xca\xb8\xd8\xd0\x91\x82\x2e\xf1\x74\x24\xd7\xef\xdd\xeb\xa4\xef\x6d\x35\x3a\xd2\xa5\x6d\x2a\x41\x61\xbf\x9d\xe5\xd0\xa5\x18\x1b\xea\x59\xeb\x54\x90\x84\x16\x4f\x59\xe7\x85\x13\x21\x71\x7d\xc4\x6c\xa2\xd2\x59\xb7\x04\x5d\xcc\x29\x3b\x79\x3b\x4c\x87\x86\x8e\xfa\x07\xe3\x93\x05\x9b\xbf\xb5\xec\x19\x7d\xc9\x8a\x20\x61\xe2\xca\x82\x5e\x99\x53\x4c\xb8\x94\x4c\x6f\x21\x53\x94\x83\xde\xe6\x28\x80\xa4\xe3\xde\xa1\x83\x80\x90\x35\x1c\xe0\xcd\x1e\x5f\xfb\x52\xc8\x3a\xbc\x29\xee\x71\xf7\xc9\xab\x85\x42\x9f\x42\x28\xc7\x8d\xbc\xf6\x78\x05\x2c\x94\x37\xe8\x32\xc2\xf3\x4b\x8d\x50\x3b\x9c\x44\x49\x9b\xed\x20\x43\x29\xf3\x66\x56\xeb\x90\xcd\x07\x27\x67\x16\x0a\x0f\xd6\xb8\x4a\x11\x0b\x83\x1f\x1f\x27\x4e\x40\xd2\xc9\xeb\x61\x42\xf3\xf7\xf5\xd1\xf3\x6a\x35\x40\x2e\xb1\xb7\xb3\xc5\x27\xfa\x4d\x76\xa4\x9c\x8a\x8e\xee\xb0\x62\xa7\x27\x2b\x9f\xb8\x1a\xfe\xfe\x41\xa3\x81\x69\x13\xc0\xae\x5e\x79\x4c\x9a\x99\xa5\x7c\xa0\xb1\x79\x1a\xc9\xc0\xd8\x23\x91\x23\xaf\x26\xd2\xe1\x10\xf1\xbe\x2e\x97\x3c\x95\xb2\x1b\x87\x4b\x9b\xf7\xf6\x22\x9b\xb5\x2b\x64\x7c\xe9\x16\x55\x15\x51\x6f\x78\xc3\x4d\x4f\x7b\x35\x01\xa5\x71\x3f\x98\x69\x3d\x46\x5f\x49\xc8\x40\x4f\x9e\xe5\xa5\xe6\xea\x69\x6b\x23\xa3\xcb\xd1\xb8\x54\xcb\x45\x79\xb0\xea\x6a\xd6\xf5\xfe\x04\xc3\xa8\xd0\x35\xfb\x3b\x36\x90\xef\xd8\x03\x61\xc8\x86\x04\xc2\xde\xd5\xac\x41\xff\x95\x13\x8b\x0c\x33\x76\xc6\x3f\x9a\xad\x68\x34\xbd\x89\xc9\x33\xf4\x27\xc6\x8f\x0a\x52\x34\xb2\x73\x9d\x08\x74\x56\x44\x6c\xb5\x29\x27\x4d\x15\x8a\xe2\x98\x42\xea\x12\x58\xea\xdf\xa8\xb7\x6e\x1a\x3c\x85\xd0\x55\x25\x2b\x89\x27\xd3\xa9\xef\xed\x37\x58\x4d\xa7\x8a\x79\xc4\x7b\xe9\xb3\xc6\xf1\x83\xc1\x3c\x63\x75\x4a\x76\x2a\x1d\x0d\xe0\xe8\xdc\xcf\xb3\xb1\x72\x88\x9e\x43\x6f\x0f\x22\xf4\xee\x84\x84\xf6\x9e\xbe\x43\x34\x94\xbc\xbe\xf8\xa3\x92\x7e\xfd\xed\x72\xff\x67\xc2\x5b\x55\x7d\x0b\x47\x59\x38\x37\x4e\xc0\x35\x53\x2e\x2e\x7f\xdf\x63\xe4\xc6\x7b\x66\x85\x4a\xb9\xaa\x7d\xe8\xd9\x39\x59\xa7\x91\x9a\x55\xad\xb7\x1b\x42\x4d\xec\xe6\x4c\x87\x98\xe4\xb5\x69\x18\x8b\xed\xa6\x06\xfc\xc5\xa6\x74\x5c\xc2\x2f\x8b\x9e\x35\x87\x41\xd7\x55\xf7\xdc\xcb\xd1\x55\x34\x9f\xcb\xed\x4d\xe8\x64\x41\x55\xc0\xc5\x11\x45\x8d\x42\xcc\xad\x15\xb8\x55\xcd\x6d\xd3\xa1\xd7\xbd\x69\x8f\x0c\xe8\x22\x11\xea\x30\x59\x43\x33\xec\x21\x36\x7a\x66\xe1\xb4\x02\x85\xee\xb5\xc8\xa0\x24\xe3\x4b\x68\x3e\x9b\x1d\xc2\x72\xf1\x46\x3d\x3e\x13\x83\x81\xb1\x8c\xa7\x6f\x0a\x0f\x44\xe3\xbf\x6c\x5e\x2b\x7a\xb1\xcb\xe4\x14\x50\x8e\x61\x8c\x24\xd3\x20\x31\x6a\x11\xc6\x12\x08\x2f\x31\x6a\x9f\x4f\xfc\xa4\x6a\x33\x0c\x29\xef\x65\xf3\x37\xcb\xfe\xf2\xbb\x1b\x4a\x61\xb1\x0d\xe4\xa5\x7f\xf7\x8b\x5c\x28\xb9\x57\x03\x4a\xc6\x86\x6c\x5d\x3f\x80\x0b\x31\x65\x72\x8b\x2e\x60\x3e\x03\x28\x1c\x0c\xef\x59\x43\xcf\x32\xb6\x01\xf2\x41\x34\x2b\xeb\x99\x9a\xff\x51\xae\xa7\x09\x12\x0c\x75\x63\x9c\xc9\x06\x1c\xca\xb2\x5b\x14\xd4\xa9\xa9\xf5\xdf\x82\x5f\x73\x7d\x83\x6c\xd2\x38\x35\x1d\xe8\x40\x32\xf2\x80\x82\x32\x9b\xe2\xa5\x5b\x95\x24\x43\xc2\x18\x84\x56\x28\x3e\xa2\x79\x8c\x12\x11\xbe\xf9\x41\xc9\xc0\xf2\x91\x9b\x6e\x11\xac\x11\xe2\xde\x19\xc0\x7b\x6f\x6c\x6c\x75\x6d\x1a\xcd\x53\x35\x46\xde\x2c\x45\xef\x32\xc9\xeb\xfe\x20\x8b\xc6\xf7\xc5\xb6\x70\x3d\xff\xc0\xe1\x10\x6b\xfb\xe8\x5c\x08\xd8\x4a\x05\x61\x9c\x4f\xec\xb5\xf8\x30\x0a\x77\x2e\xf0\x7b\x50\x07\x4a\x0f\x99\xe2\x71\x9f\x51\x4d\x65\xec\xa1\x36\x72\xf9\x23\x2a\x4c\xf5\x1d\xfe\xfc\xef\x5b\x11\xe0\x71\x8b\x6d\xf5\x0b\x9d\x2c\xeb\x56\xcf\x71\x58\xf4\x19\xd8\x5b\x31\x34\x6d\x6d\x1e\xc2\x5d\x2b\xe3\x37\xde\xbb\x34\xdd\xa9\xba\xc4\x95\x84\xa7\xfe\xf0\x50\x1e\xb8\xca\x88\x64\x56\xc6\xf9\x5d\x88\x92\xd7\xcb\xa3\xe3\xa2\x7a\x98\xd2\x71\x3d\xc4\xac\xa3\x4d\x6b\xbc\xeb\x2b\xcc\xd2\xd6\x6f\x20\x06\x13\xc7\x03\xd0\x7f\xf3\xe0\xfd\x58\x6f\x9e\x91\xaf\x4c\xd1\xcd\xf7\x80\x3f\x3a\xdb\xad\x11\xc8\x39\xbd\x40\x9e\x4b\x98\x06\x21\x9b\xb0\xd7\xcf\x9d\x33\x66\x34\xa6\x08\x7e\x8d\x98\x55\x71\x35\xba\x63\x5b\xf2\xf7\xfd\x89\xbc\x0e\x55\x83\xcf\xef\xae\x2d\x0a\x4e\x35\x93\xb2\x96\x4b\x2b\x58\x32\x36\xe8\x5e\xe7\xdb\x7f\x00\x10\x6f\xd2\xcb\xcd\x8c\xb9\x74\xea\xc8\xe9\x1d\x26\xdb\xa9\xfc\x24\x17\x5f\x4d\x30\xfe\x5c\x24\x29\x68\xeb\x46\xce\x57\x33\xb3\xfb\x67\xfb\x41\x2b\x6c\x8b\xcd\x08\x12\x59\x20\x9c\x1b\xcc\x50\xaf\xaf\x95\x1e\x51\x1d\x45\xee\x5f\x4e\x03\x85\xb6\xe6\x5b\xa0\x2b\xbd\x25\x7f\xdd\x49\xc5\xa1\x22\xd9\x75\x6e\xf5\xa3\xc4\xc5\x59\x95\xfa\x43\xee\xfd\x46\x29\xd9\x10\x87\x35\x66\x06\x1f\xe3\x6a\x1f\x72\xf3\x66\x39\x67\x24\x2b\xe5\x14\x31\xae\xc4\xcd\x06\xfc\xd6\x33\x6b\x80\xe6\x83\x49\x9a\xb0\xc6\x1d\x96\x98\xaa\xf0\x0d\xb4\xbd\xb9\x20\x7d\xb5\x8e\x47\x4c\xe9\x74\xbd\x1c\x3f\xdf\xf7\xc7\x58\x99\x3f\x43\xa2\xb6\x45\x87\x25\x1b\xdb\xb3\x0d\x39\x3f\x42\x30\xe1\x3e\x19\x24\x63\x10\xd4\x65\xb4\x17\x5e\x3d\x07\x82\xfc\x54\x5a\x50\xdc\x98\x57\xad\x70\xe0\x68\xb4\xf7\x7f\x89\x3d\x8b\x56\x19\x79\xa9\xe7\xac\xdb\x25\x13\xfd\x88\x76\xb9\x87\x2d\xaa\x76\xe9\x59\x38\x20\xf8\xb1\xd1\x12\xe0\x0f\x0f\x21\x25\x9d\x96\x66\xce\xef\x81\xff\x14\xbb\x75\xd9\xfa\x6b\xa3\x90\xaa\xb9\xb9\x45\xd7\xea\xc3\xc1\x2c\x0a\x0c\xf6\xe6\x8a\x03\xd6\xf8\xf6\x13\xba\xae\x6e\xdb\x21\xd9\x73\xff\x9b\xcb\x01\x55\xbb\x05\x2d\xd2\xa2\x2f\x32\x0d\x75\xa1\xd7\x65\xe1\xef\xb8\xa8\xbb\x2b\xbd\xee\x9b\x21\x31\xac\x45\xde\x1d\x3f\x6d\xce\xe0\x81\x17\x03\x0f\x9e\x17\x1a\xf5\x92\x60\xfc\x94\x66\xe8\x16\x10\x9f\x2c\x23\x94\x8d\xeb\x41\x8e\xba\x4d\x62\xc2\xa3\xdc\xd5\x7d\x36\xc7\x1d\xa7\x58\x4a\x00\xbc\xeb\xca\x2f\x55\xe3\x1e\xc8\x45\x1d\xd9\x8d\xad\x81\x3c\x28\x8d\xc4\xcc\xec\xfc\x27\x8d\x9f\x7f\x3c\x97\x10\x2c\x4a\xf5\xc5\x1b\xb3\xfd\xda\x31\x52\x2c\x4e\x2d\x01\x7e\x00\x0b\x99\x78\x34\x88\xff\x39\xef\xbb\xdb\xb0\x6f\xb5\x21\xf5\x45\xe7\xb4\x97\x1a\xba\x33\xa0\x60\x29\x0c\xe9\x2d\x56\x35\x2b\x07\xf9\x13\x25\x68\x30\xac\xec\xd7\x9c\x8e\x52\x18\x36\x10\x13\xdf\x1b\x05\xcf\x29\x69\x15\x58\x5a\x46\x42\x43\x9b\x56\xf0\x5e\x1f\xd7\x26\x23\x4d\x01\x00\x20\x23\xa1\x78\x96\x63\x80\x75\xbd\xa7\xfb\xa9\x4e\xa6\x7c\xfa\xf9\x1b\x31\x05\x7d\x91\xb7\xd2\xe0\x5c\xa7\x28\x06\x1d\x23\xd9\xab\x8e\x6a\x42\x28\x51\xbc\x5e\xa3\x09\x4d\x3f\xb8\x76\xff\x32\xf7\xda\xa9\x32\x33\xf2\x0a\x49\xd4\xee\x95\x8c\xd7\x5d\xc2\xfe\xa6\xe8\x2e\x2d\xf3\xf1\x9d\xff\x47\x33\x2e\xbd\x1d\x3b\x9a\xba\xe9\x49\x15\xf4\xc7\x4a\x61\x25\x58\xe8\x85\x28\xd3\x01\x32\xfc\xd7\xed\x09\x37\xcf\x7d\x94\xa5\xd7\x02\x36\xdb\xb1\x52\x3b\xbd\x4e\x54\x77\x3f\xd6\x88\xa9\x81\x4f\x56\xe0\x1f\x67\xbd\xe1\x6b\xcb\x51\x7d\x36\xc5\x3e\xbb\x41\x75\x28\x39\xad\x60\x4a\xb7\x5a\xa5\xd9\xf4\x6d\xbb\x11\xbf\xb1\x4f\xeb\x06\x39\x83\xe2\x75\x73\x44\x79\xb6\x94\x0d\x56\xb6\x15\xa1\x48\xe1\x70\xe7\xbd\xb5\x16\x0e\x40\x44\x23\x85\x07\x2a\x7b\x3e\x84\x4a\x82\x3d\xc9\xf3\xb3\xea\xbb\x10\x00\xd5\x58\xac\x3e\x8d\xab\xd3\x7c\x07\x1e\x96\x98\x12\x29\xfa\xe0\x1a\x3d\x0f\x13\x48\x37\xd7\x91\x29\xa2\x42\x5d\xe1\x5a\x24\xc1\xed\x49\x05\x96\x17\x5b\x35\x6e\xdc\x9a\xe3\xe7\x85\x17\xb6\xf9\xdd\x6b\xb6\xfa\x7f\xa9\x45\x9d\xeb\xc4\x92\x0f\xbf\xe6\x6b\x0e\xbc\x84\x92\xf6\xbc\x15\xd5\x53\xc8\xeb\x0b\xe5\xd4\x8c\xeb\x64\x32\x0d\x65\x4a\x59\xe2\xde\x85\x0f\xd6\xb9\x4e\xb7\x3e\x67\x32\xbd\xfc\xc6\x64\x85\x4d\xd6\x15\x65\xda\x9c\x55\xc7\x95\x4a\x5e\xa3\x74\x03\xa1\x3d\xa7\xd5\xd7\x93\x14\x74\x27\x99\x35\x46\x57\x47\xe1\xa2\x5f\xba\x33\x9a\xfa\xfc\x56\xe3\x8d\x89\x07\xa1\x80\x3b\xf3\x7a\xac\x11\x42\x5f\x01\xf9\x9d\x54\xf7\xc8\xce\x1e\xc5\xea\xad\x17\x29\x29\x69\x70\x6c\x09\x4d\x82\x08\x17\x2e\x95\xab\x40\x9a\x50\x32\x0b\x2d\x30\x9d\xc9\xab\x14\x18\x30\xce\x51\x72\x09\x74\x08\x5e\x5d\x95\x0b\xba\xaa\xbf\x7e\x29\x33\xb8\xbc\x4a\x7c\xd1\x0b\x60\xd1\xf9\x03\x91\xbd\x86\xc6\xd3\xc4\x01\xdf\xe7\x4a\x4f\xd5\x70\x1f\x30\x66\xbd\x68\x95\xa8\x6f\x7e\xda\xc8\xf0\xe0\xa8\x04\x3d\x44\xdb\x13\x7f\x05\x44\xea\x0e\x24\x3a\x3b\x41\x68\xcb\xac\xdb\xdb\x3d\x97\x16\xdf\xe1\x76\x16\x8b\xd0\x43\x76\x59\x49\x04\xa5\xd5\xdd\xaf\xd0\x18\x03\xd6\x15\x99\x45\xec\x55\xab\x9d\x0f\xdb\x4e\x93\xd3\xcf\xd5\x2f\xfe\x1f\x45\xd9\xc7\x38\x17\x41\x79\xbb\xb8\x72\x8f\x5c\x13\xcf\x42\x05\xff\xc9\x8a\x22\x00\xef\xac\xcc\x88\x13\xc9\xec\x1c\x9b\x0c\x52\x42\x0e\xe6\xed\xbf\x59\xa2\xb2\x50\x78\xda\xa7\x05\x89\x84\xa4\x08\xc7\x06\x07\xfd\x8e\x49\xd3\x05\x9e\xd1\xd0\x58\x1c\x0c\x6e\x5e\x3c\x2a\xe7\x85\x03\xd2\x8b\xf2\x22\x35\xdb\xbe\x8e\x05\xdf\xb9\x56\x65\xb3\x56\x43\x75\x45\xf8\xb4\xe6\xa1\x65\x75\xc7\xa6\xf2\x7f\x55\x02\x2f\xc0\xd7\x6c\x4e\xfd\xfc\x62\xb3\x56\xfc\x1e\xeb\x09\xbe\x57\x92\xb2\x17\xa6\xdd\xb6\x96\x42\x8e\x6a\x67\x2a\x94\x79\x88\xf3\x20\x9c\x8b\x1e\x91\xd7\xa8\x6b\xaa\x19\x87\x83\x06\xe0\xe0\x83\x77\xd4\xd0\xda\xcb\x42\x47\xf0\x7a\x83\xcd\xa6\x79\x90\xaa\xd1\xd6\xe8\x9c\x05\x73\x79\xa3\xac\x7a\x0f\xf6\x36\x5c\xbe\xe5\xde\x53\x8d\x7b\x2d\xa3\xe9\x1a\xbf\x8b\x66\x50\xde\xe0\xbd\x16\x40\xb0\x94\xb3\xdd\x68\x71\x7a\xe4\x70\x0c\x08\x74\x45\x7a\xb1\x10\xa1\x4b\xde\x50\x44\x6d\x2c\x32\x35\x4e\xdd\x0d\xaf\x1f\x37\xdd\x31\x7e\x96\x5f\xa2\x4a\xc5\x6c\xfd\xa8\xaf\xe0\x6a\x1f\x30\xdc\x27\x75\x26\xdd\xde\x52\xeb\xde\xa2\xea\x3e\x68\x29\x3c\xa3\x4d\xa0\x93\x63\x7e\xa2\x65\xd0\x7e\x29\x10\x12\x34\xc5\xb2\x1c\x8a\xd4\x67\x4c\x68\xfa\x0c\x37\x0f\xaa\x93\xde\x76\x8e\xf4\x1c\x25\x10\xc7\xa1\xc9\xdb\x9c\xb1\xc9\xdf\x5f\x8a\xfa\x49\x47\x3d\xfd\xad\xc1\xb1\xf4\x23\x78\x54\xf6\x5c\x05\xe3\x28\xcc\x7e\xfc\x6d\x2c\x2b\xa8\xe0\x52\x38\xe4\x15\xec\xe3\xf7\xb2\xb0\x6f\x0d\x0c\xfd\x40\xdd\xef\xe3\xe4\x46\xe6\x81\x1b\xf9\xa5\x02\x48\x35\xc6\xa3\x63\xf7\x3f\x46\x85\xc2\xa9\x92\xd9\xb8\x1d\xb3\xe1\x19\xdd\xb3\x13\x9c\x4d\x9d\x4b\xde\x6b\xa5\xfc\x19\xc1\x9c\x0a\x80\xb4\x7f\x55\x75\x9f\x1a\xe9\x89\xdc\xd0\xea\x5a\xec\xe9\x27\xfe\xb8\xa3\x5c\x7d\x38\x3f\x2d\x34\x30\x99\xb4\x95\xfb\xde\xc2\x6c\x70\x3d\xe6\xe4\x14\x82\xe7\x83\xf4\x42\xe3\xff\x4b\xad\x4c\x25\x1a\xde\xcf\x7d\x5a\xed\x76\x6f\x4f\x9b\xd8\xb8\xfd\x01\x4f\x5c\xbd\x24\x90\xed\xbc\x56\x19\x63\x78\x7d\xbc\xe5\xa8\x38\x80\xae\x60\xf5\xf0\xc3\xb5\xae\xbd\xd5\x7e\x62\xe1\x28\x52\x42\xb1\xfb\x67\xe6\x87\xda\xca\x3b\xae\xa2\x69\xf7\xf7\x62\xc8\xb1\x9a\x30\xbd\xf4\xaf\xad\x5c\x30\x26\xf9\x30\x27\xfe\x73\xcb\x88\x72\x53\x5d\x52\xbe\x4a\x38\x82\x69\x4f\x05\xcf\xaf\x59\xf7\xf0\xa9\x36\x04\x84\xdf\x6a\x8c\xe5\x4f\x61\xd4\x99\x00\x57\xd1\x54\xb6\x43\xf7\x43\xfd\x3d\xd7\x29\x91\xed\x95\x2b\x96\x5e\x1f\x12\xa7\x1e\x6c\xa6\xaa\x36\x0c\xc5\x62\x15\x4a\xd1\xa5\xd1\xe6\xef\x64\x8d\x0d\x00\xc8\x47\x9c\xb9\xe0\x4c\x7b\x2a\xca\x9a\xc1\xbb\x97\x2d\xdd\xc4\x77\x3d\xf6\xb5\x97\x97\x2a\xa7\xb8\xc5\x4c\x5e\x4f\x1c\x66\x9b\x56\x96\x61\x03\x43\x54\xf2\x51\xf2\x0f\x6a\x27\x93\x6e\x3e\x4e\x92\xfa\x75\xe5\x9e\xec\x62\x9d\xae\x0d\x1b\x69\x23\xc5\xb8\xa8\x9f\xa5\xe1\xff\x56\x8f\x86\x83\xe1\x21\xfd\xe0\x6c\xd0\x52\xbe\x26\xcc\xc0\xec\x2c\x93\x79\x38\xc6\x7a\x4b\x9e\x4d\x76\x05\x66\x58\xc7\x66\xf1\x5c\xc3\x95\xac\x15\x5c\xda\x28\x76\xad\xe5\x28\xb8\x6c\x88\xed\x56\xfb\x1c\x26\x84\xaf\x4d\x25\x9b\x75\xa0\x86\x60\xf8\xc8\xb0\x5d\x95\xc9\x58\xd2\xfa\x50\xd2\x1d\x33\x5b\xf0\xc7\xce\xd5\x08\xef\x12\x80\xc7\x5b\x2d\x6f\xf5\x16\x93\x11\xda\x74\xeb\xac\xe1\x33\x51\x96\xe1\x45\x12\x74\x9a\x7e\xad\x31\x6e\xd8\x5f\x03\xa7\x35\x34\xb3\x3e\x1d\x41\xd6\xf8\x02\xd8\xb2\x03\x79\x50\x67\xb3\x9a\xac\x1c\xfa\xfd\x37\x0b\xff\xca\x0e\x6e\xea\x13\x67\xc7\x38\xcf\x56\xb0\xb6\x1b\xa5\x84\xc3\x26\xef\x96\xcc\x02\xec\x85\xba\x49\xec\xcc\x1e\x7c\x73\x5e\x81\x5f\x49\x2f\xb7\x12\x77\x4b\xf0\x3a\x8c\xa8\x54\x42\x3c\xfb\xbf\xa3\x3e\x74\x56\x0e\x72\xf8\xb5\xec\x13\x06\x44\x16\x0d\xff\xfb\xf7\x3f\x61\x73\xef\x3d\x87\xa9\xd7\x48\x2d\x39\x94\x3d\x4f\xe0\x40\xc9\x2e\xeb\x20\x36\xa1\x91\xa5\x8b\x68\x14\x31\xc5\x97\xd6\xf5\x6e\x03\x2a\x90\x22\xef\x14\x18\x1d\xeb\xa3\xb3\xc5\xac\xe5\xec\xf9\x69\x11\xf3\x55\xb7\x9a\x48\xa9\x17\x1e\x32\x7d\xdd\xdf\xc1\xc4\x9d\x98\xce\xd3\x9a\x96\x5b\xaf\x5a\x91\x8a\x4b\x7a\x62\xe8\xa3\x54\x02\x97\x42\xaa\xbc\xac\x59\x8f\x09\xca\x75\xed\xbf\x06\x53\x3a\x0d\xa1\x94\x3f\x56\x0e\xb0\x13\x38\xbc\xc1\x7a\x56\x61\x13\xfa\x83\x4d\x83\xb7\x07\x08\xf6\xac\x7a\x3a\xeb\x6c\xe7\x7e\x00\xc7\x93\xfb\xa1\xd2\x42\xeb\x6f\x47\x2f\x58\x31\x8f\x88\x25\xde\x63\x32\x82\xde\x56\x96\x57\x83\x99\x5c\xb2\x20\x41\x59\xa8\x1c\xf0\xeb\x1f\x5a\x9b\x3d\x20\x1b\xbd\x06\x9b\x59\x9b\x25\xd4\x5a\x5a\xef\xe8\xc9\xed\xbd\x01\x3e\xb1\x78\x0c\x33\x4e\x83\xa8\x18\x96\x41\x8a\x1e\x54\xbd\xe2\xb0\x87\x4c\xdc\xc8\xb9\x85\xb4\xfd\x13\x79\x9b\xc9\x2a\xf3\xca\x95\xdc\xac\xc3\xf9\xec\x0a\x87\x6f\xd2\x2f\xf4\x07\xcd\x8f\x0a\x96\x7b\x5a\xd0\xb9\xdd\x0b\xc4\xa0\x0d\xba\x52\x26\xaf\xf5\x36\x36\x94\xb3\xa7\x10\x4a\xc7\xea\x62\x37\xd8\xb0\xe8\x34\xbd\x02\xd7\x7d\x2b\xae\xe9\x37\xdf\x12\x63\xda\xc8\xc2\xd5\x9b\xec\x3c\xe3\x75\x7c\x46\x57\xbc\x82\xff\xb9\xb8\xa8\xfc\xb4\x44\xa1\x29\x3e\x74\x54\xa9\x00\x15\xa4\xfd\x12\x5a\x7a\xd7\x87\x33\xea\xb3\x61\x00\xe6\xbb\x5f\x2a\x1b\xf6\x50\x96\x00\xbc\xac\x35\xfb\x5d\x53\x27\x81\xf5\xb5\x71\x05\x4a\xb7\x07\x8d\x4a\x9b\x8d\xfd\xd6\xf3\x49\x7e\xa5\x5f\x43\x8a\x4f\xcb\x0a\x26\x17\x3b\xe3\x9d\x69\x0f\x0b\x82\xe0\xfe\xbd\x07\xb8\xdf\x89\x47\x88\x2f\x07\xd7\x6b\x8b\x75\x9b\xad\x93\xf7\xec\xb0\x5a\xfd\x78\xeb\xab\x6f\xe5\xb9\xab\x05\xa6\x58\x11\x21\x88\x86\x59\xad\x24\xb3\xaa\x74\xbf\xad\xa4\x80\xbb\x55\x84\x7e\x2c\xa8\x26\x19\x62\x48\x59\x67\xe3\x43\xe8\xb6\xf3\x9c\x28\x1f\x7b\xb3\xdb\x3b\x13\x69\x5b\xa3\x2b\x78\xa8\x55\x41\x91\x31\x86\x61\x63\x43\xc0\xb5\xf9\x1d\xe5\x36\xf7\x3d\xf1\x0c\x9c\x5f\x51\x1e\x18\x3e\x33\x4b\x87\x2c\xc8\x62\x0d\x40\x8e\x17\xca\x7b\x91\x7c\x1f\x9e\x66\x85\xfc\x4a\x1d\x33\xe4\x90\x7e\xbc\x7f\xe6\xaa\x6e\x6a\xdb\x64\xf5\x76\xe3\x63\x81\x50\xd4\x34\x71\x0f\x78\xf1\x3f\xaf\x6c\xba\x44\xb4\x6c\xef\xa1\x31\x2f\x9a\xa6\xb6\xe6\x38\x43\x8f\x8b\xa0\x0c\xb3\xd8\x43\x92\xea\x8c\x0a\xeb\xdd\xb1\xd7\x0c\xff\x4d\x6a\x1c\x33\x73\x75\x24\x81\x4c\x11\x66\x50\x2c\x7a\x46\xdb\x71\xa3\x68\xb3\xe0\x3e\x58\x15\x6d\x1d\x59\x30\xab\x6a\x15\x7f\x6f\xeb\xa0\x1f\x69\xc9\xeb\xc7\x20\xf3\x2c\x76\x2b\x18\x4f\xf1\x1a\xc6\x3d\x8b\xc9\x3f\x4c\xa4\xcc\xe9\x1f\x69\xa3\x89\x44\xa3\x5c\x8f\x26\xfe\xdf\x57\x9c\x6c\x7a\x09\x2a\x51\x18\x3d\xe9\x72\xdd\xac\x47\x38\xb5\xc0\x76\x9b\x22\xce\x0a\xdf\xf5\x8c\x19\x26\x46\xa3\x06\xcb\x3f\xc9\xa1\x4c\xea\x5c\x02\x69\x9e\xc6\x46\x29\xbd\x80\xee\x10\x58\x9e\x37\xd1\xb7\x6b\x3d\x2a\x7a\xef\x0d\x06\xb9\x75\xb5\x8e\x4a\x20\x6e\xea\x06\x84\xf6\x90\x1c\xba\xcc\xeb\x9a\x10\x3e\x6a\x62\x26\x73\x3f\x69\xef\xf2\xb2\x0a\x2f\x84\x88\xd4\xe6\x51\x9b\x19\x8a\xaf\xe9\xc9\x02\xa1\x39\x0b\xed\x85\xd6\x1c\xe5\xd0\x99\xb8\x05\xcc\x6a\xe4\x86\xa3\x2f\xb1\xb9\x10\x32\x0f\x53\xb2\xea\x04\xfd\x29\x55\xc9\xa9\x9b\x6d\x87\x74\x8c\x83\x6f\x5b\x04\xf6\x15\xac\x81\x6b\xaf\xfb\x28\xe3\x12\x81\xd3\x43\x06\x23\x5a\x38\x64\x4f\x49\x5d\x5a\x17\xee\x1c\x36\xd3\xcb\x66\x83\x5f\x2c\x91\x11\x3b\x5b\x80\x0d\x6c\x33\x03\x7c\x6a\x8b\x01\x72\xe3\x1a\xe7\x19\xd4\x60\x52\xae\x7e\x77\x1a\x6c\xf1\x50\xe3\xb6\xe8\xa6\xe4\xe3\x68\x35\xa7\xca\x91\x1d\xa3\x84\xfb\x9f\xad\x14\x12\x83\x4c\x78\x55\xd4\x86\x28\xf5\x62\x7a\x74\x71\x6a\xef\xc2\x86\xbc\x8a\x45\x39\xaa\x53\xc9\x0d\xf0\x45\x54\x44\x19\x19\xae\x54\x96\x55\x83\x08\x52\x59\x16\x40\x47\x34\x8b\x24\xd0\xca\x4b\x6b\x2c\x28\x5d\x9d\x32\xca\xc0\x1d\xe6\x39\x93\xde\xe9\xe5\x68\x1e\x3f\x51\xec\xb2\x30\x67\x3d\xdd\xf4\x25\x55\xdc\xa6\xfa\x1a\x75\x17\x06\x20\x27\x55\x4e\x4c\x9d\x5d\x45\xca\x52\x96\x05\x11\xa9\xb5\xa7\x7f\x89\xfd\x04\x98\xb3\xe3\xfc\x53\x10\xdc\x4c\xce\xf1\xb2\x72\x5d\x27\x9c\x4d\x0a\x2f\x2a\xbc\x71\x4a\x30\x04\x3a\xb6\x36\x37\xb6\x98\x2f\x21\xba\xb8\xbc\xf5\x53\xf2\xaa\x2b\x9e\x99\x27\x74\xa6\x9e\xa3\xe4\xd5\x74\x12\xd1\x2e\xbe\x06\xd8\x15\x19\x89\x2f\x10\x03\xdc\x7b\x9b\x6f\x85\x99\x8d\x61\x96\xa8\xe7\x5a\x25\xcc\xb1\x03\xdf\x57\x73\xfa\xfd\xe8\xe9\xf4\xd3\x0e\xf6\xbf\xaf\x9f\x24\x2b\x20\xc4\x6c\x65\x30\x24\xba\x46\x35\xfb\xf5\xfa\x53\x18\xf1\x3b\x47\x15\xa6\xf3\xd4\x28\x96\xf3\x5b\x15\x2f\x7b\xe8\x85\xb0\x37\x8b\x55\x62\x21\x13\x1b\x77\x00\x4b\x2e\xb9\x2f\x0a\xf0\x77\xc8\xb1\x34\x6c\x01\xe8\x7a\x3d\x80\x90\x94\x28\x2c\x05\xd4\x96\xb9\xa2\x34\xf0\xc6\x11\xf2\x07\x6b\xe3\xd0\x5c\x54\xce\x38\x51\x7d\xe0\x21\x34\x85\xf8\xda\xab\x57\xbd\x18\x6c\x92\x6a\xfa\x2c\x29\x27\xfd\xda\x93\xa1\xed\x71\xd0\x90\x17\x5a\x16\x1b\x25\xd4\xfd\x82\x7a\x0f\xa3\x45\x76\x4d\x33\xf8\xe9\x9b\xd6\x69\xd2\x86\x3b\xc2\x33\x02\x05\x3b\xf4\x66\x6d\x13\x9a\xc0\xbc\xfa\xa2\x86\x36\x5e\x22\x21\xc0\xae\xed\xd7\x20\x29\xba\x48\x61\x70\x51\x67\xd4\x17\x3b\xd8\x9c\xd9\x1f\xcc\x5b\x1d\x8d\x0a\x1b\x54\xc0\xcc\xfb\x0f\x34\x6f\x7b\x31\x35\x6a\x47\x53\x12\xb3\xda\x8c\xc0\xf4\x84\x0a\x91\x1b\x89\xf6\xba\x4e\xf6\x47\xfb\xf0\x53\x00\xe9\xec\x44\x28\xaf\x6f\x05\xc2\xa3\x07\x46\x5c\xd9\x04\x06\x0b\x8d\xb6\xdf\xc9\xd2\x7c\x1c\x18\x3a\x55\xf0\xc3\x06\x79\x98\x84\x10\xab\xbd\x5f\x6b\x43\x1b\x61\x2d\xb2\xc7\x05\x67\x61\x42\xb2\xd6\xee\xea\xa8\xb9\x6f\x4d\x82\x74\x69\x16\x65\x48\xeb\xd8\x45\x2b\x8d\x8e\x7b\x87\x58\xe5\x5b\x3f\xd4\x8f\x53\x0d\xeb\x97\x8a\xfb\xfb\x5f\xb3\x15\x35\x36\x4c\x9d\x20\xd5\x12\x79\x1c\xb5\x45\x11\xff\xaa\xde\xde\x67\x0a\xb0\xc9\xdb\x94\xc0\x2f\x6d\xef\x5e\x56\x66\xa4\x44\x70\x20\xb1\x51\xbf\x81\xb9\xce\x70\x0a\x42\x4f\x03\x20\x2a\xd7\xb8\x21\xfc\x77\x8f\x0d\x0e\xa4\xcd\x27\x9e\x6d\xb0\xe8\x62\xf6\xd1\x20\x0b\xa8\x4c\x55\x04\x7b\xd2\xc7\x5e\xd5\xe8\x30\xbc\x97\xb4\x38\x88\x8f\x21\xae\x45\x09\x5d\x2e\x24\xb8\xb9\xf3\x25\x28\xeb\xb4\x14\x67\x05\xd1\xd9\x22\xec\x27\x9c\x3e\x9e\x0f\x12\x25\xca\xe5\xf8\x68\xec\xee\xe7\xe8\x09\x16\x9c\x8f\xf9\xee\x9c\x56\x47\x02\xfd\xb5\xf7\x51\xf2\x82\x1f\xd7\x56\xc1\x6a\x68\x81\x9a\xee\xe7\xb0\xb7\xcd\xa7\xc2\xec\xbf\x2f\xac\x17\x65\xf5\x8f\x0d\x86\x9d\x1b\x14\xb6\x5d\x8e\x6a\xf0\x92\x88\x98\x1d\xa5\x15\x85\xbc\x68\x1c\x95\x6d\xc3\x22\x51\x4d\x8b\x1d\xbd\x3a\x13\x82\xa3\xe0\xd6\xbf\x69\x8a\x05\xa2\x57\x55\x62\xec\x10\x4a\xbe\xb1\xb2\x6e\x1f\x8b\x9e\x51\x78\xfa\x41\xf7\x2b\x8a\xba\x33\x34\x46\x6d\x17\x25\xbd\x6c\xc7\xd8\xa9\x20\x93\xc5\x5c\x26\xf4\x44\x41\x92\x95\xe4\xd9\x5a\x88\xab\x82\x70\xae\x49\xb3\x5d\x65\x59\xcb\x61\x97\x0d\x54\x8c\x79\xdd\x28\x75\xf6\x1a\x26\xe2\xc3\x31\x91\xcc\xda\x73\xe7\xa4\xa4\x04\x8e\xb1\xc2\xcd\x46\xb1\x5b\x7a\x1e\x12\x51\x67\x17\xb9\xda\xd0\x55\xac\x27\x36\xf1\xa6\xa4\xd5\x22\xf1\x75\xd8\x80\x1d\x65\x53\x07\x8c\x5f\xdd\x5e\x8b\x91\xb3\x68\xe4\x5b\x42\x33\x20\xc6\xbb\xbb\x14\x28\x9f\x3e\x2c\xf0\xeb\x45\x35\x76\x9f\x8e\x02\x75\xe0\xf0\xff\x9e\xff\x7d\xe0\xe6\x3a\x41\xe8\x63\x64\x74\xb9\xca\x8a\xee\x3a\xa7\xf8\x0a\xc3\x1a\x3d\x00\xcf\x1a\x92\xc0\xaa\x2d\xd4\x11\xbc\xf4\x81\x34\x16\x35\xb6\x3d\x99\x43\x10\xfa\x82\x23\x01\x44\xc7\x95\x3b\xc9\x9a\xcd\x1a\x71\xa0\x56\xe9\x92\xf0\xd1\x47\xb5\x62\xa8\x1a\xc2\x7a\xf2\x6a\xd6\x8f\x25\xda\x55\xca\x44\x23\xc7\xae\xfe\x14\x1f\xd3\xab\x8b\x29\x36\x93\xdf\xf3\x9f\x69\xf5\x9f\xea\xf9\x69\x34\xc3\x47\x9a\x52\x11\x12\xf7\xf2\xe4\x03\x61\xbc\xee\x91\x7d\x52\xe6\x6a\x0c\xce\x02\x4e\xc7\x25\x65\x65\xd3\x3a\x5b\x55\x5a\x34\xbf\xce\x5b\x83\xd0\xf0\x0c\x97\x25\x5f\x8f\x89\xa7\xf0\xf6\x51\x03\xaa\xc9\x47\xbd\x95\xd8\xa2\xa4\x06\xd8\xfe\x9f\x2b\x01\x9e\x62\x89\xea\x69\x20\x41\xfb\xa3\x55\xa4\xdb\xf1\xfd\x60\x45\xd8\x5e\x57\xfb\xc4\xd2\x1a\xd8\xcb\x03\x86\xb3\x44\xab\x54\x2a\x5f\xab\xde\x33\x56\xf5\x95\x8d\x34\x60\xcd\x57\x98\xfc\xd9\xf2\x54\x54\xbf\x6d\xc4\xb3\xcb\xdb\xa0\x92\x10\x3f\xf9\xe0\xfc\xf2\xa3\xc4\x05\x86\x0a\x1f\x7a\xea\x35\x77\x22\x6d\x26\x2b\xd6\x28\x20\xa4\x63\x35\x90\x0d\x1a\xfe\x9e\xc9\x6e\x6c\x15\xdb\x28\x57\x63\x8a\xdc\x89\xd5\x2a\x0d\xe0\xd6\x50\x76\x61\x97\x07\x02\x2f\x22\xf2\x7b\xe2\xc7\x06\x4f\x60\x84\xd4\x7c\x39\x72\xd4\xe3\x27\xe8\xf7\xc8\x94\xcc\x03\xd2\x3a\x93\x63\xd6\x86\x2f\xe9\xed\xc7\xe8\x74\x57\x2b\x65\x64\xc5\xfc\xa8\x1b\x29\x5d\xd0\x14\xf9\x60\x89\xae\x33\xac\x7a\xa4\x0a\xdb\x45\xfc\x6c\x55\x3b\x41\x47\x55\x13\xf5\x47\xf2\xef\xb8\x01\xf6\x3e\xfa\xcd\x31\x00\x53\x58\xbc\xee\x19\x08\x67\xcb\x2e\x6e\x5b\x87\xa0\xf2\x16\x24\x1b\x83\x55\x22\x03\x4d\x41\x0e\xa4\x41\x8a\x73\x3d\xcb\xb8\xb6\xd4\xf0\x17\xe5\x3d\x71\x70\x0d\x06\x77\x69\xec\x72\x38\x0a\xde\x2b\x32\x4a\x49\x08\x3b\xdb\x8b\xda\x5e\xbb\x62\xa9\xed\xf7\x3d\xf6\x70\xb3\xb6\xb8\x79\xa9\x46\x8f\xf0\xfa\x0a\xac\x55\x7f\xf0\xa7\x1c\xa0\xdf\x2b\x90\x5a\xaf\x6f\x3c\x7f\x55\x80\x2f\x48\x22\x3e\x79\x51\x9c\xaf\xfd\x61\xec\xc7\x4f\x13\xe7\x3f\x54\xe8\xcb\xe1\x83\x60\x62\xe6\x63\xe2\xaf\xbd\x47\xd6\xa1\xf1\xd9\xeb\x9c\x78\x7a\x67\x52\x22\x58\x7c\x60\xbe\xce\x56\xc9\x9a\xc7\x88\xb2\xec\xb5\x24\xd2\x31\x21\x57\x11\x08\xc4\x76\xa7\x44\x46\x47\xce\x45\x98\x3a\xad\xf1\x3a\x64\x45\x1f\xe3\xfd\xdb\xab\xd5\x56\xb3\xaa\xbf\x5f\x04\x13\x6b\x0f\x8e\x98\x3a\xc7\xd1\xa8\x17\x99\x8a\x6c\x0e\x8f\xc1\x93\xc4\x72\x67\x39\xcd\xf6\xfb\x23\x5d\x78\x19\xf1\x04\xdc\xaf\x90\x31\x95\x75\x0d\xe5\xcc\x48\xe1\x31\xac\xfd\x14\x67\x89\x4f\x9f\xf5\xa8\x73\xe9\xbd\x3a\x9e\x1c\x72\xfb\xa7\xcf\x89\x25\x4b\x56\x7a\xa6\xa7\xac\x88\xa5\xbd\xaf\x50\xb1\x9a\xc5\xeb\xdd\xda\x4c\xc2\x83\xe2\xa4\xaa\x3c\x64\x53\x5b\xad\xc2\xcb\xf0\x63\x07\x22\xac\x6e\x62\x20\x10\xf4\x7d\xf4\x03\xe7\x59\xa5\xdc\xcf\x43\x24\xbe\x95\xeb\x60\xca\xf6\x07\x9f\x84\xba\xca\xf8\xec\x6a\xff\x73\xbb\xc9\x21\xb7\x24\x71\xf3\x3b\xf3\x80\x34\x5c\x30\xbd\xe6\x15\xe6\xd6\x59\xe8\xc9\x41\xa9\xce\x3a\xfe\x3a\x75\x90\x4e\x66\x53\x74\x6e\x98\x85\x55\x01\x4f\x6e\xa4\x1a\xb4\x2e\x50\x2d\x69\x46\x5a\x66\xc0\x48\x8e\xef\x6f\x42\x4b\xea\xea\xd7\x67\x0e\xda\xd0\xb5\x3f\x56\x8f\xd8\x1e\x22\x6f\x57\x01\x5d\x24\x44\xe0\xbb\x4e\x25\xc3\xfe\x34\x1f\x4c\xfd\x4b\x02\x0f\x75\x74\x8c\x14\xfb\x03\x78\xcb\x70\x75\x54\x81\x4a\xe4\xc9\xdd\x6e\xb7\x06\x72\x20\xa4\x7b\x2f\x9a\x09\x1c\x3d\x1f\xe0\x8e\x4e\x3c\x64\x73\x31\xc1\xe9\x35\xf1\x30\x28\x87\x7e\xaa\xd6\x29\x51\xcc\xca\xcd\xf7\x01\xb8\xc5\x77\xb7\xd1\x6d\xb5\xae\xae\x45\x2e\xe3\xee\x86\x81\xbb\xa9\xce\xc6\x20\x0a\x86\x49\xa6\x6e\xa0\x79\x5a\xa0\xec\x5d\x99\xa6\xa9\xcb\xfd\x8e\xac\x47\xce\xdf\x1a\x5f\x9a\x5d\x9c\xb6\x98\x87\x26\x02\xa4\x7e\xb8\xc3\xbc\x8e\xbd\x3b\x08\x25\xfd\x58\x6c\x56\xa0\x49\x17\x13\x0f\x51\xe3\x68\x2e\xaa\xf6\x49\xd2\x63\x6c\xf4\x6b\x0a\xc7\x3d\xf6\x4c\xd6\x75\x71\xcd\x1a\xd6\x96\xb7\x7b\xf5\xdb\x6b\x97\x51\xca\x28\x33\xce\x73\x2f\x1a\xc6\x45\xe2\xa8\x1d\x9d\x03\x97\x0c\x4e\x02\x99\xe4\x51\x69\x96\xb7\xa2\x3b\x9a\x1c\x47\xb5\xbf\x69\x56\xa9\x55\xcf\x59\x7d\xfd\x62\x8d\x9d\x40\x9f\xa7\x93\x81\x1e\xa5\x31\xca\xfe\x75\x6b\xf4\x03\xea\x7d\x4b\xf4\x88\x9f\x3a\xa3\x93\x6e\x16\xad\x9b\x3e\xd5\xdd\xd5\xe3\xb9\xa3\xf5\xbc\x50\x49\x55\xc9\x7f\x58\x8a\x9d\x10\x71\x3e\xf2\x4f\x7b\x03\xe4\x7c\xad\x31\x3e\x34\xf3\x56\xb8\x4a\x32\x44\xc5\xd7\xb6\x6d\xa3\x87\xa0\x92\x64\xab\xde\x07\x6c\xf0\x2a\x5d\x54\x53\x37\x1a\xe6\xe0\x2f\x98\xa8\x68\xa0\xe4\x01\xdf\xbd\x42\x63\xd3\x92\x46\xcd\x96\x27\x8a\xcd\x36\x2b\x94\x0b\x4a\xbd\x52\x62\x38\xc0\xcd\x7a\x10\x27\xc6\x3c\xab\x5e\x61\x13\x9e\x2a\x23\x18\x4b\x82\x35\xbe\x15\x18\x81\x3a\xf0\x75\x1b\xfa\xa3\x69\x27\x79\xd2\x5b\x8a\xfd\x1a\x95\x45\xc5\xf2\x31\xbe\x4d\xcc\xda\x63\x3d\x8a\x9b\x9a\x95\x0e\x16\x8a\x0a\x06\x35\x16\x87\x5b\x85\x17\x35\xfd\xa6\x73\x9e\x6a\x27\xc7\x60\xac\x49\x13\x6d\xbd\x80\xcd\x52\xb5\x96\x7b\x6f\xd7\xea\xdf\xa3\x29\x72\xf9\x5e\x4f\x3b\x0a\x4b\xc1\xd1\xdd\xc4\x3f\x89\x4f\x3b\x3b\xf3\x60\x43\x2a\x9d\x02\x82\x0d\x9d\x7d\xe7\x28\x34\xdd\x0f\xa0\x37\x86\x42\x18\x58\x3a\x5e\xd1\xc6\xd2\x70\xff\xd8\x0b\x39\x42\x85\x8f\x17\xd3\x86\x7a\x41\xde\x06\x86\xab\x17\x85\x7f\x12\x23\x62\x12\xf7\xae\xc0\xd9\xae\x50\xa9\x18\x48\x4c\x1d\x23\x7f\x4c\x13\xcf\xf8\x12\x45\x0f\x4c\xb3\x74\x85\xaf\xf3\x6a\x56\x18\x5f\xa0\x34\xb8\x20\x9c\xe4\xb0\xe1\x81\x28\x6f\x90\x7a\x5c\xf1\x03\x75\x72\x8c\x2a\xa5\x5f\x4d\x58\x47\x45\x72\xe9\xcc\xe1\xd3\x06\x35\x2b\x59\x19\x26\x9e\x11\xcb\xf8\x5a\xdb\x10\xf6\x1c\x84\xd0\x28\xc0\x95\x7e\xd9\x30\x45\x55\x2d\xf9\x28\xd1\x80\x58\x6e\x35\xda\x41\x34\xf5\xf6\xc1\x98\x5f\x33\xb4\x73\x6b\xd2\x7b\xd4\x47\xe7\x38\x8c\x04\x42\xb6\x34\x9e\x71\xf4\x73\x8a\x7d\xdb\x58\x9e\x7f\x63\xf2\x56\x3a\x19\x24\xc7\x61\x22\x55\x2c\x95\xd0\x26\x20\x27\x51\xbd\x12\x78\xc1\x90\xa1\x78\x4f\x5a\x19\x47\x3b\xad\x3a\x3e\x64\x76\xae\xa5\x7f\xca\xba\xb8\x6a\xfa\xc2\x18\xbf\x22\x1b\xd5\x85\x7a\x32\xd3\x07\xfc\x76\x75\xe9\xf2\x52\x3d\xc9\x6b\xd5\xb0\x0e\x1a\xa3\xeb\x68\x27\xd5\xdc\xd0\x7a\x4e\xc3\xec\xac\x4f\x60\x99\xb4\x35\xe9\x53\x6c\x6a\x16\x55\xa1\x2e\xba\x0f\x9a\xc9\x20\xeb\x64\x46\xbd\xea\x22\x4a\x72\x26\xb4\x76\xf6\x2c\x12\xe2\xa6\xff\xfe\xc4\xb9\x53\x46\x23\xb7\x87\x01\x4d\xd6\xbe\x8d\xd6\x3e\xaf\x29\x80\x77\xd8\x66\x92\x86\x2b\xd5\x89\x31\x24\x0b\xda\x66\x89\x0b\xc2\xac\xad\x3c\x54\x7d\x48\x89\x3b\xa7\xfd\xbd\x19\xa2\x22\x58\xd5\x65\x25\x71\xf1\x19\xdc\x61\x1d\x34\x4a\x9c\x6a\xd4\x53\x71\xa4\x48\xd6\x63\x78\xc7\x3e\x06\x60\x9a\x7b\x33\xc5\x3d\xdd\x38\xcd\xcf\x93\x6a\xd1\x84\x78\xf6\x92\xf2\x60\xc3\x80\xa4\x1f\xfe\x42\x68\xf7\xf8\x97\x32\x53\x3a\x28\x95\x9f\x47\xf9\x8c\xee\x5b\x63\x1a\x80\x45\x03\xb1\xf3\x69\x65\xb1\x41\x9b\x97\x36\xeb\xea\x4e\xa4\x1d\xa4\xc2\x27\x9b\xa4\x6c\x88\xfe\x3c\xc0\x3c\xba\x64\xe8\xec\xcd\x2a\x2b\x56\xaf\x3a\x62\x88\xd8\xab\x4a\x4d\x63\x9d\x29\xa4\xeb\x14\x12\xd8\xc7\x7e\x1c\x70\x78\x99\xa9\xef\x20\x31\x2c\x53\xec\x55\xd0\x39\xa0\x6b\x46\x7a\x41\xb7\x20\x95\x8d\x7c\xf5\x32\xa5\x86\x3d\x12\xe5\x70\x36\xc7\xbd\x7a\x03\x84\xc7\xc8\x59\xe8\xdd\xa9\x1c\xd3\x03\xe2\x9b\x9f\xd4\x89\x9a\xda\xef\xbe\x96\x26\xf6\x8b\xa7\xc1\x44\xfd\x95\x9c\xfd\x53\x2c\x42\x86\x37\x7a\xb3\x1e\xd4\xe8\x9d\xee\x40\x9d\xb8\xfe\xc4\x86\x50\x91\x7d\x06\x4b\x9a\x54\xc2\x30\xa2\xe3\xcd\x21\x2f\x07\xa7\xa0\x52\x0b\x55\x64\xa8\x38\x53\x15\xbc\x7f\xa5\x5f\x48\xd9\x1b\x21\x36\xb7\xaa\xe8\x15\x45\xa1\xea\x1b\x36\xf9\x1a\xe5\x9d\x69\x63\xab\x4e\x1d\x78\x83\x4b\x38\x00\xad\x30\x77\xb1\x23\x86\x84\x29\x96\x31\x83\x07\xeb\x5e\x27\xdf\x4b\xba\x19\xf5\x53\x26\x9b\xb6\xce\xd2\x20\xe5\x3b\x30\x6a\xff\x02\xfa\xcb\x6f\x9c\x11\x02\xe0\x9c\xd5\x1a\x4e\x23\x25\xe8\x2f\xad\x88\xcd\x14\x90\x49\x61\xec\xde\xd9\xe3\x4e\xd7\xe6\xef\x27\x69\x2d\xa0\x0f\x0b\xaa\xef\x5c\xab\xff\x38\x94\xdd\xaf\xf5\x4b\xb1\x5b\x41\x76\xdf\x57\x59\x06\x5d\x70\x57\x5d\x81\xcb\xec\xf1\xc9\x42\xd3\x64\x3b\xf5\xd7\x2a\xa7\x94\xe3\xd0\xb3\xe3\x69\x9e\xc9\xea\xa3\x6b\x28\x93\xa3\x41\xa2\x76\x27\xc2\x9f\x04\x58\x02\x2d\x8a\x96\x57\xdd\x2f\x7c\xec\x10\x7b\x24\xe4\x1c\x3b\xab\x5d\xd5\xb6\xe2\x9c\xa7\x30\x6c\x4c\x63\x9c\x34\x3d\xc2\x18\xb0\x0b\x6e\xe2\x38\xbd\x19\x69\x88\xa1\x42\x56\x93\xde\xa4\xa6\xaa\x09\x74\x89\x60\x47\xf7\xd0\x47\xf0\x15\x2a\xcd\x59\x97\xc4\xe4\xb7\x8f\xc2\xd8\xbf\xaf\xf3\x21\xd2\x0c\x6e\xaf\x29\xf1\x93\x3b\xfd\xbd\x03\x44\x8f\x25\x06\x07\x56\x0f\xf4\x64\x79\x9e\x73\x09\xdf\xbb\xd2\x66\x26\xb4\xcd\x8e\x21\x23\x70\xd1\x1f\x16\x55\x9d\x30\x9d\x18\x0e\x99\x16\x1e\x2a\xf2\x4c\xfd\x1a\xa7\x14\x03\x16\xd7\xb1\x25\xf6\xee\xb0\x13\xe8\xf4\x51\x40\x5f\xf6\x15\xba\x3e\x64\x8b\x97\x66\xef\xd5\xb3\x3a\xc7\xa2\xae\x9b\x27\xb3\xda\xc0\x43\x8e\xd1\xc1\xc7\x4e\x31\x04\x76\x37\x6b\xc5\x5b\x35\xd6\xc8\x89\x30\x8a\x88\x5f\xfa\xba\x3f\x29\x98\x58\x9e\x68\x62\xbb\x71\x58\x69\x51\x3a\x04\x4b\xbd\x89\x28\x39\x5a\xd3\xdb\xb0\x59\x93\x8b\xfe\x6e\x1f\x5d\xa0\x6b\x2e\xb4\xff\x82\xa3\x6a\xbf\x40\xf2\x63\xc1\x65\x72\x32\x6b\x74\x54\x79\xd0\x7a\xc6\x1f\x63\x76\xba\xda\xc9\x71\x54\x25\xac\x67\xd2\x94\xf1\x66\xa8\xa7\xf0\x19\x59\xa2\xd2\x5f\xac\xc7\xc4\x6f\xae\x53\xa5\xdd\x5f\x16\xc0\x40\x67\xf4\x64\xd2\xea\xe3\x79\xb5\x32\x0d\xab\x5d\x56\x38\x77\x99\xf7\x4e\x43\xc3\xa8\x85\x03\x4d\x5a\x37\x3a\x65\xbd\x26\x4d\xbe\x2c\x0b\xba\x31\xa6\x75\x70\x34\x01\xfe\x32\x96\x0d\x6a\xc3\xf2\xfd\x8d\xe8\x76\xe1\x64\x2c\xa8\x34\xb0\x3c\xe2\xcb\xaa\xc9\xc0\x11\x62\x74\xff\xe0\xc8\xea\xa0\x36\xeb\x07\xdf\x90\x93\x1a\x1d\x97\xc5\x6e\x43\x62\x12\x44\x72\x54\xb7\x18\x20\x40\x3a\x07\x16\xc6\x2f\x6e\xcc\xe4\x7f\xdd\xb0\x92\x48\x6f\x75\x59\xa9\xdb\x76\x0e\x44\x24\xa3\xcd\x72\x7e\xb2\xab\x07\xa9\x1e\x2f\xba\xef\xa8\xf8\x07\x15\x4f\xb7\x8d\xdc\x7e\xf3\xd4\x3d\xde\x33\xc7\x90\x57\x1e\x8e\x3a\x4b\x07\x43\xf6\xd6\x90\x1b\x28\x90\x7b\x27\x9b\xba\xce\x09\xd5\xc6\x26\x1e\xb5\x8e\x84\x29\xf9\x5c\x6e\x9d\x69\xe6\xcf\x97\x9a\x44\xee\x91\xa0\x84\x1f\x1e\x69\xaf\x06\x40\x9e\x85\x50\x91\x36\x8d\xac\x2c\xe0\x37\x66\x87\x2d\xed\xa5\x7b\x89\x1a\x36\xde\x2e\x58\x9c\x98\x18\xea\x78\x0c\x3c\xf8\xfc\xfb\xce\x98\xf9\x66\x01\xc8\x87\xff\x35\xfe\x22\xf5\x02\x0f\x73\x65\x33\x10\xa2\x5f\xab\xde\x29\x10\xd0\xf6\x88\xbd\x7f\x63\x6d\xd9\xe8\x69\x09\xf4\xb4\x49\x7b\x8c\x53\x55\xd4\x71\xca\xf5\x88\xe6\x0a\x6d\x04\xeb\xe2\xaf\xcd\x45\xe9\xf6\x5b\x3e\x36\x4f\xbe\x6b\xb8\xbf\x59\x74\x0c\xce\xb8\x95\x34\xe1\xde\xa6\x1a\x72\xd5\x0f\x1f\x12\x45\x80\xec\x67\x97\xa0\xda\x28\x3b\x71\x5a\xf5\x4c\x64\xe2\xfa\x19\xc7\x8e\xca\x6e\x3d\x21\xa2\x70\x2b\xf9\xa1\xa2\x4c\xb5\x24\x34\x50\x56\x54\x23\x4a\x98\x6c\x6f\xc2\x1e\x44\x67\xf6\x17\x14\xf4\xc5\xe5\x64\xa8\x5b\xa0\x7d\x46\x4c\xd7\xb9\xae\x8b\x32\x52\x73\x80\xb6\x06\xc4\xe0\xbf\x7f\xdd\xcc\xb8\xbc\x37\x31\xfc\x29\x1d\x19\xec\x30\x45\xb1\x8b\x05\x08\x86\x9d\x20\x80\x19\x4a\x59\xa7\x59\xb2\x72\xc1\x84\x4e\xdd\x0f\x7a\xd8\x94\x29\xd8\xa4\x00\x3f\x1f\x6f\x2b\xa0\x10\x34\x97\x57\x1d\xf4\x5e\x88\x6e\x4b\x6f\xbf\xc5\x04\x37\x23\xaf\x25\x8c\x2c\x8b\x4e\x43\xbf\xd1\xa2\x2e\x8b\x4d\x99\x13\x68\xa3\xa4\xcf\xe6\xf4\x7c\x05\x65\xdb\x03\x4f\xf2\x58\x95\xa4\x4e\x46\x26\x3f\x1d\xdd\x31\xb1\xf4\xfd\x8d\x6d\xb7\xa1\x60\x20\x1a\x1c\x6c\x4a\xa8\xed\x3d\x4f\x28\xb9\x4f\x61\xd9\x63\xa3\x1f\xce\x28\xb9\xb2\xd6\x51\xdf\x0f\x5f\xea\x1a\x85\xb4\xb2\x7b\x76\x6d\x28\x1e\x02\x17\xcd\x43\xad\xcf\x3a\xaa\xcf\x26\x87\x6f\xf6\xbd\x04\xc6\x5f\x33\xb7\xbd\xdc\x4d\x48\x44\xe4\x27\x02\x89\x75\x2e\x96\x65\x1d\xd5\xac\xb9\xaa\x59\xa3\x68\x72\xef\x57\x5a\x97\xdc\x5d\x6c\x59\x9b\x32\xc0\x45\xff\xa9\xf7\x15\x5a\xba\x82\x9a\x26\x0f\xc7\x1c\xf8\x9e\x02\x16\x3e\xd5\x2f\x4e\xf0\x4a\x2c\x19\xb6\x2d\x13\xfb\x66\x12\x80\x13\x8e\x77\x75\x69\xb8\x6f\xc5\xc1\x48\xd0\x5b\x67\xb3\xc5\xbc\x61\x1c\xdd\xe7\x0c\xaf\xd5\x8a\xd3\xf1\xa7\x0e\x36\x2a\x41\xa4\x33\xa2\x28\xb2\xb5\xd5\xd9\x09\x12\x01\x32\x43\x03\x08\x2f\x5a\x8e\x31\xb1\x3c\x3b\xfa\x63\x05\x90\x6a\x22\x41\x0d\xcd\xff\x3c\x93\xaa\x52\x32\xf6\xe6\x70\x9c\xbe\x10\xba\xbd\xb9\xbc\x2b\x5e\x47\xe1\xad\x68\xb1\xa5\xf8\x02\x3f\xce\xcd\x86\xc7\xde\xad\x22\xe9\x16\x87\x10\xc7\x35\xec\x7b\x0e\xeb\x37\x89\x7e\xcb\x45\x7f\x92\x6d\x53\x68\x21\x23\xcc\x9b\xae\xbb\xd8\xd5\x82\xf6\xef\x5b\x41\xa0\x90\x6b\xe4\xc7\x6e\x6c\x5b\xb1\xf9\xfa\x83\x72\x27\x46\x77\xa8\xb5\x69\x06\x53\xa5\x2a\xa8\x10\xb7\x08\x7d\xeb\xe3\xc2\x3e\xca\x7b\x30\x30\x3f\x9e\x25\xea\xbf\xf7\xec\xe6\x1f\x23\xab\x19\xf9\x0b\x95\xe6\xaa\x38\x83\x3a\xbb\x94\x6b\xc9\x7d\x21\x0b\xc9\x69\xa2\xdd\xec\x80\x72\xe0\xa3\x64\xdc\x42\x3d\x2c\xdf\xb1\xe7\xc9\x69\x7c\xe8\x47\xfb\x0c\x6a\x80\xfc\x71\x48\x3a\xbb\x0b\xf7\x83\x33\xbd\xa3\x4e\x6b\xb0\xbe\x6a\x6c\xf0\x03\x61\xc7\x24\xf4\xf6\x6d\x5d\x7d\xde\xb1\xba\xef\x13\x0e\xf1\x85\xce\xd1\x1f\xef\xbb\xac\xf7\x5d\x8e\xb5\xe6\xd9\xa2\x8a\x57\x3b\x45\x87\x50\xb6\xc9\xa6\x0d\x37\x35\xf3\x81\x5d\xb8\x9d\x16\x72\x58\xb8\xa4\x4c\xb9\x40\xe2\x67\xf3\xe1\xb2\x5d\xb1\xe5\x35\x54\x35\x84\x5b\xc2\xaa\xee\x4a\x7a\x08\x48\xe1\xdb\x2a\xcd\xbb\xbb\xee\x7a\xbf\x7b\x4c\x8f\xf7\xeb\xf6\xd7\x1f\x1c\x72\x31\x8f\xb7\xa2\xa0\x39\xfe\xac\x49\x2f\xf4\x4f\xbb\x87\xfa\x85\x79\x1c\x01\x9b\xfd\x98\x0e\x99\x1d\x9f\x65\xe4\xad\x2b\x91\xbc\xb2\x56\xdd\xb1\xce\x39\xdb\x94\x87\x2e\xdc\xb4\xed\x9c\x9e\x56\xc6\xf6\xc4\x86\x1f\x0a\xd0\x67\x3c\xba\x9c\x4b\x38\x2b\x4c\xa0\xc2\x27\x3c\x59\x84\xea\x65\xed\x0b\xe1\xa2\x70\xf8\xbe\xaf\xae\x4a\x95\x1b\x61\x28\xfb\x12\x0c\x84\x71\xbb\x71\x29\x23\x82\xdc\x3a\x3e\xc6\x99\xc4\x0f\x0d\x84\xa6\x7f\x7e\x8a\x45\x61\xca\xf7\x96\x1f\xde\xe6\x66\x81\xc7\xe2\xbc\x36\x30\x2c\xb4\xf7\x1c\x8c\x55\xa6\xec\x77\x23\xa3\xbb\xfc\x9e\x85\x98\x5c\x67\x25\x53\x8b\x5c\x0f\x27\x7b\x25\x65\x7e\x54\x11\xdc\x0e\x14\x40\x9d\xf5\x3d\x62\xa9\x8c\xce\x1a\x14\xf0\xf6\x70\xa5\x0d\x7f\x7a\xc4\x0b\xcf\x5d\xc0\xa3\x65\x8d\x9b\x96\xb8\xaf\x7b\x79\xd2\x38\xd2\x17\x6a\x82\x34\xcd\x6b\x14\x61\x4a\x9b\x35\xb8\x2f\x90\xae\x89\xab\x4a\x01\x58\x79\x8f\x2c\x0f\x76\x4a\x18\xe8\x38\x67\x8c\xbf\x6d\x7f\xf5\x56\xc7\x76\xa4\x0f\x56\x71\x70\xae\x7f\x15\xa0\x3a\x31\xf7\x62\x4a\x58\x0a\x7f\x2e\x3f\x28\xc4\xc5\xa2\x31\xee\x2b\xf9\x4d\x83\x51\x2b\x6b\x11\x7b\x1b\x72\x1e\x9a\x98\x18\x16\x94\x62\x39\x37\x3a\x1d\xf0\x7d\x2c\x8f\xf8\x4c\x8f\xfb\x83\x99\x8d\x9a\x94\xfe\x2e\x62\xd3\x0f\xc3\xe4\x12\xf8\xc9\x01\xc7\xf6\x13\x87\xe3\xeb\xdf\x16\x7c\xc6\xc5\x84\xe5\x3f\xe7\xf5\xed\x18\x8a\xba\x08\xb3\x65\x9b\xd0\xf3\x83\xc0\x0e\xf9\x69\x85\xbe\xa3\x9a\x39\x37\x4e\xec\x0f\x1e\x9d\x19\xc8\x6b\x23\x85\xc7\xc7\xca\xf2\xf6\x3c\x5d\x3c\xdc\x30\x4e\xf8\xd1\xb6\x39\x2a\xf0\xd1\x1a\x56\x40\xe5\x3c\x26\x98\xe4\x47\x67\x97\xb8\x45\xec\xa4\xe5\x1c\x7c\x5d\xef\x3a\x4c\xec\xb4\x67\x17\x89\xb7\x88\xa4\xfe\x99\x15\xe3\x6d\x60\x41\x98\x16\xef\x49\xbe\xf3\xc8\x5f\xf7\xf2\x12\xc7\x9c\xf9\x1c\x5e\x5f\xb1\x3b\xa1\x66\xd5\x68\x73\x66\x41\xd5\x87\x97\x0d\x01\xa6\x3e\xb1\x64\xd7\x31\x30\x4b\x6a\x58\xe2\xab\xaa\xaa\x9e\xa7\x70\x78\xcc\xfe\x9d\x0d\x48\xc5\x4c\xe4\x1c\xa5\x61\x8e\x72\xaf\x06\x9e\x11\xf8\x15\x55\xad\x0e\x25\x27\x8d\x21\x6c\x40\x10\x9d\x73\xb3\x7f\xd2\xd9\xeb\x66\xf3\x79\x99\x29\xe4\xa2\x64\x41\xf6\x14\x9a\x01\x6a\x63\x97\x55\x0f\xf4\x57\x16\x61\xb9\x77\xa6\x94\x12\xfb\xd1\x01\x78\xc8\x72\x3e\x68\x1b\x27\x33\x71\x8d\x39\x0e\x15\x3e\xda\xa1\x8a\x1a\x33\xa2\xf3\xbb\x2a\x63\xb2\x47\xe7\x7a\xd6\xd0\x0b\xec\xe5\x47\xa6\x92\x6d\xc7\xe3\x1b\xcd\x5b\xe5\x93\x83\xb2\x71\xa1\xfd\xa3\x9e\x27\xbc\x60\x1c\x3d\x53\xee\xcf\xd6\xff\x19\xa5\x52\x85\x2b\x70\xd7\x50\x65\xf8\xbf\x55\x8f\x50\xa5\xd9\xef\xbb\x86\xf8\xc4\x4a\xdb\xd8\x6c\x4d\x94\xbb\xdb\x46\xdd\x00\x81\x7b\x1f\x93\x4e\x4e\xfd\xec\xf6\xc3\x83\x05\x31\x2d\x9b\x0d\x3a\x7e\xae\x24\xc9\x68\x09\x19\x67\xf3\x8a\xd2\x1f\x07\xf4\x48\xc7\xe2\x6e\xa2\x44\xd3\x93\x82\xe9\xb8\x4b\xc6\x2e\x24\x1f\xd5\xbf\xb3\xff\x13\xeb\x5c\xe7\x8d\x84\x20\x25\x5f\x45\x5b\x94\x90\x94\xd0\x84\xac\x95\xea\x89\xa7\xbf\xd6\xa7\xac\xbc\x26\x8e\xbf\x26\xa0\xd9\xa7\x74\xba\xa2\xdd\xde\x09\x1c\xe6\x18\x25\x65\xdd\xdc\xe5\x55\xc0\xe8\x29\xf0\x4b\xc3\xc2\x6d\xf8\xd8\x62\xe1\xb7\x49\xb5\x33\xba\xd8\x2c\x8b\xad\xcd\x7f\xf7\x6c\x9b\x0d\x0e\x2e\x8b\x36\x96\xf4\x43\x97\x1a\xea\x4e\xf0\x62\x47\x96\xdb\x40\xe1\xb6\xdc\x26\x64\x96\x1e\x9c\x83\xe1\x07\xce\x4f\xe3\xee\x66\x35\x91\xdf\x5c\xd7\x1b\xe6\x12\x02\xc0\x0b\xab\x6a\xe0\xc5\x26\x68\x0c\x31\xa8\x57\xcd\x4a\xb5\xbc\xd1\x77\x3e\x10\x2a\x66\x45\x0d\x86\x5a\xf7\xb2\x8f\x53\xc2\xe8\x6d\xa2\x48\xac\xb6\x3e\x38\x26\xab\x53\xa3\x3c\x1c\x8c\xed\xfb\x79\x98\xf8\xb7\x8a\xa9\x6d\xa4\x8c\x3f\x1f\x43\x2a\x1e\x65\x46\x1c\x7f\x51\x98\x94\xda\x51\x15\x76\x3c\xd5\xde\x6e\xc7\xa7\x3a\xaf\xb4\x02\xf7\x04\x46\x45\x05\x0f\xab\x16\x4d\x39\x2b\xbe\xc0\x4a\xe2\xbb\xb8\x96\xfa\xed\x29\x2d\xc9\x2a\x3d\xb6\x4e\xce\x9e\x5c\x96\x62\x13\x7a\x3e\xae\xb4\x41\xc9\x3b\x16\xd9\xaf\x23\x29\xbd\xfa\x2a\x18\xe8\xee\x7d\x54\xb5\xd8\x83\xfd\xf0\xf3\xc8\xda\x97\xa8\xd4\x22\xac\x43\x77\xb5\xe1\xe8\xec\x31\xcc\x5a\xcd\xdd\x70\xf3\x33\xf1\xcc\xe8\x11\x36\xc7\x4e\xb5\x8d\x83\x89\x45\x3e\x9c\xe7\x6b\x83\xe9\x98\xfa\x24\xa3\xcb\x7b\xfa\x1a\x9c\x66\x74\x9e\x5b\x8d\xbf\x94\xc2\xcb\x33\x76\xea\x43\x16\xa9\x5c\x35\xbb\xfd\xa5\x76\x6a\xb4\x41\x52\xfc\x1d\xd7\xa0\x36\xd4\x53\x04\x64\x06\xc2\x8f\x4e\x0e\x4f\xfd\x45\xd7\xb1\xad\xfc\x1b\x89\x30\x88\x3d\x2c\xcd\xee\xc7\x34\x29\x02\x62\x7c\xee\xe9\xeb\xee\xc4\x7b\xf5\x75\x62\x0f\x3b\xea\xab\x91\x89\x12\x90\x44\xf0\xa9\x24\x3e\x8d\x45\xb7\xc6\x97\x88\x2d\xd9\xc4\x63\xfe\x41\x31\x68\x21\xaf\x01\x86\xde\x86\x6f\x5d\x1f\x87\xc6\x33\x51\xa5\xd7\x70\xae\x0e\xcf\x54\xb0\xc6\x49\x31\x87\xb2\xf5\xa3\xe0\x2a\x96\x87\xf1\x3d\x18\x14\x40\x8c\xe5\x6e\x7b\x78\xfe\x82\x95\x3d\xaa\x2c\xce\x33\x7c\xfe\xd1\x2e\xc3\x58\x70\x15\xb3\x11\x7a\xa0\x35\x49\xc1\x8e\x2a\xb6\x14\xfd\x49\xd8\x5b\x13\xc3\xfe\x66\xa2\x0e\x34\xc2\xde\x42\x5e\x3b\x08\x08\x2a\xf8\x57\x7d\xd5\xbf\x14\x01\xfb\x90\x65\xbd\x99\x9b\x55\x51\x11\x5c\x6c\xa6\x35\x4a\x1e\xcc\x7e\x36\xba\x72\xa7\x69\x46\x4e\x77\x18\x40\x88\x1f\xbd\xd2\xbb\x27\x9e\x67\x54\x0a\x13\x9e\xd8\x54\x00\xb2\x3e\xd2\xa2\x35\xed\x04\xc5\xf9\x52\x1c\xcc\x1e\x10\x26\x8c\xed\x15\x14\xd8\xed\x82\x25\xae\x29\xc7\xf9\xce\xef\xa9\xc6\x6f\x13\xee\x28\x6c\x67\x01\x39\x31\x03\xb1\xd7\xb9\x88\x62\xe3\x4c\x0b\x83\x3c\x92\xe3\xd9\xa9\xb1\xcf\x87\x3c\x71\x46\x0c\x51\x30\x86\xcb\x50\xe5\x8a\x6d\x59\x6c\xaf\xb2\xb3\x71\xee\x19\x89\xeb\x5e\x83\xf7\xa5\xe8\xa5\x54\xab\xda\x95\x27\x0b\x7f\x6d\xb5\x41\x55\xdf\x2c\x64\x3b\x64\xd2\x16\xf1\x26\x86\x99\x3b\x3d\x51\x53\x3a\x6f\x0c\xf2\xfc\xa5\x19\x88\xcf\x1f\x28\x3c\x36\x71\x48\x99\xa6\xfb\xde\xa4\xd6\x1b\x94\x01\x15\x49\x1e\x20\x10\xae\x0a\x1a\x40\xa8\x3f\xe8\xb9\xd7\xc6\x8f\xc5\xc6\xa9\xf7\x62\xab\xb2\xc9\xea\xa0\x4f\x96\x96\x10\x13\xee\xa0\xbf\xb2\x44\x25\x29\xf9\xda\x13\x9b\xc2\xf6\x57\xd8\xc2\x78\xd5\xa4\xd7\xbd\x56\xa2\x5f\xfb\xf9\x7c\x5a\xb4\x79\x22\x43\x10\x77\xa6\x02\x22\xe0\xbf\x63\x11\xa7\x0a\xd9\xbb\x3d\xd2\x09\x64\xe7\xd0\xae\xee\x6d\x97\x95\x7a\x59\x8f\xd7\x23\xe1\xb6\xf8\xfe\x21\x17\x4a\x43\x2e\x32\xa2\xb2\x90\x6d\xb1\xed\xcc\xd3\x72\x98\x43\x5e\xc3\x4f\x1b\xac\xda\xde\xbd\xa6\x3b\xa5\xa7\x8e\x0a\x0a\x64\xe6\x12\x5c\x44\xaa\xcb\xd4\xd6\x87\x70\x0e\x5f\x2c\x9b\x9a\x17\x56\x49\x50\x3d\x51\x2e\x21\xd5\x54\x74\xd5\xed\x0c\x7e\x4c\x2e\x8e\xe3\xbb\xd3\x89\xa3\x8f\x25\xc3\xdf\xb7\xb8\x6d\xcd\x47\x77\xe9\xfc\xd3\x7a\x4d\xec\x12\x00\x9c\x4c\x7d\x08\x94\x38\x8e\x9d\xe0\xf1\x24\xbd\x73\xd8\x22\x88\x81\x12\x0a\xda\xc5\xa7\x48\x2a\x10\x7b\x25\x7e\x9a\xde\xab\x43\x14\xc5\x37\xad\xd1\x59\x3b\x1f\xbf\x6c\x5e\x27\xa1\x17\x0c\x53\xab\x34\x7e\x8f\x09\x9f\x1d\x3d\x80\xae\x42\x21\x19\xb1\xbd\x66\x6f\x16\xb2\x60\x93\xc1\x7e\x23\x50\x59\xc9\x62\x97\x05\xbb\x12\x6f\xa7\x89\x40\x37\x89\x8f\x67\x6f\xf0\xc7\x21\xe2\xaf\xec\xde\x4a\x95\x9b\x84\x46\x85\x35\x61\x76\x3f\x7b\x7c\x3e\xed\x93\xdc\x74\xd7\xdc\x0c\x31\xd4\x4e\xa6\x86\x52\x40\x0f\xd7\x85\x76\x87\x8a\x0b\x99\x14\xdc\x61\xf5\x77\x86\xfc\x56\x64\x8b\x10\x23\xfd\x51\x7e\x6d\xd2\x3b\x17\x0a\x2f\xa8\xfd\x65\xf7\xb4\x0e\xe9\xe8\x9d\x72\x73\x2e\xc3\xe8\xb0\x97\x55\x80\x23\x3d\x0d\x48\xa1\x02\xb1\x93\x53\x8c\xef\xfd\x13\xad\xac\xe6\x1e\x15\xd9\xdf\x7d\x46\x72\x6e\xf8\x58\xd9\xdf\xf2\xee\x9c\x39\x20\xc2\x86\x4d\x81\x13\x31\xae\x4a\xf2\x94\xd4\xcd\x22\x0b\x60\xa0\x83\xb8\x72\xfe\x77\xb3\xf1\x53\xf6\x68\x1e\x02\x14\x85\xb0\x47\xa4\x04\xd3\xe8\xd0\x34\xc2\xee\x58\xc0\xda\x5d\x77\x9c\x61\x50\x01\xda\x5c\xc9\x11\xf1\x4e\x29\x46\x18\x6f\xb8\x72\x28\x30\xdd\x9c\x8a\xe2\x9d\xf0\x53\x0f\x61\xe3\x41\xf5\x38\xd8\x38\xe7\x17\x69\xb1\x82\x0d\x7c\x03\x32\xb9\x87\xea\x86\x0e\xbf\x04\x78\xa5\x11\x56\x1e\x52\xed\x81\xf5\x28\xab\xe3\xa8\xd9\x6a\x04\x8f\x1c\x4b\x55\xb6\x99\xe8\xa2\x1f\x35\x40\x6e\xaf\x7c\x47\x74\x83\x22\x07\xca\x1b\xe4\x68\x24\x4f\x0a\x07\xed\xf7\xa0\x8d\x70\xf5\x3f\xc5\x1a\x18\x3c\x6e\x02\x35\x47\x96\xd2\xf1\x2e\x47\xfb\xad\x53\x22\x95\x3e\x34\xf1\x57\xb3\x75\xba\xe2\xa2\x59\x69\x8f\x64\x6a\xda\xdf\xbc\x08\x20\x5c\xb7\x19\xae\x7a\xcc\x99\xf6\xa4\xa3\x7d\xbb\x0b\x22\x70\xea\xb4\xcf\x46\xa5\x69\x9a\xe3\x57\x47\x6a\x19\x23\x8a\xa9\x4a\x95\xe7\xbb\xc9\x13\x5f\x7d\x44\x55\x68\xed\x62\x46\xb5\x0d\xea\x3e\xf8\x1e\xd0\xa5\x03\x89\x79\x44\x4e\x3e\xc9\xba\x4e\x1a\x2c\x03\x18\xa1\x1a\x9c\x03\xa9\x4d\x2f\x9c\x9b\xfb\xfd\x5b\xed\xa9\x56\x6b\x4c\xd1\x79\xcd\xc5\x02\x5c\x32\xb3\xb7\x4b\xea\xe8\x5b\xed\x36\x38\x0a\xa6\xbd\x3b\x07\xfc\xa2\xa6\xa4\x9f\x39\x7e\x83\xeb\xb4\x0c\x6c\xea\x82\x72\x5a\xc9\x5b\x6d\x72\x5d\xfd\x39\xdd\x41\x54\x48\xd8\x75\x68\xee\xc2\xef\xba\xc6\x40\x68\xd0\xa1\x8f\x8e\x2f\xa5\x01\x34\xfe\xbe\x32\x3d\x4e\x64\xc4\xac\x04\x1e\x7e\x14\x9a\xac\xf9\x5d\x14\xf5\x3e\xb5\xc9\x5a\xdc\x87\x33\xac\xcb\xbe\x9d\x22\x2e\x84\x4f\x89\x7f\xcd\xe5\xa8\x24\x5c\x35\x8f\xe4\x28\x20\x2a\xa7\x6a\xcd\xfd\x40\xdf\x98\xa3\x76\xf7\x69\xac\xa0\xe1\x7d\x08\x8e\xdf\x1e\xd5\x93\x83\xd5\x3b\x1f\x1b\xf6\x77\x52\x7a\x2f\x4d\x72\x05\x34\x89\x08\x3f\xcf\x67\x3e\x06\xc7\xcc\x74\x22\x34\xed\x03\xe1\xed\x23\xd9\x43\x32\x4a\xfc\x58\x77\x4c\x54\x87\x96\x6b\x75\x02\x67\xce\x38\x8d\x5c\xac\x5b\x9a\x52\xd7\x35\x80\xb3\x48\x53\x2a\xc6\x30\x4f\x28\x30\xc4\x93\xcd\x83\x39\x34\xf6\xce\x3f\xb6\x72\x60\x48\x41\xc9\xae\x41\x6f\x4e\x60\x4a\x91\x78\x6a\x64\x5e\x00\xea\x95\x43\xf4\x1c\xb0\xd7\x59\x59\x1a\x35\xf4\x2c\x56\xb2\x9a\xa0\x3c\xf4\xb6\x69\x34\x77\x25\xb7\x74\xbc\x9c\x33\xe4\x7d\x9c\x6e\xcf\xfb\xd6\x86\x53\x57\xd1\x2d\xad\xb1\xba\x7a\xb0\x86\xa3\xd4\x8f\xf2\xbb\x42\x17\xce\xeb\x31\x6d\x7a\xf2\xbb\x1f\x35\x46\xe5\x78\xea\xc6\x78\x7b\x33\x2b\x31\xb4\x4b\x73\x69\x41\x67\x96\xae\xd3\x9c\xed\x17\x94\xe8\x6a\xe6\x12\x46\x75\x53\x62\x1a\xb5\xd3\x76\x45\xf8\xf4\x3a\x0d\x7f\x29\x8c\xcb\x12\x38\x43\x1d\x96\x8c\xf0\x6b\xfa\x74\x4b\x57\x5d\x12\xc3\x50\x13\xa2\x0e\xd3\x36\x19\xca\xa4\x3d\x13\xac\xef\x0d\x43\xb6\xd5\xba\x98\xaa\x5b\x2f\xa1\x7b\x25\xff\x38\x24\x58\xdf\x77\x56\x58\xd8\x93\xcf\xfd\xdf\x90\x18\x86\x91\x3b\x60\xd3\xcb\xa6\x35\x68\x5b\x41\x47\xa3\x0e\x98\x0f\x96\x86\x2a\x38\x4f\xcc\x16\xa3\x56\xb2\xce\xee\x8e\xc6\x1a\x87\x7f\xa9\x42\x7d\x63\x63\xf7\xfa\x15\x65\x82\x23\x52\x80\xd2\xa6\xa1\x27\xc7\x2a\x38\xab\x2e\x7b\x26\x53\x9c\xbe\xec\xc2\xe5\xd0\xfb\xbe\xf8\x61\xa6\xce\xf7\x35\x0e\xd3\xba\xf8\xa2\x9c\x87\x35\x6a\x70\x60\xdc\xb6\x4e\x3c\x63\xb2\x8e\x7d\xad\x6f\xae\xf7\x5f\x12\x17\xaf\x3f\x8b\xcb\x9d\x60\xba\xa6\x56\x1a\xea\xd6\xb1\x2f\x2c\xae\xaa\x4a\xd3\x26\x1c\xb8\x7c\x5a\xd5\x5b\x19\xc1\xed\xc7\x6f\x92\x61\x76\xeb\x44\x45\x28\x4c\xc2\x4a\xea\x6b\xb2\x8a\x63\xca\x4f\x4d\xc1\xcf\x76\x59\x61\x95\x2f\xf9\x17\x69\x68\xde\x3b\x70\x1a\xa3\x5a\x4d\x72\x60\x59\x54\x40\xd8\x6b\x6e\x8b\xfe\x39\x2f\x12\x50\xc4\x3c\x91\x45\x97\xc9\x5d\xdb\x2d\x9e\x80\x2f\x32\xf6\xbe\x41\x7e\x51\x52\x40\xfa\x0a\x98\xd1\xb5\x0a\x07\x9b\xd1\x48\xd8\x44\x41\xd3\xd3\x95\xa7\x05\x97\x0b\xfb\xb1\x1a\x46\x02\x03\xe4\xcf\xa4\x1f\x23\x6c\xdb\x00\x41\x7d\xed\x8a\xa2\xb0\x56\x3d\xf9\x0e\xea\xea\xfc\x9e\xb3\xd5\x83\x87\xcd\x35\x69\x45\xb8\x42\x77\xea\xf8\x1e\x5e\x34\x6f\x75\xaf\x21\xe4\x15\x01\xcd\x9a\x5e\x84\x15\x60\x2d\x54\x1d\x97\x87\x0d\xda\xe4\xbe\x52\x1f\xd4\xe3\xa0\xb4\x91\x1e\xf6\xbc\x1c\xe7\x3c\x54\x93\xd0\x9a\xc0\xee\xa7\x6f\x06\x23\xd3\xbd\x0e\x5b\xa9\xe0\x74\xcc\x97\x5c\xc8\x0d\x73\x3b\xec\xe7\x41\xdc\xfb\x33\xe0\x99\x95\xd1\x69\xa4\x5d\xd5\x8a\x0e\x3f\x60\xd4\x5e\xab\xcd\x99\x1e\x4a\x60\xd3\xc5\x98\xd0\x4d\x25\x86\xcf\x90\xe9\xbf\x28\x3b\xa7\xfa\x87\x12\x16\xdc\x7f\x35\x1b\xcb\xa0\x16\xa4\x66\x2d\xba\x61\x29\xe9\x4b\xfb\x1d\x93\xc0\x3c\x64\x2c\xce\xd5\xe0\x0a\x60\x12\xb1\xf7\x50\x79\x6c\xc5\x87\x8f\x8f\xee\xfd\xaf\x7e\xc7\x80\xd0\x81\xea\x77\xdf\x3d\x7b\x74\x3e\xae\x73\xac\xd4\xb8\x24\x3a\xab\x54\x51\x7e\x95\xa6\xd8\x2e\xeb\x18\x65\x8d\x71\x82\xe9\xc4\x39\xa7\x41\x83\xed\x83\xc3\x78\xd3\xe9\xab\x4e\x4f\x70\xf0\x59\xfa\xfb\xea\xe2\x0b\x7a\xc8\x43\xa6\xfa\xac\x21\x23\xaa\x3e\x8c\xa5\x11\x06\x6f\x97\x4c\xf5\x27\x8d\xd3\xa8\xcc\xbd\x97\x0e\x30\xf2\x8a\xfa\x01\x71\x58\x70\x36\x9f\x52\xd1\x98\x00\xfe\xca\xc2\x1e\x27\x45\x15\xd2\xa2\xc3\xbb\xd3\x06\x12\x63\xe4\xd6\x7c\x5e\xd4\x95\x95\x81\x0f\x96\xec\xd7\x30\xdb\x05\xa6\xf1\xc9\x08\xbd\x60\xc6\x5e\x9b\x54\x9d\x38\xe7\x92\xc1\x72\x7e\xb2\x69\x29\x50\x80\x4d\x51\x31\xc4\x1e\x14\x34\xba\x74\xef\x94\xb0\x2a\x6f\xa4\xb7\xac\xc6\xc0\x0b\x89\xb2\x99\x70\x1c\x96\xbc\xaa\x6f\xc3\xce\x1f\xab\x9f\x6b\xfc\xb3\xac\x13\x4d\x80\xca\x14\xcb\x1d\xc7\xd6\xa6\x3a\x5b\xaf\x20\x0d\x2c\xbd\x3f\xe2\x3f\x50\xd9\xef\xef\x77\xd2\xf6\x70\xf6\x49\x4e\xd4\x30\x74\x24\x79\xa7\x35\xd8\x47\xbc\xdf\xb6\xaa\x1d\x50\x70\x3b\x42\x69\xcd\x31\x0c\x99\x32\xff\x5f\xb7\x2f\xe1\x49\xfe\x94\x03\x99\x72\xad\xf2\xfd\xfd\x8c\x90\xa4\x08\x34\x4e\x16\xd3\x41\x6b\x8c\x29\x1e\x55\xc1\x67\x12\xcf\x32\x8c\x51\xa4\xf3\x9e\xfd\xb6\xe6\x3e\x63\x32\x45\x46\xf5\xc0\x85\x1e\x6d\xb5\x75\x16\xc4\x31\xc1\x58\xe9\xc5\xf4\xc0\xd0\x93\x57\x61\xea\x86\x79\x25\x28\x91\xe4\xeb\xe2\x71\x4f\x1d\x04\x76\x79\x3a\xed\x0f\xcf\x19\x34\xeb\x98\xfb\xb4\x39\xc7\xa6\xc3\x7f\x5e\x71\x51\xf3\x3a\x9a\xac\x73\x4a\x1b\xac\x2a\xe2\xe1\xc0\xbd\x56\x48\x8b\xc4\x57\x45\x37\x5d\xf2\x3a\xd3\x01\x98\xbf\x66\xf8\x0e\x26\x23\xe3\x43\xe9\xe2\xef\x7d\x7a\x67\x0b\x79\xb5\x19\x1a\x28\x59\x16\x67\x15\x33\xe3\xe3\xd9\x0c\xf8\xdc\xa6\x88\x88\xf6\x35\xd9\xac\x53\x54\x03\x8e\x06\x39\xbe\x30\x3a\x17\xe6\xc0\x3d\xe0\x0c\xb8\x48\xf0\xa2\x8c\x8b\xc1\x4e\x36\xf0\xb0\x1a\xad\x7f\xd7\x58\x1f\xe0\xa0\x56\x45\x63\x54\xcf\xdd\x57\xff\x9f\x99\xff\x88\x22\xab\x9d\xf3\xd5\x46\x4a\xc6\x28\xca\x42\x20\x80\xce\xd0\xca\xbd\x08\x50\x83\x15\x49\xfb\xa1\x70\x5e\x5c\x1f\x0a\x4b\x74\xf7\x08\x13\xc7\x83\x57\x88\x45\xb3\x3c\xe6\x28\xe8\xbc\x9e\x32\xa7\x3c\xec\x27\x03\xbf\x1b\x39\xad\x4c\x55\x1d\x4a\x87\xa9\x64\x30\x06\x64\x0f\x88\x83\xa2\xdd\x62\x32\x3b\x67\xc1\xb9\xfa\x2d\x56\xeb\x1b\x3c\xf7\x06\xeb\x58\x43\x5c\x95\xce\xc7\x45\x1b\xd8\x66\x6f\x07\xab\x8f\xd2\x8a\xb9\x5b\xd1\x48\x7a\x08\x56\x6d\xab\x55\x17\xb4\xb9\xfd\x24\x03\xb6\x74\x65\x9a\x0e\xdb\x86\x53\x60\x6a\xfa\xd2\xe5\x46\x34\x07\x25\xf4\xcc\xa0\x52\x38\x81\x96\xbc\x57\xc1\x9b\x42\x52\x08\xfb\x95\xc4\x93\xe1\x52\x15\xa2\x55\x2d\xfb\x3b\x8e\x5d\xa9\x8a\x4f\x5b\x3f\xec\xc8\x66\x4d\xce\x2a\xae\xe7\x42\x87\x3c\xf5\xad\x26\xeb\x77\xaf\x6b\x14\x64\xcf\xd6\xb9\xec\xfd\xbb\xd5\x39\xd3\xfd\x03\x56\xa0\x65\xa0\xfd\xa3\xdf\x9b\x21\xda\x43\x74\xd7\x53\xa5\x4d\x0a\x2a\x2a\x6e\xe0\xe2\x75\xf9\x26\x77\x02\x43\xa9\x78\xe4\x2d\xea\xfd\x55\xd1\xc5\xa8\x59\xa3\x89\x4e\xf3\x81\xec\x9e\x4f\xe0\x22\xec\x25\x30\x94\x09\xe1\xff\xa7\xeb\xcd\x92\x64\xd7\x91\x2c\xc1\xff\x5a\x45\x4a\xa4\x48\x7d\x05\x3d\xe3\x65\xbc\xce\x8e\xfa\x6c\xe9\x25\x54\x6f\x40\x09\x2a\x49\xb8\x61\xe0\xc5\x60\xe6\x76\x53\x6a\xef\x2d\x04\x38\x40\x0f\xec\x7d\x5e\xbf\xee\x66\x1c\x00\x85\x0e\x67\xe0\x17\xd8\x90\xa4\xa7\x20\xb3\xfc\xfe\xcd\x08\x1f\x90\xe9\xc1\x2a\x79\x47\x51\x85\x9c\xb4\x3b\x38\x02\x77\x3b\xcf\x71\x18\x3e\x38\xcc\x14\xb5\x65\x39\x57\xcf\x7b\xa6\x24\x17\xfe\x1e\x01\x3e\x74\x7d\x54\x4e\x43\xef\x3b\x5d\xaa\xe6\xa1\x42\x04\x81\x15\x59\x41\xc1\x72\x7e\x38\xf2\x34\x57\xb5\x84\x3f\xe4\xf7\xa1\x18\xd1\xe1\x2f\x47\xa3\xc6\xc4\x22\xfa\xce\xbb\xe3\x72\x13\xc3\x97\x9b\xa5\x94\x10\xbb\x8a\x72\xbb\x7d\x6d\xf5\x37\x56\xbf\xd8\x71\xda\xa8\x4a\x81\xdf\x2d\x1b\x9b\x05\x6e\x30\xf0\xa1\xe9\x0c\x09\x45\xe2\x1f\xcc\x00\x0b\x69\x79\x38\xb2\x3a\x91\x9d\xef\xb9\xa7\xd4\xf6\x17\x79\xe1\xc8\x8e\x67\x2d\xf5\x5f\x57\x36\x1b\x04\x16\xe3\x15\xda\x52\x57\x66\x95\xc0\xaf\x7c\x70\x4f\x75\x94\xbc\x15\x6d\x89\x32\xb9\x9e\xa1\xee\xfe\x16\xd0\xad\xca\xd1\x05\xc1\x20\x6d\x94\x6f\x43\x19\x5b\x5b\x05\x18\x6f\xcb\x1b\x09\x11\xd5\x6e\xd9\x77\x25\xf6\x49\xa6\x32\x4e\x45\x1c\x91\xdd\xc2\xa1\xdd\x7a\xe7\x2e\x27\xf3\xe7\xce\x2d\x72\x3d\x7e\xae\x66\x6e\xd4\x3f\x15\x6d\xfd\xaf\xe6\x40\xfd\x95\x25\x3f\xc9\xf1\x6b\x50\xb8\x06\xf7\xa2\x58\x9e\x4e\x14\x39\x7c\x52\x81\x59\xa9\x9b\x40\x1d\x6c\x13\x78\xd4\x6f\x9d\x7e\xaf\xd4\x8a\xab\x5c\xcd\xa9\x9b\x82\x61\xd4\x1b\x5c\xc2\xb2\x7c\x13\x87\xc5\xd9\x70\xce\x17\xef\x4a\xc4\x6f\x22\x6f\x4b\x25\x94\xa2\x8e\x26\x45\xe1\xef\x1c\xa9\xce\xa2\xef\xd1\x51\x55\x34\xf2\xc1\x76\xcb\xfa\x79\x5a\x6a\x5d\x97\xa6\xd5\x21\x5f\x7b\x73\xf3\x03\x17\x69\x6f\x38\xd8\x3f\xf0\x3d\x6a\x4b\xad\x74\xd4\x80\xaa\xf4\xd4\xbe\xd7\x70\x48\xde\x5c\x23\x9d\x1b\x2b\x5d\x21\x56\x52\x19\x69\x7f\x86\x52\x99\xa2\xc6\x93\xce\xd3\x70\x24\x53\x31\x3d\xff\x6c\xf1\x99\x50\x72\xdd\xed\xdc\x4f\xa9\x99\xee\x1b\x20\x71\xf3\x0f\x72\x0c\x2c\xdb\x1f\x6d\xbb\xa0\x6c\x4f\xdc\x98\x40\xdf\x1a\x33\xc4\x0c\xca\xc5\x13\xbb\xe7\x5e\x8b\x00\xdb\xae\xd0\x1f\x41\xe4\xe0\xa0\x81\x23\x8a\x6e\xdf\x68\xb3\x46\x86\xa7\x4e\x09\xd0\x04\x13\x6f\x41\x3f\xd1\x7c\x27\x07\xc0\xb6\x75\xd6\x81\x13\x25\x1a\xa1\x3d\x32\x86\xc3\x36\xfe\x3e\x9d\x2c\x8e\x8b\xac\x8e\x06\x95\xd9\x8a\x4a\x54\x8b\xf7\xd2\x25\xbf\xc3\x13\xe8\x96\xf6\x96\x18\xe2\x05\xa8\xf6\xc9\xfb\x36\x02\x9c\x6e\x17\x50\x4b\xb9\x6f\xb2\x00\x01\xad\xd4\x51\x48\xa2\x69\x03\xe1\x21\x1a\x5b\xcd\xd8\xe9\xe0\x3a\xde\x23\x55\x27\x7a\x8b\xb3\x0f\xb0\xdb\x74\xb6\x1b\x48\x66\x1a\x34\xdc\x89\x9b\x12\xc9\xc2\xc4\x81\xcc\x50\x71\x31\xd0\x40\xca\xe1\xc9\x6f\x8f\xf6\xf5\xfc\x19\x38\xf4\xa0\x16\x82\x33\x52\x00\xa5\x79\x43\x2a\x78\x2d\x1a\x69\x96\x25\x4e\xeb\xb4\x78\x87\x99\x2e\x1c\x47\xd9\x95\xa9\xbf\xda\x13\xc0\x98\x3e\x60\x01\xe9\x75\x88\x16\xde\x5e\xeb\xac\x63\x0e\xdc\x69\x62\xee\xf9\x5c\x60\xd4\x60\x29\x6f\xb5\x57\x24\x8d\x91\x43\xc2\x53\x90\xab\x88\xb0\xf8\xb6\x95\x8f\x12\xbe\xd9\xb3\xfd\xf8\x63\xfa\x00\xca\x49\x41\x8f\xd9\x81\x7e\x08\x2b\x68\x1d\xed\x39\x21\x23\x8e\xfa\x54\x9d\xb9\xf1\xa8\x35\xe9\x41\x9f\xfe\xc2\x83\x38\xba\x7c\x57\xed\xb2\x97\x5b\xc3\x56\xa0\x34\x32\xfd\x2a\x9d\x67\xd8\xd6\xca\x57\x41\x26\xf9\x96\xce\x19\xe7\xdd\x63\xf3\xc9\x6f\x90\xe6\x89\x64\x7a\x0b\x7e\xcf\x04\x09\x8e\x05\x51\xc0\x7c\xe7\x98\xba\x02\xbe\x68\x33\x6e\xc5\x3a\x12\x41\x24\xc6\x68\x70\x4a\x4e\x3e\x04\x0d\x98\x07\x9c\xc8\x6c\x1e\x67\xc3\x65\x2e\xce\x5d\x63\x62\x0b\x7e\x09\x64\x2d\x06\xf6\x53\xd8\xd7\x03\xf0\xa5\x45\xd9\xdf\xd4\x0c\x65\x4e\x72\xc6\x95\x5b\xbc\x98\x1f\x43\x0b\x39\x8b\x7a\xc1\x43\x6e\x64\x03\x1b\x34\x6f\xff\xd3\x88\x06\x49\x57\xf3\x97\xcd\x03\xeb\x78\x13\x73\x97\xab\x1f\x14\x07\x23\x21\xf5\xa4\xab\x19\xf0\xb5\x5d\xfd\x68\xf4\x82\x37\x19\xb3\x13\x13\x8c\x6e\xfa\xbf\x67\xd5\x91\xc3\x93\xbb\x36\xc3\x53\x2f\x3e\x74\xba\xf0\x7a\x2f\xb1\xe0\xcc\x39\xea\xf8\x7b\x06\x3d\xee\x7b\xff\x28\x01\xae\x0b\xd6\x1e\xdc\xa0\x85\x71\xc9\x9f\x77\xc2\x59\xe4\xf9\x82\x10\x97\xf2\x17\xb6\xff\x7a\x9a\x97\xab\xf0\xf5\x02\xbc\x77\xf2\x1d\x19\x96\x84\xc1\xd1\x54\x1b\xab\x1b\x4a\x5c\x46\xa5\x37\xe4\x45\x25\x3d\x77\xc6\x47\xd8\x2c\xf4\x21\x39\x31\x82\x2d\x6a\x58\x98\xd9\x28\xe3\x13\xb0\x0d\xbf\x59\x52\x4b\xc2\x97\xfe\x12\x8d\x8b\x6f\xaf\xc5\x58\x70\x2c\xc7\x03\xae\x19\x69\x0d\xa7\xed\xe6\x43\xea\xa4\xf4\x63\x76\xee\xfd\x24\x10\xb0\x78\xbb\x49\x64\x4b\xd6\xbb\x0a\x09\xbd\x2d\x22\xfa\x1b\x7e\xce\x4a\x78\xd6\xb8\xc3\xf3\xe1\xf6\xfe\x82\x41\x8e\xf2\xae\x28\x46\xcb\xd7\x43\xea\x57\xd6\xb1\x43\x7c\x66\x77\xc0\xe4\x3b\x78\x94\x97\x29\xdc\xfa\xde\xca\x13\x85\x67\xba\x97\xb6\x24\x01\xe2\x0f\xc9\x6b\xce\x61\x41\x0e\x0e\xdf\x6c\xed\xbb\xc1\xb8\x67\xd9\x71\x30\x34\x04\x96\xfe\x2e\x2e\x27\xc9\xe4\x9f\xcd\x87\x20\xb3\x90\xf1\x7e\x94\x59\x45\x21\xe2\x74\x5c\xbe\x7d\xb1\xc8\x21\x7f\xe8\x12\xb6\xcb\xdf\x0b\x5f\x06\x29\xa5\x1d\x0a\xc4\x1b\x04\xdd\xc6\xf7\x5e\x91\x05\x04\x2c\x8c\xac\x1e\xbe\xa7\x29\xb8\xd3\x5c\xe5\xcf\xe6\xc0\xd9\x0c\x29\xfe\x28\x85\xc9\xee\x88\x32\x7f\xfc\xe3\xee\xc5\xc7\x8d\x2a\x32\xe4\xde\x5c\xfb\x4e\xc9\xee\xd2\x50\xbb\xba\x4c\x39\x75\x6c\x0c\x37\x41\xfa\x55\xd8\x10\x8d\xaa\x58\x7b\x3c\x20\xf7\xb3\x08\xeb\x77\x3d\xca\x3b\x69\x87\x88\x9b\x3b\x9e\xd3\x74\xe7\x4c\xe2\x1a\xac\xf4\x22\x1d\xd1\x86\xfe\x30\xd4\x59\xce\x27\x74\x55\xab\x3e\xf1\xb0\x70\x02\x38\x42\x60\xc5\x47\xc2\x7d\x17\xb6\x4e\x6d\xc2\xaf\x7e\xdb\x70\x28\x90\xb4\x48\x2b\xb3\x7b\xf5\xe4\x87\xa4\x93\xc1\x4c\x22\x26\x6f\x45\x43\xb0\xa8\x13\x4f\x5d\x5a\x90\x3a\x85\x0e\x6f\xb5\x03\xd9\x8b\x07\x27\xb5\x66\x21\x16\x54\xe4\xb4\x97\x04\xae\xb9\x41\x28\x03\x17\x75\x12\x71\xe8\xee\x99\xdb\x87\xd8\x98\xdd\x7e\xeb\xba\x6b\x75\xd7\xa4\x07\x73\xc8\x89\x8d\x7e\x72\x80\xbc\x72\xc3\x52\x88\xed\xbe\x8c\x94\x38\x37\x28\x4f\x41\x6c\x66\xab\xe7\xd9\x88\x72\x20\xbd\x3e\x30\xb9\x1f\x69\x0d\x3e\x2f\x6b\xc4\x54\x94\x42\x27\x53\x1e\xb5\x11\x22\xc5\x53\x90\xd0\xe6\x0a\xa5\x81\x25\x38\x8b\x6b\x8a\xf0\xdc\x62\x49\xf6\x45\x6e\x1b\xaa\xdc\xe3\x7d\xb7\x78\x28\x1f\x27\xeb\x5d\x81\x6c\xc1\xaf\xba\x97\xb3\x2a\x4a\xee\x1d\xa5\xf6\x75\xa7\xce\xf7\x85\x57\xae\x23\xa4\xbb\x87\x7b\xd4\xd5\x3b\xe3\x97\x0c\xc0\xee\xa4\x11\x36\xb6\x4a\x4c\x0f\x41\x08\xac\xa6\xef\x9d\xce\x84\x5a\xf5\x42\xae\xab\x86\x69\xde\x93\x0b\x04\x14\xed\xbf\xdd\xf6\x6b\xcb\xc8\x72\x8f\xac\x08\x35\x9e\x35\x52\xc8\xaa\x8f\x07\x54\xa7\x14\x4e\x3f\xbd\xbb\x6c\x32\xd0\x7a\xd3\x96\x64\x62\xad\xbc\xb5\x5e\x82\x7b\x82\x26\xb7\xc0\x64\xac\x9a\xae\x5c\x34\x74\x63\xbe\xba\x9b\xdc\x8a\xbd\x1b\xc1\x70\x67\xee\xdc\xfd\x4f\x2f\x1d\xf1\x52\x72\x48\x68\xb5\xbf\x92\x4b\xef\x04\xd2\x0c\xd1\x68\x4c\x4a\x9f\xec\xf8\x77\x66\x31\x07\x52\xde\xf2\x74\x58\x05\x5f\x90\x20\xed\x1e\x46\x36\xe5\xb2\x54\x0e\x28\x27\xd0\x43\x24\x34\x29\xbd\x87\xef\x76\x04\x59\x10\x1e\x5d\xca\xcf\x14\x93\x74\xf3\xd8\x03\xf4\x86\x12\x9d\x2a\xf0\x94\x0d\x84\xaa\xcd\x30\x0d\x23\x85\x85\x7a\xa7\x3b\xbd\xc8\x62\x63\x36\xf4\x94\x09\xf3\xc3\x87\x37\x2d\x72\x06\x42\x76\xfc\x00\x13\x55\xde\x8e\xb9\x03\x8a\x9f\xa4\x07\x89\x31\xf0\xdb\x5a\x8b\x61\xfc\xf5\x09\x30\xe0\x86\xf5\xb2\xca\x3c\xf9\xf0\xd1\x94\x5d\x19\x42\xa7\xb8\x4d\xcc\x2a\x8a\x1c\x03\x38\x86\xe6\xe0\x83\xe8\xfe\x92\x0e\xa3\x0f\xae\x47\x78\x8f\xa4\xda\xc9\xae\x62\x13\x85\x47\x6a\xa8\xba\x36\xf7\x5b\x8d\xe9\x34\x4e\xbb\xcf\x4a\xa9\xb9\xfd\x9f\xed\x5e\x37\xc5\xb8\x0a\x0f\xec\x5a\x4e\x16\xb5\x30\xec\xff\x6c\x9c\x82\x64\x5d\x3c\x29\xff\x88\x93\x38\x71\x78\x6a\x7e\x75\x3a\xbe\x55\x52\x9b\x04\x55\x6d\xa9\x50\xf3\x1b\xf5\x55\xdd\x82\xfe\xbc\x35\xc8\xdc\xb4\xd2\x93\xa5\xfb\xd1\x9e\x35\x08\x09\xef\x52\xe8\x46\xe8\x4e\xec\x87\x6c\x91\x6a\x40\x73\xb3\xb5\xdb\x68\x23\x21\x73\x20\xbb\xc5\x90\x2d\x55\x97\x5c\xb6\xca\x8b\xf1\x85\x3e\xd4\x90\xef\xa0\x53\x73\x5a\xd9\x3d\x31\xda\xd2\x0f\x44\x39\x1f\xa4\xdc\xe1\x46\xf1\x36\x9d\xbb\x37\xd6\x4c\x30\xd2\x29\x27\x9d\x4c\xd1\x2c\xa5\x98\x61\x4c\xb2\x51\xc7\x84\x5b\x3d\xd7\xb6\xc1\x9d\xd8\x67\xd7\x15\x9b\x6c\xda\xd0\xbd\xfa\x98\xba\x91\x90\x7f\xee\x2f\x80\x64\xd2\x6e\x29\x2a\x2f\xaf\xde\x7a\xc0\x88\x03\x64\xcf\xbb\xb9\x5a\xea\x76\x68\x16\xb5\xd2\x26\xf9\xf5\xd9\x1d\xd4\x8d\x7e\x40\x95\xbc\x7a\x6c\x38\xfa\xe1\x18\xa1\xc0\xf2\x18\x6f\x7e\xd8\x0d\x6b\x87\x31\x3e\x44\x87\xba\x83\x2e\x4f\x5a\x18\x49\xa6\xea\xfa\x74\x03\x35\x1f\xda\x09\xdd\xf3\x62\x7a\x2f\xaa\xde\xe2\xfa\x87\x38\x93\x89\x03\x92\xba\x56\x26\x93\xd6\xe1\x44\xa6\x60\x0f\x3f\x87\xda\x2f\x6a\x44\x9b\xbf\xe9\xd9\xbe\xb5\x89\x69\xc2\xe1\xd4\xea\x8d\x79\x1f\x88\xa4\xfb\x30\xd9\xb0\xac\x11\x4d\xcf\xaa\x9b\x80\x3a\x74\x7c\xb2\x63\xee\x13\xcd\x5b\x3e\xb3\x8f\x5b\xc0\x42\x27\xaa\x79\xc0\x1f\x4d\x81\x55\x45\x9a\x41\x4a\xaf\x20\xf3\x44\xf0\xd5\x0e\x13\xe4\xd5\x77\x5c\xa8\x42\xb6\xe8\x6a\x28\xf0\xcc\x29\x86\x90\xe2\xeb\xbc\x7a\x0c\xa3\x4f\x20\x4a\x3f\x16\x34\xe8\x1f\x98\xb2\xca\xd0\x2d\x93\x10\xed\x9e\xde\x64\x97\x80\x7c\x57\x95\xba\xcb\x58\xee\x26\xb9\xd3\x2f\xa1\x38\x50\x84\x3d\xb0\x56\x34\x0f\x79\x70\x28\xa1\xca\xb9\x79\x1d\x8b\x25\x81\x1c\x4d\xa9\xe0\x5f\xa2\x48\x3e\xcb\xbf\xdb\x19\x60\xd5\x20\xdf\x56\xb0\xe7\x5d\xa1\x3b\x15\x85\x5b\x99\xed\x1e\xb2\x2a\xe2\x89\x56\x17\x22\x6c\xc6\x18\x94\x40\x2b\xbe\x9a\x62\xd0\x46\xa9\xf4\x6d\xa1\x27\x7b\x0c\x5b\x45\x80\x99\xe7\xe1\x14\xf3\x6d\x92\x63\xcd\xe6\x18\xbc\x34\x87\xf2\x6c\xaa\x58\xf2\x5d\xc8\x91\xa2\x0e\x9e\x19\xd8\xf8\x0f\x06\x19\x64\x29\x71\x46\x8c\x91\x4c\x19\x66\xc3\x3f\x7a\x78\x4a\x85\xb6\x8d\xd2\xca\x28\x11\xe3\xdd\x62\xbc\xed\xc2\xff\x84\x66\x3d\x13\xcb\x69\xf4\x4b\x27\x39\x53\x35\xbc\xc8\xc6\x8e\xd1\xea\xd4\x32\xbd\x2b\xf5\xd9\xe4\x5e\x72\x70\xa3\xf8\x5b\xf2\xe1\xf7\x48\x86\x2b\x6d\x63\x0a\xed\xc0\xbb\xf2\x32\x8a\xfb\xf8\x7d\x91\x7b\x9d\x25\x03\x53\xa1\x69\xaf\x9d\x9d\xa7\xd1\x93\xa4\xb4\x05\xbf\x55\x3d\xbf\x3f\xdb\xc1\x4e\x87\xf8\x0f\x4b\xdb\xcf\x2a\xd4\x94\x28\x73\x29\x9f\xa3\x28\x11\x27\x5e\x02\x03\x77\x4f\x2f\x16\xcc\xfc\xfc\x63\xa8\x48\xc0\xa6\x05\x51\x68\xd8\x1d\x97\x2a\xcc\x35\xb7\xbd\x12\x09\x93\x5d\xe7\x30\xe9\x3b\x83\x97\xd9\x17\x46\x3c\xa8\x1c\x4d\x3e\xef\x29\x13\x0d\x56\x3b\x99\x36\xcc\x3a\xf0\x5c\xc6\xa6\xa8\xef\x51\x8d\x43\xb1\xb1\xeb\x1e\x45\x7a\x5b\xae\x4a\xb7\xe0\x54\x26\x8b\x36\x4f\x99\x7f\x94\x39\x06\x2a\xf5\x58\x63\xc4\x3d\xd2\x92\x71\x41\xca\x54\x64\xd5\x86\x26\x36\xdb\xaa\xa9\x3b\x08\x9f\xda\x18\x92\x01\xe5\x9b\x16\x61\x9d\x1a\x13\x99\x4e\x38\xd3\xab\x6c\x4f\xc1\x68\x08\x27\x63\x0e\xd0\x30\x38\x1c\x1e\x79\x68\x91\x18\xf7\x47\x0d\x3a\x0d\x6f\xbf\xff\x55\xf5\x07\x6d\x70\x1b\xf2\xd4\xdf\xb3\xd1\xd2\xd3\xcc\xf8\x90\x23\x63\x9f\x78\x4f\xbe\x06\x3f\x6c\xbe\xfa\x44\x5d\x45\x23\x93\x45\x3b\xac\xc0\xdb\xa1\x49\x78\x1d\xc1\x59\x02\xa0\x83\x4f\xde\x4d\x5e\x26\x3f\x37\x9d\x05\xbe\xfa\x38\x96\xaf\xbf\xfe\x26\xe7\xc4\xba\xbf\xba\x2f\xf2\x20\x7b\x89\xa0\x34\xeb\xea\x2e\x71\x8f\xb5\xab\x91\xbb\x38\xb3\x0a\x82\x41\x2a\x07\x4d\x3a\x14\xbd\xe7\x61\xe1\xa2\x26\x89\x5c\x1b\xcb\x61\x68\x58\x07\xf7\xdc\xbe\x7d\x00\x63\xa0\x29\xa6\xc0\x92\x9c\xfc\x6c\x37\x97\x15\xe2\xec\x63\xd0\xa5\xd3\xd2\x14\xa9\xca\xcf\x72\x6a\xcb\x65\x25\xb5\xd7\xb2\xe4\xf7\x31\xcd\xb8\x7a\xfe\x93\xd5\x09\x4f\xa3\x6f\x7e\xdd\x2c\x83\x1b\xf6\x96\x71\x30\x24\x94\x09\xda\xdf\xd5\xa6\xc4\x60\x69\xc1\x51\x29\x3d\x1d\x90\x9e\xc2\x7e\xa1\x72\x07\x05\x9d\x7e\x3f\xe4\x63\x8e\x2f\x1d\xe3\x20\x74\xea\xae\x3a\xac\x48\x07\xd4\xde\xd5\x2d\x1b\x3e\xf1\xad\x28\x74\x7f\x59\x59\xcb\x32\x8d\xde\xaf\x15\x1b\xb1\xd5\x8e\xf6\x09\x8d\x71\x2d\xa8\x43\x85\xa1\x02\x46\x06\xd5\x6d\x58\x1e\x9d\x65\x2a\x25\x42\x1d\x53\x4c\xc3\xe1\xda\x79\xa7\xb7\x6c\x58\x0a\x5c\xff\x4d\x40\xe6\xf3\xb2\x8a\x60\x7b\x1a\x87\x00\x1c\x30\x07\x47\xd0\x58\xb1\x39\x4c\x87\xd5\x74\x13\xe5\x6b\xa1\x25\x61\x41\xde\x6a\xa5\x27\xd0\x95\x19\xfd\x2c\x99\xef\x87\x67\x22\x6a\x00\x3f\x34\xce\x00\xb5\x5b\x69\xec\x0d\xe0\x94\x80\x2c\x8e\x26\xf3\x40\x6e\x1a\xde\x6c\x8c\x74\x34\x79\x01\x1a\x93\x9e\xde\x4d\x24\x78\xcd\x7b\x40\x80\xb1\x08\x99\xc0\x34\xbd\x87\xa8\x56\x9e\x32\x34\xc3\xd2\xd9\xaf\x6b\xba\x53\x5e\xa9\xbc\x41\x92\x51\xb5\x3d\xaa\x18\xdf\xa7\xe1\x41\x2e\xa6\x04\x1f\x2c\x84\x73\x95\xdd\xbf\x57\x84\x9f\x49\x28\x38\x59\x72\x79\xa6\x7b\x60\x78\xaf\xd8\x11\x4d\xfa\xad\x5e\xb2\x20\xd9\xd2\xe4\xbb\xee\x7e\x60\xed\x94\x0f\x9b\xc7\xc4\x29\x91\x7e\x91\xe3\x28\x70\x29\x07\xac\x5c\xbc\xf5\xe2\x58\x24\x92\xb6\x29\xab\x87\xe9\x35\xbf\x57\xf7\x2b\xeb\x08\x6a\xb2\x12\x54\x5c\x9c\xdf\x68\x71\x40\x42\x21\xc0\x58\xaf\x05\xa2\x2b\xac\x8e\x83\x3e\x6f\xff\x7a\x1e\x86\xf6\xcb\xe8\xf0\x22\x81\xb7\x0f\x9e\x86\xe9\x10\xc0\x14\x5f\xfc\xa2\xdf\xda\x48\x5b\x0b\x9e\x67\xbd\x00\x46\x73\x4e\x43\x11\xda\x11\x3c\x8c\xc2\x3f\x96\xb9\x2d\xe4\x81\x79\x5b\x49\x20\x69\xc2\xa1\x17\x77\xbd\x82\x5c\x8c\x1c\x1a\xf0\x13\x1b\x7b\x30\xcd\x2e\x54\x44\xd4\xbc\xc8\xfe\xa3\xd5\x4e\x5b\x90\xd2\xf6\xdd\x3c\xa5\x32\x81\x87\xcd\x90\xc3\x22\x77\xf6\x3f\x07\xc3\xf2\x86\x10\xb9\x19\xa1\x26\x81\xb0\x43\xc7\x60\xe3\xe7\xed\x86\xda\x56\x7b\x88\xa2\x2e\xa5\x0b\xc5\xc4\x0a\x2b\xcc\x89\xd5\x03\x1a\xab\x3a\xc9\xbc\xb6\x97\x41\xaa\x7c\x86\x21\xb2\x42\x08\x40\x99\x6d\x89\x67\xe2\x16\xf4\x46\xf5\xc1\x06\xff\xe1\x70\xc0\x6e\xe5\xc6\x6e\xea\x26\xb5\xec\x62\xaf\xb7\x62\x29\xa2\x1a\x8e\xd5\xd3\xf0\xc7\xff\xfa\xaf\x7f\x88\x1f\xbe\x99\x02\x8c\xe8\x18\x3a\x6f\x29\x78\xc3\xd9\x02\xd0\xa5\x7c\x7e\xf3\x7b\x0f\x14\x2d\xda\xda\x35\xb6\x97\xab\x1d\x9f\x57\x79\xf7\xd4\x4e\x21\xae\xaa\x1b\xaf\x59\x35\x79\x77\xfa\x55\x5c\xcb\x48\x31\xba\x66\xdb\x69\xf8\x43\xd6\xf3\xdb\x89\xcf\x69\xa4\xe6\x6a\xb0\xbe\xf6\x8b\x5a\x35\x3f\xb9\x83\xd1\x1c\x12\x5b\x30\x25\xb3\xba\x99\x37\x5e\x4f\x62\x8c\xde\x80\x74\x74\xcc\xbf\xf7\x74\xae\x2d\xef\x28\x4c\x92\x5e\x1e\xfc\x98\xa3\x60\x49\xbc\xd8\xcc\x10\x9a\x27\x4a\xf4\x17\x92\xd0\x31\x89\x4c\x70\x62\x7b\xda\xb9\x43\xb9\x2b\x32\x52\x0a\x4b\x46\x65\x2e\xe3\x47\x1e\x52\x90\x5a\x9b\xf6\x1d\x34\x41\xb8\xda\x8b\x1c\x1c\xb7\xd5\x65\xf5\x7f\xc9\x65\xb5\xfa\x07\xe8\x09\x55\x73\xec\x1e\xf9\x15\xe5\xc1\x76\x10\xcd\x9b\x9c\xca\x29\xbd\x75\x2a\x0f\x39\x46\x49\x93\xad\x60\x0d\x09\x5b\xf6\xda\x0c\x8d\x68\x4b\x9b\x28\xf5\x4d\x8f\xd3\xd5\x16\x77\x12\xff\xa8\x95\x1c\x1c\xd6\x41\xcf\x0f\x39\xa4\x98\xc9\xc8\x52\x9d\x7f\xd4\x5e\x85\x00\x62\x5f\xf9\xe1\xb0\x17\x84\x6c\x05\x78\x1f\xd5\x6b\x01\x27\x62\xe6\x49\xae\x6d\x45\x6f\x86\x14\x1c\x9f\x7e\x82\x22\xef\xf2\x4d\x92\xb9\x8c\x85\x52\x53\x79\xb7\xec\xd9\x23\x3a\x03\xd1\xdb\x02\x8c\xa7\xb8\xad\x2a\x98\x77\x53\xe8\xc0\xac\x71\xf5\xaf\x05\x6c\x95\x02\xb9\xdf\x5a\xdc\x42\xe0\xd2\x20\xed\xa1\x64\x4f\x8f\x71\x59\xbb\x19\x32\xad\xfd\xfc\xd4\xdd\xf8\x7c\x8c\x8a\x1d\xf4\x8b\x7f\xbf\xb8\x68\x00\xdc\xe4\x37\x99\xbb\x5b\x72\xd2\x62\xaf\x94\x02\x1d\xa0\x71\x0c\xde\x62\x37\x6a\x3f\x5a\x74\x4a\x2c\xa6\xb0\xf1\xa1\xc5\x1e\xf1\x8e\xdf\xb7\xa0\xcc\xd5\x9d\xd7\x6e\x42\xfc\xff\xc6\x7b\x19\x4e\xed\x01\x6c\x7c\x19\x74\x16\x17\x97\xc3\x9c\x34\xb6\xe3\xcb\x40\xbf\x74\x3b\x30\xa2\xb8\x0e\x87\xb4\xfc\xbd\x8c\x95\xd1\x38\xa1\x3f\x7a\x17\x9d\xe7\x5c\x19\xa2\xe9\x31\x9f\xc8\xf0\xeb\xee\xb3\x59\x28\x48\x85\xac\xcb\xd5\xbb\x93\xd5\x31\x1e\xdf\x6a\x3c\xb6\xf1\xd5\x02\x0b\xa0\xd0\xce\x46\xff\xe8\xae\xeb\x32\x33\x12\x0a\x67\x72\xb9\xed\x82\x8c\x7a\x9a\x40\x40\x34\x9e\xb8\xa3\x3b\xb8\x6a\xee\xa0\x27\x5b\xd0\x4f\x19\x1a\x28\xbe\x4f\x6b\xfd\xff\xbc\xd7\xc6\x91\xfa\x1e\xc2\x1a\x57\x5a\x3a\x86\xbd\xda\xee\x26\xbd\x7e\x43\x0b\x35\xfe\x49\x1f\x4e\xee\xdf\x17\xa6\x5c\x00\x1b\x67\xad\x90\xe8\x43\x73\xda\xbf\x47\x3e\xad\xec\xf4\xec\xc1\x5b\xd2\x34\x84\xd1\x0b\xb2\xf2\xf5\xf8\x92\x3d\x89\x4f\x76\x9f\x01\xf5\x69\x4f\xef\xf5\x03\xa7\x09\x41\xdf\x07\xea\xac\xc7\x8e\x86\x9b\xb8\xf0\xe9\x30\x5e\xbb\x42\x96\xf6\x8e\xd1\x3a\x39\x55\x14\x76\x93\x70\xc2\x04\xd8\x25\x3d\x2c\x1c\x84\xe0\xc8\x52\xfa\x3c\xa2\xa7\x31\xb5\x8b\xc2\x64\x25\xe6\xad\xd6\xbb\xa2\x20\x06\xb9\x05\xed\x3f\x1d\xdb\x33\x67\xcc\xda\x5c\x0c\xec\xeb\xdb\x02\x0c\x30\xdc\x6c\xc0\x60\xd5\x77\x58\xfd\x42\x87\x08\x34\x6c\xeb\x3b\x1e\x84\xda\x7b\x50\x22\xed\xb7\xc8\x6a\x85\xeb\xa3\x29\xe0\xae\xbe\x34\x53\x42\x6b\x95\x6f\xda\x4a\xc1\x84\x2e\xef\x71\x65\x33\x79\xf0\xbe\x0d\x4f\xc6\x89\xfd\xe4\x9d\x50\x0d\x28\x1c\x72\x39\x40\x3f\x64\x6b\x7e\x7f\x10\x73\x04\xdd\x14\x36\x7a\xc1\x86\x7b\xe0\xf2\x48\xc5\x3a\xcd\xc7\x56\x6c\x8c\xec\x66\xfe\x80\x40\x8f\xfc\xeb\x43\xc3\xbb\x8a\x71\x23\xa9\x8e\xce\x2c\xac\x49\x4d\x64\x89\xd2\x30\x19\xb0\x3b\x4e\xc6\xfa\x98\x06\xc5\x21\x41\xa7\x93\xbe\xec\x97\xf8\x77\xaa\x45\xdb\x93\x71\x8c\xea\x73\x7a\x1d\x08\x82\xfb\xaa\x0c\xc8\x9e\x56\xd1\x57\x85\xf5\x49\x4c\x03\xcf\x33\x7f\xfe\xdc\x6d\x2b\x60\x3e\x1c\x98\xea\xa0\xb2\x7d\xf6\x8c\x99\x8d\x42\xf5\x53\x6f\xde\xb1\xe5\x98\xb4\x1a\x68\xdb\x8c\xc6\xf0\xe2\x27\x59\xc1\x7f\xfb\xd5\x45\x40\x38\xc4\x6d\x8f\xa8\xc2\xbe\xc1\x52\x28\x8e\x15\xed\x03\x4e\xab\x61\x64\x9e\x78\xe7\x37\x8f\x06\x7d\x93\xf7\x68\x6e\xa9\x3b\xe5\x92\x27\x87\x8e\xdb\xe5\x1f\x2d\x80\x25\xaa\x35\x78\x69\xe8\x6f\xd5\x4c\xc1\x1c\xcc\xa0\x66\x16\x91\x3a\xd6\x82\xe5\xe9\x38\x27\xef\xb9\x19\x19\x31\xdf\x79\x51\xa8\xda\xc3\x7f\x34\x27\x8b\x38\xea\xf7\x14\x4b\x85\x83\x49\x7c\x71\x8d\xf9\x85\xe8\x43\xab\xd1\xf1\xf9\xa5\x67\xfe\xfc\x9a\xf5\x07\xb5\x93\x58\x7a\xb1\x20\xa2\x45\x6f\x9f\xe5\xe4\xca\x52\x69\x8c\x9a\xb7\x1c\xa4\x47\x26\xd8\x2e\x2f\xef\xa7\x61\x8f\x1c\xf0\xf3\xb1\x54\xb7\xcd\xf3\xfc\xf6\xf4\x2b\x8b\x64\x3b\xbb\x52\x02\x23\x9d\xc8\x00\x7c\x7b\xd5\x6e\xea\x8c\x85\xb4\xab\x4e\xf9\x10\xeb\xe6\x0b\x55\x79\xa5\xef\xb3\x3f\x3b\x6b\x57\x89\xf3\xa2\x30\xcb\x7c\x52\x4a\x8a\x2e\x81\x59\x16\x59\x45\x4e\xd3\x78\x92\x0b\x9c\x6d\x10\xf6\x22\x4f\x36\x1f\x8c\xf4\x2f\x11\x46\x79\x5a\xed\xd9\xaa\xbc\x71\x6f\x40\x3d\xf1\x30\x12\xee\x1a\x0b\x33\x0c\xfd\x73\x64\x18\xb4\xce\xf4\xec\xdd\x90\x83\x9e\xb4\x2a\x48\x2b\x28\x83\xfc\xe8\xa1\xab\x1f\x15\x4a\x41\x8e\x94\x52\xb1\x00\xc4\x8e\x94\xf3\x6e\xc8\x3d\xd0\xa3\x38\x62\xcb\x1c\x8c\x82\xed\xc4\xd2\xdc\x31\x09\xfb\xf3\x1f\xcd\x77\x03\xbd\xe4\x80\x4b\xc3\x96\xd3\x00\xa9\xf3\x81\xe3\x4a\x13\x62\x98\xaa\xa5\x4f\x8f\x3e\xdd\x6f\xa4\x83\x15\x6e\xd6\x89\x17\x5a\x51\x5b\x67\xab\xf2\x7a\x51\x8b\xe3\x04\xd1\xe9\x36\x5e\xb8\x61\x2d\x33\xf8\xa9\xc5\x0d\x68\x82\x2f\x9d\xd6\x15\x44\x1f\x73\x84\x1e\xbd\x76\x4f\x4c\x5c\x55\x91\x3d\x3d\xe8\x1a\xff\xba\x9f\x52\x86\x62\x99\x3b\x2c\x66\x6a\xb9\xa4\x25\x1f\xaa\x4c\x2e\xf1\x10\x6a\x6d\x20\x8b\x35\xf5\xb8\xa4\x04\xef\x49\xd6\x49\xfe\x00\x64\xfe\xb3\xb8\x6a\x21\x60\x7f\xd1\xf9\x14\x20\x6f\x90\x12\x66\x1e\xa6\xe3\x73\x64\xf5\x85\xc7\xa7\x7f\x18\xea\xe1\x95\xa4\xaa\xf5\x41\x3f\xb2\x17\x98\xef\x40\x0a\x95\x49\xbb\xe6\x59\xfa\xda\xfc\x0b\x0c\xb2\x6b\x91\xd9\xbc\x8a\xef\x2c\x7b\x00\x2b\xe1\x58\x28\xae\x7a\xdb\xd0\x15\x4c\x71\xd7\xb9\x1e\x59\x1d\xee\x70\x77\x21\x90\xdd\x1e\xbf\x10\x53\xf4\x1e\x92\x1f\x40\xf4\x3b\xfe\xca\x1d\x63\xcc\x6e\xbd\xa6\x43\xf1\xc5\x08\xb2\x4b\x9d\x1d\xb9\x8e\xdd\x7a\xe0\xf9\x65\x88\xca\x4b\xd5\x30\xbb\x0e\x05\x1d\xa3\x36\x0c\xa8\x35\x97\x8a\x49\x14\x22\x2b\x47\x72\xdf\x5e\xa6\x30\x79\x59\x8e\xab\xbe\xe2\x2a\xfd\x68\xd1\xf6\x2e\xb8\x98\xa7\xcc\x56\x63\xb6\x3a\x79\xeb\x87\x25\xf8\xbc\x89\xa8\x4c\x2e\x6e\xb7\x35\xfd\x95\xb3\x9b\xa8\x65\x71\xa9\x3b\xf7\xf4\x35\xbc\x63\xcd\xea\x1a\xa1\x29\x0a\x32\xc0\x17\xd0\xd1\xd0\xb7\xd6\x43\xb6\x40\x8c\xb2\xb4\x38\x68\xe5\x9c\x72\xae\xb7\xb2\x75\xb5\xf0\x93\xcb\x25\x6f\x1c\x36\x0f\xc7\xfc\x14\x90\x1e\xb1\x15\xe9\x52\xd1\xdb\xa5\x60\xde\x83\xf1\x32\xc7\x1b\x09\x2c\xf8\xc7\x9c\x0e\xb5\x5f\xb1\x71\x71\xb8\x64\x4c\x47\xdc\x34\x05\x07\x07\xfb\x6a\x2f\x15\xe1\xb3\xb0\xe7\xb9\x18\x69\xb9\x92\x98\xf6\x2b\x18\x96\x40\x53\x46\x3e\x71\x5a\x39\xd0\x56\x94\x4f\xc4\xf5\x8c\x70\xfe\xd2\x4f\x77\xab\x29\x1b\x03\x93\xbd\xe9\xa5\xdd\x84\x7b\x60\x62\xd7\x18\x43\xdd\x65\xb3\xf7\x78\x1c\x05\x7e\xfa\x1e\xdd\xbb\xe8\x89\xc5\xf2\x7a\xfb\xf0\xe8\x22\xd1\x52\x2a\xf8\xd8\xf4\x25\x12\x1b\x2e\x3d\xd1\xd6\x82\x37\x69\x09\x31\x08\xf4\xc1\x83\x96\x9c\x6b\x80\xf4\x4d\xee\x29\x59\xab\x25\xb3\x75\x1a\x2a\x10\xab\x8d\x91\x6c\x7a\x4e\x23\x41\x57\x9d\x46\x92\x84\x77\x8d\x95\xfc\x46\xab\x1b\xea\x6e\x6d\x2e\xe0\xe0\x42\x21\x44\xca\xcf\x33\x3b\xf4\xba\xd3\xcb\x7a\xaa\xfe\xdd\xab\x38\x51\xf2\xb2\x8c\xd9\x8c\xb7\xa4\x00\x60\x51\x44\x6d\xa4\x3e\xcf\x8b\x8c\x29\x3b\xf4\x6f\x62\x56\x27\xe7\xde\x6a\xaf\x4c\x65\x1f\xcf\xb0\x93\xf8\x75\x1d\x95\x98\xec\x3b\x84\x6c\x89\xce\xfe\x49\x92\xbf\xbe\x31\x30\xca\x67\xc7\x95\x39\x0d\x96\x41\x38\x53\x79\xe3\xc1\x2e\x25\x16\x75\xc2\xce\x7b\x3b\xad\x81\x79\x78\x31\x3f\x30\xa3\xd1\x8b\x1b\xf6\xa2\x47\x01\xc2\xf8\x48\x91\x9b\x66\xea\xcc\x49\x50\x90\x04\x24\x41\xad\x1a\x6d\x1b\xfd\xd4\x57\x07\xb1\x77\x21\x88\xb4\x92\x00\x07\x3b\xad\x56\x6f\x24\x54\xa6\xfa\x24\x75\x8e\x1e\xea\x51\x46\xdb\xc1\x1b\xd0\x54\x72\x53\x10\x75\xc5\x74\xea\xb2\x03\x3f\x68\xa3\x50\x98\xc9\x43\x17\xaf\x96\xfc\x16\x6f\x2d\x31\x2a\x23\xb2\x23\x94\xcb\x29\x6a\x7c\x90\x11\xb9\x13\x8f\x7a\x65\x6d\xc5\xd9\x98\xf7\x9a\x26\xa4\x0f\xd3\xa6\x51\x3f\xb4\xd3\x10\x15\x55\x2f\xd6\xa0\x3a\xf3\x57\x42\x5b\xeb\x25\xc8\x21\x52\xd0\xe3\x88\x20\xc3\x69\xe5\xa8\x9f\x98\x6b\xfa\xcd\x90\x0c\xd5\x60\x13\x67\x29\xca\x2f\xd7\xd6\x66\x19\x64\xfc\x18\x39\x20\x60\x63\x0f\x14\x38\x0f\x9b\x42\x5e\x86\x4f\x64\xad\x55\x8f\x1c\x5c\x17\x2f\x4d\x45\xab\xf5\x0b\x1c\x85\x83\x4e\x0d\x00\xf9\x78\x7d\x51\x5f\x3b\x3d\xd3\x1b\xc7\xbc\x69\xe1\x34\xa8\xdc\x01\x76\x26\xd3\xb6\xb1\xdf\xec\x86\x4b\xe7\xbb\xa9\x0b\x7f\x78\x1a\x3a\xcc\x47\x51\x5d\x13\x37\xfb\x60\xbb\x89\x9e\xf9\x38\x4a\x80\xae\x0e\x41\xee\xea\x7d\xdd\x7f\x86\xee\xbd\x74\x5c\xb1\x3f\x73\xc0\xd6\xc5\x57\x6a\x6b\xd1\xc6\xf7\xb1\x7a\x3f\x5c\x36\x6f\x93\x98\x58\x86\x40\x8f\x55\xfc\xf6\xfa\x9e\x02\x65\x03\x47\x68\xa8\x2a\x9e\xcd\x8a\xe4\x8e\x4b\x7a\x12\x5d\xe4\xa6\xb7\xe4\x2a\x57\xf6\x6e\xf6\x1e\x6c\x0f\x54\xe1\xa8\x28\x31\x11\xe4\x7e\xe5\xb6\xb7\x93\x57\xb2\x55\xfb\xac\xc1\x14\x07\xdd\x03\x85\x28\x46\x28\x3a\x28\x05\xaf\x34\xf2\x82\x0e\x01\x05\xb1\xfb\x0d\x5f\xc8\xa3\xbb\x9d\x12\xb0\xd7\x1c\x1e\xa2\xb6\xa3\xae\x91\x5e\x07\x89\x38\xd5\x64\xc7\x43\xdc\x8c\x46\xd5\xb0\x51\x1f\x16\xb3\x77\xac\xb4\x6c\x3a\x98\xe6\xa1\x4a\x32\xf8\x79\x28\x67\x2c\xa8\x91\x4c\xfc\x64\xe3\x37\x9e\x86\xd2\x28\x0f\x20\xd1\xb4\x40\xfb\x2c\xad\x7c\x15\x3b\x57\xb7\xdf\x50\x04\x22\xe6\x9a\xad\x7c\xa3\x33\x19\x03\x0e\x83\x76\x3f\xb4\x05\xe7\x7a\xf4\x04\xad\xf8\xa8\x08\xb3\xa9\x17\x1b\xb3\xaf\x4c\xc7\x18\xce\xc6\x1c\x0b\xf5\x73\x48\x6f\xa9\x49\x71\x28\xea\x4a\xa8\x8c\x79\x5b\x81\x0c\x8a\xca\x07\x79\x5f\xaf\xa0\x9f\x43\x51\x5d\xbb\x0f\x2d\x92\xd7\x37\x06\x3f\xb2\x4c\x01\x37\x0e\x65\x27\xa2\x20\xcf\x98\x7c\xf8\xf4\x8c\x37\x1f\x30\x80\x59\x1d\x57\x2d\xe6\xc7\x89\x7f\x20\xc7\x5a\xd6\xe4\xa0\x7e\x8a\x0f\x0d\x14\x1c\xc3\x5d\xb0\x4a\xa4\xbc\x6d\xb5\x21\xaa\x89\xf4\x30\x31\x19\x90\x96\xd2\xcb\x9e\x35\x6f\x41\x2b\xec\xac\x65\x37\x75\x4e\x87\xa3\x77\xd3\x07\xf7\xc7\x8d\xc3\x4a\x9b\x40\xd3\x25\x21\x9f\x50\x67\xa4\xbd\x0c\x0b\x4a\x12\xa9\xa9\x6d\x8d\x4c\x3a\xb2\x1d\xfd\x4b\xba\xd1\xee\xa5\x6f\x9b\x76\x24\x9d\x3a\xeb\x13\x94\x9e\x8b\x7a\x11\xcc\x9e\x44\x0e\xf0\x0d\xac\x6d\x41\xf9\x62\xab\x7d\xa5\x30\x0d\x06\x5d\xd7\x82\x1e\x71\x53\x27\x86\x65\xed\xfc\x6b\x98\x78\xce\x4e\x81\x59\xb9\x91\x03\x8b\x40\xef\x7d\xc3\x09\x2f\xbb\x22\x83\x2f\x43\xd7\x04\xd4\x98\xd2\xd6\xf1\x1d\xbd\x24\xe6\xd2\x98\xad\x2f\xe8\x56\x45\x76\x85\x95\xd5\xc1\x32\xea\xac\x8f\xa7\x21\x26\x59\xfa\x3b\x39\xbc\xa7\x45\x0b\x5a\x99\xb7\x1f\xb3\xb1\xcb\xbd\xfb\x6f\x1f\x3d\x31\xda\x13\x54\x85\xf7\x48\x23\x2c\xaf\x14\x48\x63\x28\xa0\xc9\x87\x6f\x01\xcb\xa4\x31\xe6\x00\xc3\xe1\x05\xea\xd3\xc3\xfa\xe0\x0e\xb0\x7b\x78\x70\x32\x5c\xa9\x95\x9c\x62\x03\xf9\xb3\xf5\x49\x3f\xf1\x0c\x0a\xbc\x68\x2b\xd9\xeb\xcb\x2a\x2d\x87\x96\xac\xf7\xf4\x05\xd2\x9f\xa0\x17\x99\x7a\xec\xb1\xa4\x20\xb3\xff\x68\xc0\x36\x52\x49\xfe\xb6\x6e\x6e\xac\x7b\x28\x50\x8c\xb8\x38\x7d\x7c\x08\xfd\xf7\xd3\x03\x12\x09\xb2\x18\x1e\x8a\x33\x59\xb7\xd2\x25\x18\xb9\x8a\xfa\x5f\x11\xef\x57\xa6\x29\x70\x4b\xa4\xac\xa2\x29\x32\xe1\xff\x3e\xb4\xcf\xef\x49\x99\xdf\x04\x79\x3b\x16\xd5\x22\x98\x2d\xb0\xfb\xf6\x32\xd3\x8e\x2b\x7d\xc0\x2e\x18\x1f\x8a\x03\x9a\xd8\x79\x74\xf2\x3d\xef\x0e\x88\xb7\x9b\x5c\x41\xcf\x73\x86\xf5\xe7\x8d\xe5\xee\xd4\xbe\xa2\xf1\xaf\xc1\x7a\x4c\x1a\xf4\x13\x5c\xb6\x42\x81\x46\xc8\x19\xbf\x57\x1d\x77\xe4\x4d\x32\xd9\x31\x12\x9f\x45\x8e\x5f\x72\xc9\xba\xc7\x10\x59\x05\x96\x55\xd8\x9c\x79\x0d\x22\x54\x3d\x7b\x65\x41\x6d\xb7\x5e\x31\xa8\x24\x27\x45\x70\xe0\x43\x6f\x69\x64\x38\xe4\xe6\x6c\xcc\x30\xdf\xba\x69\xd7\x0b\xec\x21\x6e\xfb\x2a\x0b\xfe\x03\x53\x22\xb0\xed\xac\x5a\xb7\xc0\x0b\x52\xce\x9f\xde\x1c\x62\x6e\x37\x25\x2c\x3c\xf6\x18\x1d\x25\x49\x23\x80\x10\xa2\x32\x90\x48\x5f\xc2\xe0\xc0\x70\x5b\xbc\x9f\x06\x47\x5d\xd7\xd8\x54\x45\x9c\xa6\x33\xe8\xb0\x57\xa6\xb2\x91\xda\x55\xca\x7b\xf0\x41\x8b\x59\x83\x19\x6f\xfa\x72\x9b\xf4\x2d\x04\x01\x5d\xef\xd3\x69\x5a\x71\x73\x28\xa4\xc4\xe7\x61\x70\x75\x4e\x02\xee\x0b\x0a\xa4\x1e\x13\x90\xf1\x95\x76\xbd\xe3\xe0\x5a\x53\xe0\xab\x4f\xf1\xaa\x84\xc7\x5b\xb4\xd9\xe7\xa4\x40\x32\xd8\xe9\xf4\x9b\x61\x2c\x13\xaf\xe6\xe8\xfd\x66\x0d\xbd\x65\xd2\xf6\xd0\x72\x0c\x90\x02\x61\xfb\xf3\xc5\xb4\xd5\x52\xf3\xf6\xba\x16\x31\xb3\x2a\x12\xc1\xda\xaa\xae\xc9\xe2\xb3\xab\xef\x61\x57\xe5\x4c\xa8\xd1\x9c\x5d\x49\x74\xdc\xa7\x23\x4e\xa8\x4e\x59\x6d\x26\xc8\x91\x95\x06\x5b\xee\xc0\xdd\x28\xc5\x79\x77\x18\x24\xe0\x39\x6a\xb7\x95\x22\xc8\xee\x86\x24\x69\xf7\xde\x1c\x7d\xe9\xbb\xcb\x93\x17\x98\x65\x3b\x4a\xb2\xea\x32\x79\xe4\xc3\x16\xaf\x49\x59\x13\x0f\xb8\x22\xf6\x03\xee\xb5\xb2\xc1\x4b\x3b\x95\xf1\xc4\x03\x7d\x51\x48\xbf\x45\x2f\xbb\x8d\xec\x7b\x3e\x3e\xf7\x63\x90\xe8\x73\x18\x54\x60\xb2\x90\xc7\x1e\x5e\x00\x32\x3f\x29\xc3\xcc\xe1\xf0\x5e\xc0\xc6\x92\xe1\x27\x4c\x3e\x69\xea\xcd\x92\xad\x2f\x2a\x7a\x93\x7c\x44\x46\xc8\x4d\x18\xfd\xe8\xa1\x28\xea\xad\x7a\xdd\x69\xbd\x0d\xc9\x0f\x85\x8c\x2d\x42\xc2\x3b\x49\xe9\x2b\xb5\x6a\xf5\xa0\xb8\xb6\xdf\x62\x59\x28\x6b\xf3\x54\x67\x16\xcd\x14\xa7\x72\xa9\xae\xe4\x8c\x0c\x47\x29\x0a\xbc\x5d\xd4\xea\xeb\x67\x2b\xb4\x69\xd9\x7d\x98\xeb\x96\x64\x64\xf8\x88\x49\x2e\x3a\xe5\x10\x5b\x23\x69\xb3\x57\x30\xe2\x67\xde\x74\xd2\x60\x29\x5c\x46\xcb\x77\x91\x90\x51\xf8\xb3\xd4\xcb\x07\x02\xe3\xc6\x1f\xea\x18\x41\x0a\x6f\x65\x7a\xbe\x3b\x91\xf6\xe2\x14\x88\xe9\x9c\xd3\x96\xcc\x50\x19\xe4\x32\xa8\x5a\x7b\xec\xe7\x1b\x14\x5b\x57\xc9\x9d\x06\xb9\xde\xaf\xcd\x4e\x62\xd8\x4f\x4a\xf1\xd6\x29\x84\x71\xf0\x9d\x88\x7e\xe9\x16\x01\x84\xd4\x52\x08\x12\xb6\x79\xaa\xa0\x5c\xd3\xb9\xc5\xf9\x80\xf6\xef\x56\xe3\x01\xb4\xe7\x12\xb5\x99\x04\x95\xe4\xa9\xf4\x7e\x23\xa1\x8d\xd7\xd2\xad\x42\xb1\x4b\x75\x02\xd8\xe8\x9f\x57\x0b\x13\xe8\x73\x6f\x3d\x3b\x29\xe5\x91\x83\xca\xc6\x47\xd9\x3e\xdc\x28\x1d\x32\xf7\x62\x10\xf5\x02\x99\x1a\xcb\x41\x89\x22\xc3\x98\x39\x9b\xce\xf8\x32\x05\x8e\x11\xd0\x00\xc9\xe7\x18\xb5\xd8\x4a\xaf\x70\x20\x2a\xae\x1e\x12\x87\x23\xfd\xbd\x82\xc1\x56\x07\x8f\x10\xa0\x14\x3b\x0a\xda\x43\x07\x54\x9a\x82\x9c\xec\xa3\xf6\x77\x1e\x87\x08\xce\xf5\x85\x14\x2c\xb6\x1a\xbb\x76\xa0\x76\x4f\x9a\xa0\x8b\x0a\x52\x93\x81\x37\x14\xef\x0e\xbc\x07\xe2\x24\x4f\x9b\xcd\x1f\x9b\xe5\x5c\x78\xbf\xb2\x56\x3e\x14\xbf\xe3\x04\x8e\x7d\x8e\x9f\x1f\xa4\x7c\x08\x29\x5e\x96\xad\x0f\xef\x81\xdd\xda\x9b\x3f\x8e\x34\x2a\x2f\xe6\x0e\xe4\xde\x97\xcc\xd2\xbd\xec\xe8\x37\x3b\xd4\xc5\x3a\x19\x93\x0d\x52\xee\x2a\xd9\x9e\x64\x32\x4a\xbb\xd3\x6f\x0a\x93\xcf\x71\xa8\xaa\xe5\x15\x1a\x8e\x45\xc4\x94\xdb\x2c\x3b\xd6\x3a\x03\xe0\xa5\x9b\x21\xd7\x23\x58\xaa\x02\xef\x4f\x97\x52\xc6\x4d\x07\x42\x4d\xd2\x29\xe8\x19\x1b\x43\x81\xe9\x31\x53\x04\x17\xb7\x51\x3b\xb9\x07\x27\x5d\x94\xd8\x3a\x64\x62\xe4\x5a\xb9\x5c\x39\xc2\xb7\xff\x2d\x94\xb6\xc6\x77\x10\xf3\x08\xbf\x25\x60\x2f\xa8\xb7\x11\x86\x28\x7c\x1a\xcd\xdc\x9a\x89\xda\xfa\x6a\x38\x75\xf5\x60\xdd\x65\x81\x71\xdd\x5c\x81\x3a\xcb\xc3\xcb\xfb\x41\xae\xd9\x82\x1e\xc2\x12\x89\x82\x8d\x43\x24\xd3\x71\xe2\x1f\x00\xd9\x67\x97\x48\xcb\x82\x44\x3b\xe7\x9f\xbd\x3e\x19\x53\xbc\xa1\x5c\xed\xac\x5b\x41\xf7\xdd\xd0\xdb\xcf\x33\xce\x68\x65\x7e\xf3\x22\x23\x31\x8e\x23\xb9\xe9\x45\x4b\x47\xb8\x4e\xda\x29\x28\x2d\x8b\xd5\x93\xc8\xb9\x43\x85\x84\xef\x0f\x07\xae\x65\x7d\x17\x8f\x1b\x92\x89\x43\xb1\xe3\x16\x65\x66\xb5\xf1\x41\xed\x7b\xfd\xa4\x30\x0d\xb3\xf1\xc1\x79\x60\x8d\x27\xee\x15\xd6\xb7\x55\x1b\xbd\x6d\x22\x6a\xc6\x62\xae\x20\x5e\xbd\xe5\x24\x33\x8a\xa0\xbd\x24\x9b\x86\xa0\x59\x20\x21\xa2\xc4\xe9\x57\xf2\xca\xbf\xfe\x6f\x11\xa8\x9e\x7f\x21\x56\x8b\x1e\xf7\xc5\x03\x53\x2c\x0b\x53\xb4\xd6\x64\x88\x35\x95\x33\x04\x8a\x73\x3e\x25\x7a\x91\xf4\x99\xa8\xa1\xb7\xdb\xc1\x90\x68\xee\x69\x1d\x8c\x18\x9a\xe7\x76\x02\x8d\xdb\xd9\xa5\xa5\x37\x94\xe5\xfa\xa8\xc7\x6e\x68\xc9\x16\x57\xa0\xff\x54\xd4\x26\x0f\x6b\xee\xf0\xa5\xd1\x40\xa1\xad\x83\x1b\xa9\x60\x98\xfe\xd5\xa4\x5e\x21\xe4\x0d\x22\xca\xbe\x35\x45\xb5\x48\xce\xbb\xb7\x85\x59\x01\xbb\x27\xc8\xa9\xc6\x8d\x5d\x44\x66\x8e\xf1\xbf\x32\x12\x6c\x26\x1d\x55\x8e\xb1\x8b\x84\x7b\x02\x2a\x95\x88\x02\x27\x80\xab\x91\x4b\xde\x09\xa0\x63\xcc\xd3\x04\x2d\xaa\x0c\x35\xce\x9e\x75\xbf\xc7\x03\x6e\xdb\x18\xcd\x7f\x20\x6c\x05\x7e\xea\x28\x11\x32\xd6\x3b\x8d\x95\xf0\x4a\x33\xe0\x32\xbe\xa6\xaf\xb6\x93\x69\x19\x20\x51\xa6\x02\x49\xaf\x45\xee\xdd\x35\x6f\x6f\x56\x97\xf7\xce\xcb\x12\x21\x14\x9d\xd8\x96\xea\xd0\x39\x92\x4c\x81\x5e\x6e\x80\x09\xc4\xec\x73\xd7\x1a\x51\x64\xcc\xd0\x39\x61\xbf\x80\xa2\xbb\x90\x70\x31\x28\x76\x79\xd0\x5a\xfb\x29\x23\x1b\x14\xd4\xfd\x9f\x2f\x31\xdc\xc3\x96\xca\x4c\xc3\x1f\xff\x6a\x53\x40\x4a\x34\x38\x4e\x87\x1e\x98\xd8\x23\x95\xd0\xd8\xb6\x45\xa8\x6a\x39\x5e\x6f\xfd\xa5\xa3\x00\xeb\x5b\x0a\x3a\x69\xcb\x43\x76\x75\x0d\xde\x27\x3e\xa5\x15\x26\x07\x34\x23\x1a\x74\xdf\x6e\x62\xab\x57\xa0\xe0\x1f\x4d\x26\xea\x1b\xe6\xd9\xdd\xee\x2f\x92\x1a\x90\xeb\x5f\xcd\xdc\x6b\xda\xa6\xc3\x59\xf1\xdf\x7b\x5e\xe4\x2f\xbf\x72\x75\x54\x17\x17\x55\x38\xd0\xe2\x21\x8c\xe8\x26\x51\x50\xfb\xdd\x61\xb3\xe7\x96\x2f\x20\x2d\x4d\x79\xff\x0e\x89\xb8\x4e\x41\xcf\x06\x67\x06\xa9\x63\xf3\x32\x8f\x5e\xf4\x6c\xb8\x67\x7e\xbd\x28\x81\x46\x8c\xf6\x51\xcf\xb2\xbc\x36\x40\x7b\x29\x20\x38\x39\x02\x66\x72\xaf\xb5\xa6\x9c\x7f\xde\x61\xa0\x6a\x33\xb4\xcf\x6f\x5b\x7d\x92\x41\x7c\xe2\x81\x6c\x99\xc2\x3a\x84\x66\xaa\x75\x14\xe3\xc9\xcb\xf6\xb6\xcd\x8e\x42\x3c\x71\x06\x77\x32\xb4\xef\x46\x70\xef\xdd\x3c\x80\xf4\x5e\xfb\xe7\xa1\xeb\xba\xd5\xd3\xab\x83\xfd\xc4\x74\xf4\x89\xaf\x79\xb3\x36\x16\xce\x92\x27\xe3\x3a\x29\x08\x08\x90\x50\x2c\x1a\x78\x5d\xc7\x83\x83\x82\x3c\x86\x7f\x68\x4f\x2f\xa3\x78\xe8\xea\xe4\xb8\xb7\x7a\x24\x48\x85\x37\xd4\x71\x50\xb5\xe9\x2a\x22\xcb\x38\x93\x7d\xf0\x58\x44\x18\xee\x53\x34\xa7\xa3\x89\x76\xc1\x90\x67\xda\x1f\x8d\x8c\xd1\x2f\x1d\xfb\xaa\x60\xf1\x41\x48\xb7\x95\x8a\x05\x23\xf6\xbe\xac\xe0\x44\x5e\xe9\xb7\x70\x52\xac\x18\xfc\xb6\x0a\x34\x87\x5a\x43\xb3\xc8\xf7\x35\x06\xed\x54\x76\xec\xd4\xca\xed\x48\x6f\xce\x67\x0a\x7d\x0b\x80\x19\xfa\x2d\x68\x4c\x67\xa7\xb8\x97\x5a\x94\x2c\x73\x0a\x96\x16\x9e\x40\x71\x5c\x1b\x21\xeb\xf9\x60\xfd\x5b\x3a\x53\xbc\x0d\xbd\x30\x87\x2f\x08\x95\xeb\xee\xf6\x54\x5a\x52\x0e\xcc\x1b\xac\xf8\x27\x40\xac\x4c\xfe\xe5\xd0\x99\x75\xcb\x21\x82\xa4\xc3\xcb\xfb\x49\x65\xd0\xfd\xe1\x02\xc1\x7d\x76\x0c\xff\xe2\x1e\xd8\xa9\x76\x07\x10\x39\x0a\x2b\x27\xff\x01\x3c\x91\x43\x0a\x5e\x6a\xc1\x51\x5c\x09\x5d\xca\xa4\x45\x47\xe2\xa1\x10\xf1\x9c\xef\x8c\x29\x36\xc3\xc3\x44\x16\xac\x27\xb7\xfd\x9c\x04\xb1\x92\x6c\x0c\x8d\x64\xbc\x97\x07\xf8\xb2\x62\x92\xc5\x80\x5f\xdb\x02\x27\x19\x2d\xd6\xaf\xf9\x4b\x00\x4c\x30\x8f\xda\x0c\x03\xf1\x81\xdc\x95\xab\x5c\xbf\xa4\x9d\x02\x41\x08\xfd\xf4\x41\x61\x1b\xb8\x28\x92\x03\x6e\xe0\x00\xa0\x37\xfd\x03\xa6\xa0\x00\x3b\xb3\x51\xa0\x4a\x4c\x17\x36\x60\x24\x70\x6f\x46\xff\xca\x7a\xea\xc6\x1f\x8a\x62\x67\xbd\x59\x19\xdd\x9a\xcc\x07\x80\x9d\xfa\x40\x26\xce\x11\x51\xdd\x0b\xbb\x5c\x61\xd4\x4d\x67\xda\xec\x0f\x3c\xc2\x16\xf2\x0e\x61\x94\x21\x23\x7c\x82\x1c\x5b\x59\x17\x15\xc3\xcb\x4e\x20\xc2\x7d\x4b\xd3\xbb\xb7\xdd\x08\x9c\x92\x93\xdf\x8e\x86\xff\xfd\x2e\x62\x64\xdc\xed\xdf\x39\xa2\x55\x82\x0a\x5e\xf9\x49\x8b\x66\xc8\x56\xb2\x39\xb9\x35\xb5\x31\xb9\xcb\x8e\x5f\xa4\x1e\xef\x2e\xa9\x15\xe0\xba\xf5\xb5\x49\x10\xce\xf9\x96\x6f\xea\xea\xdc\xad\xe0\x29\xab\xae\xf0\x51\x3e\x84\x0e\x81\x92\x72\x78\xb0\xc8\xa0\x93\xee\x14\x36\x98\x83\x91\xf3\xd4\x4d\x73\x90\x62\xa3\x33\x1b\xef\x3a\x97\xe6\xc0\xcd\x3a\xba\x39\x01\xe9\x76\xf0\xbb\x56\xb0\x9e\x81\xad\xe4\xc3\x54\xf0\x53\x22\x26\xbe\xbc\xcc\xae\xd9\x5a\x51\xd5\xe6\x63\xf0\x71\x77\x24\xf7\xf2\x11\x5a\x50\x6a\x25\x07\xdd\x8e\xdf\xb4\xf8\x50\xb9\xe3\x77\x66\x53\x15\x46\xef\x06\x60\x11\xf5\x94\x09\x46\x60\x05\x32\xfd\x3a\xbe\x2a\x89\xe5\x36\x22\x36\x8f\x2b\x79\x69\xe6\xcd\xe5\xa3\xae\xf7\xb0\xea\x30\x0d\xbf\xf2\x35\x25\xbc\xd6\xf8\x24\x22\x44\xd1\xc9\x1a\x52\x80\x16\x5f\x43\x4e\xbf\xdb\x45\xfc\xc1\xd2\xab\x86\xca\xb3\x88\x95\x69\xa9\xfa\xe0\x75\xbe\x79\xed\x26\xfe\x01\x0d\xb3\xcd\xf4\x66\xc2\x9f\x7c\x7a\xb2\xdb\x82\x47\x75\x82\x3c\xcf\x9d\x88\x4b\xc1\x65\x23\x4d\x64\x1e\x56\x2f\x81\x23\xd7\x21\x20\x0f\x4a\x6f\xb9\xba\x0c\xfd\x79\x9f\xfb\x53\xf1\x03\xf7\x42\x81\xab\xb0\x1e\xa0\xf7\x5b\x24\x6e\xe5\xa8\x6b\xa4\x6e\x72\x43\x52\x6f\x8e\x14\xf2\x5d\xfd\xa1\x02\x79\x1d\xe7\x87\x69\xf3\x75\x3b\x4c\x21\x8d\x2c\x47\x19\xf3\xfe\x18\x00\x8b\x43\x2e\xce\x80\x77\xf9\xf6\x22\x75\x28\x88\x25\x91\x8b\x3d\x8b\x79\x35\xd0\x04\x36\x0e\x7d\x5a\x15\x13\xa1\x04\x2d\x85\xa4\x53\xa0\x05\xa9\xe1\x9b\x0e\x68\x9e\xeb\xd2\x9a\xa3\xa6\x28\xe8\x0c\x34\x06\xbf\x7c\xf0\x87\x9c\x84\xe3\x63\xe9\x6e\x15\xf9\x7b\xf1\xda\x87\x22\x2a\x1b\x5b\x6f\xbb\x22\x8b\xd7\x11\xee\xf6\x7d\x61\xda\x52\x9c\x6c\x8e\x97\x78\xcc\x55\xf9\xac\x4c\xbd\xfb\xc7\x26\x31\x87\x4b\xf0\x1d\xaa\xe4\x89\x3e\x22\xff\x53\xe8\x75\xea\x6d\x93\xad\x4f\x76\xb2\x7d\x6a\x98\x56\x81\xfe\x54\xd2\x34\xb0\x28\x13\x00\x69\xd3\x4d\x1f\x74\xd1\x63\xa2\x05\x5f\xc5\xc4\x4f\x8f\xf0\x8e\x44\xef\x01\xf9\x86\x46\xcf\x3c\xc4\x8e\xc0\x46\x8c\xa8\xcb\x3d\x2b\x8c\xa0\xdb\x56\xc4\xb3\x6e\xa1\x8c\xe6\x77\x0f\xc1\xcf\x6b\x77\x14\x39\x8f\x3f\x6f\xae\x68\x0e\x69\x6d\x22\xd7\x3d\xdf\x52\x6b\xf0\x05\x95\x0c\xc7\x42\x6d\xae\x8a\xb7\x7b\xfa\x76\xdd\x9e\x8d\xae\x94\x47\x80\xfe\x66\xa7\x55\xc9\x1d\xef\x36\x46\x77\x1e\xef\x55\xe0\x90\xba\xe7\x5d\x2d\x9e\xdb\x7d\xf4\x2b\x33\xa0\xec\x29\x24\xf4\x6f\x9d\x0b\xcd\x60\x65\xee\xda\x01\x42\x9c\x91\x0b\x88\x8c\x23\x76\xca\x0e\x91\x23\x79\xe4\xe2\x50\xff\x90\x32\x83\x79\x28\xc8\x29\xbe\xfa\x61\x61\x01\xa8\x02\xe4\x4c\x3f\x91\xb0\x7f\x99\xe8\x02\x0c\x94\x7a\x93\x59\x04\x7c\x15\x08\xb8\x7c\x01\xc1\x1e\x60\xae\xe6\xf3\x75\x27\x4f\x5c\xbc\xcc\xdb\xab\xb7\x3e\x78\x25\xc5\xdc\x57\x7d\x68\xcd\x35\x39\x6c\xa7\xf4\xd8\xa1\x9e\x9d\x4f\xfd\xb4\x25\x70\xd4\x46\x63\x43\x72\x03\xcd\x9a\xad\x5c\x3c\x6c\xf4\xc5\xe8\xa4\x56\xec\x2f\x73\x8c\xb2\xb3\xef\xb3\xe1\x67\x6d\xec\xdd\x2c\xbe\xa3\x14\xea\x87\x3c\x7c\x88\x49\xb7\x0c\x09\x28\x4c\x8b\xca\x90\x60\x89\x8c\xde\x44\x2c\xbb\xa3\xfe\xe9\x38\x2e\x29\xe4\x82\x08\xbb\x39\x78\x78\x88\x6c\xd2\x7d\x7b\x02\x57\xf4\xe2\x48\x9b\x72\xdd\xfe\x77\x0f\x6a\xbf\x9c\xee\xc9\xce\x3e\x2c\xa2\xfd\xe3\x38\x07\x09\x9e\xec\x6a\x41\x33\xd7\x46\xc4\xd5\x69\x0d\x51\x78\xe3\xb8\xd8\x26\x34\x96\x1c\xf8\x08\xee\x9b\xa9\xf7\x96\x09\xdc\xdb\x1c\x50\x70\x35\xd5\xba\x3d\xe1\x9f\x7b\x5e\x4a\x52\x81\x67\x3f\x53\x65\xc5\x19\x2d\x19\x33\x14\x5d\x00\xb8\xf6\x6e\x62\x54\x7c\x6e\xaa\xd6\xc6\x9f\xcd\x4a\xea\x6d\x29\x6b\x8f\x59\x5e\x9e\x66\x31\x1e\x32\x9d\xfa\xc4\x93\x16\x0d\x19\xd7\x23\xbf\x48\x83\xff\xb6\x5c\xd8\x63\x4e\x6f\x03\x59\x83\xac\xe7\x47\xe3\xdd\xc4\x83\xa1\x09\x23\xcd\xa1\x28\x2d\x39\xd5\xfe\x00\x0e\x5c\x19\x46\x98\x64\x5b\xee\x84\xdc\x23\xe2\x34\x74\x5e\x74\x1f\xed\x47\xaa\x9f\xef\xdf\x04\x24\x32\x68\xf7\x80\x84\x83\x15\x84\xf2\xcd\x10\xf0\x6b\xbf\xbd\x3a\xca\x95\xeb\x93\xe8\x79\xa0\xef\x9a\xc2\x4d\xbb\xb9\xb7\xac\x86\xf6\x58\x15\xfa\xd2\x1e\x84\x14\x17\xd3\xc9\x28\x62\x7b\x8c\x52\x7e\x09\xc3\x9d\xb0\xe7\xd9\x8a\x87\x95\x84\xaf\xb5\xf1\x3e\xca\xe7\xbf\xfa\x10\x79\x08\x84\x93\xef\x0f\x93\x9e\xc0\x34\x79\x18\x73\x7b\xe1\xac\x5c\x10\x81\x64\x86\x85\xb0\x4d\x16\xb1\xe0\xc1\x4c\x6e\xe5\xc4\xc1\xef\x99\x16\x70\x9a\x0d\xbd\x8a\xee\xc2\x53\x9b\xf2\x1c\xae\xb1\x8a\xae\x10\xea\x36\x61\x17\x92\xc9\x49\x44\x85\x95\xb7\x3d\xc6\x48\xd0\x06\x59\xc6\xfd\x96\x32\xc7\x49\x20\x48\xf6\x3a\x73\x28\x52\x0c\x88\x75\xec\xc4\x6d\xc8\x24\x4b\x4e\x0c\x8d\x48\x1b\xc1\x90\x70\x39\x05\x9d\x90\x10\x43\x91\x82\x6a\xa5\x4c\xac\x76\x3a\x32\xd2\x03\x56\x0e\x2f\x2d\x80\x65\x6a\xff\x30\x25\xdb\xae\xab\xe7\xb8\xc2\xb9\x59\x74\x30\x1d\x92\x7c\xd3\x97\xb6\x11\x60\xa2\x62\xd7\x3d\x4f\x89\xaf\xa6\x08\x8e\xef\x98\x58\x89\x78\x39\x69\x49\x25\x9c\x74\x54\x81\x71\xb5\xef\xa5\x57\x11\xe8\xc4\x16\x06\x4d\xfc\x0b\xe1\x10\xce\xbf\x86\x22\x7d\x85\x54\xeb\x4f\xce\x05\xa5\x91\x51\x84\xed\xe4\xac\x2c\xb6\x2c\x26\x57\xb0\x47\xf1\x43\x10\xe9\x2b\xbf\x44\x93\x07\xd3\xf9\x3a\x93\x87\x5b\xf2\x5b\xe1\x3c\xa2\x52\x53\xa9\x5b\xe5\x04\x62\xc6\xde\xf1\x78\x38\xce\x9f\xd7\xf2\x34\xd2\x29\xc3\xe7\xa0\x39\x0c\xc7\x58\xbd\xb9\x8f\x0a\xb5\x2f\x52\xea\x32\x4a\xeb\x6d\xcf\x08\x45\x96\x50\x4f\x50\x01\x62\xad\x76\xed\xa2\xaf\xbc\x7f\x60\x9d\x6a\x63\x10\xf0\xe6\xed\x1f\xed\x89\x41\xd3\xa4\x15\xe4\x44\x39\xf9\x0b\x2a\x7b\xa5\xe4\x21\xf3\xb2\x0a\xe5\xdf\x6d\x65\xb3\xc5\x21\xee\xc9\xa6\x48\xc4\x08\x26\x2e\x7e\xfc\xe6\x4e\x64\xec\x7b\x8f\xbb\x67\x0c\xb8\x5b\x16\x36\x9b\x44\x8e\x61\x3b\x55\x6b\x41\x91\xee\xec\x17\x8e\x9f\x39\x52\xb1\xfc\xb8\x4e\xc3\x1c\x46\x72\x6d\x3c\x5b\x7d\xb0\xe0\x80\x40\x49\x49\x99\x15\xd2\x61\x2c\xdf\xfe\x5f\xf7\xd1\xe2\xb5\x4c\xe9\x14\x59\x96\xb0\x7a\x45\xe1\x43\x65\x33\xf3\x28\x7b\x1a\x5e\xf6\xdf\x1f\xda\x39\x96\xc8\x48\xfe\xa9\x5e\x9e\xf2\xf8\x7c\x0b\x72\x89\xbc\x4b\xef\x1f\x43\xf2\xc3\x08\x36\x22\x7b\xe8\x4d\xb5\xdd\x74\xa7\x56\x1e\xf5\x83\xed\xfe\x06\x9c\x83\x16\xe7\x5e\xe3\xca\x00\xc0\xa9\xda\xb7\xcb\xcc\x45\x79\xf7\xb1\xa3\xb4\x9f\xaa\x49\x52\xc0\xfd\xe7\x5e\x9b\x4e\xbf\x39\x1c\x72\xc8\x8d\xb5\xe1\x31\x74\xb9\x86\x10\x5e\xf5\x16\x76\xfc\xc3\x76\x03\x61\xd7\x9e\x87\x5a\xc6\x7d\xa5\x43\x2d\x37\x6b\xa3\xa4\x7f\xbd\x2d\xf3\x86\x4d\x7e\xd8\x4a\x62\x93\x73\xcf\xca\xe1\xfc\xf3\x7b\xc4\x7a\xc8\x50\xe2\x63\x14\xd1\x78\x7f\x53\x9d\x3e\x48\x0e\x89\x23\x48\x74\x04\x20\x85\x8c\xd9\x3c\x34\x64\x46\x39\xa4\xfd\x28\x92\x61\xf3\x32\xcd\xec\xf6\x7d\x8e\xbc\xfa\xda\x06\xb9\xe5\xa4\x0f\x55\x72\x15\xfc\xab\x63\x21\xf8\x11\x2b\xea\x24\x53\xde\xb4\x07\x34\xd9\xa7\x79\xb1\x29\xbf\x73\x01\xaf\x7d\x78\xa0\xfc\xce\x48\x59\x65\x71\x77\x96\x29\x22\xb8\xcc\xbd\x84\x45\x9e\xc9\xd2\x56\x7c\xd4\x21\xad\x93\xac\x41\xd4\x4a\x6e\x92\x67\x73\x60\x43\x3f\x48\x8e\x35\x72\x65\x1f\x5a\x08\xb7\xc2\x56\x8c\x5c\x91\x3f\x77\xfa\x67\x10\xce\xbb\x94\xa2\x11\xd3\x52\x9d\x20\xb6\x24\x49\xa7\x3f\x09\x1b\xf7\xdf\xc0\xb4\x26\xb2\xd5\x17\xa0\xff\xa3\xba\xad\x47\x95\x83\x62\xba\x80\xd7\x62\x29\xc4\x23\x51\xbc\x9f\x58\x07\xea\xe0\x79\xd6\x4a\xf7\xb2\x11\x0b\x58\x39\xa7\xb5\xc7\x7c\x19\x2d\xd0\xda\x71\x25\x21\xd2\x53\x46\x0c\x87\x30\xc6\x8d\x6d\x3c\x4d\xee\xa1\x97\xa2\x15\x80\x64\x26\xee\xbc\x79\x0f\x05\x6e\xb5\x7a\x68\xff\x2a\xb2\x3e\xcf\x06\x66\x73\xab\x36\x26\x8e\x3e\x80\xae\x74\xd1\x58\x5d\xbd\xe3\x4f\xd2\x58\x64\x53\x10\xc9\x6f\xf0\x6f\x01\x4d\x9d\x8d\xef\x40\xd1\x85\x2c\x11\x01\xdb\x11\x00\x72\x3f\x1e\x56\x8d\x4d\xc9\x9b\x4f\x03\x0e\xf1\x28\xf6\x27\x14\x19\xfc\xe5\x43\xc7\xf0\x0b\x74\x46\xf1\xbb\x51\x90\x9d\xd2\xc6\xe0\x38\xc6\xf8\x3c\xb5\x11\xe8\x22\x8c\xcb\xc7\x3d\xe3\xe3\x56\xfe\xc9\x0e\x95\xc2\xf6\xe8\xe2\x92\x0f\xef\xff\xd8\x77\x44\x6c\x75\x2d\x40\x42\xa0\xd8\x9e\x27\x59\xee\xe9\x2e\x4b\xac\xcc\x3e\x00\x7c\x96\x26\xd1\xc1\xdc\xbc\xf9\xd5\x3e\x78\xb7\x1f\x71\x30\xe5\x2f\x5d\xf3\x16\x1f\x50\xfc\xba\x47\xb9\x5d\x7b\x71\xbc\xa2\x79\x7f\xc7\xa6\xbb\x83\x4a\x21\x14\xef\x9a\x56\xb5\xf5\x18\xe5\x44\x8b\x19\x65\x21\x1f\xc8\x34\x45\x51\x99\x66\xcb\x6c\xea\x11\x57\x6a\xc7\x30\x4f\x8f\x59\xed\x95\xff\x15\xd6\xc2\xd4\xad\x14\xeb\x9d\x84\xfe\x5b\xea\xf0\x72\x56\x9a\x03\x07\xa4\x9a\x17\xe0\x0a\x6e\x2a\x4f\xdd\x24\x73\xa5\x60\x04\x6c\x21\xae\x14\xb8\x52\x98\x87\x82\x4e\x87\x5c\x31\xc8\xbc\x7a\xba\xec\xbb\xee\x28\x48\xe0\x8d\xf9\x02\x19\xf4\x83\xb8\x86\x53\x93\x30\x41\x47\x85\xec\x86\xf8\xa8\xed\x02\xa1\xfd\x67\xfb\x13\x91\x87\xeb\x89\xcc\xdf\x24\x17\xd7\x63\x8f\x2e\x94\xda\x0f\x92\x9b\x1c\x78\x1a\xcc\x39\xaa\xbf\x8f\x46\x4e\xd2\xfb\x31\x26\xbf\xb5\xdf\x98\x5d\x75\x29\xd2\x0b\x7c\x0b\xe7\xe0\x3b\x9d\xe8\xe9\x03\x09\xd0\xfa\x0c\x9b\xaf\x18\x53\x47\x81\x68\x51\xa1\x57\xb6\xd7\xc1\x3b\x59\x1e\xd4\xa6\xbb\xc9\x9d\x2a\x3c\xc8\x89\xe8\xb8\x51\xe8\xc4\x29\xd5\xa9\xbd\x75\xf5\xe2\x75\x54\xd2\xc9\xcc\xd1\xef\x32\xd2\x93\x7d\xd6\x9e\x17\xba\x07\xe9\x00\xe4\x9b\x91\xd3\x6a\xb8\xe2\x64\x9a\x6c\x67\xaf\x00\x86\xf2\x22\xa1\x96\x0f\xc8\xc2\xdc\x8f\x6b\x52\x6b\x8e\x9c\x44\x33\xb8\xfa\x39\x31\xa8\x6e\xbd\xde\xa7\xb8\x54\xb3\x5c\x61\xb5\x6e\xfb\x27\x2e\x28\xb9\xe6\x46\x4a\xb8\x55\x6e\x25\x1c\x99\x45\x02\xed\x21\xe6\xad\x4b\x17\xf7\x7a\xda\xf8\x08\x7a\xb6\x2a\xf8\xa8\xfc\x26\xdf\xdf\x42\x27\x8f\xe5\xee\x05\x15\xd1\xe6\x28\xf0\x66\x13\x8f\x69\x08\x1a\x11\xad\x3f\x43\x38\xf0\xc1\x77\x93\x1f\x18\xd1\x7b\xe5\xf2\xd2\xa7\x5f\xc7\xfd\x6b\x55\xbf\x5a\x6a\xb1\x15\x04\x86\x07\x05\x4a\x63\xfc\x69\x7c\x7f\x03\xb5\x82\x6e\x0d\xb4\x49\x29\xdf\x02\xe9\x96\xcc\x72\x5c\xb4\x8a\xf3\xc4\xfa\x90\x16\x5a\xfe\xca\x22\x7f\x82\x20\x30\x06\x7a\x30\x10\x93\x1e\xbc\x1f\x60\xed\xb2\xfa\xce\x9d\x38\x43\x97\x40\xcf\x7c\x60\x66\x1b\x8f\x12\xa5\x8d\xee\x0c\x8a\x4b\x61\x20\x31\x59\xcb\x25\x16\x7e\x9d\xb6\x6a\xf5\xa8\x8b\xfb\x72\x68\x13\x4d\xdb\x56\x0e\x74\x5c\xe9\x8f\x47\x37\x99\xe2\x27\x92\x41\xa3\xe4\x7f\x6d\x05\x55\x27\xf2\xdb\xaa\x64\x21\x1a\x4a\xa3\xa9\x22\x6b\x93\xdc\xb4\xbc\xbc\xb7\x24\x05\xe0\xd9\xee\x4b\x00\x86\x1e\xf6\xf0\x80\xbd\x86\x70\xe5\x90\x42\x19\x21\x1f\x8d\x6f\x87\xa9\xbc\xe9\x89\xad\xbe\x14\x84\xee\xad\x40\x05\x0b\x28\xb2\xcb\x4e\xb6\xac\xb2\x48\x55\xa7\xa8\x7b\x75\xc0\xae\xc3\xc5\x82\x93\xb5\x7b\x8b\x09\x90\x61\x09\x02\xb7\x04\xae\xd5\x81\x80\xf6\x33\x16\x76\x19\x70\x5d\xc8\x4d\x6d\x4b\xf1\xb2\x0f\x14\xb1\x58\x8d\xa2\xfc\xd3\xc6\x0c\xda\xcd\x1e\x35\xb1\x4a\xdd\x82\xb8\xdf\xdc\xde\x94\xdb\x53\xd6\xe4\xfd\x10\xf3\x98\x20\xba\x9c\x3a\x47\xff\x29\x96\x4f\x0e\xd6\x6b\xd1\x0e\x70\x7e\x14\xdd\x9b\x95\xdc\x82\xd3\x5d\xaf\xcd\x30\x05\xdd\xe1\x92\x0d\x3d\x78\x28\xc0\x44\xb1\x07\xf2\x15\x34\xce\x0f\x7d\xe8\x07\xe4\xe1\xea\x98\x52\x5f\x97\xd1\x76\xf0\xb2\x73\xac\x38\x46\x80\xf2\x32\x45\x23\x3a\xbf\xda\xad\x1c\xe0\x2c\x7d\xea\x28\xf9\x21\xa4\xc4\x5f\x44\xa4\xb2\xc7\x8a\x25\x6d\x66\xc7\xc5\xbb\xb6\x53\x08\xf3\x2e\x7a\xf9\x8c\xc7\x3a\xf1\xe7\xff\x58\xb3\x6b\x4f\xee\xf4\x62\xf3\xe4\xe1\x5c\xbe\xf7\x27\x90\xf9\x0b\xb6\xd7\xa6\xf7\x54\xb2\xa5\xf3\x7d\x7b\x8d\x42\x8d\x81\xd9\x45\xc3\x49\x6a\x39\x94\xaa\x10\xf5\xed\x4b\xb8\x4a\xbf\x57\x5a\x58\x62\xd9\xc2\x81\xfd\x15\x85\x76\x81\xb6\x14\xdb\x5d\x59\xd9\x17\x62\xb8\xe8\xbf\x84\xd3\x4f\xf2\x0a\x04\x87\xe1\x89\x4c\x67\xdc\xd3\xcb\x12\xea\x90\x1a\xbf\x41\x0d\x8f\x07\x4b\xce\xee\x77\x76\xea\x03\x6f\x39\x1e\x94\x80\xbb\x72\x5f\x3a\x41\xef\x19\xfd\xe4\x5d\x0e\x1d\x2b\x8d\xed\x5e\x54\x23\x24\x45\x4f\x1c\x13\x6f\x30\xd1\x75\xfc\x02\xd7\xc6\x55\xa7\xa4\x41\xe7\xa6\x34\x7f\xa1\xe6\x0c\x45\x87\x0e\x12\xb4\x8a\x90\xbf\x42\x44\x52\x41\x50\x66\xf6\x8d\x5f\x4a\xbe\xa1\x28\xbc\x01\xb3\x65\xaf\x7b\xa4\x8e\x64\xc9\xa3\x7b\x30\x5f\xdc\xd3\x90\x24\xdf\xd7\x16\x78\x48\x68\x41\xb9\x67\x74\x3e\x6a\x2c\x32\xc9\x2d\xb9\x53\x40\x4b\xfe\x2d\x8f\xff\xf8\xd0\x16\x89\xf1\xf1\xf5\x25\x32\xa7\xf2\xe9\x43\xa2\x0e\xd0\xef\xb3\x9b\x0a\x61\x19\x3d\x1c\x33\x8e\xbe\x8a\x47\xda\x07\xf3\x23\x36\x3d\x74\xfd\xb4\xae\x96\x7b\x18\x98\xdb\x7e\x8c\x0a\x66\xbd\x14\xd7\xe4\xdd\x90\xea\x4b\x6c\x6a\x3d\x42\x88\xce\xc1\x27\x91\xa9\x9f\x5a\xbd\x37\x96\xba\xb9\xb9\xf3\x49\x2b\x26\xa0\xea\x17\x4c\x56\x18\xf6\xb4\x81\xd1\x33\x67\x91\x08\xd9\x79\xd6\x13\x3d\x61\x6a\x56\xba\x13\x70\x3c\xca\x25\xca\x5a\xa2\x24\xb3\x52\xda\xb5\x7b\x8c\x1c\xbd\x4d\x44\x45\x2e\x9a\x0c\x4b\x06\x01\xed\x89\xcd\x91\xef\xdf\xab\x76\xe3\xee\x7d\x06\xbf\x00\x34\xef\xcc\x31\xee\x10\xf2\x96\x23\x00\x53\xac\x21\x51\x66\xa0\xd4\xfb\x62\xb2\x7f\x30\x4d\x9a\xac\x3d\x68\x8c\x31\xdf\x10\x63\x5e\x40\xa3\x2e\x83\x58\x6b\x7b\x60\xc1\x48\xee\x51\xaa\xd7\xf6\xca\xbe\x89\x84\x92\x60\x56\x3d\x0e\xe0\x3b\x4b\x06\xd1\x9e\xb1\x41\xa3\xf8\x83\xe8\x35\x8c\xae\x4b\x52\x14\xf7\xbc\x71\x40\xcb\xd3\xa6\xa4\x16\x3f\x2e\xb2\xfb\x20\xbc\x95\xdd\x5e\x8f\x68\xd3\x19\xc3\xad\x86\x24\x0a\xb5\xda\x25\xe1\xc8\x6c\xd2\xf1\x98\x5f\x74\xdd\xfb\xea\xfb\x21\xe7\x87\x05\x22\xba\xc7\x36\xf9\xcc\xd5\xaf\x4c\xda\x25\xb4\x96\xb0\x1d\xfc\x40\x15\xa3\x66\x08\x6e\xa3\x5e\x5e\x1a\x36\xb8\xe5\x88\x28\x54\x43\xf1\x21\xed\x87\x7c\x4e\xeb\xe1\x49\xfa\x47\xd3\x8d\xe4\xb8\x82\x86\x7b\xcd\xbd\x87\xa6\xd4\xba\xc3\x5d\xd2\x7b\x8c\xe2\x93\x1d\x77\xaf\xb5\x39\x2f\x2c\x1f\xd6\xaf\xbc\xff\x7a\x02\x61\xaf\x10\xfc\x0b\xa4\xf9\x99\x42\xd7\xff\xcd\x68\x63\xab\xea\xa0\x00\x55\x00\x8b\x32\x85\x78\xe8\x2f\x76\xa9\xb5\x4e\x4a\xa7\xf1\xfe\x35\xd6\xa4\xc0\x13\x3f\x21\xa1\x23\x2b\x25\x1e\xb9\x62\x0d\xba\x82\x69\xd5\x6e\x92\x8f\x7f\xd2\xd1\x49\x25\xc9\xf0\xb5\x7e\x01\xf0\x88\x91\x7f\x4a\x71\x5f\x18\xf0\xad\x46\x8f\xbd\x3d\x3a\x1b\xdd\x49\x44\x2a\x76\x11\x5a\x52\x93\x96\xf8\xfb\x65\x3f\xf5\x13\x4a\xe2\xfc\x45\xd6\xcc\x3a\xad\x40\x50\xa0\x05\xeb\xe4\xa7\x97\xf9\x28\xc7\xe3\x18\x68\x08\xef\x21\x01\xe3\x63\x23\x05\xae\x1b\x75\xb8\x23\x03\xf5\x5e\xb3\x7d\x98\x33\xc3\x38\xe1\x72\xd5\x12\x0d\x30\x4b\x13\xe7\xf6\x27\x91\x9d\xf6\x01\x1b\xd2\x66\x2f\x29\xa6\x0f\xd9\x7e\x27\xbc\x9b\x42\x8e\x49\x22\x6e\x38\x07\x3f\xfa\x6a\x5f\x7a\x37\x52\xdf\x1b\x22\x57\xb3\xab\x42\x02\xf0\xdc\xa7\x80\x44\x55\x45\x89\x8c\x47\xa3\x71\x46\xe1\xd5\xe5\x98\xd4\x5e\x6f\x45\x4f\x0b\x9f\xaa\xeb\x17\xd6\xc3\x1b\x4d\x8f\x8a\xd0\x6c\x06\x24\xf3\x87\x8c\x47\xbb\xe2\x3a\xd4\x49\xd6\x2c\xb9\x8b\x75\xa5\x83\xb2\xdf\x4d\x06\x2c\x79\x41\x7c\xa8\x2b\xc9\xbc\x26\x12\x46\x0b\x04\xed\x4b\xbb\x09\x00\x05\xa7\x1e\xc7\x3f\x9b\x37\x45\x68\x9d\x96\xe3\x7e\xe4\x17\x6f\x05\xb9\x44\xaa\xcf\x32\x78\x9e\x05\x72\x4a\x47\x44\xbf\x68\xbb\x75\x16\x40\x63\x9e\xf6\xe3\x5e\xfa\xdf\x50\x8c\xc3\xc1\x58\x87\xba\x45\x11\xda\x71\x90\x9c\x15\x95\xb7\x30\x2c\x9e\x4c\x1c\xc8\x18\xf4\xe4\xb8\x20\x11\x38\x03\x7d\xa1\x00\xff\x7c\x1a\x5b\xdf\x47\xed\xf7\x1e\x11\xa5\x1b\x95\xd3\x33\x47\xa9\x2b\x6e\xf5\x74\xc4\xf3\xbb\x85\x1f\x55\x35\x78\xb8\x73\x87\x27\x0c\x49\x57\x6f\xc0\x30\x77\x01\x26\xc7\xe8\x53\xd5\xcf\xfc\xe7\x4d\x7e\x33\xb2\x86\x64\xe5\x9d\xb7\xb8\xfa\xdf\x4c\x61\xe8\xa5\xe0\x96\x3d\xfb\x95\x33\x9b\x27\x07\x47\x2a\x83\x82\x31\xe7\x6d\xf5\x20\x9c\x70\x21\xe0\x64\xa9\x66\xd2\x90\xd6\x73\x22\xd5\x9c\x5c\x3e\x2c\x9a\x26\xd1\xdb\xdd\x03\xea\x70\x5e\xb0\x38\x3b\x0a\xd9\xf4\x37\x40\x00\x97\xcc\xc6\x48\xdf\xc5\x09\x8c\x10\xf2\x16\x4a\xb8\x02\x38\x1c\xf5\x61\x9a\x42\x78\x0f\xb3\x0f\x2f\xe4\x98\x3f\xfc\x43\xb7\x2b\xf6\xa9\x27\xf6\x6d\xcd\xf6\xcd\x55\x97\xf1\xcf\xeb\x17\xf8\x75\x69\x30\x5d\x0f\xec\x47\x31\xcb\xd5\x54\x83\xdf\x70\xb8\x9e\x8b\xa7\xfb\xa3\x3a\x2c\xce\x93\x21\x67\xa1\x38\xee\xcb\x2c\x0e\x86\x5e\x48\x3a\x34\x94\x65\x86\x52\xc6\x4b\x1c\x93\xb6\x88\x44\xd8\xb4\x03\x4c\xe7\x7e\xbe\x58\x40\x3a\xa7\x43\x96\xf0\x02\x91\xe7\xb0\x80\xc8\x06\xa5\xe4\x85\xc5\x92\x2f\xbe\x2b\x93\x4c\xd1\x4b\x24\x8d\xb8\x14\xdb\x43\xdf\x7e\x88\xff\xda\xa9\xca\xf0\x92\x3f\x74\xa2\x7b\x3a\x75\xe3\xff\x82\x39\xe2\x37\x96\xd3\x31\x0d\x73\x9d\xb4\xdd\xaf\xbf\xc3\x5c\xad\x3e\x83\xb8\xc5\x93\xc3\xd6\x51\x3c\x02\x7f\x22\x6d\x26\xea\x6c\xf3\xc2\xe9\xfb\x00\x43\xd3\xc3\xaf\x92\x86\x7f\xca\x1c\xfe\xbb\x6d\xf2\xec\x15\x5a\xa1\x2a\xca\x07\x77\x58\x49\x35\x13\x1e\x96\x83\xf5\x3d\x03\xa2\x21\xe4\x71\x04\xcc\xf5\xcf\x87\x74\x35\xaa\x35\x5b\x08\xba\x29\xe3\xe9\xbc\x7d\x09\x10\xc1\x41\x5b\x01\x6c\xd6\x9e\x93\x75\xe2\x7c\x89\xdc\x84\x63\x15\x15\x58\x1e\x3c\xa4\x82\x77\x6f\xc9\xf1\x0e\x3e\x9b\xd2\xe5\xba\x4c\xfb\xf7\x32\x39\x78\x45\x61\xd2\x7e\x09\x52\x67\x6e\x4b\x20\xad\x5d\x15\xb1\x64\x16\xb3\x79\x51\x13\xea\x60\xd1\xbc\xb6\x92\xdf\xc4\xdb\x5f\xa9\x1b\x82\x07\x7a\xd2\x82\xe5\xc1\xe8\x7f\x3e\x98\x4f\x1c\x82\x80\xf8\xcb\xe5\xb1\x68\xb7\x0c\xc1\x7b\x3b\x20\x2c\x75\x0c\xcc\x28\xa2\xfc\xf2\x41\xc6\x58\xe3\xf7\x3f\x27\x80\xaa\x8f\x5f\xf4\xa5\x5b\x36\x2a\xcf\xc3\x1f\xff\x12\xe8\x85\x82\x63\x92\x3d\x31\xd9\x57\xe7\x09\x65\xd9\xf8\x47\x65\xec\xd9\xfb\x6d\x30\xfc\x64\xac\x4e\xbd\xf2\x41\xf8\xe3\xd0\xb2\x20\x26\xbc\x45\x18\xde\x59\xba\x5b\x2a\xa6\xf0\xae\xbc\x75\x08\x22\x08\x17\xa9\x7c\x1d\xd1\x6a\x27\xbd\x34\x66\x51\x4f\xfd\x81\xd5\x70\x48\x99\x40\x5b\xcd\x27\x16\x2a\x98\x4b\xb1\x0f\x95\xac\xfb\x55\x9b\x89\x97\x1a\xd6\xee\x57\xd8\x36\xb0\xc2\x23\x82\x74\x26\xbf\x19\x1b\x3d\xde\xf2\x09\x82\xb9\x2a\x8a\x2c\xbf\x69\x2e\xbd\x97\x61\xf9\x20\x3c\x54\x87\x48\x57\xc0\xe4\x49\x67\x1c\xd3\x97\xae\x75\x8f\x15\xaf\x6e\xd0\x90\xf2\xf2\x06\x7a\x1f\x56\x8a\xca\x3c\xe8\x48\xc6\xee\x79\x98\x11\x12\x6b\xd1\x16\x2b\x21\xc0\xab\x9b\x2c\x3d\x96\x0f\xd6\xfe\x7d\xd9\xae\xa7\x21\x1d\xc0\xb3\xd8\x56\xac\x73\x76\x13\x55\x98\x28\x9a\xb3\x96\xe9\x5f\x07\xc5\x57\xfd\xc8\xbf\x8c\x3f\x96\x40\x2d\x79\xa2\xd0\x0f\xb2\x5b\xae\xda\xa8\x39\x13\x83\x4e\x59\x1e\x81\x49\xbd\x5a\xe2\xe9\x7e\xa0\x46\x40\x59\x16\x42\x13\x3b\x0e\x72\xd2\x33\x33\x4f\xc6\x83\x7a\x8a\xd1\x9d\x9f\x2e\x6d\x75\x84\xd3\x5e\x9f\x31\x72\x5b\x45\xeb\x7d\x1a\x56\x7a\xc9\x24\x2f\xf8\xed\x21\x51\xf3\x45\x29\x0f\xe6\x00\x7b\x9c\x81\xe9\x01\x8d\xbd\xe0\xfc\x1c\xd8\x55\x8e\xcd\x9f\xcd\x0a\x53\xfd\x2e\xd2\xd5\x4c\x40\xac\x1c\x3f\x76\xea\x77\x4b\xe0\x94\x70\xce\x1f\x82\x16\x15\x5a\x18\x6e\x21\xa1\x3f\xae\xa1\xbb\xa1\x05\x03\xd0\xf8\x1e\x3e\x75\x49\x9f\xd4\xd3\x85\xd4\x7e\xc7\x87\x62\xe0\x15\xd2\x5e\x84\x03\xfa\xea\x40\x06\xf9\xe0\xac\xdd\x5e\xaf\x90\x39\xbb\xd6\xb2\x5f\x94\x27\x41\x6e\xd8\xe2\x5b\xad\x9a\xd2\xe9\xa7\x7b\x73\x13\x31\xd6\x27\xfe\x0b\x95\xab\xda\x50\x00\xaf\xbd\xb4\xd7\x51\x80\xdb\x7d\x04\xf0\x30\xfd\xa0\x5b\xb4\x9f\x56\xc6\x4b\xa3\x7b\x1a\x67\x61\xc9\x63\x00\xb0\x33\xb1\x62\x97\xc2\x07\x61\xe1\xd8\x05\xd5\x62\xa1\x8c\xa5\xf3\xe8\xfd\x23\x32\x3a\x97\x17\xda\xad\x30\x51\x69\xc4\x74\xe8\xc9\xd0\x88\xc3\x59\xf5\x6f\xad\x1e\x06\xf4\x9d\xb0\x37\xac\x56\xf2\xd0\x01\x7d\x1f\xd3\xe6\xbb\x43\xe7\x2a\xd8\xae\x19\x65\xb6\xba\x05\xaf\x55\x27\x1e\x56\xd2\xe8\x1e\x68\x9e\xed\x34\xa3\x68\x12\xe2\x7c\xa6\xd4\xbb\xe8\x6d\x9d\x93\xaf\x7b\x41\x26\x02\x4c\x21\x31\x72\x78\x8a\x07\xcb\xa7\x81\x5c\xa2\x0f\x36\x27\x45\xe6\x11\xdb\xa2\x17\xc2\x0f\x96\x69\x70\x14\x7c\x96\xbd\x99\xed\x98\xdb\xfd\xd1\xc6\x2d\x59\x5b\x55\x57\x7a\x28\x37\xaa\x9e\x13\xb7\x70\x07\xcb\x86\xb5\x10\xc0\x4d\x1a\x06\xb5\xcb\x21\xf0\x71\xaf\xd7\x17\xb3\xd8\x24\x71\x0b\x8c\xd2\x32\xa7\x77\xa9\x78\xce\xfa\xe9\x93\xdc\x21\x86\x14\x76\xed\x95\xd1\xe7\x6c\xfc\x3a\xc7\xf3\x26\x82\xde\xe6\x0d\x05\xaf\x05\xf4\x3a\xb0\x3d\x38\x23\xd7\x75\xae\x7e\x13\x1f\xf3\x5a\xb5\x43\x7d\xef\xc4\xbf\xdb\x02\xd4\x68\x7c\x31\x7a\xdf\xeb\x15\xd8\x0a\x90\x57\x33\x57\xa9\x0f\x3c\xa9\xb0\xc2\x70\xfe\x07\x8b\xd5\x54\xcf\x9d\x3b\xc1\x78\xa2\x6a\x27\xa5\x04\x66\x67\x51\x2f\x95\x7e\x71\xab\xf0\x04\xbf\x67\x8e\x62\x6d\x51\x8c\xc7\x50\xe0\x3a\xea\xb3\x29\x3e\x07\x9d\x4c\x32\x3d\x58\x8e\xb8\xab\x87\x01\x0e\x8b\x5e\x3a\xad\x67\x8e\x8b\xd5\x0b\x16\x66\x0e\x39\x1e\xe4\xa6\x50\x54\xf9\xae\xbe\xd4\x9e\xe5\x02\x70\x81\x79\xf8\xf4\x05\xb6\x88\x2b\x4e\x60\xe5\xf9\xcd\x82\xa8\xac\x17\x87\x76\xa6\x5e\x29\xc2\x52\x33\xa6\x5c\x59\xd5\xf7\x1e\x2a\xc9\x3c\xb2\x3f\x99\xa5\x7d\x5b\xf0\x91\x8c\x16\x87\x7a\xf0\xe8\x9c\xdd\xe3\xe5\xf6\xa3\x44\xbe\xf1\x46\x07\xf5\x92\x2e\xd8\x04\x58\x59\x2b\xe0\x60\x04\x8e\x87\x29\xf7\xdf\xa4\x72\x62\x99\x3c\xa2\x70\xc4\xb8\x79\x76\x04\x18\x33\x17\xcf\x06\xe6\xf9\x35\xbf\xb2\x96\x9b\x57\xad\x24\x4d\x14\x8a\x5b\x6f\xcf\xb9\xdb\xc2\x41\x93\xbe\x31\xc9\xc5\x18\xe0\x29\xe2\x49\x55\x2c\x47\x79\xd0\xcd\x87\x84\x54\x09\xe3\xc9\x0d\x97\xb4\x2f\x82\x39\xb9\xaa\x96\xdf\x80\xff\x54\xac\xc1\xda\x8e\xc8\x5e\x93\xbd\x24\x92\x7e\x26\x05\x4f\xb0\xce\xa2\x25\xc4\x8b\x96\xe5\x13\x1b\xbd\x98\xd4\x9c\xb3\xa6\x9b\x9f\xeb\x24\x5a\x06\x4d\x13\x8a\x5b\x4a\x63\x63\xb3\xbe\x28\xae\x90\x17\xa6\x97\x56\x20\x9a\x74\x08\x31\x0a\xa6\x24\x9d\xce\x12\xd7\xce\xb8\xf3\x66\xf9\xae\xb7\x42\xa7\x97\xaf\x3a\x3c\x38\x0d\x9f\xd0\xca\x13\x47\x85\x2e\x36\xa7\x15\xb1\xe4\xea\xa8\xd6\xcd\x77\xe6\x4a\x9b\xb8\xf2\xae\xe8\xfc\x2b\xa6\xc3\xaf\xf3\x5e\x2c\xe1\xec\x13\xb4\x84\xee\x92\x09\xc9\xd3\xa7\x28\x6a\xe2\xa0\x60\x9e\x7d\xe8\x7c\xb3\x8a\xb1\x4d\x44\x08\xe4\x56\xf8\x63\x68\xbb\x4c\x81\x46\x2d\x4b\xe2\x22\xd1\x0d\x89\x51\x4c\xc1\x77\xd8\x8a\x2f\xfa\x7a\x7f\xb5\x62\x4f\xd9\xc9\x3e\xda\xe2\xe5\x61\x95\xbf\xe2\xd7\x30\x35\xe6\x9f\x57\x57\x97\x42\xad\x60\x6e\xec\x36\x8d\x3e\x11\xa6\xbe\xd5\x61\x13\x88\x44\x75\x24\x27\x60\x30\x94\x52\x27\x0e\xbd\x7a\xcc\x3a\x2c\x2a\x41\xc5\xc7\x3b\xaa\x40\x30\xcf\x7d\x71\x90\xd5\x55\xdc\x60\xb8\x64\xb2\x1d\x39\x40\xcd\xa8\x56\xca\xf3\xcc\x39\x0c\x53\xd0\x4f\xf9\x09\xf4\xb5\xb4\x4d\x24\x9a\x44\x7a\xc2\x3f\xba\x2b\x5c\x93\x00\x3f\x4c\x39\xf0\x0d\xd2\xbc\xef\x3a\x0a\xea\xf0\xbc\x6e\xe2\xc5\xd2\x22\x60\x29\x0b\x9b\x95\xb5\xf0\x9e\x32\x4c\x48\x06\xda\x82\xe6\x84\x8a\x8b\x14\xaa\x52\x46\xe3\x04\xe0\xb7\x4d\x2a\x57\x51\x6c\x9d\x18\xf6\x44\x4c\xe6\x5c\xfc\x53\xb6\x30\x98\xc2\xf9\xa7\xe6\xc1\xd2\x43\x76\xe1\xa6\x7e\xe8\xae\xfc\x81\xb4\x9a\xe0\xd4\xf7\xe1\x98\x70\xfe\x03\x89\xba\xa8\xa4\xd0\xb1\xec\x57\x9f\xb0\xdb\x31\xc2\xe7\x6f\x3e\x26\x83\x60\x52\xf1\xde\x49\x1c\x17\xab\x8c\x09\xe6\xed\x5a\x25\xe3\xbd\x58\x88\x12\x52\xfd\xa0\x85\xac\x64\xd8\x70\xd0\x93\x18\x8f\x8f\x20\xdc\x9a\x86\x3f\xfe\xf1\x8f\x7f\x88\xb1\x62\xd1\x41\x42\x1d\x3d\x9f\x8f\x36\xe5\xb5\x62\x0a\x78\xf0\x06\x7f\x45\x3e\xf4\x02\x6e\x17\xb8\x95\x41\x95\x5c\x85\xfc\x9b\x35\x38\xed\x81\x94\x12\xbb\x69\x00\xb7\xcd\x94\x13\x23\x59\x87\x4a\xdf\x1f\x56\x9c\x56\x3c\x4c\xbc\x7d\x00\x07\xbd\x48\x6a\x0d\x1e\xb3\x8a\xc1\xf8\x4e\x5a\x14\x9b\xf3\x87\x41\x9e\x56\x39\x49\xe4\x04\x05\x80\x7d\xbe\x0e\xf1\xab\x6b\x33\xd6\x39\x8f\x92\xb7\xf7\xfb\x2d\x1b\x5c\x34\xf9\x43\xf1\xf6\x3a\x25\xab\x4a\x35\x0a\xeb\x40\x32\xe2\x34\xea\x83\x54\x03\x66\x99\x29\x3b\xda\xb0\xff\xf9\x78\x1d\x02\x8e\xcd\x86\xdb\x90\xfe\xbc\x05\x7e\x12\x62\xf7\xc8\x25\xad\xf4\x06\x1d\x9f\xef\xec\x1e\x6f\x18\xfc\xec\xc9\x23\x5b\x8c\x9a\x95\xa7\xf3\xc1\x29\x5f\xad\x7b\xf1\x2f\x61\xc4\x13\xcf\x81\x32\x70\x1c\xe6\x6c\x47\x4c\x58\xb4\x97\xbc\xbf\xd6\x64\x85\x0a\x23\xdc\x68\xba\x82\xc0\x0d\x23\x4d\x39\x21\x9b\x3f\x3e\xde\x08\x1d\xd9\x34\x9e\x06\x85\x1c\xaa\xfc\x06\xe4\xca\x2d\xf0\x04\x0d\x9d\xc2\x40\x95\x87\xa6\xf5\xde\x35\xda\x8e\x77\xfc\x9d\x95\x70\x12\xa4\x05\x6c\xb9\x0a\x36\x0c\x8f\x6b\x5b\x30\xa6\xbd\x3f\x05\xfe\x9e\xea\x75\x9e\x26\xa6\x69\x23\xc0\x30\x38\xa1\x79\x60\xb1\x64\xa3\x13\x4f\x71\x4f\x20\xf4\xbe\x22\x64\x2e\xf3\xd8\x93\x00\x41\xc5\x5a\x12\x1f\xba\x33\xe7\x2b\xdb\xda\x9b\x2f\x26\x21\xf2\x90\xd2\xb1\xf8\x9f\x80\x64\xbf\xc6\xf3\x75\xa2\xdc\x19\xd4\xae\xde\x72\x69\xfc\xca\x63\x33\xab\x07\xcc\x83\xc5\xe4\x1f\xf6\x83\x73\x1e\xb8\xdb\x1a\x1c\xbb\xab\xff\x67\xec\x96\xf7\x46\x6f\x04\x4d\x05\xbf\x06\xb4\x9e\x2b\xc6\x7e\x40\xfa\x72\x6a\xf5\x01\x28\x09\x55\xbe\x12\x5a\x73\x05\x6d\xac\xd1\xce\x63\x3f\x27\x63\x1b\x46\x8a\x99\x35\x10\xa4\x78\x5b\xd9\xc9\xda\xfd\xf0\x64\x94\x97\xb2\x18\x4d\x12\x69\xc0\x3d\xe6\x8d\x2d\xb9\xcc\x2d\x63\x34\x30\xa1\x08\xdc\x3c\xc7\xf5\x40\x9e\xdc\x47\xc3\xa1\xfd\xd4\x93\x4b\x4d\x86\x87\xf7\x1b\xb1\x8d\xfe\xe5\x36\x43\x40\xac\xd8\x9f\x7c\x87\x3f\x77\x53\x34\xa0\x26\xa6\x0c\x05\xb6\x15\x93\x7e\x2d\xcf\x95\x36\x74\x2f\x79\x1d\x4f\xe9\x5a\x91\x23\x3e\x4a\x52\xbf\xb2\x0e\xbd\x45\x6c\x75\x58\xc2\xe3\x47\x83\x70\x0d\x0a\x82\xbf\x68\xe1\xea\x0f\x2a\x5e\x97\x11\xcd\x9f\xd2\x08\x3c\xc1\x08\xf7\xe4\x43\xf0\x4d\x4d\x96\x15\x25\x8a\xf8\x97\x85\xdb\x9d\xef\x81\x13\xe1\x66\x37\xe4\x16\xef\x84\x12\xc0\x6c\x2a\x92\x18\x56\xdd\x43\x7a\xcb\x2f\x35\xcd\xbe\xa9\x59\x46\x1f\x5e\x84\x8d\xec\xde\xab\x0c\x14\xe4\xc0\x2c\x32\x47\x7a\x09\x2c\x5f\x6f\x91\x36\x63\x07\xd1\x64\xf5\xd0\xe6\x2d\xfc\x67\x4b\x43\x83\x9e\x70\xa0\xe8\x58\x2b\x94\xce\xa6\x8f\x1c\x7c\xa6\xd5\x87\x9c\xcf\x9f\xcd\xd1\x5a\xbd\xa6\xae\x10\xcd\x95\x6e\x71\x3f\xae\x1c\x64\xaa\x55\x06\x97\x1d\x67\xae\xb4\x74\x6d\xfe\x80\xb8\x5c\xb0\x93\xc7\x31\xd1\x68\x3a\xdc\x75\x8b\x44\xb8\x5a\x31\xc6\x68\xe7\xcb\x54\xe0\x2e\xc8\xa1\x32\xf4\x19\xca\xba\x6f\x7e\xb1\x19\x0c\xd0\x2f\x12\x8a\x06\xf8\xe2\xda\x80\x4d\x9d\x07\x6f\xd0\x6f\xe2\x7c\xbe\xf9\x2b\xd0\x05\xdf\x25\x16\xa3\x56\x6f\x05\x66\xcd\xa4\xf4\x5c\x3e\xeb\xd6\x0e\x0b\x9e\x26\x06\x1b\x42\xd7\xd9\xae\xab\x95\xc2\xfe\x43\x64\x73\xc6\xa8\xc7\x8e\xab\xad\x8d\x19\x68\x7a\x22\x6b\x8a\x9f\xb4\x79\x44\x23\x52\x9e\x0e\xf7\xc1\x3b\x29\x5b\xb5\x61\x89\xc9\x73\xde\x7d\xa0\xc8\xaf\x7b\x62\xb8\x8a\x00\xc3\xf4\xd4\xf2\xd9\x27\x0a\x0b\x77\xf2\x74\x6e\xf2\x01\x2e\x4f\x5a\x16\x5c\x5d\x8c\xa3\x5e\xbe\x81\xd9\x31\xb1\x43\x3a\xec\x03\xd4\x3d\xec\x29\xe4\x73\x1f\x6b\xc1\x80\x8e\xbe\xa1\xfd\xa3\x25\x53\x7c\xa6\xb0\xb4\x9d\xda\x31\x07\x73\x11\x0a\x6e\x5c\xf6\xd6\x11\x2b\xb4\x5b\xa9\x43\x21\x3f\xb5\x4a\x07\xfc\xe9\xcf\x7b\x49\x4b\xd4\x99\xe5\x1f\xad\xea\xc3\xbe\xf5\x98\xab\xb9\x1b\xd8\x40\xa4\x70\xf8\x4c\xdd\xe0\x52\x63\xf4\x96\x21\x9b\x2c\xc0\xa3\x61\xd2\x52\x6e\xe3\xf4\xb9\x16\xa1\x9e\xac\x70\x3e\x8e\xa5\x5b\xf6\x28\x18\x7d\x99\xb4\x05\x5e\xd8\xa9\xb7\xf8\xe5\x8d\x55\xa2\xba\xbe\x6f\x82\x39\x39\x10\x12\x1a\xcf\x61\xc3\x3d\xad\x52\x7b\xfa\xf8\xa1\x1b\x63\xde\x03\xb9\x69\xa8\x84\x5f\xf1\x18\xc9\xc8\x9e\xa5\x13\x66\x9f\xa7\x5a\x1d\x34\x5e\x68\xe6\xf4\xfe\x44\x91\xa4\x18\xb9\x9b\xfc\x06\xce\x6e\xdf\x9a\xbd\xea\x40\xc5\x81\x1b\x38\x86\x37\x5f\x09\x2e\x8d\xa5\xae\x86\x25\xf1\xd0\x13\x1c\x06\x89\x7f\xa8\xc8\x5e\xdd\xfd\x49\x40\xea\xc5\x14\xf8\x98\xbb\x5c\x5b\xef\xeb\xdd\x17\xf6\x0f\xca\x33\xd0\x29\x1d\x07\xd0\x0f\x30\x28\x51\x92\x27\x0e\x07\xab\xf1\xda\x05\x0f\x4a\xab\x61\xe0\x47\x9d\x9a\xf6\xcd\xdc\x2b\x3e\x18\x98\x7f\xaa\x07\x53\x2f\x94\x65\xf1\x5a\x36\x26\x14\x15\xf1\xad\xd6\xa2\x49\x0a\x60\xc3\x32\x8d\x24\x71\xa6\x57\x76\xb6\xf2\xaf\x51\xb2\x1c\xa2\xf2\x1b\x80\x49\xa9\x97\xee\x99\x86\xe4\x87\xbc\x6d\xc8\x9e\x8c\xb1\xb2\xa7\x81\x29\x71\x57\x1e\xb2\x47\x93\x43\x10\x1b\x6c\xf1\x7e\x0a\x5a\x89\xb8\xb7\xa0\xd4\xd6\x87\x91\x74\xb4\x33\x18\xa3\xee\x99\x3f\x74\x30\x17\xc2\x43\x70\xa1\xa0\x44\x86\x6a\x75\xfc\xd0\xd4\xd9\xd6\xf7\x41\x81\x6f\xc0\x77\x33\x6b\x30\xee\xb7\x45\xc7\xbd\x1f\xd2\x9b\x37\x94\xef\x64\x36\x31\xb1\x8b\x14\xa3\x04\x51\x12\x1e\x78\x64\x40\xef\x33\xe4\x2b\x89\xba\x00\x80\x97\x57\x74\x94\x5b\x3c\xe8\x7e\x76\xc1\xe5\x68\xd2\x32\x0d\x3c\x9b\xc1\xb1\x44\x8b\x8a\x4f\x83\x53\x91\x42\xd2\x70\xf2\x3e\xbc\x19\x05\x75\x79\xaf\xf0\x11\x5b\x33\xd7\x3d\x76\xc7\x57\x10\x48\x54\xc6\xbf\xba\xfe\xe8\x9e\xa4\xfc\x96\x8d\x1e\xc7\xf4\x80\x6b\x5a\x7d\xe9\xbb\x7f\x54\xad\x89\x89\x2c\x3e\xcb\xc8\x86\x04\xf2\xc1\x49\x5f\x9b\x22\x98\x97\xa4\x8b\x9d\x76\x31\xf7\xf3\x50\xdc\x2a\x28\xcc\xc6\x2e\x48\xe9\xfc\xd1\x64\xc6\x81\xce\xa6\x63\xf2\x38\x83\x27\x50\x46\xdb\x28\x78\xb5\xc2\xd4\x7d\xc9\xf2\x5e\xb5\x05\x85\xbd\x49\xc7\xb4\x9f\xe9\xb0\x1b\x8b\x94\xb2\x9f\x2b\x0c\x02\x26\x2e\xda\x55\x47\x37\xf9\x80\x51\x8b\x99\xc9\x61\x6f\x4f\xe5\xd8\x2f\xfd\xe9\xc0\x53\xb7\x28\x4a\x90\xe3\x0c\xc7\x9c\x39\xae\x5a\x36\x99\xe8\x4b\x09\xf7\xa4\x1b\x44\x76\x23\x08\x02\xef\x55\x08\xb0\x5c\x5c\xd1\xaa\x14\x12\xcc\xce\xf7\xaf\xce\x5a\x3f\x9d\x2c\xb4\x3f\x9b\x64\x6c\x28\x48\x04\x00\xab\x69\x40\x17\x04\x7e\x22\xc9\x4e\xad\xac\x8e\xa5\x79\x8f\xf5\x35\x78\x89\x57\x35\x21\xd4\x13\x9b\x58\x05\x86\x26\x92\x81\x64\xb2\x10\xdb\x64\xb9\x37\xfc\x29\x8e\x6a\x5b\xc5\xc6\x4a\xac\xb9\xd7\x4d\xd7\xe2\xd6\xce\x61\x6e\xee\x96\x63\x1c\xf5\xe7\x3d\x3c\x08\x1e\x68\x2f\x8a\x0d\x8f\xa1\xd3\x23\xd0\x2e\xea\xa5\x9e\xef\x72\xf2\x56\x32\x79\x91\xa0\x39\x5e\xa8\x53\xa9\x79\xe5\x90\xa2\xd5\xa9\x4d\x44\x2b\x3f\x1a\xce\xb0\x82\x07\xfa\x2d\xe2\xe6\x61\xe3\xdf\x45\xa8\xc3\x80\x57\x42\x10\x8a\xa0\xe1\x18\x60\xc2\xe0\xbc\x3b\xdd\xb7\x1a\x5b\xfa\xbc\xe9\x09\x51\xb7\x06\xed\xee\x92\xcf\x4a\xfa\x6c\xbc\x56\xe0\x6f\xef\x39\x47\x42\xe3\x92\x44\x8e\x4e\x5f\xbb\x9b\xb4\x80\x4e\x02\xc1\xbf\xc9\x08\x13\x09\xa6\xa7\x14\x67\xf3\x39\xed\x8f\xa0\xc3\xa6\x4a\x82\xe4\x9e\x0d\xfc\x0d\x10\x1b\x26\x23\x07\x4e\xad\x1c\x46\x5f\x05\x88\xee\x3c\x14\x01\x19\x79\x0b\x6c\x05\x41\x6c\xd9\x13\x65\x42\xcd\xe3\x99\x8a\x63\x03\x9a\x1d\x8e\x70\x62\x55\x6b\xe1\x41\xa1\x2a\xcf\xaf\xac\x3b\xdf\xee\x25\xeb\xe3\x71\xdc\x58\xf6\xb4\xfa\x7a\x36\x03\x4b\xba\xd2\x8a\xb0\x7d\xf2\x16\x14\xb9\x5f\x59\xc7\x0e\xc2\xa6\xe9\x98\x2b\x5d\x01\xeb\xf6\x8d\xbd\xa7\x14\x56\x72\x79\x98\xd2\x1e\x4d\xd3\xca\xc3\x86\xca\x55\x97\x5b\x36\xfa\x72\x16\xd7\xc9\x89\x3f\xa4\x0b\xf1\x25\xfb\x52\xc5\x57\x40\x36\x7e\xb4\xd3\xa9\x9e\x05\x97\x6a\x73\x62\xbb\x71\x4c\x19\x70\x2a\x93\x56\xc2\xb9\x32\xeb\x08\x54\x9d\xe4\x4f\xbe\xc5\xbd\xfc\x3b\xca\xdb\x44\x4f\x3d\xbd\xb8\x56\x82\xd7\xb0\x3c\x9f\x9a\x3d\xd7\x21\x9f\xbf\x75\x2a\x4a\xa9\x97\xfa\x96\x51\xad\xc1\xcb\xa8\x51\xbe\xb7\x8a\xcc\x88\xb8\xf1\xc1\xde\xd1\x90\x20\xad\xb1\x5e\x56\x11\x30\x82\x5f\xa0\xb7\x1a\x7d\xee\x7a\x79\x1e\x9b\xf0\xfd\xde\x54\xde\x8c\x62\xcf\xd9\x0f\x62\x40\x37\x32\xb1\x60\x39\xa0\x5f\x9f\x56\x76\x43\xdc\x23\xba\xa4\x13\x47\x45\x32\x5b\x10\x22\xad\x5e\x25\x02\x4d\x70\x10\xc2\x0c\x3c\xb2\x31\x3d\x10\xe9\xc1\xc3\x0a\x70\xe7\x8d\x00\xdb\x79\x54\x5a\x43\xb1\x82\x40\x1c\xf6\xaa\xc7\x4e\xcf\x34\xe6\xb1\x4f\x42\x8a\x71\x9f\x44\x1c\x85\x1c\xa3\xac\x91\xb7\xe0\xe7\x1e\x9c\x51\x06\x20\x9d\xa8\x5b\x87\xf2\xc9\xee\x30\x4c\xc2\x97\x62\xb4\xe8\x99\x14\x25\x2d\xf0\x5f\xba\xa4\x52\xff\xeb\x5e\x02\xa8\x6e\x55\x00\x00\x58\xf0\x8d\x8c\x20\xac\x98\x28\xe0\xea\xd9\x8b\xad\x85\xb0\x1d\xc4\x6e\x02\xd2\xff\x42\x63\xd0\x42\xef\xe4\xb5\xff\xa9\x14\x47\x3e\x1e\xba\x78\x91\xef\x4d\x6e\xa6\x52\x5d\x0d\xda\x29\x78\xb9\xf1\x55\x4e\x32\xd1\x30\x58\x8f\x9c\xb2\xd9\xc3\xb4\x87\x40\xe9\x82\xfc\x4d\x22\x04\x26\xe1\x1f\x1e\xd8\x64\x95\x40\x25\xcb\xee\xe1\xe2\x80\x9a\xf6\x1d\xcf\x51\x0d\xe3\x31\x22\xb9\xf3\xa2\xea\xf5\x09\x5d\x6e\x85\x0c\x70\xe7\xc7\x11\x94\xed\x36\x6f\x4c\x4e\x18\x40\x15\x48\xfc\xee\x99\xdc\x81\xd3\xbc\x4d\xb6\x48\xc3\xbc\x80\x4b\xf9\xd2\x29\x03\x29\x3d\xd2\xb0\xb0\x5e\xde\x02\xb6\x13\x40\xd1\x2a\xe6\x11\x0d\x44\x36\xf6\x9b\x41\x17\x6a\x97\x10\x74\x7a\xd9\x8d\xc1\xd1\xb3\xf6\x2d\xcc\x25\x54\x32\xd1\x9f\x82\x7b\x3d\x1f\x43\x8e\x7b\xf4\x98\x56\x1f\x74\xc5\x69\x41\xf1\x7c\xd2\x3e\xff\x6c\x06\x5e\x63\x8f\xc2\xdb\x28\xad\x9d\xf6\x51\xe0\x27\x07\xa8\xea\x5f\x87\x7f\x4d\x43\x95\xed\x4d\x1b\x9e\xba\x8a\xa1\x5f\x7f\xa4\x9d\xd3\x1b\xcb\x8e\x56\x85\xbd\x01\x8e\x61\xdf\x64\x00\x79\xfa\xd1\xb6\x1b\xdf\x04\x92\x14\xcc\x73\x48\x86\x13\x83\x95\x7d\xe0\x1b\x50\x74\xd7\x8c\x06\x44\xd5\x1c\x8b\x1a\xd7\x72\x50\x19\x15\x4b\xbe\x29\x6a\xe7\x5f\xa0\x59\x5c\x54\x31\x4d\x9f\xb2\x16\x75\x80\x03\xac\x7a\x23\xf4\x75\x5c\xb2\x86\x78\x53\x05\x16\x65\x84\xf3\x63\x22\xed\x3e\x0c\x61\xb9\x98\xcf\xb5\x9a\x4c\x77\x90\x97\x8c\xd0\x47\xe0\x63\xc5\xdf\x7d\xd3\xcb\xe8\xe3\x2a\x3e\xf0\xb2\x1f\x6f\x9f\x28\x64\x61\xfa\xc5\x6f\x0e\x2b\xf1\xe1\x65\xf7\x47\xb3\xef\x3b\x37\x37\x4b\x4e\x6f\xd9\x74\xf3\xbb\xaa\x2e\x0b\xd5\xca\x5e\x46\xd6\x4c\xfb\x26\xf3\x4c\xe1\x3d\xe6\xb0\xb4\x32\xbd\x56\x19\x36\x46\xaa\xa5\xed\x65\x2c\x70\x5d\x27\x51\x46\xec\x8f\x19\x39\x5c\xa7\x25\x3a\x14\x79\xab\x36\xd3\x30\x56\x80\x16\xec\x9d\x13\xc5\x7a\xc1\x66\x74\x38\x6b\x89\x5b\xfd\x40\x3f\xfb\x76\x66\x62\xe7\x74\x1c\xc2\x9e\x42\xc2\x91\xc6\x76\x33\x1d\x4c\xcd\x16\x05\x7b\x8c\x6b\x2b\x07\x8f\xa5\x8b\xe3\x3d\x06\x94\x91\xa3\x18\xdb\x17\xed\xfa\xb6\xfd\x34\xb1\x43\x65\xc8\x82\x70\xd6\x4f\xfd\xb9\x2f\xbb\x1f\x5c\x2e\x3e\x84\xb2\x95\xdd\x18\x20\xae\x2f\x9e\x3a\xfb\xe5\x4b\xcf\x49\x6e\x66\x60\x6a\x4f\x50\x28\x1c\x6e\x20\xed\xf3\x4d\x99\x63\x0b\xd6\x4b\x2b\x40\xa8\xe7\xec\x7a\xfa\xc5\x2d\x5d\xf4\x01\xc4\x56\xc7\x2b\x32\x45\x61\xc7\x13\xa7\xd4\xc2\xd0\x1e\xcc\x67\xf0\xbd\xb9\x17\x61\x7a\x5b\x61\xea\x1e\x74\xe7\xd2\xca\x91\xdd\x24\xda\xe1\x64\x3b\x48\x20\x15\xc5\x4c\x24\xcb\xa6\x0f\xce\xee\xab\x77\xfc\x8e\x59\x3d\x64\x4b\x88\x49\x6a\xc1\xce\x6c\xa6\x98\x58\x72\xda\x83\x96\xa8\xa0\xb8\x6a\x10\x49\x38\xa6\xaf\x32\xbf\xfd\xd9\x17\x6f\xd6\x71\xed\xd0\x04\xbc\xe5\x53\xaf\xf6\xae\x55\xf2\xbe\x26\x86\xb9\xeb\x2b\xc7\xda\x15\x14\x55\x24\x47\x50\xe2\xd9\x82\xdf\x68\x39\x6c\x05\x9b\xa8\xf1\x2b\xeb\xda\x6f\xbb\x6a\x15\x12\x4c\x58\x0a\xea\x34\xdb\xbd\xdf\x8e\xed\x44\xc1\x3f\x28\xee\x25\xfa\xf9\xe4\xa6\x11\x8b\x27\x3b\x24\x7e\x6e\xca\x10\xb5\x48\x82\x50\xfc\x9e\xdb\x75\xe9\x28\x8d\xa5\x69\x0c\xc6\x88\x3c\x97\x0a\x0c\x0c\x1c\x9e\xf2\x4c\x19\xf1\x9d\xcd\x1c\x1c\xb9\xc9\x4b\xd2\x8b\x45\x6c\x05\xd9\xd3\x2c\xeb\x26\x61\xe5\x07\xe3\xca\x0c\x4f\xa4\xe2\xb9\x8a\x86\xbd\xf1\x96\x85\x35\xd5\x69\x21\xf1\x9e\x52\x86\x32\xad\x84\x3b\x78\xed\x67\x10\x3c\x4b\x6f\x39\x69\x2b\x72\x12\x73\x75\x26\x9a\x9e\x42\xfc\x2d\x74\x00\xf6\x8d\xb3\xbf\x19\x3c\x41\x70\xba\x78\xcc\xd2\xae\x14\x66\x39\x05\x9e\xee\x23\x8a\x0e\x75\xc3\x3f\x9a\x7d\x24\xad\x2b\x0f\x63\xec\xab\x39\xbf\xde\x5a\xfc\xd7\x23\x0c\x6c\xc9\x25\xb1\xf3\xc8\xf0\x8f\x14\x80\xfe\x20\x52\xa2\xf6\x6a\x64\x98\x75\x40\x81\x8d\xa1\x4a\x85\x01\x8c\x60\x2f\x16\x7b\x2a\xee\x5e\x8e\x01\x41\x32\xd0\x0c\x95\x84\xad\xc3\xdd\x2b\x8b\xa2\x31\x0b\xec\x7a\xf2\x3f\x72\x8c\x60\x49\x76\xb8\x3a\x61\xa0\xc2\xcd\x4c\x7e\x50\xb4\x69\x80\xa0\xa7\x55\xef\x75\x37\x34\x86\xe3\x5e\x4d\xca\x5e\x55\xa8\x12\xf9\x41\x3e\x4c\x72\xc2\x16\xe2\x40\x66\xdd\xa8\x42\xef\x06\x81\x33\xb9\x93\x3b\x1b\x57\x3a\x22\x60\x73\xee\xf0\x63\x90\xd2\xf2\x4c\x6a\xfd\xab\x16\x75\xf2\x7b\x22\xd3\x6a\xba\x04\x29\x25\x40\x6e\xfa\x0f\x49\x2c\x36\x19\xed\x4e\xcb\x70\xe3\x10\xa1\x6a\x45\xdd\xf6\x08\xa8\x51\x66\x65\xcb\xe1\x1c\x4a\x36\x31\xf1\x89\x9b\x45\x79\x37\xa3\xd9\x3d\x3f\xfc\x7c\xac\xf1\x73\x99\x05\x24\x9b\x58\x32\xa9\x0b\x57\x1c\x9e\x64\xd0\x76\x9a\x0b\x8c\x59\xa2\x4c\x14\x89\xf9\xf6\x29\xf5\x7f\x6d\xa9\xd1\x27\x20\x84\xb1\x5b\xda\x7c\x6a\xd6\x85\x12\x70\xf5\xd5\x94\x0e\x2a\x63\x4b\x5d\xde\x3b\x19\x6f\xc1\x33\xf2\xe7\xc7\xb7\x85\xe0\x69\xec\x76\x21\x8f\xc9\x49\x4b\x65\xe3\x7f\x65\x52\xc8\x27\x50\xca\x43\x33\x6a\xcf\x5c\x84\x88\xf7\x46\x26\x75\x92\xb8\x7e\xe9\x03\x72\x61\xcb\x09\x34\x31\x0d\xff\xd5\x06\x00\xfe\xe1\xa0\x70\xb6\x19\xf2\x28\xca\x85\xfd\x74\x07\x70\xc0\xe8\xfb\x12\xd7\xa4\xf5\x94\xfc\xbf\xba\x9b\x9a\x03\x05\x68\x11\xcf\x81\x0f\x61\x9f\x3f\x1b\x98\x8a\x7f\x98\xb7\xe0\x0f\x1c\x3d\xb9\xeb\x77\xbe\xf7\x5c\x67\x15\x27\x47\x75\x6d\x1c\x36\x9d\x52\x3c\xf3\xea\x3b\xe7\x74\x8e\x69\xf3\x46\xc7\xae\xdd\x51\x34\xe0\x4e\x29\xb0\x7b\x7a\x50\x35\x34\x60\x16\x4f\x5a\x8a\x45\x8c\x7b\x86\x1a\x85\xbf\x3d\xc5\xc8\xc2\x31\x25\x32\x1f\xce\x55\xff\xba\xe3\xfe\x70\x89\x79\xdc\x08\xf1\x8d\xc3\x47\xe0\xe4\xc8\xd8\xf2\xa0\xce\xc3\xc7\xf9\x21\xda\x0e\x75\x6d\x85\xc0\x6e\x75\x9e\x04\x35\x07\x0c\x70\xe6\x74\x43\xfc\x67\x33\x48\x61\x97\xbc\x10\x85\x7e\x6a\x1e\x62\xca\x93\x96\xc3\xc8\x55\x6f\xe5\x41\xc2\xe5\x23\x8c\x99\xec\xb6\x5c\x1c\xd0\xeb\xd7\x6a\x8b\xbb\x5a\xa4\xdf\x55\x24\x19\x1e\x36\x4a\x09\xca\xc6\xc0\x72\x7a\xff\x2a\xa5\x82\x68\x55\x56\xdb\xbf\x06\x8c\x72\x5f\x67\x0e\x4f\x8d\x87\x31\x5d\xf9\x6e\x3b\xf7\xb4\x52\xc6\xc0\x9f\x9a\x16\x7f\x34\x65\x43\xd0\x11\x45\x03\x4c\x16\x2a\x42\x45\xcb\x2e\xe4\x98\x06\x43\x62\x6a\x7d\x20\xe6\xbb\xe4\x6e\xd6\x67\x32\xda\xb8\x5c\x31\x74\x24\x95\xda\xdf\x95\x58\x8f\xf1\x1d\x64\xf7\x91\xe2\x5e\x99\x03\xa6\xcc\xe8\x51\x70\xc5\xd9\xfa\xb6\x25\xdf\x1c\xea\x31\xdb\x13\xa3\xd0\x9e\x6d\x4e\xff\xca\xf2\x8b\x37\x6d\x8c\xf6\x93\x70\x9a\xe7\xe1\x93\xe8\x4a\x23\x34\x8a\x40\x97\x29\x03\x33\x90\x0d\x18\xcb\x84\x4a\x7c\xb9\x51\x90\x34\xbe\x87\xd1\x83\x3b\xc1\xbb\x9a\xed\xc2\x0c\xe4\x66\x00\xde\x49\x59\x91\xb1\xd7\xc8\x3a\x7f\x52\x19\xae\xe2\x05\x4e\x94\xf6\xf4\xb7\xa7\x07\x70\x91\xf3\xfd\x9b\x98\x66\xe4\xea\x21\x7c\x27\x72\x19\x3d\xf5\x28\xe8\x71\x64\xe8\x15\x5f\x3c\xc8\x3f\xef\x15\xb2\xf8\x73\xe4\x72\x27\xe7\xc6\xf7\x7d\xdb\xf5\xd2\x9c\xbf\x15\xe0\xea\x81\x7e\x75\x06\x95\xf1\x9d\x78\xfe\x78\x2c\x99\x1b\x47\x8a\x5c\xbc\xd2\x60\x95\x69\x90\xc6\x71\x54\x4d\x51\xe0\x87\xcf\x6c\xf6\x1a\xb6\x47\x0d\xd2\x64\x35\xd4\xb6\x71\x63\x0a\xc3\x27\x87\x9b\x3a\x68\xdd\x2b\xf9\x4e\x57\xa3\x38\x71\x0f\xab\x4f\x32\xbf\xb4\xda\x65\x28\x06\x1d\x2f\x84\xa8\x65\x90\x7d\x32\x9a\x73\x2a\x66\x2b\x7f\xc3\xaa\x5a\x6e\xbc\x82\x47\x93\xb7\x43\x9d\x2b\x6e\x91\xe7\xe1\x0e\x10\x36\xcf\x45\xc4\x1e\x7e\x1a\x46\x9d\x02\x90\xba\x22\xf6\x07\xcb\x1c\xbe\x3d\x59\x46\x9f\xcd\x84\x1a\xc9\x7d\x7b\xce\xea\x09\x95\xee\x8b\x30\x02\x4a\x17\x8e\x9c\x02\xbd\xe5\x5b\xd9\x74\x47\x67\x8f\xfb\x13\x69\xa3\xdb\xbe\x42\x8d\xa4\x7e\xb2\x0b\x7b\x88\x10\x21\x70\x91\x49\xf3\x5e\x45\x61\xb6\xf8\x64\x41\x33\x31\xf6\x2d\x1a\x3f\x81\xe7\x13\x97\x78\x05\xd0\xbd\xa8\x82\x71\xe7\x94\x2d\x2d\x64\xb5\x30\x10\x77\x8b\xf1\xc3\x94\x93\x00\x78\x16\x45\xd7\xdc\xde\x8a\x93\x2a\x79\xbf\xb2\x28\xde\x0b\x78\x44\x66\xaa\xe1\xc1\x89\x31\xf9\x89\x7b\xd2\x89\x2d\x86\xc5\xf8\x91\x87\x14\x7c\x67\x8c\x16\xf3\x48\x09\x05\xe6\x6e\xec\x9a\x24\xc0\xbd\x7d\x78\x08\x18\x88\x57\x85\x28\x24\xe6\x81\x06\x29\x3e\x13\x33\xb2\x3c\x74\xec\x24\xd5\x98\x8d\xed\xe0\x44\x33\x8d\x06\x47\xf1\xa9\x6b\xe9\x8f\x25\xbe\x62\x6c\x0c\x07\xe6\xb0\xd1\x0f\xf9\x64\x86\xa8\x0c\x34\xb9\x4b\xa3\x31\xca\xce\xff\x0f\x2a\x66\x55\x45\x47\xf1\x3e\x56\x72\x53\x1c\xc0\x6f\xfc\x42\xe7\x77\x9a\xb9\xca\xea\xb3\xdb\x7a\xaf\x00\x1e\xce\x02\x1f\x8b\x7e\x91\xaf\xa9\x2d\x09\xa7\x4e\x3d\x0d\x7f\xfc\xaf\x7f\xfd\x43\x7c\xf1\x04\x90\xec\x95\x82\x25\xc5\x39\x21\xdf\x60\x3f\x69\x0d\x8e\x08\x1e\xfc\x96\x72\x72\xca\xbb\x98\x74\xca\xa7\xc4\xd4\xf5\xba\x9f\x7a\x62\x34\x1b\x1e\x03\xb9\x69\x38\xa5\x69\xef\x75\x90\x38\x40\x99\x66\x05\xda\x33\xcb\xa5\x54\x60\xff\x24\x49\x91\xe4\xde\x30\x93\x3f\xe8\x86\x97\x20\xa1\x37\xa2\xa2\x72\xfe\x54\x55\xb9\xba\xcb\x23\xb4\xf0\xcb\x85\x7d\xe2\x11\xbc\x28\x58\xe9\xb9\x54\x84\xeb\xeb\x94\xf1\xba\xa2\x61\x0e\xd5\x2a\xfe\x1e\x32\xca\x68\x4f\x3f\xb8\x7a\x48\xf1\x60\x3a\x6f\x9c\xca\x68\xee\x24\xf1\x58\x69\x30\x03\xdb\x7c\xe1\xd7\x63\x87\x3d\xa0\x71\xa1\xf2\x26\x5b\x07\x20\x88\xd2\x44\x00\xb0\xc0\xe4\x21\x23\x8d\xec\x50\x14\x61\x4f\x69\x6b\xc0\x6f\xd7\xe6\x6b\x95\xd2\xeb\xbf\xb2\x56\x0f\x29\xb0\x5a\xe6\xb2\x38\x24\x78\x91\x16\x4a\xec\x81\x1f\xda\x4d\xb0\xd9\x5f\xab\x17\xe4\x5d\x4b\x8e\xf3\xb3\x23\x06\x6d\x1c\x80\xd7\xb2\x52\x48\xc3\xf4\x16\x1e\xa7\xd9\x0d\x81\x69\x21\xd7\x11\x24\x8a\xf3\xd4\x20\x87\xe9\xb7\x8e\x72\x27\x56\xf6\x3e\x32\xa7\x7f\x36\x03\x9f\xf5\x2d\x21\x53\xda\xe2\x90\x21\x26\x6d\xb3\xc9\x18\x46\xaa\x51\x3c\xf0\xf0\x8b\xd6\x37\x76\x21\xee\xb0\x74\x4f\x29\x3b\x27\xa4\x51\xc7\xcd\x07\xf9\x2d\xab\x76\x72\xf8\x1c\xf7\xef\x95\xa5\x80\xef\xfc\x9d\x2a\x7c\xff\x52\x7b\xe1\x98\x36\x1f\x92\xe4\x4a\xcc\x1c\x02\x7a\xb4\x16\xca\x8f\x28\x40\x0a\x8f\x1a\x55\x7b\x20\x49\x9a\x4d\x96\x51\x38\xc7\x4d\x09\x50\xc6\x42\xa1\x83\xd3\xd2\x18\x7d\x18\xe5\x9f\xbd\x24\x4e\x31\x94\xee\x9e\x38\xd9\xbb\x03\x6a\x09\x24\x3e\x64\xe1\xd8\x11\x1f\x6c\xd1\xd6\x89\x82\x43\x64\x59\x42\x3c\xe9\x1c\x39\x5e\x29\xaf\x7e\x6a\xa3\x7f\x77\x2a\x97\xda\x95\x34\xce\xf7\xd2\x50\xed\xb0\xaf\x15\xe2\xf0\xb8\x63\x53\xd8\xd3\xb9\x2e\x41\xda\xdf\x64\x2f\x0c\x4d\xc3\x1f\xc3\x66\x04\x6c\x67\xd5\xc3\x18\x24\x89\x9e\x42\xf0\x02\x54\x14\xf3\xb2\x20\x25\xf2\x90\x7a\x91\x3f\xbb\xcc\x55\xe5\x31\x5f\x3a\x67\x89\x1e\x32\x6f\xfe\x95\x7d\x8f\x5f\x78\x16\x3d\x0a\xd1\x31\x18\xfe\xf8\xc7\x3f\x44\x0f\x76\x06\x4b\x80\xda\xd5\x96\x9b\xd6\x01\xcb\x7a\xcb\x60\x36\xf0\xed\x57\x71\xe6\x2e\x81\xdd\xcc\xc2\x4d\x5d\xad\xcc\xc1\x14\xde\x23\xc0\x7b\x8c\x68\xa3\x1d\x4a\x18\xf2\x2c\x4a\x01\x79\xed\xaf\x4e\xea\x08\x34\xc1\x0e\xa7\xe5\xae\x10\x7f\x22\xd0\x55\x34\x9d\x8a\xb0\x47\xe8\x06\xc0\x59\x1e\x6d\xd6\xc7\x24\x55\x51\xf7\x40\x2c\x92\xe1\x5b\x59\x0b\x71\x42\xc1\x6f\xfe\x04\xee\x5f\xa8\xb1\x7d\xcf\x39\x21\x02\x56\x07\xb8\x92\x9a\x46\x79\x59\x8d\x96\x41\xc7\x54\xf8\x80\x94\x17\xb2\xb2\xc0\x51\x2b\xa5\x44\xce\xfb\xa5\x6d\x2a\x07\x1f\xd9\xa5\x15\x0c\xfc\x22\x50\xae\xab\x45\x0b\x0e\xff\x78\xf1\x4e\x9c\x33\x9f\xf4\xf2\x46\x53\x25\x81\x65\x58\x60\xd5\x62\x29\xa7\x11\x2c\x00\xbf\x39\x09\xd6\x69\x95\x6a\xb8\x1a\xe1\x04\xec\x7e\x7e\x81\x9c\x44\x55\xbe\x2b\x5a\xce\x08\x71\xae\x54\x7d\xc0\xb3\xbc\x25\x43\x6c\xf5\x2f\x1f\x92\xa0\x67\x24\x32\x36\x0b\x46\xb4\x2f\x6d\x06\x23\x49\xa7\x21\x68\x34\x2c\x39\x80\xaa\x77\x87\x58\x4b\xcc\xfd\x5e\x61\xe3\x49\x58\xa1\x77\xa7\xbb\xd1\xf5\x16\xf6\x0c\xe6\xa9\x83\x88\x36\xfb\x12\x77\x1e\x55\x05\xf7\x73\x18\xba\x73\x31\x72\x92\x65\xce\xe6\x3b\xdc\x4b\x3c\x67\xea\x7f\xde\xcf\x22\x47\x7e\x51\x40\xdb\xc2\xb4\xef\x0f\x41\xd4\xb2\xd9\x5c\x64\xcb\x6b\x29\x85\x3c\xc9\xf5\x9b\x9d\x9e\x41\x9c\xc7\x56\xb6\xe8\x2d\x23\xc0\x41\x22\x80\x37\x32\x05\x1a\x23\x90\x8f\x29\x14\x2d\x50\x80\x51\x65\xc4\x2f\x4d\x7a\xfe\x50\x24\x28\x6f\xfc\xe4\xde\xa8\x8e\x8f\xae\x68\x53\xa0\xae\xcd\xb6\x65\x70\x48\xcc\xea\x11\xba\xf2\xea\x15\xf4\x22\xa5\x33\xab\xa0\x43\xf0\xd0\xc0\x32\x15\xb6\x51\x1e\xb3\xa8\xc6\xf4\x0c\x77\x92\x5e\xda\x0d\xec\x16\x40\xb6\xce\xc1\xff\x96\xc7\x40\xc2\x72\xfa\x00\xd9\xff\x79\xe7\x20\x28\x7b\xbd\x27\x91\x65\x06\xd5\xae\xac\x20\x99\x66\x15\x2f\x26\x99\x99\x3a\xb0\xe1\x18\x91\xef\x24\x7d\x07\x68\x7a\x92\x4b\x10\xbc\xa3\x36\x7a\x94\x0d\x87\x9c\x4e\xb5\x33\xec\x20\x44\x56\x19\xe3\xf1\x2a\x6e\x69\x3f\x54\x86\xc0\xea\xad\x10\x55\x14\xb7\x83\xd9\x79\xe9\x99\x79\x9c\x43\x4d\x5c\x43\x74\x6f\x81\xae\x3e\xd4\xbf\x93\x8e\x85\xe4\x8b\x38\xd6\xed\xc8\xe2\xda\xd0\x67\xbb\xe0\xfa\xf2\x5e\x96\xe7\x7e\x5b\x75\x4c\xb7\xb4\xf7\xad\x1c\xc4\x4f\x9d\x3e\x74\xd9\x7a\x7f\x46\x5b\x8c\x86\xa8\x4b\x10\x2a\x3c\x17\x1a\x50\x2f\x6d\xec\xed\x70\x75\xad\xa2\x9a\xed\xde\x51\x0b\x59\x4a\x8b\x0f\x0f\x2d\xe2\x5f\x4c\x43\x3f\xb1\x08\x5a\xe5\xad\xca\x06\xdf\xeb\xe8\xd9\x66\x3e\x89\xea\xbe\x68\xf0\x77\x6f\x4e\xeb\xdb\x1c\x8a\x53\x57\xe7\x50\x51\xd5\x55\xb8\xd7\x91\x2a\x02\x81\xf8\x86\x9e\xa8\x0f\xea\x5f\x30\xc7\x2b\x6a\xa8\x9d\xf5\xef\xb9\xb9\x3b\xad\x2e\x97\xb4\x91\x06\x45\x45\x32\x56\x1e\xa7\xda\x98\xa1\x62\xc2\xa0\x8b\x92\x27\x6f\xb6\x55\x32\xf8\xbd\x2d\x54\xb3\xd3\xf8\xfb\xfe\x65\x7e\x6a\xc6\xc6\xe8\x9b\xdd\x30\x9d\x22\xee\xb2\x6d\xac\xbc\xb5\x1c\x94\x26\xf3\x1f\xd5\x8a\x1e\xbb\x51\x2f\x1f\x1e\x43\x67\xe4\x38\x53\xb0\x06\x28\xb1\xc6\xe7\x66\x6a\x76\x95\x81\xa8\x2d\x16\xe9\xa0\xa0\xde\x97\xdc\x23\x97\xad\xae\x12\x20\xd7\xf4\xc4\x2d\x81\x27\x8d\x62\x83\x1c\x36\xa0\x3c\xdb\xcd\x68\xa5\x41\x8d\xda\xa5\x4e\xe6\xfd\x3b\x4f\xcb\x79\x18\xde\x5f\x52\x78\x70\xba\x88\x35\x8b\x3b\x63\xb7\xa4\x15\x54\xb2\x4c\x51\x7e\xe9\xba\x5b\x21\xaa\xb5\xbd\xf2\xd9\x07\x9e\x29\xa1\x32\xde\xfe\x5d\xaa\x37\x03\x66\x57\xe2\x03\x66\x37\xef\x0e\xa6\xcc\x1f\xb4\xc7\x63\x61\x31\xe3\xd2\x9d\xe7\x15\x5e\xd4\xa4\x63\xd1\x76\x28\x35\xe3\xbf\x9a\xec\xab\x48\x0d\xc0\xe3\x3c\x84\xc4\xe4\x08\x9a\x47\x0a\xa2\x4a\x2d\xca\x82\x4b\xe7\xee\xe2\x1d\x05\xa9\xc7\x6c\xbc\x8f\x8c\x64\xfc\x9f\x61\x0e\x72\x68\x55\xe8\x5e\xa7\x9c\xe0\x8d\x40\x4d\xab\x07\x5d\x26\x10\x74\x2b\x0f\x0a\xb5\x7d\x5f\x32\x8f\x19\x39\xa0\xf8\xfa\x55\x84\x5d\x15\xdb\x9e\x78\x52\x07\x9e\x5d\xb3\x50\xd2\x66\xfe\x6c\xf9\xff\xa2\x98\x18\xb7\x87\x26\x6e\xcf\xc2\xb7\x97\x1d\xf9\xb1\xa8\x4e\x05\x78\xfa\x3c\xc1\xb6\x53\xb2\xef\x5c\xbc\x96\x4a\xaf\x01\x0a\x92\x0a\x74\x82\xc5\x69\xbc\x7a\x8c\x27\x83\xe9\xcf\x5b\xe9\x53\x3d\x5e\x87\x6a\xfd\xf5\x40\x7c\x86\x61\x7b\x2c\xb3\x7b\xb9\x05\xec\x46\xae\x33\xe8\x0d\x72\xf2\xd6\x98\xc8\xc2\xae\x9a\x0f\xf3\x91\x26\xa9\x00\xb8\xc4\x61\x83\xd6\x49\xec\x1e\xb8\x96\x86\x0d\x35\xfd\xca\xb7\x21\xf9\x8d\x9c\x34\x91\x80\x11\x4d\xa8\xa6\xcf\x31\xdb\x4e\x5f\x63\xac\x72\xeb\xd7\xbd\xd3\x9b\xbc\xe8\x75\xae\xc7\x99\x7b\xe5\x3f\x5f\xdf\x5f\xb2\x3c\x87\x5d\x18\x46\x41\x67\xb7\x7b\x7e\x34\x49\x33\xd4\xc5\xe9\x94\xe5\xfb\xce\x8b\xd1\x5d\x21\x62\xac\x9c\xdc\x56\x91\x2d\x8a\x02\xeb\x5b\xd4\x45\xe4\xe3\x5d\xf5\x72\xd4\xfc\xd7\xc9\xf9\x8e\xe9\x20\x58\x34\xa3\x4e\x9d\xa0\x9d\x35\xf6\xf6\xb2\xd5\xac\x55\x94\xa6\xf5\xc3\xce\xac\xf3\x46\x69\xae\xf4\xd4\xb2\xf2\xd7\x23\xb7\x55\xee\x8b\x1e\xf2\x98\x2e\xf3\xf8\xe4\x07\xab\xa7\x21\x62\xfe\x54\xe0\x0f\xe4\x5c\xc6\x3d\xbc\x51\xa0\xd2\xd7\x80\xc5\xb2\x67\xf0\x80\xee\xac\x58\xc2\xfb\x29\x88\x32\x40\x91\xe5\xea\x7e\x7f\xd7\xd1\xc1\xfb\x87\xc8\x3f\x0e\x39\x88\xe6\xe8\x03\x0b\x8b\xc0\x95\xb1\x2a\xc6\x70\x39\x2c\xc2\x31\x74\x4f\xef\x02\x81\x9f\x64\xf0\xaf\xa9\x43\x22\x4c\xdc\xe9\xd3\x1c\xae\x54\xca\xf7\xf3\xdd\xf7\x14\x7c\x3f\xcc\x4f\x93\x90\xae\x4c\x86\x22\x69\x21\xfb\xe1\x8b\xe4\x2a\x4a\x46\xa6\x95\x3f\x66\x35\x96\x8a\xc3\x6c\x5b\x4e\xea\x73\x78\x77\x9f\xe3\xd3\x64\xb8\x07\xf6\x9d\x48\x50\x29\xd8\x7c\xb9\x52\x0f\xde\x4c\x00\x13\x9a\xc7\x6e\x7c\x89\x9e\x3a\x13\x1b\xd4\xf0\xf0\xb9\x50\x0c\x3b\x1f\xda\x83\x4e\x5c\x8e\xb5\x7b\xe8\xbd\xc2\x5e\xd3\x13\xad\x5e\xbc\x2f\xe0\x9b\xc7\x3c\x96\x96\x00\x52\xa4\x83\xe8\x20\xae\xe4\x4a\xf9\x72\x37\x63\xc3\x9e\x0f\x08\x4b\x89\x68\x7c\xd7\x72\xf8\x70\x9a\xaf\x14\x26\xd9\xb0\x9f\xbc\x31\x14\x86\xea\x4d\x09\xb1\xcd\xfb\x19\xea\xbd\x7d\x7b\xb3\x68\x4d\xd5\xbe\x34\x88\xfd\x6c\x7b\x71\xdc\x4d\x32\x6c\x0e\x93\x57\x2d\x0d\x61\x26\x8d\x91\xc1\xea\x49\x1a\x09\xf0\xaf\x0c\x7a\x12\xf6\x30\xab\xbc\x6f\xa1\x26\xa6\xa2\x38\x38\x18\x48\xd7\xf3\xf9\xe5\x60\xc0\xc5\xe1\xf9\x81\xdc\x73\xf4\xe7\xfa\xd5\x5a\x51\x23\x92\xfe\xc4\x45\x44\xac\x4b\xc2\x1c\x7d\x0b\x4c\xa5\x9e\xe4\xfc\x73\xf5\x24\x86\x4a\x6d\x93\xaa\x79\x9f\xa8\xab\x7a\x5a\x14\x20\xfb\xdd\x2b\x6f\xaa\xb9\x02\x56\x22\xce\x17\x29\x3d\x39\xd5\xaa\x75\x58\xa3\x1a\x7e\x81\x38\x28\x8c\x1f\x74\x3a\x0f\x4b\x8f\x86\xe0\x96\x38\x5a\x72\x38\x58\x99\x35\x80\xf8\x9f\x59\xc6\x2a\xe7\xd8\x48\x9d\x96\x4f\x50\x0e\x2d\x1a\x4c\xd9\x15\xb5\x75\xd9\x79\x17\x05\x48\xa5\x7e\xc8\xec\x6f\x0c\xb4\x17\xf3\x24\x4f\x54\xcb\x02\x29\x4d\xc6\x68\x12\x5f\x56\xf0\x50\x46\x46\xbd\x6a\xf0\x08\xcf\x75\x36\xbe\xa4\xcf\x03\x0a\x4f\xd5\x52\xe1\x3d\x50\xea\xa8\xa1\x65\xd1\xc2\xa9\xbe\xf9\x20\xa8\x21\xfb\x41\x39\x7a\x40\xb1\x15\x30\xb1\xe3\xc5\x27\xdd\xe5\xec\x6f\x2f\x8e\xb0\x97\x4e\xdd\xf3\x3c\x2a\x42\xb9\xb5\x7f\x6b\x6b\xa5\x8c\xcf\xc8\x72\xb4\x37\x07\xe9\x16\x3a\x66\x5b\x96\xe3\x85\x33\x32\x3e\x57\x53\xf1\x73\x4f\xbd\x98\x1f\xc8\xe7\xd4\xae\x2a\x9b\x18\x48\x6f\x31\x2c\x8d\xed\x6b\xf0\x4f\x0e\x07\x69\xe0\x6e\xf9\x39\x6f\xb2\xc9\xb2\xe5\x2a\x59\x2c\xd5\x8f\x7f\xb8\x64\x2d\xe4\x46\x78\x32\x16\x65\x23\x1f\x93\xb2\x0b\xa4\xba\x27\xbc\x42\x00\xed\xac\xd1\xc0\x24\xa6\x80\xcd\xe5\x7b\xdc\x60\x27\x00\x37\x75\xe2\xb9\x4c\xc3\x87\x82\x73\x76\xb1\x57\x64\xa2\x27\x9e\xce\xcc\x93\xf4\x37\x3c\xf4\xa4\x90\x21\x59\x48\xf7\xa4\x3f\x68\xb8\x44\x36\x82\xf7\x53\x03\x35\xce\xa1\xe3\xf0\x47\x13\x4b\xf7\x1c\x4c\x56\x48\xa8\xbe\x30\x65\xd1\xa6\xd9\x8f\xcd\x8d\xdd\x84\xfd\x4c\xed\x54\x31\xf7\x90\xa9\xc9\x28\xa2\xf0\xba\x17\x73\xe2\xb6\x1f\x6c\x37\xe8\xa9\x6a\x2c\x43\x7e\x51\x12\x44\x96\xc3\xaa\xf9\x7f\xdd\x20\xf8\x7e\xe4\xb4\x3f\xfb\xde\xe2\x64\xd6\x4f\x1e\x4e\xc0\xf4\x95\xdd\x51\x70\x70\x92\xce\xf9\x18\xe3\x34\xa8\x6b\xee\xb3\xfe\x48\xb2\x0f\x5a\xfb\xab\x12\xc0\x30\x6b\xf5\xb9\xaf\xb7\x78\x3f\x1d\xb4\xd9\xbb\xd8\x4d\x4f\x91\x0a\x3b\x2d\xc6\x5b\x7e\xbb\x2b\xd1\x7b\xdc\x18\x2a\x0d\x14\x33\x99\x31\x8b\x30\xbe\x69\x31\x5e\x60\x20\x45\xd8\x3d\x19\x87\xa9\x00\x89\xa4\x37\xed\xb5\x60\xf0\x0f\xc6\x19\x58\xbd\x2a\x0f\xce\xd8\x21\x65\x3c\xa2\x03\x5b\xa4\xee\x94\x66\x51\x4c\x59\xf6\x74\xa7\x3c\x92\xd0\xfb\x2f\x9c\x60\x99\x92\xdb\x8c\xba\x2d\xef\xbc\xf8\x68\xe8\x29\x8e\x81\x89\xdd\xc1\x1f\xbd\x27\x62\xf1\x4b\xa4\xa0\x96\xcd\xe9\x00\x7b\x9d\x38\x2e\xe9\x51\x7b\x48\x88\x17\x43\x31\xf6\x30\xa6\x31\x87\x09\x59\x50\xd6\xbb\x89\xde\x83\x3b\x11\xe8\xe7\xd5\x84\x8c\xbe\x70\xf3\x9e\x54\x60\x42\x71\x4f\x0c\x04\x32\x68\x0a\x24\x8d\x6a\xf6\xba\xd2\xd0\xe9\xbf\x79\xe7\x1b\x05\xf9\x8e\xd7\x14\x2b\x67\x26\xad\xe4\x06\xfe\xd9\x3a\x4a\x0d\xd9\x7d\x09\x4c\x05\x26\x7d\xd1\xf7\x4a\x09\x38\x04\x72\x9d\xf7\x4c\xaf\x2d\xba\xd2\x24\xe3\xa6\xfe\xfd\x1b\xed\xe1\xa3\xa8\x6a\x75\xf4\x17\x83\xf2\x5a\xa8\x94\x0d\x41\x99\x66\xde\x51\x63\x03\x3c\x05\x3d\x66\x00\xcd\x69\xa3\x8f\x72\xe4\xe6\x98\x8f\x87\xa6\xc7\xdd\xaa\x58\xb5\x83\x4c\x4f\x1b\xc1\x08\x5a\x28\xe0\x58\x27\x9e\x4a\x45\x17\xce\x28\x2f\xf9\x1c\x08\x35\x03\x9c\x2a\xa8\x83\xfe\x46\xf6\x2d\x53\x32\x09\xa5\xb6\x7a\x72\xb8\x02\x27\xb6\x5e\x85\xda\x11\xff\xa3\x41\x3c\x20\x23\x58\x51\xca\xb2\x37\x5a\x4c\x93\xa1\x7e\x7e\x7a\xb9\xb5\xa7\x17\x85\x19\x54\x01\x92\x4f\x64\xc0\xff\x63\xe2\x2d\xd7\x38\x71\x27\xf0\xaa\x73\x8b\x7b\xf9\x2e\xa6\x56\x31\x94\x1b\x6c\x9f\xa3\x56\xf2\xa6\x03\xef\x5f\xd7\xe1\x55\x0b\x0b\x5f\xbe\x9c\xcf\xbe\x27\x31\x05\x98\x86\x17\xa0\x4f\x96\xd2\x56\xae\xb7\x10\x8f\x79\xfc\xe6\x6e\xdc\x79\x89\xb7\x62\x3b\x7b\xf3\x0f\x54\xd7\x29\xa8\x7c\x80\x32\xe6\xa8\x8d\x01\x1c\x30\x4d\x46\xf2\xe9\x0a\x9a\x7b\x48\xf4\x23\x7e\x6b\x53\x14\x84\x89\xe7\x07\x53\xd8\x52\x69\x3a\x1c\xfb\x69\x37\x0d\x51\x8a\x9f\x6c\xc1\x27\xc0\x10\x6f\xa4\xb8\x8f\x5a\xdf\x1d\xa7\x43\x78\x48\x6c\x41\x3b\xa4\xf6\xe9\x50\xf5\x11\x60\x50\x6c\xde\x95\x32\x3c\x44\xc3\x2c\xd5\x8c\x8b\x0a\xca\x3a\x18\x0a\x0b\x9c\xb0\x0b\x09\xe5\x8e\x91\x83\x04\x14\x2c\xc1\xe3\x4c\x6f\xe3\x60\xa1\xf5\x41\x9b\x57\xe1\xbd\xad\x58\xbb\xa9\x62\x08\x99\x7d\x86\x8f\xa8\x42\x7b\x51\x7a\xa3\xbd\x56\xb0\xf1\x4b\xfb\x91\x80\xf2\xaf\xfa\x30\xea\x69\xb4\x7b\x5c\x2b\x87\xc0\x6e\xa1\x85\x71\xf5\xb0\x20\x16\x2e\xdc\x08\x87\x35\x85\xc2\xe2\xe4\x4b\x8c\x2f\x48\xce\xc9\xae\xfe\xa5\x8b\xcf\xcf\xe5\x0a\xfa\xe4\x90\x41\x71\xb7\xb8\x49\x73\x0a\x5a\x0d\x7b\x76\xfc\xa1\x1f\x6d\x68\xf4\xbd\xb2\x58\x71\x40\xc7\x0e\x52\xa2\x07\xef\x5f\x32\x14\x65\x25\xa0\x62\x4f\x87\x74\xdf\xe9\x93\xfe\xe7\xbf\xee\x0d\xec\xad\xc7\x91\x9c\xf2\xc3\x7e\xd2\xc3\x12\x64\x7c\x9d\x23\x8b\x30\x5c\x24\x7c\xc5\x14\xbe\x38\x1a\x40\xbb\x2e\x11\x18\x71\x6d\x1e\xed\x24\x38\x2a\xea\xd3\xfa\xd1\xa4\x51\x70\x1b\x39\xc2\x44\x72\x24\xf7\x18\x26\x32\x46\xd0\xe2\xf6\xd5\x36\xbc\xc8\x3c\x40\xc9\xc1\x77\x7d\x81\x95\x9c\x6f\xf3\x98\xea\x49\xd1\xc8\x9f\xac\xa5\x07\x65\xa4\x80\x50\x60\x9a\x8a\x16\xbc\x4c\x32\xb4\x99\x80\x5b\xbf\xad\xda\xac\x7b\x89\xed\x40\x1a\xc5\xee\xe5\xd6\x69\xe6\x28\xc3\x48\x3d\x5b\xdb\x0a\xc3\x77\xba\x0b\x45\x52\x44\x8a\x05\x7b\xa7\xb8\x17\xaa\x98\x29\x8a\x96\x7f\x35\xd7\x8b\x32\x67\xf2\xfd\xe0\x89\xc9\xc4\x41\xe5\x30\x76\x86\x13\x05\x01\x80\x7a\x7f\xe5\x81\x88\x87\xe1\xdb\x80\xe9\x5f\x32\x1d\x1d\x8d\x5e\xfa\x8a\xf0\x55\xe5\xd3\xc4\x72\x3a\x68\x89\x9d\xc1\x60\xd0\x1b\xfc\xa4\x78\x6b\x40\xd7\x15\x35\xae\x5e\xc1\xbb\x65\xce\x45\xab\xea\x92\x8c\x69\x92\xa1\x22\xc2\xd0\xb4\x42\x8a\xe6\x02\xd6\x50\xd5\x38\x04\x69\x43\x6c\xfc\x76\xae\xae\xd6\x76\x24\x71\xd5\xbe\xba\x65\x34\xe2\x11\xfa\x3a\x34\x0f\x2a\x0d\x2c\x41\xf3\xb3\xfb\xae\xd7\x7e\x45\x51\x6a\xf7\x96\xa9\x52\xa7\xd8\xb0\x68\x70\x8b\xf9\x46\xaa\x5a\x95\x64\xd9\x2f\x7b\x58\x32\x05\x72\x09\x94\x17\x0f\x9a\x7e\x5b\x20\x1a\x27\x6d\x34\x2c\x39\xb5\xb2\x9c\x8e\x0a\x0a\x2d\x55\x85\xe8\xb6\x95\x50\x25\x3a\xf0\x5a\x86\x91\xd3\x8b\x81\x1b\xbe\x22\xc1\x62\x2f\xa5\x26\xc8\x6f\xe6\xc3\xdc\xa5\x59\xe6\x3a\x3e\x10\xbe\x71\x48\xb3\xc8\x4f\xeb\xd3\xe6\x31\xe4\xb8\xe7\xfb\x68\x4a\xa6\x23\x6d\x75\x74\x8a\x29\x91\xc1\xed\x50\x6b\x2b\x20\x3f\x3f\xbd\xc1\xb2\xd9\xb0\x03\x1b\x03\xa5\x93\xfe\xcd\x0e\xcb\x85\xdf\xde\x24\x90\xde\x7f\xea\x6e\xb3\x97\xb6\x83\xfe\x64\x61\xbd\xff\xb2\x8c\xf7\xe7\xe9\x23\x01\x61\x8c\x14\xb7\x25\xd4\xb4\xb1\x61\xe1\xd5\x16\x53\x43\x00\xce\xe9\xe0\xfb\xdc\x7c\x0d\x50\x7b\x78\xfa\x90\x80\x07\x1b\x38\xf6\xcf\x23\xf6\x91\xee\xf2\x6a\x05\x71\xd2\x30\x09\xef\xa4\x79\x59\xa9\xb8\x09\x8b\x31\xda\x41\xcd\xbb\x39\xed\xde\xbc\xed\x56\xcf\xa7\x6b\xcc\x77\x8e\x45\xef\x37\xe5\x52\xf1\x1c\xc6\xc6\xe0\x93\x43\x02\x28\x29\x2d\x12\xc9\x56\x2d\x34\x81\x97\x99\x37\x0e\x91\x40\x36\x36\xb0\x63\x31\xc4\x8e\xef\x90\xa1\x44\xa4\xa2\x2c\xbd\x82\x4a\x27\xc5\xa3\x89\x7f\x6f\xb8\xf8\xa8\x50\xc8\x2b\x57\x33\x84\x88\x6f\x17\x95\x38\xc3\x48\x05\x7d\x58\xee\xdf\x08\x5f\x44\x3c\x69\x07\xe3\xd7\xb1\x88\xb6\x0f\x0f\x07\x9c\xb2\x3a\xd7\xf0\x9d\x78\x8b\x11\x87\xe9\x5a\x32\x7d\x39\xed\xde\x73\xe9\x3d\xf3\x30\x70\x9e\x40\xcf\xf7\x82\x5e\xc9\x1c\xf5\xec\xc1\x0a\xe9\xaf\x1f\x72\xf4\x23\x5a\xb8\x5e\xad\x6c\xe1\x4f\x37\x21\x9f\xaa\x0f\xda\x28\x84\xf5\x44\x5a\x04\x9a\x07\x3b\x57\x67\x2d\xed\xa0\xfa\xd2\x5d\xbd\xc7\x9f\x66\x92\x13\x5d\xb5\xa7\x24\xec\x96\x8e\x47\x52\x1f\x79\x93\x70\xf0\x53\x4a\x6c\xd6\xb1\xd6\x60\x34\xe9\x4e\x64\x86\xd5\x21\x81\xf8\x67\x93\x56\x57\x8d\x4d\xc0\x36\x85\xc3\x00\xb1\xa9\x09\x34\x1a\x18\x45\xc3\xf4\x5b\x26\xe3\xe3\x13\x35\x16\xab\x1e\xa9\x8c\x6c\x53\xbe\xf4\xec\x1b\xc2\xff\xb2\x0e\xc2\x68\xf9\xbe\xee\x1c\xd4\x5a\xb1\xbc\xb7\xba\x14\x6f\x7b\x69\x28\x11\x1b\xe1\x7c\x21\xb7\xcf\xf9\x34\x01\x4d\x39\x63\x43\xd3\xfa\x4f\xa6\xd1\x25\xf7\xef\x92\x9c\x11\xf9\xc9\x49\x6e\x92\x6a\xdc\x06\x8e\x34\xc7\x70\x47\x63\xff\x5e\xbc\xc6\x04\x02\xce\x26\xff\xb0\x45\x4d\xd9\xa9\x10\x23\x8f\x37\xf8\x8f\xeb\xe8\xf2\x56\x28\x88\x97\x7e\xa2\x08\x00\xa6\xee\xdb\x46\x92\x7f\x91\x06\xf8\x2b\x4d\x12\x21\x33\x69\x5a\x9c\x47\xc0\xd4\x4a\x23\xd2\x5b\xc3\xcd\xcd\xff\x1f\xff\xf6\x6f\xff\x67\xff\xf9\xdf\xfe\x5f\x6f\x0a\xb4\xfb\x58\x55\xff\x5d\x7f\xf7\xdf\xff\xdd\x15\xa7\xc5\x7f\xff\xf7\xbf\x17\x9c\xd1\xb9\x43\xf7\x0b\x3b\x37\xd5\xdf\x93\xcf\x31\x16\xc7\xd9\xf3\x67\xe3\xdf\x4f\x81\xe3\xf3\x27\xcd\x07\x2d\xec\xd8\x9d\xd6\x56\xf7\x1f\x24\x7e\x55\x59\x9b\x0f\x7f\xb2\xd1\x7b\x98\xab\xa3\xd1\x87\xff\x5d\xd9\x55\x1c\xe1\x87\xff\xd3\x55\x74\xf6\xf3\x7f\xbe\x78\x5a\x4a\xb1\xd1\x7e\xf0\xf7\xdf\x0f\x88\xd4\x87\x3f\x38\x74\x50\xcb\x0a\xfb\xf0\xdf\x15\xc3\xf7\xf9\xff\xd4\xe8\xff\xe2\xe6\xee\x99\xd0\xf5\x30\x0c\x3f\xf5\x5f\xdd\x2d\xd5\x52\xec\xc3\x37\x78\xbf\xfd\xd5\x9d\x2a\x6f\x8e\x43\xec\xd3\xd3\xe5\x85\x62\xfe\x8b\x4f\xdd\x78\x93\x9f\xfa\xfd\xf7\x57\xf1\xb6\xfd\xab\x2f\x3a\xe5\xd3\xfe\xe2\xf3\xc2\xf7\xe7\x9f\xef\xe9\xcb\x54\xfd\xa3\x3f\xfc\xaf\xf3\xa7\x5c\xc2\xa7\xd7\x58\x09\x6c\xd7\xd3\x7b\xe9\x13\xd2\xfa\xe9\xfa\x5a\x15\xf4\x7b\x0d\x17\x14\xcf\x5f\xfc\x8d\x61\x1d\x6b\xd0\x3a\xff\xd3\xff\xdd\xe4\x49\x3d\xfe\xea\x0d\x8d\x81\x6b\x45\xf6\xe9\x02\xca\x21\xfa\x57\x5f\x94\x0e\x28\xd4\x7d\x33\x3c\xf1\x81\x1b\xfa\xf0\x07\xad\x11\xcb\xf5\x7a\x66\x4d\xe6\x51\xe0\x53\xd7\xe6\xfe\x7f\xc6\x31\xf0\xf3\xff\x3b\x04\x57\xff\xfb\x0c\xab\x7e\x6e\xfe\x30\x7c\xe9\xe6\x5f\xcb\xd7\x43\xfc\x5f\x7b\xc5\x89\x5d\x7b\x3d\xdf\x5f\xed\x5a\x50\x6b\xbb\x39\xe2\x97\x6a\xfe\xb5\xad\x5f\xed\xfb\x75\x5f\xb6\xf9\x97\xe5\x18\xc5\xbd\x6f\xd4\x7e\xec\xd7\xb3\x7d\x5f\x4e\x89\xab\x6b\x7f\xb3\xbd\x52\x71\x61\xe2\x3f\x78\x8b\xe2\x32\xdb\x8b\x4e\x5f\xed\x1a\x7d\x7d\x3d\xdb\x8f\x27\x71\x47\x24\x6e\xa1\xf8\xf3\x36\xff\x16\x7f\x68\xdb\x2f\x9c\xc4\xa7\x98\x34\x89\xcf\x6c\x7f\x73\x4e\xe2\xbf\xda\x4f\xfc\x16\xcb\xe2\x25\x5f\x83\x8f\xe2\x09\xb5\x6f\xd3\x6a\x11\x7b\xf3\x57\xfb\x31\x4a\x5c\x65\xfb\x7b\x15\x3b\x7f\xff\x5f\x1b\xe8\x56\x71\x3f\x0f\x71\x61\xdf\xf2\xba\x8c\xf8\x57\xd8\xda\x37\x2e\x9e\xa6\xf9\x9a\xe5\x1b\x6b\xbf\xbd\xfd\xcc\xd9\xb4\x4f\x25\xf2\x96\xc4\xdd\x45\xf1\xd0\x56\xf1\x1d\x41\xdc\xe1\xcc\x63\xbb\x3c\x7d\xbb\xe4\xda\xbb\xf5\x0f\xf9\x7d\xed\xbe\x11\x1b\xa3\x5d\x47\xfa\xcb\x8a\x17\x4b\x62\x2f\x7c\xcb\x65\xf5\x14\xa7\x69\x10\x2f\xd3\x8b\x95\x6c\xb5\xd8\x8c\xf4\x65\xbf\x58\xec\xb1\x76\x41\x3e\xda\x7f\x18\xf1\x94\xda\x5b\xa2\xa0\x7f\x8b\xcf\x68\xaf\x74\x14\xaf\x25\xbe\xc4\x1b\x13\x0b\xfe\x6b\x14\xff\x7e\x8b\x75\x40\x46\xcf\xe2\xb5\xb4\xff\x62\xf1\xaf\xef\xaf\x4d\xfc\x9f\x15\x4b\x46\x6e\xc1\xf6\x95\x89\x10\xcc\x5f\x46\x3c\xa6\x45\xae\x11\x71\x17\xe2\x85\x8a\x9b\x7f\x71\xfb\xaf\x45\x04\x86\xa9\xbd\x0e\x12\x6b\x52\x79\xd3\x1e\x51\x29\x8b\xd3\xc7\x3f\xc4\x2f\xb7\xb7\x2e\x76\xa6\x4c\xb1\x44\x40\x54\xed\xc7\xcf\x62\xdd\xb5\x2f\xb6\xfd\x80\x55\xbc\xe1\x55\x3c\xaa\x55\x04\xb5\xf6\x5a\xbf\xfe\xed\xeb\xdf\x60\x77\x45\x19\x78\xbe\xb4\x38\x2c\x94\x17\xeb\x73\x16\x0f\xbe\x7d\xd0\x73\xd0\xe2\x5b\xb7\x2f\x19\x76\xe5\x35\xb5\xff\x37\x71\xbb\x77\x44\x98\x15\xf7\xd8\x3e\x95\x18\xc4\x4a\xfd\x16\x6f\xbc\x7d\x03\x96\xbe\xc5\x87\x3c\x45\xdc\x6e\xdf\xa2\xc8\x10\x5e\xba\xbd\x0e\x71\xce\xb5\xdf\xfc\xaa\xb2\x18\xd7\x5d\xe6\x45\x3c\x4a\xff\x65\xbf\x44\x48\xe2\xf6\xeb\x6d\xfb\xcb\xcf\x24\x62\x92\x3c\xa0\xc5\xe6\x16\xaf\xc7\x8a\x23\xd7\x89\x28\xf4\x2d\xbe\xfb\x29\x0f\x14\x79\x44\x2a\xf1\x34\xe5\xe9\xf6\x12\x27\x45\xb5\x8d\xbf\x53\x93\xff\xcd\x2e\xfd\xef\x74\x61\xc0\xff\xfb\xdc\x3e\x97\xba\xdc\xb5\xe0\xb3\xbc\xc3\x8d\xc2\x29\xdf\x76\x45\x18\x16\xbf\xd2\xee\x09\xed\x26\x16\x7b\xd7\x92\x6b\xff\x74\xf4\xa2\x5c\x39\xea\xf3\xfb\x28\x10\xcb\x85\xc9\x9d\xc3\xaa\x6b\x4b\xaf\xed\xbf\xac\x17\xb1\x47\x24\x96\x69\xe5\xd8\xfe\x2e\x59\x2f\xea\x09\xe7\x8b\x2a\xb2\x91\x69\x9d\xe3\x27\x87\xfe\xc7\x91\x8c\x08\x25\xd9\xc9\x62\x47\x5c\x55\x94\xff\xaa\x38\xb9\xfb\xb2\xc4\x8a\x4d\xab\xa8\xa8\x8a\x08\x56\xfb\x4e\xe1\xf6\xc5\xd6\x3d\x18\xd5\xf7\xf6\x8c\x9b\x16\xb5\x47\x15\x3d\x94\x65\x54\x5a\x45\x05\xd2\xc8\x69\x9f\x3f\x99\xc5\x6f\xc3\xea\xb0\x3e\xf0\x61\xda\x74\x5f\x47\x91\xd5\x16\x2b\x64\xf5\x2f\x96\xbf\x75\x5a\xd6\x9e\xdf\x92\xce\xd5\xf9\x3f\xfe\xcf\xff\xf8\xff\x03\x00\x00\xff\xff\x6b\x66\x99\xb1\xb1\x26\x06\x00")
-
-func dataEnglishJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_dataEnglishJson,
-		"data/english.json",
-	)
-}
-
-func dataEnglishJson() (*asset, error) {
-	bytes, err := dataEnglishJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "data/english.json", size: 403121, mode: os.FileMode(0644), modTime: time.Unix(1669644253, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd4, 0xdf, 0xe7, 0x7f, 0x9d, 0xbb, 0x8e, 0x50, 0x4a, 0x2a, 0xc1, 0x3, 0xac, 0x8c, 0x93, 0xda, 0xf3, 0xfb, 0x6a, 0xce, 0xe1, 0x94, 0xb7, 0xe0, 0xb8, 0x7e, 0xe9, 0x38, 0xfa, 0x5e, 0x28, 0x4}}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// AssetString returns the asset contents as a string (instead of a []byte).
-func AssetString(name string) (string, error) {
-	data, err := Asset(name)
-	return string(data), err
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// MustAssetString is like AssetString but panics when Asset would return an
-// error. It simplifies safe initialization of global variables.
-func MustAssetString(name string) string {
-	return string(MustAsset(name))
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetDigest returns the digest of the file with the given name. It returns an
-// error if the asset could not be found or the digest could not be loaded.
-func AssetDigest(name string) ([sha256.Size]byte, error) {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[canonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s can't read by error: %v", name, err)
-		}
-		return a.digest, nil
-	}
-	return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s not found", name)
-}
-
-// Digests returns a map of all known files and their checksums.
-func Digests() (map[string][sha256.Size]byte, error) {
-	mp := make(map[string][sha256.Size]byte, len(_bindata))
-	for name := range _bindata {
-		a, err := _bindata[name]()
-		if err != nil {
-			return nil, err
-		}
-		mp[name] = a.digest
-	}
-	return mp, nil
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"data/english.json": dataEnglishJson,
-}
-
-// AssetDebug is true if the assets were built with the debug flag enabled.
-const AssetDebug = false
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"},
-// AssetDir("data/img") would return []string{"a.png", "b.png"},
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		canonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(canonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"data": {nil, map[string]*bintree{
-		"english.json": {dataEnglishJson, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory.
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-}
-
-// RestoreAssets restores an asset under the given directory recursively.
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	canonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(canonicalName, "/")...)...)
-}
@@ -1,11 +1,12 @@
 package english
 
 import (
+	"encoding/json"
 	"regexp"
 	"strings"
 
 	"github.com/neurosnap/sentences"
-	"github.com/neurosnap/sentences/data"
+	data "github.com/neurosnap/sentences/data/english"
 )
 
 type WordTokenizer struct {
@@ -14,6 +15,19 @@ type WordTokenizer struct {
 
 var reAbbr = regexp.MustCompile(`((?:[\w]\.)+[\w]*\.)`)
 
+// Abbreviation classes for english, the abbreviations are supervised along with their class.
+var (
+	titleAbbrevs    = []string{"dr", "mr", "mrs", "ms", "prof", "sen", "rep", "gov", "gen", "col", "capt", "lt", "sgt", "rev"}
+	internalAbbrevs = []string{"e.g", "i.e", "vs", "cf", "viz", "approx"}
+	terminalAbbrevs = []string{"etc", "inc", "ltd", "co", "corp", "bros"}
+	unitAbbrevs     = []string{"km", "kg", "cm", "mm", "lb", "lbs", "oz", "ft", "mi", "hr", "min"}
+)
+
+func init() {
+	sentences.RegisterLanguage("english", NewSentenceTokenizer)
+	sentences.RegisterAnnotator("english.multi-punct", newProfileMultiPunct)
+}
+
 // English customized sentence tokenizer.
 func NewSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceTokenizer, error) {
 	training := s
@@ -30,11 +44,29 @@ func NewSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceToken
 		}
 	}
 
-	// supervisor abbreviations
+	// supervisor abbreviations, layered on top so the caller's storage is untouched
+	supervised := sentences.NewOverlay()
 	abbrevs := []string{"sgt", "gov", "no"}
 	for _, abbr := range abbrevs {
-		training.AbbrevTypes.Add(abbr)
+		supervised.AbbrevTypes.Add(abbr)
+	}
+
+	classes := []struct {
+		class   int
+		abbrevs []string
+	}{
+		{sentences.AbbrevTitle, titleAbbrevs},
+		{sentences.AbbrevInternal, internalAbbrevs},
+		{sentences.AbbrevTerminal, terminalAbbrevs},
+		{sentences.AbbrevUnit, unitAbbrevs},
+	}
+	for _, class := range classes {
+		for _, abbr := range class.abbrevs {
+			supervised.AbbrevTypes.Add(abbr)
+			supervised.AbbrevClasses[abbr] = sentences.OrthoChange{Add: class.class}
+		}
 	}
+	training = training.WithOverlays(supervised)
 
 	lang := sentences.NewPunctStrings()
 	word := NewWordTokenizer(lang)
@@ -48,10 +80,11 @@ func NewSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceToken
 	}
 
 	multiPunct := &MultiPunctWordAnnotation{
-		Storage:      training,
-		TokenParser:  word,
-		TokenGrouper: &sentences.DefaultTokenGrouper{},
-		Ortho:        ortho,
+		Storage:       training,
+		TokenParser:   word,
+		TokenGrouper:  &sentences.DefaultTokenGrouper{},
+		Ortho:         ortho,
+		WindowGrouper: &sentences.SlidingTokenGrouper{Left: 1, Right: 2},
 	}
 
 	annotations = append(annotations, multiPunct)
@@ -117,28 +150,52 @@ func (e *WordTokenizer) HasSentEndChars(t *sentences.Token) bool {
 	return false
 }
 
-// Attempts to tease out custom Abbreviations, e.g. F.B.I.
+/*
+Attempts to tease out custom Abbreviations, e.g. F.B.I.  The tokens are visited
+in the pairs of the TokenGrouper, or in the windows of the WindowGrouper when
+there is one, which needs a token before and two after to find references and
+acronyms that end a sentence.
+*/
 type MultiPunctWordAnnotation struct {
 	*sentences.Storage
 	sentences.TokenParser
 	sentences.TokenGrouper
 	sentences.Ortho
+	WindowGrouper sentences.WindowGrouper
+}
+
+// WithStorage returns a copy of the annotation that reads from s
+func (a *MultiPunctWordAnnotation) WithStorage(s *sentences.Storage) sentences.AnnotateTokens {
+	ann := *a
+	ann.Storage = s
+	if ortho, ok := a.Ortho.(sentences.StorageOrtho); ok {
+		ann.Ortho = ortho.WithStorage(s)
+	}
+	return &ann
 }
 
 func (a *MultiPunctWordAnnotation) Annotate(tokens []*sentences.Token) []*sentences.Token {
-	for _, tokPair := range a.TokenGrouper.Group(tokens) {
-		if len(tokPair) < 2 || tokPair[1] == nil {
+	grouper := a.WindowGrouper
+	if grouper == nil {
+		grouper = &sentences.PairWindowGrouper{TokenGrouper: a.TokenGrouper}
+	}
+
+	for _, window := range grouper.Windows(tokens) {
+		if window.Next(1) == nil {
 			continue
 		}
 
-		a.tokenAnnotation(tokPair[0], tokPair[1])
+		a.tokenAnnotation(window)
 	}
 
 	return tokens
 }
 
-func (a *MultiPunctWordAnnotation) tokenAnnotation(tokOne, tokTwo *sentences.Token) {
-	if a.IsListNumber(tokOne) || a.IsCoordinatePartOne(tokOne) {
+func (a *MultiPunctWordAnnotation) tokenAnnotation(window sentences.TokenWindow) {
+	tokOne, tokTwo := window.Token, window.Next(1)
+
+	// a number after an abbreviation is a reference, e.g. "Fig. 3.", not a list item
+	if (a.IsListNumber(tokOne) && !isReference(window)) || a.IsCoordinatePartOne(tokOne) {
 		tokOne.SentBreak = false
 		return
 	}
@@ -159,6 +216,11 @@ func (a *MultiPunctWordAnnotation) tokenAnnotation(tokOne, tokTwo *sentences.Tok
 	tokOne.Abbr = true
 	tokOne.SentBreak = false
 
+	// the next word ends the sentence itself, e.g. "5 p.m. ET. The"
+	if a.endsSentence(window) {
+		return
+	}
+
 	nextTyp := a.TokenParser.TypeNoSentPeriod(tokTwo)
 	/*
 		[4.1.1. Orthographic Heuristic] Check if there's
@@ -177,9 +239,43 @@ func (a *MultiPunctWordAnnotation) tokenAnnotation(tokOne, tokTwo *sentences.Tok
 		frequent-sentence-starters list, then label tok as a
 		sentence break.
 	*/
-	if a.TokenParser.FirstUpper(tokTwo) && (a.SentStarters[nextTyp] != 0 || a.HasUnreliableEndChars(tokOne) || tokOne.Tok == "." || a.IsCoordinatePartTwo(tokOne)) {
+	if a.TokenParser.FirstUpper(tokTwo) && (a.IsSentStarter(nextTyp) || a.HasUnreliableEndChars(tokOne) || tokOne.Tok == "." || a.IsCoordinatePartTwo(tokOne)) {
 		tokOne.SentBreak = true
 		return
 	}
 
 }
+
+// isReference is true for a number with a period that follows an abbreviation, e.g. "pp. 12."
+func isReference(window sentences.TokenWindow) bool {
+	prev := window.Prev(1)
+	return prev != nil && prev.Abbr && strings.HasSuffix(window.Token.Tok, ".")
+}
+
+var reAcronymEnd = regexp.MustCompile(`^[A-Z]{2,4}\.$`)
+
+// endsSentence is true when the next token is an acronym that ends the sentence before a capitalized word
+func (a *MultiPunctWordAnnotation) endsSentence(window sentences.TokenWindow) bool {
+	next, after := window.Next(1), window.Next(2)
+	if next == nil || after == nil || !next.SentBreak || !reAcronymEnd.MatchString(next.Tok) {
+		return false
+	}
+
+	return a.FirstUpper(after)
+}
+
+// newProfileMultiPunct builds a MultiPunctWordAnnotation for a profile, the options set its window
+func newProfileMultiPunct(env *sentences.AnnotatorEnv, options json.RawMessage) (sentences.AnnotateTokens, error) {
+	window := &sentences.SlidingTokenGrouper{Left: 1, Right: 2}
+	if err := sentences.DecodeOptions(options, window); err != nil {
+		return nil, err
+	}
+
+	return &MultiPunctWordAnnotation{
+		Storage:       env.Storage,
+		TokenParser:   env.WordTokenizer,
+		TokenGrouper:  &sentences.DefaultTokenGrouper{},
+		Ortho:         env.Ortho(),
+		WindowGrouper: window,
+	}, nil
+}
@@ -1,7 +1,10 @@
 package english
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/neurosnap/sentences"
 )
 
 var tokenizer, _ = NewSentenceTokenizer(nil)
@@ -112,3 +115,115 @@ func TestEnglishSemicolon(t *testing.T) {
 		}
 	}
 }
+
+func TestEnglishStorageUntouched(t *testing.T) {
+	t.Log("Tokenizer should not add supervised abbreviations to the caller's storage.")
+
+	training := sentences.NewStorage()
+	if _, err := NewSentenceTokenizer(training); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(training.AbbrevTypes) != 0 {
+		t.Fatalf("Storage was modified: %v", training.AbbrevTypes)
+	}
+}
+
+func TestEnglishAbbrevClasses(t *testing.T) {
+	t.Log("Tokenizer should use the english abbreviation classes")
+
+	actualText := "We met Capt. Page at the dock.  They sell pens, paper, etc. Zebras are not on the list.  Use a solvent, e.g. Acetone, to clean it.  The plank is 6 ft. long.  I bought a kg. Then I left.  He ran 5 mi. After that he rested.  The wall is 3 cm. Then it ends."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{
+		"We met Capt. Page at the dock.",
+		"  They sell pens, paper, etc.",
+		" Zebras are not on the list.",
+		"  Use a solvent, e.g. Acetone, to clean it.",
+		"  The plank is 6 ft. long.",
+		"  I bought a kg.",
+		" Then I left.",
+		"  He ran 5 mi.",
+		" After that he rested.",
+		"  The wall is 3 cm.",
+		" Then it ends.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+	}
+}
+
+func TestEnglishContextWindows(t *testing.T) {
+	t.Log("Tokenizer should look past the next token around abbreviations")
+
+	overlay, err := sentences.LoadOverlay(strings.NewReader("fig\npp\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualText := "The call starts at 5 p.m. ET. The agenda follows.  See Fig. 3. The valve is open.  It is on pp. 12. The rest is on pp. 14."
+	actual := tokenizer.WithOverlays(overlay).Tokenize(actualText)
+
+	expected := []string{
+		"The call starts at 5 p.m. ET.",
+		" The agenda follows.",
+		"  See Fig. 3.",
+		" The valve is open.",
+		"  It is on pp. 12.",
+		" The rest is on pp. 14.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+	}
+}
+
+func TestEnglishProfile(t *testing.T) {
+	t.Log("Profiles should build the english tokenizer and its annotators")
+
+	profile, err := sentences.LoadProfile(strings.NewReader(`{
+		"language": "english",
+		"annotators": [
+			{"name": "type-based"},
+			{"name": "token-based"},
+			{"name": "abbrev-class"},
+			{"name": "english.multi-punct", "options": {"left": 1, "right": 2}},
+			{"name": "realign"}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profiled, err := sentences.NewFromProfile(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualText := "The call starts at 5 p.m. ET. The agenda follows.  We met Capt. Page at the dock.  He said \"Stop.\" Then he left."
+	expected := tokenizer.Tokenize(actualText)
+	actual := profiled.Tokenize(actualText)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index].Text {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index].Text)
+		}
+	}
+}
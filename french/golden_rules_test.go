@@ -0,0 +1,116 @@
+package french
+
+import (
+	"testing"
+)
+
+func compareSentences(t *testing.T, actualText string, expected []string, test string) bool {
+	actual := tokenizer.Tokenize(actualText)
+
+	if len(actual) != len(expected) {
+		t.Log(test)
+		t.Logf("Actual: %v\n", actual)
+		t.Errorf("Actual: %d, Expected: %d\n", len(actual), len(expected))
+		t.Log("===")
+		return false
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Log(test)
+			t.Errorf("Actual: [%s] Expected: [%s]\n", sent.Text, expected[index])
+			t.Log("===")
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestGoldenRules(t *testing.T) {
+	var actualText string
+	var expected []string
+	var test string
+
+	test = "1. Simple period to end sentence"
+	actualText = "Il fait beau. Nous allons à la plage."
+	expected = []string{
+		"Il fait beau.",
+		" Nous allons à la plage.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "2. Detached question mark"
+	actualText = "Quoi ? Tu pars déjà ? Oui, je dois y aller."
+	expected = []string{
+		"Quoi ?",
+		" Tu pars déjà ?",
+		" Oui, je dois y aller.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "3. Detached exclamation mark"
+	actualText = "Quelle surprise ! Je ne t'attendais pas."
+	expected = []string{
+		"Quelle surprise !",
+		" Je ne t'attendais pas.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "4. Guillemets with an incise"
+	actualText = "« Tu viens ? » demanda-t-il. Elle ne répondit pas."
+	expected = []string{
+		"« Tu viens ? » demanda-t-il.",
+		" Elle ne répondit pas.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "5. Guillemets at the end of a sentence"
+	actualText = "Il a dit : « Je reviendrai. » Puis il est parti."
+	expected = []string{
+		"Il a dit : « Je reviendrai. »",
+		" Puis il est parti.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "6. Title abbreviations"
+	actualText = "M. Dupont est arrivé hier. Mme Martin l'attendait."
+	expected = []string{
+		"M. Dupont est arrivé hier.",
+		" Mme Martin l'attendait.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "7. Abbreviation p. ex."
+	actualText = "Il aime les fruits, p. ex. les pommes et les poires. C'est sain."
+	expected = []string{
+		"Il aime les fruits, p. ex. les pommes et les poires.",
+		" C'est sain.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "8. Abbreviation c.-à-d."
+	actualText = "Le gagnant, c.-à-d. Jean, a reçu un prix. Bravo à lui."
+	expected = []string{
+		"Le gagnant, c.-à-d. Jean, a reçu un prix.",
+		" Bravo à lui.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "9. Detached colon and semicolon"
+	actualText = "Voici la liste : pommes ; poires ; fraises. Rien d'autre."
+	expected = []string{
+		"Voici la liste : pommes ; poires ; fraises.",
+		" Rien d'autre.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "10. Dialogue over several sentences"
+	actualText = "« Je suis là. Tu viens ? » Elle sourit."
+	expected = []string{
+		"« Je suis là.",
+		" Tu viens ? »",
+		" Elle sourit.",
+	}
+	compareSentences(t, actualText, expected, test)
+}
@@ -0,0 +1,206 @@
+package french
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/neurosnap/sentences"
+	data "github.com/neurosnap/sentences/data/french"
+)
+
+/*
+WordTokenizer follows French typography where a (narrow) non-breaking space
+separates a word from the "high" punctuation ? ! : ; and from « » guillemets.
+Detached punctuation is merged back into the word it belongs to so the rest of
+the pipeline sees "Quoi?" instead of "Quoi" and "?".  The Position of every
+token is still the byte offset of its end in the original text.
+*/
+type WordTokenizer struct {
+	sentences.DefaultWordTokenizer
+}
+
+func init() {
+	sentences.RegisterLanguage("french", NewSentenceTokenizer)
+	sentences.RegisterAnnotator("french.dialogue", func(env *sentences.AnnotatorEnv, options json.RawMessage) (sentences.AnnotateTokens, error) {
+		return &DialogueAnnotation{env.WordTokenizer, &sentences.DefaultTokenGrouper{}}, sentences.DecodeOptions(options, nil)
+	})
+}
+
+// French customized sentence tokenizer.
+func NewSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceTokenizer, error) {
+	training := s
+
+	if training == nil {
+		b, err := data.Asset("data/french.json")
+		if err != nil {
+			return nil, err
+		}
+
+		training, err = sentences.LoadTraining(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// supervisor abbreviations, layered on top so the caller's storage is untouched
+	supervised := sentences.NewOverlay()
+	abbrevs := []string{"m", "mm", "mme", "mmes", "mlle", "mlles", "p", "ex", "c.-à-d", "cf", "env", "av", "bd"}
+	for _, abbr := range abbrevs {
+		supervised.AbbrevTypes.Add(abbr)
+	}
+	training = training.WithOverlays(supervised)
+
+	lang := sentences.NewPunctStrings()
+	word := NewWordTokenizer(lang)
+	annotations := sentences.NewAnnotations(training, lang, word)
+
+	dialogue := &DialogueAnnotation{
+		TokenParser:  word,
+		TokenGrouper: &sentences.DefaultTokenGrouper{},
+	}
+
+	annotations = append(annotations, dialogue)
+
+	tokenizer := &sentences.DefaultSentenceTokenizer{
+		Storage:       training,
+		PunctStrings:  lang,
+		WordTokenizer: word,
+		Annotations:   annotations,
+	}
+
+	return tokenizer, nil
+}
+
+func NewWordTokenizer(p sentences.PunctStrings) *WordTokenizer {
+	word := &WordTokenizer{}
+	word.PunctStrings = p
+
+	return word
+}
+
+// Tokenize splits text into words and reattaches detached punctuation and guillemets
+func (e *WordTokenizer) Tokenize(text string, onlyPeriodContext bool) []*sentences.Token {
+	tokens := e.DefaultWordTokenizer.Tokenize(text, onlyPeriodContext)
+	merged := make([]*sentences.Token, 0, len(tokens))
+
+	var opening *sentences.Token
+	for _, token := range tokens {
+		if opening != nil {
+			token.Tok = opening.Tok + token.Tok
+			token.Start = opening.Start
+			token.LineStart = opening.LineStart
+			token.ParaStart = opening.ParaStart
+			opening = nil
+		}
+
+		if isOpening(token.Tok) {
+			opening = token
+			continue
+		}
+
+		if len(merged) > 0 && !token.LineStart && isDetached(token.Tok) {
+			prev := merged[len(merged)-1]
+			prev.Tok = prev.Tok + token.Tok
+			prev.Position = token.Position
+			continue
+		}
+
+		merged = append(merged, token)
+	}
+
+	// an opening guillemet at the very end of the text stays a token
+	if opening != nil {
+		merged = append(merged, opening)
+	}
+
+	return merged
+}
+
+// isOpening is true for a token that only holds an opening guillemet
+func isOpening(tok string) bool {
+	return tok == "«" || tok == "‹"
+}
+
+// isDetached is true for a token that only holds punctuation which French
+// typography separates from the preceding word
+func isDetached(tok string) bool {
+	if tok == "" {
+		return false
+	}
+
+	for _, char := range tok {
+		if !strings.ContainsRune("?!:;»›", char) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Find any punctuation that might mean the end of a sentence but doesn't have to
+func (e *WordTokenizer) HasUnreliableEndChars(t *sentences.Token) bool {
+	enders := []string{
+		`."`, `.'`, `.)`, `.’`, `.”`, `.»`, `.›`,
+		`?"`, `?'`, `?)`, `?’`, `?”`, `?»`, `?›`,
+		`!"`, `!'`, `!)`, `!’`, `!”`, `!»`, `!›`,
+	}
+	for _, ender := range enders {
+		if strings.HasSuffix(t.Tok, ender) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find any punctuation excluding the period final
+func (e *WordTokenizer) HasSentEndChars(t *sentences.Token) bool {
+	enders := []string{
+		`."`, `.'`, `.)`, `.’`, `.”`, `.»`, `.›`,
+		`?`, `?"`, `?'`, `?)`, `?’`, `?”`, `?»`, `?›`,
+		`!`, `!"`, `!'`, `!)`, `!’`, `!”`, `!»`, `!›`,
+	}
+
+	for _, ender := range enders {
+		if strings.HasSuffix(t.Tok, ender) {
+			return true
+		}
+	}
+
+	parens := []string{
+		`.[`, `.(`, `."`, `.'`,
+		`?[`, `?(`,
+		`![`, `!(`,
+	}
+
+	for _, paren := range parens {
+		if strings.Index(t.Tok, paren) != -1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+DialogueAnnotation keeps a quotation and its incise together, French
+dialogue often continues after the closing guillemet in lower case, e.g.
+« Tu viens ? » demanda-t-il.
+*/
+type DialogueAnnotation struct {
+	sentences.TokenParser
+	sentences.TokenGrouper
+}
+
+func (a *DialogueAnnotation) Annotate(tokens []*sentences.Token) []*sentences.Token {
+	for _, tokPair := range a.TokenGrouper.Group(tokens) {
+		if len(tokPair) < 2 || tokPair[1] == nil {
+			continue
+		}
+
+		if a.HasUnreliableEndChars(tokPair[0]) && a.FirstLower(tokPair[1]) {
+			tokPair[0].SentBreak = false
+		}
+	}
+
+	return tokens
+}
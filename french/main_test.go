@@ -0,0 +1,85 @@
+package french
+
+import (
+	"testing"
+
+	"github.com/neurosnap/sentences"
+)
+
+var tokenizer, _ = NewSentenceTokenizer(nil)
+
+func TestFrenchDetachedPunctuation(t *testing.T) {
+	t.Log("Tokenizer should attach punctuation separated by a non-breaking space ...")
+
+	actualText := "Quoi\u00a0? Tu pars déjà\u202f! Oui, je dois y aller."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{
+		"Quoi\u00a0?",
+		" Tu pars déjà\u202f!",
+		" Oui, je dois y aller.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+
+		if actualText[sent.Start:sent.End] != sent.Text {
+			t.Fatalf("Offsets [%d:%d] do not match sentence text %q", sent.Start, sent.End, sent.Text)
+		}
+	}
+}
+
+func TestFrenchWordTokenizer(t *testing.T) {
+	t.Log("Word tokenizer should merge guillemets and detached punctuation and keep byte offsets ...")
+
+	actualText := "« Tu viens ? » demanda-t-il."
+	tokens := NewWordTokenizer(sentences.NewPunctStrings()).Tokenize(actualText, false)
+
+	expected := []struct {
+		tok      string
+		position int
+	}{
+		{"«Tu", 5},
+		{"viens?»", 16},
+		{"demanda-t-il.", len(actualText)},
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("Actual: %v, Expected: %d tokens", tokens, len(expected))
+	}
+
+	for index, token := range tokens {
+		if token.Tok != expected[index].tok || token.Position != expected[index].position {
+			t.Fatalf("Actual: %q at %d, Expected: %q at %d", token.Tok, token.Position, expected[index].tok, expected[index].position)
+		}
+	}
+}
+
+func TestFrenchAbbrevContexts(t *testing.T) {
+	t.Log("Abbreviation candidates should show merged tokens where they are in the text")
+
+	finder := sentences.NewAbbrevFinder(sentences.NewStorage(), NewWordTokenizer(sentences.NewPunctStrings()))
+	finder.Width = 12
+	finder.Add("Il lit le « chap. 3 » ce soir, puis le « chap. 4 » demain.")
+
+	candidates := finder.Candidates()
+	if len(candidates) == 0 || candidates[0].Type != "chap" {
+		t.Fatalf("Actual: %v, Expected: chap first", candidates)
+	}
+
+	expected := []string{"Il lit le [« chap.] 3 » ce", "puis le [« chap.] 4 » demain."}
+	if len(candidates[0].Contexts) != len(expected) {
+		t.Fatalf("Actual: %q, Expected: %q", candidates[0].Contexts, expected)
+	}
+	for index, context := range candidates[0].Contexts {
+		if context != expected[index] {
+			t.Fatalf("Actual: %q, Expected: %q", context, expected[index])
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package sentences
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// GazetteerName is a proper noun of a gazetteer, e.g. a person, an organization or a place
+type GazetteerName struct {
+	Name     string
+	Category string
+	// words of the name, lower case, as they are compared to tokens
+	words []string
+}
+
+/*
+Gazetteer is a list of proper nouns, such as person names, organizations,
+places and products.  A capitalized word is not evidence of a new sentence
+when it starts a name the gazetteer knows, which is what the orthographic
+heuristic cannot tell for names it never saw in training.  Names may span
+several tokens, e.g. "St. Louis Blues".
+*/
+type Gazetteer struct {
+	// names by their first word, the longest first
+	names map[string][]*GazetteerName
+	count int
+}
+
+// NewGazetteer creates an empty gazetteer
+func NewGazetteer() *Gazetteer {
+	return &Gazetteer{names: map[string][]*GazetteerName{}}
+}
+
+// nameWord is how a word of a name and a token are compared: lower case and without trailing commas or quotes
+func nameWord(word string) string {
+	return strings.ToLower(strings.TrimRight(word, `,;:"')]}”’`))
+}
+
+// Add adds a name of a category, the category may be empty
+func (g *Gazetteer) Add(name, category string) {
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return
+	}
+
+	entry := &GazetteerName{Name: strings.Join(fields, " "), Category: category}
+	for _, field := range fields {
+		entry.words = append(entry.words, nameWord(field))
+	}
+
+	first := entry.words[0]
+	for _, known := range g.names[first] {
+		if known.Name == entry.Name {
+			known.Category = category
+			return
+		}
+	}
+
+	names := append(g.names[first], entry)
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(names[i].words) > len(names[j].words)
+	})
+	g.names[first] = names
+	g.count++
+}
+
+// Len returns the number of names in the gazetteer
+func (g *Gazetteer) Len() int {
+	return g.count
+}
+
+// Merge adds the names of other gazetteers to this one
+func (g *Gazetteer) Merge(others ...*Gazetteer) {
+	for _, other := range others {
+		for _, names := range other.names {
+			for _, name := range names {
+				g.Add(name.Name, name.Category)
+			}
+		}
+	}
+}
+
+/*
+Match returns the longest name that starts at tokens[start] and how many
+tokens it spans, or nil and 0.  The first token has to be capitalized, the
+others are compared ignoring case, trailing commas and quotes, and the last
+one may carry the period that ends a sentence.
+*/
+func (g *Gazetteer) Match(tokens []*Token, start int, first TokenFirst) (*GazetteerName, int) {
+	if start >= len(tokens) || !first.FirstUpper(tokens[start]) {
+		return nil, 0
+	}
+
+	for _, name := range g.names[nameWord(tokens[start].Tok)] {
+		if start+len(name.words) > len(tokens) {
+			continue
+		}
+
+		matched := true
+		for i, word := range name.words[1:] {
+			tok := nameWord(tokens[start+i+1].Tok)
+			last := i == len(name.words)-2
+			if tok != word && !(last && tok == word+".") {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return name, len(name.words)
+		}
+	}
+
+	// a single word name may end the sentence
+	word := nameWord(tokens[start].Tok)
+	if strings.HasSuffix(word, ".") {
+		for _, name := range g.names[strings.TrimSuffix(word, ".")] {
+			if len(name.words) == 1 {
+				return name, 1
+			}
+		}
+	}
+
+	return nil, 0
+}
+
+/*
+LoadGazetteer reads a gazetteer from a text file with one name per line.
+Lines starting with "# " are comments and a header in brackets sets the
+category of the names that follow it:
+
+	# people
+	[person]
+	Jane Page
+	Will Smith
+
+	[organization]
+	St. Louis Blues
+*/
+func LoadGazetteer(r io.Reader) (*Gazetteer, error) {
+	gazetteer := NewGazetteer()
+	category := ""
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || line == "#" || strings.HasPrefix(line, "# ") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			category = strings.TrimSpace(line[1 : len(line)-1])
+			if category == "" {
+				return nil, fmt.Errorf("gazetteer line %d: empty category", lineNum)
+			}
+			continue
+		}
+
+		gazetteer.Add(line, category)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return gazetteer, nil
+}
+
+/*
+LoadGazetteerJSON reads a gazetteer from JSON, either a list of names or an
+object of categories and their names:
+
+	{"person": ["Jane Page"], "place": ["St. Louis"]}
+*/
+func LoadGazetteerJSON(data []byte) (*Gazetteer, error) {
+	gazetteer := NewGazetteer()
+
+	var names []string
+	if err := json.Unmarshal(data, &names); err == nil {
+		for _, name := range names {
+			gazetteer.Add(name, "")
+		}
+		return gazetteer, nil
+	}
+
+	categories := map[string][]string{}
+	if err := json.Unmarshal(data, &categories); err != nil {
+		return nil, fmt.Errorf("gazetteer: expected a list of names or an object of categories: %v", err)
+	}
+
+	for category, names := range categories {
+		for _, name := range names {
+			gazetteer.Add(name, category)
+		}
+	}
+
+	return gazetteer, nil
+}
+
+/*
+GazetteerAnnotation revisits the sentence breaks around the names of a
+gazetteer:
+  - a break after an abbreviation or an initial is taken back before a name,
+    unless the name starts a paragraph or the abbreviation is terminal, e.g.
+    "etc." in "apples, pears, etc. London is".
+  - a name is never broken up, e.g. after "St." in "St. Louis Blues".
+
+It has to run after the annotations that decide on abbreviations.
+*/
+type GazetteerAnnotation struct {
+	*Gazetteer
+	TokenParser
+	*Storage
+}
+
+// WithStorage returns a copy of the annotation that reads the abbreviation classes of s
+func (a *GazetteerAnnotation) WithStorage(s *Storage) AnnotateTokens {
+	ann := *a
+	ann.Storage = s
+	return &ann
+}
+
+// Annotate takes back the sentence breaks a name does not support
+func (a *GazetteerAnnotation) Annotate(tokens []*Token) []*Token {
+	for i := 0; i < len(tokens); i++ {
+		name, length := a.Match(tokens, i, a.TokenParser)
+		if name == nil {
+			continue
+		}
+
+		if i > 0 {
+			prev := tokens[i-1]
+			terminal := a.Storage != nil && a.AbbrevClass(a.TokenParser.TypeNoPeriod(prev))&AbbrevTerminal != 0
+			if prev.SentBreak && (prev.Abbr || a.IsInitial(prev)) && !tokens[i].ParaStart && !terminal {
+				prev.SentBreak = false
+				prev.Abbr = true
+			}
+		}
+
+		for _, tok := range tokens[i : i+length-1] {
+			tok.SentBreak = false
+		}
+
+		i += length - 1
+	}
+
+	return tokens
+}
+
+/*
+WithGazetteer returns a tokenizer that does not start sentences at the names
+of g after abbreviations and never breaks inside them.  The annotation is
+added before a RealignAnnotation, or last if there is none.
+*/
+func (s *DefaultSentenceTokenizer) WithGazetteer(g *Gazetteer) *DefaultSentenceTokenizer {
+	return s.withAnnotation(&GazetteerAnnotation{g, s.WordTokenizer, s.Storage})
+}
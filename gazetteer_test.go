@@ -0,0 +1,117 @@
+package sentences
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const gazetteerText = `# names the english model has seen in lower case
+[person]
+Page
+Section Hughes
+
+[organization]
+Yahoo! Answers
+`
+
+func TestGazetteer(t *testing.T) {
+	t.Log("Tokenizer should not start sentences at the names of a gazetteer after abbreviations")
+
+	gazetteer, err := LoadGazetteer(strings.NewReader(gazetteerText))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenizer := loadTokenizer("data/english.json")
+	named := tokenizer.WithGazetteer(gazetteer)
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{
+			"The report was written by Mr. Page in May.",
+			[]string{"The report was written by Mr. Page in May."},
+		},
+		{
+			"The report was written by J. Page.",
+			[]string{"The report was written by J. Page."},
+		},
+		{
+			"The report was written by Dr. Section Hughes in May.",
+			[]string{"The report was written by Dr. Section Hughes in May."},
+		},
+		{
+			"I asked on Yahoo! Answers last year.",
+			[]string{"I asked on Yahoo! Answers last year."},
+		},
+		{
+			"The report was done. Page wrote it.",
+			[]string{"The report was done.", "Page wrote it."},
+		},
+	}
+
+	for _, test := range tests {
+		actual := sentenceTexts(named, test.text)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+	}
+
+	// the tokenizer it was added to is left alone
+	if len(sentenceTexts(tokenizer, tests[0].text)) != 2 {
+		t.Fatalf("WithGazetteer modified the tokenizer it was called on")
+	}
+
+	t.Log("A break after a terminal abbreviation should be kept before a name")
+
+	overlay, err := LoadOverlay(strings.NewReader("etc\n\n[AbbrevClasses]\netc terminal\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := "We sold apples, pears, etc. Page wrote the report."
+	expected := []string{"We sold apples, pears, etc.", "Page wrote the report."}
+	for _, terminal := range []*DefaultSentenceTokenizer{
+		tokenizer.WithOverlays(overlay).WithGazetteer(gazetteer),
+		named.WithOverlays(overlay),
+	} {
+		if actual := sentenceTexts(terminal, text); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+		}
+	}
+}
+
+func TestLoadGazetteerJSON(t *testing.T) {
+	t.Log("Gazetteers should load from a JSON list or an object of categories")
+
+	gazetteer, err := LoadGazetteerJSON([]byte(`{"person": ["Jane Page"], "place": ["St. Louis", "Jane Page"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gazetteer.Len() != 2 {
+		t.Fatalf("Actual: %d, Expected: 2", gazetteer.Len())
+	}
+
+	list, err := LoadGazetteerJSON([]byte(`["Page", "Jane Page"]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	word := NewWordTokenizer(NewPunctStrings())
+	tokens := word.Tokenize("Ask Jane Page.", false)
+	name, length := list.Match(tokens, 1, word)
+	if name == nil || name.Name != "Jane Page" || length != 2 {
+		t.Fatalf("Actual: %v %d, Expected: Jane Page 2", name, length)
+	}
+
+	if _, err := LoadGazetteerJSON([]byte(`{"person": "Jane Page"}`)); err == nil {
+		t.Fatalf("Expected an error for a category that is not a list")
+	}
+
+	if _, err := LoadGazetteer(strings.NewReader("Page\n[]\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("Expected an error on line 2, got: %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package german
+
+import (
+	"testing"
+)
+
+func compareSentences(t *testing.T, actualText string, expected []string, test string) bool {
+	actual := tokenizer.Tokenize(actualText)
+
+	if len(actual) != len(expected) {
+		t.Log(test)
+		t.Logf("Actual: %v\n", actual)
+		t.Errorf("Actual: %d, Expected: %d\n", len(actual), len(expected))
+		t.Log("===")
+		return false
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Log(test)
+			t.Errorf("Actual: [%s] Expected: [%s]\n", sent.Text, expected[index])
+			t.Log("===")
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestGoldenRules(t *testing.T) {
+	var actualText string
+	var expected []string
+	var test string
+
+	test = "1. Simple period to end sentence"
+	actualText = "Das ist ein Satz. Das ist noch ein Satz."
+	expected = []string{
+		"Das ist ein Satz.",
+		" Das ist noch ein Satz.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "2. Question mark to end sentence"
+	actualText = "Was ist das? Wer ist das?"
+	expected = []string{
+		"Was ist das?",
+		" Wer ist das?",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "3. Exclamation point to end sentence"
+	actualText = "Das ist toll! Wirklich toll!"
+	expected = []string{
+		"Das ist toll!",
+		" Wirklich toll!",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "4. Date with ordinal day"
+	actualText = "Er wurde am 14. Februar geboren. Seine Mutter war Lehrerin."
+	expected = []string{
+		"Er wurde am 14. Februar geboren.",
+		" Seine Mutter war Lehrerin.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "5. Ordinal before a known noun"
+	actualText = "Er belegte den 1. Platz. Seine Freunde jubelten."
+	expected = []string{
+		"Er belegte den 1. Platz.",
+		" Seine Freunde jubelten.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "6. Ordinal before a lower case word"
+	actualText = "Sie wurde 3. beim Marathon in Wien. Das war ein Erfolg."
+	expected = []string{
+		"Sie wurde 3. beim Marathon in Wien.",
+		" Das war ein Erfolg.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "7. Number at the end of a sentence"
+	actualText = "Das Spiel endete mit 3. Dann gingen alle nach Hause."
+	expected = []string{
+		"Das Spiel endete mit 3.",
+		" Dann gingen alle nach Hause.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "8. Abbreviation with a following capitalized noun"
+	actualText = "Er wohnt in der Berliner Str. 5 in Köln. Das ist schön."
+	expected = []string{
+		"Er wohnt in der Berliner Str. 5 in Köln.",
+		" Das ist schön.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "9. Abbreviation bzw. in the middle of a sentence"
+	actualText = "Das ist bzw. war richtig. Aber jetzt nicht mehr."
+	expected = []string{
+		"Das ist bzw. war richtig.",
+		" Aber jetzt nicht mehr.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "10. Low quotation marks at the end of a sentence"
+	actualText = "Er sagte: „Ich komme morgen.“ Dann ging er."
+	expected = []string{
+		"Er sagte: „Ich komme morgen.“",
+		" Dann ging er.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "11. Guillemets inside a sentence"
+	actualText = "»Wo bist du?« fragte sie. Er antwortete nicht."
+	expected = []string{
+		"»Wo bist du?« fragte sie.",
+		" Er antwortete nicht.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "12. Guillemets at the end of a sentence"
+	actualText = "Sie rief: »Komm sofort her!« Niemand kam."
+	expected = []string{
+		"Sie rief: »Komm sofort her!«",
+		" Niemand kam.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "13. Low quotation marks inside a sentence"
+	actualText = "„Das ist großartig!“ rief er. Alle lachten."
+	expected = []string{
+		"„Das ist großartig!“ rief er.",
+		" Alle lachten.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "14. Approximate number"
+	actualText = "Er ist ca. 30 Jahre alt. Sie ist jünger."
+	expected = []string{
+		"Er ist ca. 30 Jahre alt.",
+		" Sie ist jünger.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "15. Century"
+	actualText = "Das Schloss stammt aus dem 18. Jahrhundert. Es wurde 1990 renoviert."
+	expected = []string{
+		"Das Schloss stammt aus dem 18. Jahrhundert.",
+		" Es wurde 1990 renoviert.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "16. Abbreviation d.h. in the middle of a sentence"
+	actualText = "Wir fahren früh los, d.h. um sechs Uhr. Packt heute schon."
+	expected = []string{
+		"Wir fahren früh los, d.h. um sechs Uhr.",
+		" Packt heute schon.",
+	}
+	compareSentences(t, actualText, expected, test)
+}
@@ -0,0 +1,215 @@
+package german
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/neurosnap/sentences"
+	data "github.com/neurosnap/sentences/data/german"
+)
+
+type WordTokenizer struct {
+	sentences.DefaultWordTokenizer
+}
+
+// Nouns that commonly follow an ordinal number, e.g. "der 2. Weltkrieg"
+var ordinalNouns = map[string]bool{
+	"januar": true, "jänner": true, "februar": true, "märz": true,
+	"april": true, "mai": true, "juni": true, "juli": true,
+	"august": true, "september": true, "oktober": true, "november": true,
+	"dezember": true, "jahrhundert": true, "jahrtausend": true, "weltkrieg": true,
+	"mal": true, "platz": true, "rang": true, "klasse": true,
+	"liga": true, "bundesliga": true, "runde": true, "spieltag": true,
+	"auflage": true, "ausgabe": true, "kapitel": true, "stock": true,
+	"etage": true, "bezirk": true, "quartal": true, "semester": true,
+	"jahrestag": true, "geburtstag": true, "todestag": true, "mannschaft": true,
+}
+
+func init() {
+	sentences.RegisterLanguage("german", NewSentenceTokenizer)
+	sentences.RegisterAnnotator("german.ordinal", func(env *sentences.AnnotatorEnv, options json.RawMessage) (sentences.AnnotateTokens, error) {
+		return &OrdinalAnnotation{env.WordTokenizer, &sentences.DefaultTokenGrouper{}}, sentences.DecodeOptions(options, nil)
+	})
+	sentences.RegisterAnnotator("german.quote", func(env *sentences.AnnotatorEnv, options json.RawMessage) (sentences.AnnotateTokens, error) {
+		return &QuoteAnnotation{env.WordTokenizer, &sentences.DefaultTokenGrouper{}}, sentences.DecodeOptions(options, nil)
+	})
+}
+
+// German customized sentence tokenizer.
+func NewSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceTokenizer, error) {
+	training := s
+
+	if training == nil {
+		b, err := data.Asset("data/german.json")
+		if err != nil {
+			return nil, err
+		}
+
+		training, err = sentences.LoadTraining(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// supervisor abbreviations, layered on top so the caller's storage is untouched
+	supervised := sentences.NewOverlay()
+	abbrevs := []string{"z.b", "u.a", "bzw", "nr", "str", "d.h", "usw", "vgl", "ca", "evtl"}
+	for _, abbr := range abbrevs {
+		supervised.AbbrevTypes.Add(abbr)
+	}
+	training = training.WithOverlays(supervised)
+
+	lang := sentences.NewPunctStrings()
+	word := NewWordTokenizer(lang)
+	annotations := sentences.NewAnnotations(training, lang, word)
+
+	ordinal := &OrdinalAnnotation{
+		TokenParser:  word,
+		TokenGrouper: &sentences.DefaultTokenGrouper{},
+	}
+
+	quote := &QuoteAnnotation{
+		TokenParser:  word,
+		TokenGrouper: &sentences.DefaultTokenGrouper{},
+	}
+
+	annotations = append(annotations, ordinal, quote)
+
+	tokenizer := &sentences.DefaultSentenceTokenizer{
+		Storage:       training,
+		PunctStrings:  lang,
+		WordTokenizer: word,
+		Annotations:   annotations,
+	}
+
+	return tokenizer, nil
+}
+
+func NewWordTokenizer(p sentences.PunctStrings) *WordTokenizer {
+	word := &WordTokenizer{}
+	word.PunctStrings = p
+
+	return word
+}
+
+// Find any punctuation that might mean the end of a sentence but doesn't have to
+func (e *WordTokenizer) HasUnreliableEndChars(t *sentences.Token) bool {
+	enders := []string{
+		`."`, `.'`, `.)`, `.“`, `.”`, `.‘`, `.«`, `.»`, `.‹`, `.›`,
+		`?"`, `?'`, `?)`, `?“`, `?”`, `?‘`, `?«`, `?»`, `?‹`, `?›`,
+		`!"`, `!'`, `!)`, `!“`, `!”`, `!‘`, `!«`, `!»`, `!‹`, `!›`,
+	}
+	for _, ender := range enders {
+		if strings.HasSuffix(t.Tok, ender) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find any punctuation excluding the period final, German closes quotations
+// with “ (for „…“), ‘ (for ‚…‘) and either « or » for guillemets.
+func (e *WordTokenizer) HasSentEndChars(t *sentences.Token) bool {
+	enders := []string{
+		`."`, `.'`, `.)`, `.“`, `.”`, `.‘`, `.«`, `.»`, `.‹`, `.›`,
+		`?`, `?"`, `?'`, `?)`, `?“`, `?”`, `?‘`, `?«`, `?»`, `?‹`, `?›`,
+		`!`, `!"`, `!'`, `!)`, `!“`, `!”`, `!‘`, `!«`, `!»`, `!‹`, `!›`,
+	}
+
+	for _, ender := range enders {
+		if strings.HasSuffix(t.Tok, ender) {
+			return true
+		}
+	}
+
+	parens := []string{
+		`.[`, `.(`, `."`, `.'`,
+		`?[`, `?(`,
+		`![`, `!(`,
+	}
+
+	for _, paren := range parens {
+		if strings.Index(t.Tok, paren) != -1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+OrdinalAnnotation marks numbers followed by a period as ordinals when the
+context makes it clear, German writes "am 3. Oktober" and "der 2. Weltkrieg"
+where punkt would otherwise see a sentence ending number.  The period is an
+ordinal when the next word is lower case or is a noun that commonly follows
+an ordinal, e.g. a month.
+*/
+type OrdinalAnnotation struct {
+	sentences.TokenParser
+	sentences.TokenGrouper
+}
+
+func (a *OrdinalAnnotation) Annotate(tokens []*sentences.Token) []*sentences.Token {
+	for _, tokPair := range a.TokenGrouper.Group(tokens) {
+		if len(tokPair) < 2 || tokPair[1] == nil {
+			continue
+		}
+
+		a.tokenAnnotation(tokPair[0], tokPair[1])
+	}
+
+	return tokens
+}
+
+func (a *OrdinalAnnotation) tokenAnnotation(tokOne, tokTwo *sentences.Token) {
+	if !a.IsOrdinal(tokOne) {
+		return
+	}
+
+	nextTyp := strings.TrimRight(a.TokenParser.TypeNoPeriod(tokTwo), ",;:")
+	if !a.TokenParser.FirstLower(tokTwo) && !ordinalNouns[nextTyp] {
+		return
+	}
+
+	tokOne.SentBreak = false
+	tokOne.Abbr = true
+}
+
+// IsOrdinal is true if the token text is a number written with an ordinal period, e.g. "3."
+func (a *OrdinalAnnotation) IsOrdinal(t *sentences.Token) bool {
+	if !strings.HasSuffix(t.Tok, ".") || len(t.Tok) < 2 {
+		return false
+	}
+
+	for _, char := range t.Tok[:len(t.Tok)-1] {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+QuoteAnnotation removes sentence breaks after a quotation that ends in
+sentence punctuation when the sentence continues in lower case, e.g.
+»Wo bist du?« fragte sie.
+*/
+type QuoteAnnotation struct {
+	sentences.TokenParser
+	sentences.TokenGrouper
+}
+
+func (a *QuoteAnnotation) Annotate(tokens []*sentences.Token) []*sentences.Token {
+	for _, tokPair := range a.TokenGrouper.Group(tokens) {
+		if len(tokPair) < 2 || tokPair[1] == nil {
+			continue
+		}
+
+		if a.HasUnreliableEndChars(tokPair[0]) && a.FirstLower(tokPair[1]) {
+			tokPair[0].SentBreak = false
+		}
+	}
+
+	return tokens
+}
@@ -0,0 +1,53 @@
+package german
+
+import (
+	"testing"
+)
+
+var tokenizer, _ = NewSentenceTokenizer(nil)
+
+func TestGermanOrdinals(t *testing.T) {
+	t.Log("Tokenizer should not break on ordinal numbers ...")
+
+	actualText := "Wir treffen uns am 3. Oktober in Berlin. Der 2. Weltkrieg endete 1945. Danach begann der Wiederaufbau."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{
+		"Wir treffen uns am 3. Oktober in Berlin.",
+		" Der 2. Weltkrieg endete 1945.",
+		" Danach begann der Wiederaufbau.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+	}
+}
+
+func TestGermanSupervisedAbbrev(t *testing.T) {
+	t.Log("Tokenizer should detect list of supervised abbreviations.")
+
+	actualText := "Sie kaufte Obst, z.B. Äpfel und Birnen. Es kamen u.a. Vertreter der Presse. Das steht in Nr. 4 der Zeitschrift."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{
+		"Sie kaufte Obst, z.B. Äpfel und Birnen.",
+		" Es kamen u.a. Vertreter der Presse.",
+		" Das steht in Nr. 4 der Zeitschrift.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+	}
+}
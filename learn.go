@@ -0,0 +1,504 @@
+package sentences
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// GoldDocument is raw text together with the byte offsets where its sentences end
+type GoldDocument struct {
+	Text string
+	Ends []int
+}
+
+// NewGoldDocument joins gold sentences with a space
+func NewGoldDocument(sentences []string) GoldDocument {
+	doc := GoldDocument{Ends: make([]int, 0, len(sentences))}
+
+	var text strings.Builder
+	for index, sentence := range sentences {
+		if index > 0 {
+			text.WriteString(" ")
+		}
+		text.WriteString(sentence)
+		doc.Ends = append(doc.Ends, text.Len())
+	}
+	doc.Text = text.String()
+
+	return doc
+}
+
+/*
+AlignGold finds gold sentences in the raw text they were taken from.  Only
+the whitespace may differ between the two, e.g. a treebank that puts every
+sentence on one line of a wrapped text.
+*/
+func AlignGold(text string, sentences []string) (GoldDocument, error) {
+	doc := GoldDocument{Text: text, Ends: make([]int, 0, len(sentences))}
+
+	pos := 0
+	for index, sentence := range sentences {
+		for _, char := range sentence {
+			if unicode.IsSpace(char) {
+				continue
+			}
+
+			for pos < len(text) {
+				r, size := utf8.DecodeRuneInString(text[pos:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				pos += size
+			}
+
+			r, size := utf8.DecodeRuneInString(text[pos:])
+			if pos >= len(text) || r != char {
+				return doc, fmt.Errorf("gold sentence %d does not match the text at byte %d", index+1, pos)
+			}
+			pos += size
+		}
+
+		doc.Ends = append(doc.Ends, pos)
+	}
+
+	return doc, nil
+}
+
+/*
+ReadGoldLines reads gold data with one sentence per line.  A blank line ends a
+document, sentences of a document are joined with a space.
+*/
+func ReadGoldLines(r io.Reader) ([]GoldDocument, error) {
+	docs := []GoldDocument{}
+	sentences := []string{}
+
+	flush := func() {
+		if len(sentences) > 0 {
+			docs = append(docs, NewGoldDocument(sentences))
+			sentences = []string{}
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			flush()
+			continue
+		}
+		sentences = append(sentences, line)
+	}
+	flush()
+
+	return docs, scanner.Err()
+}
+
+/*
+ReadCoNLLU reads the "# text = " comment of every sentence in a CoNLL-U
+treebank.  A "# newdoc" comment starts a new document.
+*/
+func ReadCoNLLU(r io.Reader) ([]GoldDocument, error) {
+	docs := []GoldDocument{}
+	sentences := []string{}
+
+	flush := func() {
+		if len(sentences) > 0 {
+			docs = append(docs, NewGoldDocument(sentences))
+			sentences = []string{}
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "# newdoc"):
+			flush()
+		case strings.HasPrefix(line, "# text ="):
+			if text := strings.TrimSpace(strings.TrimPrefix(line, "# text =")); text != "" {
+				sentences = append(sentences, text)
+			}
+		}
+	}
+	flush()
+
+	return docs, scanner.Err()
+}
+
+// Accuracy counts the sentence break decisions of a tokenizer against gold data
+type Accuracy struct {
+	// Breaks is the number of gold sentence breaks
+	Breaks int `json:"breaks"`
+	// Predicted is the number of sentence breaks the tokenizer found
+	Predicted int `json:"predicted"`
+	// Correct is the number of predicted breaks that are gold breaks
+	Correct int `json:"correct"`
+	// PeriodTokens is the number of tokens ending in a period
+	PeriodTokens int `json:"period_tokens"`
+	// PeriodErrors is the number of those that got the wrong decision
+	PeriodErrors int `json:"period_errors"`
+}
+
+// Precision is the share of predicted breaks that are right
+func (a Accuracy) Precision() float64 {
+	if a.Predicted == 0 {
+		return 1
+	}
+	return float64(a.Correct) / float64(a.Predicted)
+}
+
+// Recall is the share of gold breaks that were found
+func (a Accuracy) Recall() float64 {
+	if a.Breaks == 0 {
+		return 1
+	}
+	return float64(a.Correct) / float64(a.Breaks)
+}
+
+// F1 is the harmonic mean of precision and recall
+func (a Accuracy) F1() float64 {
+	p, r := a.Precision(), a.Recall()
+	if p+r == 0 {
+		return 0
+	}
+	return 2 * p * r / (p + r)
+}
+
+// Errors is the number of false and missed breaks
+func (a Accuracy) Errors() int {
+	return (a.Predicted - a.Correct) + (a.Breaks - a.Correct)
+}
+
+// LearnedChange is an entry the learner added and the errors it fixed
+type LearnedChange struct {
+	Section string `json:"section"`
+	Entry   string `json:"entry"`
+	Fixed   int    `json:"fixed"`
+}
+
+// LearnResult is the outcome of supervised learning
+type LearnResult struct {
+	// Overlay holds the learned entries
+	Overlay *Overlay
+	// Storage is the training data of the tokenizer, with its overlays applied, and the learned entries added
+	Storage *Storage
+	Before  Accuracy
+	After   Accuracy
+	Changes []LearnedChange
+}
+
+/*
+Learner adjusts training data with gold segmented text.  It looks at every
+token ending in a period where the tokenizer breaks a sentence wrongly or
+misses a break and proposes abbreviations, collocations and sentence starters
+that would fix it.  A proposal is only kept if it lowers the number of errors
+without breaking a decision that was right before.  Proposals are first tried
+on the tokens around the ones they are looked up at, only the ones that fix
+something there are checked on all of the gold documents.
+*/
+type Learner struct {
+	Tokenizer *DefaultSentenceTokenizer
+	// MinErrors is the number of errors a proposal has to explain to be tried
+	MinErrors int
+}
+
+// NewLearner creates a learner for a tokenizer
+func NewLearner(tokenizer *DefaultSentenceTokenizer) *Learner {
+	return &Learner{Tokenizer: tokenizer, MinErrors: 1}
+}
+
+// learnError is a wrong decision, identified by its document and break position
+type learnError struct {
+	doc      int
+	position int
+}
+
+// learnSite is a token of a gold document, identified by its document and index
+type learnSite struct {
+	doc   int
+	index int
+}
+
+// proposal is an entry that could fix an error
+type proposal struct {
+	section string
+	entry   string
+}
+
+func (p proposal) apply(overlay *Overlay) {
+	switch p.section {
+	case "AbbrevTypes":
+		overlay.AbbrevTypes.Add(p.entry)
+	case "Collocations":
+		overlay.Collocations.Add(p.entry)
+	case "SentStarters":
+		overlay.SentStarters.Add(p.entry)
+	}
+}
+
+// proposals are the entries looked up for a token and the one after it
+func proposals(parser WordTokenizer, tok, next *Token) [3]proposal {
+	typ := parser.TypeNoPeriod(tok)
+	nextTyp := parser.TypeNoSentPeriod(next)
+
+	return [3]proposal{
+		{"AbbrevTypes", typ},
+		{"Collocations", typ + "," + nextTyp},
+		{"SentStarters", nextTyp},
+	}
+}
+
+// evaluation is how a tokenizer with an overlay decides the gold documents
+type evaluation struct {
+	acc       Accuracy
+	wrong     map[learnError]bool
+	proposals map[proposal]int
+	// tokens are the annotated tokens of every document, gold its sentence breaks
+	tokens [][]*Token
+	gold   []map[int]bool
+	// adapted is the overlay the adaptive mode put on every document, if it is on
+	adapted []*Overlay
+}
+
+// docTokenizer is the tokenizer with an overlay and the adaptive overlay of a document
+func (l *Learner) docTokenizer(overlay, adapted *Overlay) *DefaultSentenceTokenizer {
+	if adapted == nil {
+		return l.Tokenizer.WithOverlays(overlay)
+	}
+
+	tokenizer := l.Tokenizer.WithOverlays(overlay, adapted)
+	tokenizer.Adaptive = nil
+	return tokenizer
+}
+
+// evaluate runs the tokenizer with an overlay and proposes fixes for its errors
+func (l *Learner) evaluate(docs []GoldDocument, overlay *Overlay) *evaluation {
+	parser := l.Tokenizer.WordTokenizer
+	base := l.Tokenizer.WithOverlays(overlay)
+
+	ev := &evaluation{
+		wrong:     map[learnError]bool{},
+		proposals: map[proposal]int{},
+		tokens:    make([][]*Token, len(docs)),
+		gold:      make([]map[int]bool, len(docs)),
+		adapted:   make([]*Overlay, len(docs)),
+	}
+
+	for index, doc := range docs {
+		gold := map[int]bool{}
+		for _, end := range doc.Ends {
+			// the end of the text is not a decision
+			if end < len(strings.TrimRightFunc(doc.Text, unicode.IsSpace)) {
+				gold[end] = true
+			}
+		}
+		ev.acc.Breaks += len(gold)
+
+		if base.Adaptive != nil {
+			ev.adapted[index] = base.Adaptive.Overlay(base, doc.Text)
+		}
+
+		tokens := l.docTokenizer(overlay, ev.adapted[index]).AnnotatedTokens(doc.Text)
+		ev.tokens[index], ev.gold[index] = tokens, gold
+
+		for i, tok := range tokens {
+			if i == len(tokens)-1 {
+				break
+			}
+			next := tokens[i+1]
+
+			isGold := gold[tok.Position]
+			if tok.SentBreak {
+				ev.acc.Predicted++
+				if isGold {
+					ev.acc.Correct++
+				}
+			}
+
+			if tok.SentBreak != isGold {
+				ev.wrong[learnError{index, tok.Position}] = true
+			}
+
+			if !parser.HasPeriodFinal(tok) {
+				continue
+			}
+
+			ev.acc.PeriodTokens++
+			if tok.SentBreak == isGold {
+				continue
+			}
+			ev.acc.PeriodErrors++
+
+			proposed := proposals(parser, tok, next)
+			if tok.SentBreak {
+				ev.proposals[proposed[0]]++
+				ev.proposals[proposed[1]]++
+			} else if parser.FirstUpper(next) {
+				ev.proposals[proposed[2]]++
+			}
+		}
+	}
+
+	return ev
+}
+
+// sites returns the tokens every candidate would be looked up for
+func (ev *evaluation) sites(parser WordTokenizer, candidates []proposal) map[proposal][]learnSite {
+	sites := make(map[proposal][]learnSite, len(candidates))
+	for _, p := range candidates {
+		sites[p] = nil
+	}
+
+	for doc, tokens := range ev.tokens {
+		for i := 0; i < len(tokens)-1; i++ {
+			for _, p := range proposals(parser, tokens[i], tokens[i+1]) {
+				if found, ok := sites[p]; ok {
+					sites[p] = append(found, learnSite{doc, i})
+				}
+			}
+		}
+	}
+
+	return sites
+}
+
+/*
+score tokenizes the text around every site with the overlay and with the trial
+overlay, and counts the decisions of the tokens next to the sites the trial
+fixes and breaks.
+*/
+func (l *Learner) score(docs []GoldDocument, ev *evaluation, overlay, trial *Overlay, sites []learnSite) (int, int) {
+	fixed, broken := 0, 0
+
+	for start := 0; start < len(sites); {
+		doc := sites[start].doc
+		tokens, gold := ev.tokens[doc], ev.gold[doc]
+		current := l.docTokenizer(overlay, ev.adapted[doc])
+		tried := l.docTokenizer(trial, ev.adapted[doc])
+
+		end := start
+		for end < len(sites) && sites[end].doc == doc {
+			// the token before a site, the site and the token after it, joined with the next site's if they touch
+			from, to := sites[end].index-1, sites[end].index+2
+			if from < 0 {
+				from = 0
+			}
+			for end++; end < len(sites) && sites[end].doc == doc && sites[end].index-1 <= to; end++ {
+				to = sites[end].index + 2
+			}
+			if to > len(tokens) {
+				to = len(tokens)
+			}
+
+			before := current.spanBreaks(docs[doc].Text, tokens, from, to)
+			after := tried.spanBreaks(docs[doc].Text, tokens, from, to)
+			for _, tok := range tokens[from:to] {
+				if before[tok.Position] == after[tok.Position] || tok == tokens[len(tokens)-1] {
+					continue
+				}
+				if after[tok.Position] == gold[tok.Position] {
+					fixed++
+				} else {
+					broken++
+				}
+			}
+		}
+
+		start = end
+	}
+
+	return fixed, broken
+}
+
+// Learn finds the entries that fix errors on the gold documents
+func (l *Learner) Learn(docs []GoldDocument) *LearnResult {
+	overlay := NewOverlay()
+	ev := l.evaluate(docs, overlay)
+
+	result := &LearnResult{Before: ev.acc, After: ev.acc, Changes: []LearnedChange{}}
+	tried := map[proposal]bool{}
+
+	for {
+		candidates := make([]proposal, 0, len(ev.proposals))
+		for p, count := range ev.proposals {
+			if count >= l.MinErrors && !tried[p] {
+				candidates = append(candidates, p)
+			}
+		}
+
+		// the proposals that explain the most errors first, ties in a stable order
+		sort.Slice(candidates, func(i, j int) bool {
+			a, b := candidates[i], candidates[j]
+			if ev.proposals[a] != ev.proposals[b] {
+				return ev.proposals[a] > ev.proposals[b]
+			}
+			if a.section != b.section {
+				return a.section < b.section
+			}
+			return a.entry < b.entry
+		})
+
+		sites := ev.sites(l.Tokenizer.WordTokenizer, candidates)
+
+		accepted := false
+		for _, p := range candidates {
+			tried[p] = true
+
+			trial := overlay.copy()
+			p.apply(trial)
+
+			if fixed, broken := l.score(docs, ev, overlay, trial, sites[p]); fixed == 0 || broken > 0 {
+				continue
+			}
+
+			trialEv := l.evaluate(docs, trial)
+			if trialEv.acc.Errors() >= result.After.Errors() || regresses(ev.wrong, trialEv.wrong) {
+				continue
+			}
+
+			result.Changes = append(result.Changes, LearnedChange{
+				Section: p.section,
+				Entry:   p.entry,
+				Fixed:   result.After.Errors() - trialEv.acc.Errors(),
+			})
+
+			overlay, ev = trial, trialEv
+			result.After = ev.acc
+			accepted = true
+			break
+		}
+
+		if !accepted {
+			break
+		}
+	}
+
+	result.Overlay = overlay
+	result.Storage = l.Tokenizer.Storage.Flatten()
+	for _, change := range result.Changes {
+		section, _ := result.Storage.Section(change.Section)
+		section.Add(change.Entry)
+	}
+	result.Storage.UpdateChecksum()
+
+	return result
+}
+
+// regresses is true if a decision that was right before is wrong now
+func regresses(before, after map[learnError]bool) bool {
+	for err := range after {
+		if !before[err] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package sentences
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// loadGold aligns the expected sentences of the english test files with their text
+func loadGold(t *testing.T) []GoldDocument {
+	expected, _ := filepath.Glob("test_files/english/*_s.txt")
+	docs := make([]GoldDocument, 0, len(expected))
+
+	for _, fname := range expected {
+		text := readFile(strings.Replace(fname, "_s.txt", ".txt", 1))
+		sentences := strings.Split(readFile(fname), "{{sentence_break}}")
+
+		doc, err := AlignGold(text, sentences)
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs
+}
+
+func TestLearner(t *testing.T) {
+	t.Log("Learner should find the abbreviations missing from the training data")
+
+	storage := loadStorage("english").Expand()
+	storage.AbbrevTypes.Remove("dr")
+	storage.AbbrevTypes.Remove("u.s")
+
+	supervised := NewOverlay()
+	supervised.AbbrevTypes.Add("sgt")
+	supervised.AbbrevTypes.Remove("etc")
+
+	result := NewLearner(NewSentenceTokenizer(storage).WithOverlays(supervised)).Learn(loadGold(t))
+
+	if result.After.Errors() >= result.Before.Errors() {
+		t.Fatalf("Actual: %d errors, Expected: fewer than %d", result.After.Errors(), result.Before.Errors())
+	}
+
+	if result.After.Correct < result.Before.Correct {
+		t.Fatalf("Actual: %d correct breaks, Expected: at least %d", result.After.Correct, result.Before.Correct)
+	}
+
+	for _, abbr := range []string{"dr", "u.s"} {
+		if !result.Overlay.AbbrevTypes[abbr] || !result.Storage.AbbrevTypes.Has(abbr) {
+			t.Fatalf("Abbreviation %s was not learned: %+v", abbr, result.Changes)
+		}
+	}
+
+	if !result.Storage.AbbrevTypes.Has("sgt") || result.Storage.AbbrevTypes.Has("etc") {
+		t.Fatalf("The overlays of the tokenizer should be applied to the learned training data")
+	}
+
+	if result.Storage.Validate() != nil || storage.AbbrevTypes.Has("dr") {
+		t.Fatalf("Learner should return valid training data and leave the tokenizer's untouched")
+	}
+}
+
+func TestReadGold(t *testing.T) {
+	t.Log("Gold readers should find sentences and where they end")
+
+	docs, err := ReadCoNLLU(strings.NewReader(`# newdoc id = a
+# sent_id = 1
+# text = Dr. Smith left.
+1	Dr.	Dr.	PROPN	_	_	0	root	_	_
+
+# sent_id = 2
+# text = He came back.
+1	He	he	PRON	_	_	0	root	_	_
+
+# newdoc id = b
+# text = Hello.
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) != 2 || docs[0].Text != "Dr. Smith left. He came back." || docs[0].Ends[0] != 15 {
+		t.Fatalf("Actual: %+v", docs)
+	}
+
+	docs, err = ReadGoldLines(strings.NewReader("One.\nTwo.\n\nThree.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) != 2 || docs[0].Text != "One. Two." || docs[1].Text != "Three." {
+		t.Fatalf("Actual: %+v", docs)
+	}
+
+	if _, err := AlignGold("One. Two.", []string{"One.", "Three."}); err == nil {
+		t.Fatalf("Expected an error for a sentence that is not in the text")
+	}
+}
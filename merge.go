@@ -0,0 +1,216 @@
+package sentences
+
+import (
+	"sort"
+	"strings"
+)
+
+// SetRule decides which abbreviations or sentence starters a merged model keeps
+type SetRule int
+
+const (
+	// MergeUnion keeps the entries of any model
+	MergeUnion SetRule = iota
+	// MergeIntersection keeps the entries every model has
+	MergeIntersection
+)
+
+// OrthoRule decides which orthographic flags a merged model keeps
+type OrthoRule int
+
+const (
+	// OrthoOr keeps the flags any model has seen
+	OrthoOr OrthoRule = iota
+	/*
+		OrthoMajority keeps a flag if the models that have seen it outweigh
+		the ones that know the word type without it.  Models that do not know
+		the word type do not vote.
+	*/
+	OrthoMajority
+)
+
+// CollocationRule decides which collocations a merged model keeps
+type CollocationRule int
+
+const (
+	/*
+		CollocationPriority keeps a collocation if the most trusted model that
+		has seen both of its word types has it.
+	*/
+	CollocationPriority CollocationRule = iota
+	// CollocationUnion keeps the collocations of any model
+	CollocationUnion
+)
+
+// MergeRules are the rules MergeStorages combines every section with
+type MergeRules struct {
+	AbbrevTypes  SetRule
+	SentStarters SetRule
+	OrthoContext OrthoRule
+	Collocations CollocationRule
+}
+
+// NewMergeRules keeps every abbreviation and sentence starter and lets weights decide the rest
+func NewMergeRules() *MergeRules {
+	return &MergeRules{
+		AbbrevTypes:  MergeUnion,
+		SentStarters: MergeUnion,
+		OrthoContext: OrthoMajority,
+		Collocations: CollocationPriority,
+	}
+}
+
+// WeightedStorage is a model and how much it is trusted when merging
+type WeightedStorage struct {
+	*Storage
+	Weight float64
+}
+
+/*
+MergeStorages combines the base training data of several models, e.g. a
+general model and a small one for a domain, into a new Storage.  The weights
+only matter for the rules that vote or pick a source, a model with a higher
+weight wins over a lower one and models of the same weight are trusted in
+the order they are given.  An abbreviation the merged model keeps gets the
+classes of the most trusted model that classifies it.  The merged model has
+no metadata.
+*/
+func MergeStorages(rules *MergeRules, sources ...WeightedStorage) *Storage {
+	merged := NewStorage()
+	if len(sources) == 0 {
+		return merged
+	}
+
+	expanded := make([]WeightedStorage, len(sources))
+	for index, source := range sources {
+		expanded[index] = WeightedStorage{source.Storage.Expand(), source.Weight}
+	}
+	// the most trusted first
+	sort.SliceStable(expanded, func(i, j int) bool {
+		return expanded[i].Weight > expanded[j].Weight
+	})
+
+	mergeSet(merged.AbbrevTypes, rules.AbbrevTypes, expanded, func(s *Storage) SetString { return s.AbbrevTypes })
+	mergeSet(merged.SentStarters, rules.SentStarters, expanded, func(s *Storage) SetString { return s.SentStarters })
+	mergeOrtho(merged.OrthoContext, rules.OrthoContext, expanded)
+	mergeCollocations(merged.Collocations, rules.Collocations, expanded)
+	mergeClasses(merged, expanded)
+
+	return merged
+}
+
+func mergeClasses(merged *Storage, sources []WeightedStorage) {
+	for _, source := range sources {
+		for typ, classes := range source.AbbrevClasses {
+			if classes == 0 || !merged.AbbrevTypes.Has(typ) {
+				continue
+			}
+			if merged.AbbrevClasses == nil {
+				merged.AbbrevClasses = SetString{}
+			}
+			// the first, most trusted, model that classifies it decides
+			if _, ok := merged.AbbrevClasses[typ]; !ok {
+				merged.AbbrevClasses[typ] = classes
+			}
+		}
+	}
+}
+
+func mergeSet(merged SetString, rule SetRule, sources []WeightedStorage, section func(*Storage) SetString) {
+	for key := range section(sources[0].Storage) {
+		merged.Add(key)
+	}
+
+	for _, source := range sources[1:] {
+		set := section(source.Storage)
+
+		switch rule {
+		case MergeUnion:
+			for key := range set {
+				merged.Add(key)
+			}
+		case MergeIntersection:
+			for key := range merged {
+				if !set.Has(key) {
+					merged.Remove(key)
+				}
+			}
+		}
+	}
+}
+
+func mergeOrtho(merged SetString, rule OrthoRule, sources []WeightedStorage) {
+	if rule == OrthoOr {
+		for _, source := range sources {
+			for typ, flags := range source.OrthoContext {
+				merged[typ] |= flags
+			}
+		}
+		return
+	}
+
+	types := map[string]bool{}
+	for _, source := range sources {
+		for typ := range source.OrthoContext {
+			types[typ] = true
+		}
+	}
+
+	for typ := range types {
+		var voters float64
+		votes := map[int]float64{}
+
+		for _, source := range sources {
+			flags := source.OrthoContext[typ]
+			if flags == 0 {
+				continue
+			}
+
+			voters += source.Weight
+			for _, flag := range orthoNames {
+				if flags&flag.flag != 0 {
+					votes[flag.flag] += source.Weight
+				}
+			}
+		}
+
+		flags := 0
+		for flag, weight := range votes {
+			// half of the votes keeps a flag, two models of the same weight do not cancel out
+			if weight*2 >= voters {
+				flags |= flag
+			}
+		}
+
+		if flags != 0 {
+			merged[typ] = flags
+		}
+	}
+}
+
+func mergeCollocations(merged SetString, rule CollocationRule, sources []WeightedStorage) {
+	for _, source := range sources {
+		for key := range source.Collocations {
+			if rule == CollocationUnion || merged.Has(key) {
+				merged.Add(key)
+				continue
+			}
+
+			pair := strings.SplitN(key, ",", 2)
+			if len(pair) != 2 {
+				continue
+			}
+
+			// the most trusted model that knows both word types decides
+			for _, judge := range sources {
+				if judge.Collocations.Has(key) {
+					merged.Add(key)
+					break
+				}
+				if judge.OrthoContext[pair[0]] != 0 && judge.OrthoContext[pair[1]] != 0 {
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package sentences
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// sentenceTexts are the trimmed sentences of a text
+func sentenceTexts(tokenizer *DefaultSentenceTokenizer, text string) []string {
+	texts := []string{}
+	for _, sentence := range tokenizer.Tokenize(text) {
+		texts = append(texts, strings.TrimSpace(sentence.Text))
+	}
+	return texts
+}
+
+func TestMergeStorages(t *testing.T) {
+	t.Log("A merged model should keep the golden behaviour of every domain")
+
+	english := loadStorage("english")
+	legal, err := LoadTraining([]byte(readFile("test_files/legal/legal.json")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	merged := NewSentenceTokenizer(MergeStorages(NewMergeRules(),
+		WeightedStorage{english, 1},
+		WeightedStorage{legal, 2},
+	))
+
+	brief := readFile("test_files/legal/brief.txt")
+	expected := []string{}
+	for _, sentence := range strings.Split(readFile("test_files/legal/brief_s.txt"), "{{sentence_break}}") {
+		expected = append(expected, strings.TrimSpace(sentence))
+	}
+
+	if actual := sentenceTexts(NewSentenceTokenizer(english), brief); strings.Join(actual, "|") == strings.Join(expected, "|") {
+		t.Fatalf("The english model alone should not handle the legal citations")
+	}
+
+	if actual := sentenceTexts(merged, brief); strings.Join(actual, "|") != strings.Join(expected, "|") {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+
+	files, _ := filepath.Glob("test_files/english/*_s.txt")
+	tokenizer := NewSentenceTokenizer(english)
+	for _, fname := range files {
+		text := readFile(strings.Replace(fname, "_s.txt", ".txt", 1))
+
+		expected := sentenceTexts(tokenizer, text)
+		actual := sentenceTexts(merged, text)
+		if strings.Join(actual, "|") != strings.Join(expected, "|") {
+			t.Fatalf("%s: the merged model should split it like the english model", fname)
+		}
+	}
+}
+
+func TestMergeRules(t *testing.T) {
+	t.Log("Every section should be merged by its rule")
+
+	general := NewStorage()
+	general.AbbrevTypes.Add("dr")
+	general.AbbrevTypes.Add("sec")
+	general.SentStarters.Add("however")
+	general.OrthoContext["court"] = orthoBegUc | orthoMidLc
+	general.OrthoContext["plaintiff"] = orthoMidLc
+	general.OrthoContext["v"] = orthoMidLc
+	general.Collocations.Add("st,louis")
+	general.Collocations.Add("court,v")
+
+	domain := NewStorage()
+	domain.AbbrevTypes.Add("sec")
+	domain.AbbrevTypes.Add("para")
+	domain.OrthoContext["court"] = orthoMidUc | orthoMidLc
+	domain.OrthoContext["v"] = orthoMidLc
+	domain.OrthoContext["bar"] = orthoMidUc
+	domain.Collocations.Add("##number##,u.s.c")
+
+	sources := []WeightedStorage{{general, 1}, {domain, 2}}
+
+	merged := MergeStorages(NewMergeRules(), sources...)
+
+	if !merged.IsAbbr("dr") || !merged.IsAbbr("para") || !merged.IsSentStarter("however") {
+		t.Fatalf("A union should keep the entries of every model")
+	}
+
+	// the domain model outweighs the general one for the flags they disagree on
+	if flags := merged.OrthoFlags("court"); flags != orthoMidUc|orthoMidLc {
+		t.Fatalf("Actual: %v, Expected: [MID_UC MID_LC]", OrthoNames(flags))
+	}
+
+	// a model that does not know a word does not vote against its flags
+	if merged.OrthoFlags("plaintiff") != orthoMidLc || merged.OrthoFlags("bar") != orthoMidUc {
+		t.Fatalf("Actual: %d %d", merged.OrthoFlags("plaintiff"), merged.OrthoFlags("bar"))
+	}
+
+	// the domain model knows both words of "court,v" and does not have it
+	if merged.IsCollocation("court", "v") || !merged.IsCollocation("st", "louis") || !merged.IsCollocation("##number##", "u.s.c") {
+		t.Fatalf("Actual: %v", merged.Collocations)
+	}
+
+	rules := &MergeRules{
+		AbbrevTypes:  MergeIntersection,
+		SentStarters: MergeIntersection,
+		OrthoContext: OrthoOr,
+		Collocations: CollocationUnion,
+	}
+	merged = MergeStorages(rules, sources...)
+
+	if merged.IsAbbr("dr") || merged.IsAbbr("para") || !merged.IsAbbr("sec") || merged.IsSentStarter("however") {
+		t.Fatalf("An intersection should keep the entries every model has: %v", merged.AbbrevTypes)
+	}
+
+	if flags := merged.OrthoFlags("court"); flags != orthoBegUc|orthoMidUc|orthoMidLc {
+		t.Fatalf("Actual: %v, Expected: [BEG_UC MID_UC MID_LC]", OrthoNames(flags))
+	}
+
+	if !merged.IsCollocation("court", "v") {
+		t.Fatalf("A union should keep the collocations of every model")
+	}
+
+	if general.IsAbbr("para") || len(domain.Collocations) != 1 {
+		t.Fatalf("The merged models should not be modified")
+	}
+}
@@ -0,0 +1,145 @@
+package sentences
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// StorageVersion is the newest version of the training data format this package reads
+const StorageVersion = 1
+
+/*
+Metadata records where a model came from.  It is optional, models without it
+load as version 1, but when it is present the loader refuses models of a
+newer format version and models whose checksum does not match their data.
+*/
+type Metadata struct {
+	// Version of the training data format
+	Version int `json:"version"`
+	// Lang is the language code of the model, e.g. en
+	Lang string `json:"lang,omitempty"`
+	// Corpus names the text the model was trained on
+	Corpus string `json:"corpus,omitempty"`
+	// Tokens is the number of tokens in the training corpus
+	Tokens int `json:"tokens,omitempty"`
+	// Tool is the program, and its version, that created the model
+	Tool string `json:"tool,omitempty"`
+	// Checksum of the training data as returned by Storage.Checksum
+	Checksum string `json:"checksum,omitempty"`
+}
+
+/*
+Checksum is a sha256 digest of the base training data.  It does not depend on
+map order, the metadata or the overlays.
+*/
+func (p *Storage) Checksum() string {
+	base := p
+	if p.tables != nil {
+		base = p.Expand()
+	}
+
+	sections := struct {
+		AbbrevTypes  SetString
+		Collocations SetString
+		SentStarters SetString
+		OrthoContext SetString
+		// left out when empty so models without classes keep their checksum
+		AbbrevClasses SetString `json:",omitempty"`
+	}{base.AbbrevTypes, base.Collocations, base.SentStarters, base.OrthoContext, base.AbbrevClasses}
+
+	// maps are encoded with sorted keys
+	b, _ := json.Marshal(sections)
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// UpdateChecksum records the checksum of the current training data in the metadata, if there is any
+func (p *Storage) UpdateChecksum() {
+	if p.Metadata != nil {
+		p.Metadata.Checksum = p.Checksum()
+	}
+}
+
+/*
+Validate checks that the training data is complete and well formed: every
+section is present, collocations are two word types separated by a comma,
+orthographic contexts only use known flags, abbreviation classes only belong
+to abbreviations and the metadata, if any, is of a supported version with a
+matching checksum.  Errors are reported for the first problem in sorted order
+so they are the same on every run.
+*/
+func (p *Storage) Validate() error {
+	base := p
+	if p.tables != nil {
+		base = p.Expand()
+	}
+
+	sections := []struct {
+		name string
+		set  SetString
+	}{
+		{"AbbrevTypes", base.AbbrevTypes},
+		{"Collocations", base.Collocations},
+		{"SentStarters", base.SentStarters},
+		{"OrthoContext", base.OrthoContext},
+	}
+
+	for _, section := range sections {
+		if section.set == nil {
+			return fmt.Errorf("training data is missing the %s section", section.name)
+		}
+	}
+
+	for _, colloc := range base.Collocations.Array() {
+		parts := strings.Split(colloc, ",")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("Collocations[%q] is not two word types separated by a comma", colloc)
+		}
+	}
+
+	typs := make([]string, 0, len(base.OrthoContext))
+	for typ, flags := range base.OrthoContext {
+		if flags&^orthoAll != 0 || flags < 0 {
+			typs = append(typs, typ)
+		}
+	}
+	sort.Strings(typs)
+	if len(typs) > 0 {
+		flags := base.OrthoContext[typs[0]]
+		return fmt.Errorf("OrthoContext[%q] has flags %d, only the bits of %d (%s) are orthographic contexts",
+			typs[0], flags, orthoAll, strings.Join(OrthoNames(orthoAll), "|"))
+	}
+
+	for _, typ := range base.AbbrevClasses.Array() {
+		classes := base.AbbrevClasses[typ]
+		if classes&^abbrevClassAll != 0 || classes < 0 {
+			return fmt.Errorf("AbbrevClasses[%q] is %d, only the bits of %d (%s) are abbreviation classes",
+				typ, classes, abbrevClassAll, strings.Join(AbbrevClassNames(abbrevClassAll), "|"))
+		}
+		if classes != 0 && !base.AbbrevTypes.Has(typ) {
+			return fmt.Errorf("AbbrevClasses[%q] is not in AbbrevTypes, only abbreviations have classes", typ)
+		}
+	}
+
+	if p.Metadata == nil {
+		return nil
+	}
+
+	if p.Metadata.Version < 1 || p.Metadata.Version > StorageVersion {
+		return fmt.Errorf("training data format version %d is not supported, this package reads up to version %d",
+			p.Metadata.Version, StorageVersion)
+	}
+
+	if p.Metadata.Checksum != "" {
+		if sum := p.Checksum(); sum != p.Metadata.Checksum {
+			return fmt.Errorf("training data checksum %s does not match the metadata %s, it was modified after the checksum was recorded",
+				sum, p.Metadata.Checksum)
+		}
+	}
+
+	return nil
+}
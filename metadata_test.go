@@ -0,0 +1,110 @@
+package sentences
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadTrainingStrict(t *testing.T) {
+	t.Log("Loading training data should reject malformed models with a descriptive error")
+
+	tests := []struct {
+		data     string
+		expected string
+	}{
+		{
+			`{"AbbrevType": {}, "Collocations": {}, "SentStarters": {}, "OrthoContext": {}}`,
+			`unknown field "AbbrevType"`,
+		},
+		{
+			`{"AbbrevTypes": {}, "SentStarters": {}, "OrthoContext": {}}`,
+			"missing the Collocations section",
+		},
+		{
+			`{"AbbrevTypes": {}, "Collocations": {}, "SentStarters": {}, "OrthoContext": {"apple": 32, "pear": 129}}`,
+			`OrthoContext["pear"] has flags 129`,
+		},
+		{
+			`{"AbbrevTypes": {}, "Collocations": {"new york": 1}, "SentStarters": {}, "OrthoContext": {}}`,
+			`Collocations["new york"] is not two word types`,
+		},
+		{
+			`{"AbbrevTypes": {}, "Collocations": {",york": 1}, "SentStarters": {}, "OrthoContext": {}}`,
+			`Collocations[",york"] is not two word types`,
+		},
+		{
+			`{"AbbrevTypes": {}, "Collocations": {}, "SentStarters": {}, "OrthoContext": {}, "Metadata": {"version": 2}}`,
+			"format version 2 is not supported",
+		},
+		{
+			`{"AbbrevTypes": {"etc": 1}, "Collocations": {}, "SentStarters": {}, "OrthoContext": {}, "Metadata": {"version": 1, "checksum": "sha256:00"}}`,
+			"does not match the metadata sha256:00",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := LoadTraining([]byte(test.data))
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Fatalf("Actual: %v, Expected: %s", err, test.expected)
+		}
+	}
+
+	for _, lang := range binaryLanguages {
+		if err := loadStorage(lang).Validate(); err != nil {
+			t.Fatalf("%s: %v", lang, err)
+		}
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	t.Log("Metadata should survive JSON and binary encoding and guard the checksum")
+
+	storage := loadStorage("english")
+	storage.Metadata = &Metadata{
+		Version: StorageVersion,
+		Lang:    "en",
+		Corpus:  "test",
+		Tokens:  42,
+		Tool:    "sentences",
+	}
+	storage.UpdateChecksum()
+
+	b, err := json.Marshal(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromJSON, err := LoadTraining(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(fromJSON.Metadata, storage.Metadata) {
+		t.Fatalf("Actual: %+v, Expected: %+v", fromJSON.Metadata, storage.Metadata)
+	}
+
+	b, err = fromJSON.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromBinary, err := LoadBinary(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(fromBinary.Metadata, storage.Metadata) {
+		t.Fatalf("Actual: %+v, Expected: %+v", fromBinary.Metadata, storage.Metadata)
+	}
+
+	if err := fromBinary.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	fromJSON.AbbrevTypes.Add("capt")
+	if err := fromJSON.Validate(); err == nil {
+		t.Fatalf("Expected a checksum error after modifying the training data")
+	}
+}
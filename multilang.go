@@ -0,0 +1,220 @@
+package sentences
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// LanguageDetector decides which language a span of text is written in.  It
+// returns a language tag or an empty string when there is no evidence.
+type LanguageDetector interface {
+	Detect(string) string
+}
+
+/*
+StorageDetector is the default LanguageDetector, it scores a span of text
+against the vocabulary every Storage has seen during training (the keys of
+OrthoContext) and picks the language that knows the most words.  A word
+known to several models is split evenly between them, so large models do not
+win just because they have seen a few words of every other language.
+*/
+type StorageDetector struct {
+	Models map[string]*Storage
+}
+
+// NewStorageDetector creates a detector over a set of training models keyed by language tag
+func NewStorageDetector(models map[string]*Storage) *StorageDetector {
+	return &StorageDetector{models}
+}
+
+// Detect returns the language whose training data knows the most words in text
+func (d *StorageDetector) Detect(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '-' && r != '\''
+	})
+
+	if len(words) == 0 {
+		return ""
+	}
+
+	// iterate in a stable order so ties always resolve the same way
+	langs := make([]string, 0, len(d.Models))
+	for lang := range d.Models {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	scores := make(map[string]float64, len(langs))
+	for _, word := range words {
+		known := make([]string, 0, len(langs))
+		for _, lang := range langs {
+			if d.Models[lang].OrthoFlags(word) != 0 {
+				known = append(known, lang)
+			}
+		}
+
+		for _, lang := range known {
+			scores[lang] += 1 / float64(len(known))
+		}
+	}
+
+	best := ""
+	bestScore := 0.0
+	for _, lang := range langs {
+		if scores[lang] > bestScore {
+			best = lang
+			bestScore = scores[lang]
+		}
+	}
+
+	return best
+}
+
+// LangSpan marks the byte range [Start:End] of a text as written in Lang.
+type LangSpan struct {
+	Start int
+	End   int
+	Lang  string
+}
+
+/*
+MultiLangTokenizer is a composite sentence tokenizer for documents that mix
+languages.  It splits text into paragraphs, picks a language for each one and
+hands every run of same-language paragraphs to the tokenizer for that
+language.  Sentence offsets are translated back into positions in the
+original text and every sentence is tagged with the language that was used.
+*/
+type MultiLangTokenizer struct {
+	Tokenizers map[string]SentenceTokenizer
+	// Default is the language used when the detector has no evidence or
+	// finds a language there is no tokenizer for, it must be in Tokenizers.
+	Default string
+	LanguageDetector
+	WordTokenizer
+}
+
+// NewMultiLangTokenizer creates a composite tokenizer that detects languages with
+// the training data of each tokenizer.  The default language needs a tokenizer.
+func NewMultiLangTokenizer(tokenizers map[string]*DefaultSentenceTokenizer, def string) (*MultiLangTokenizer, error) {
+	if tokenizers[def] == nil {
+		return nil, fmt.Errorf("there is no tokenizer for the default language %q", def)
+	}
+
+	models := make(map[string]*Storage, len(tokenizers))
+	toks := make(map[string]SentenceTokenizer, len(tokenizers))
+	for lang, tokenizer := range tokenizers {
+		models[lang] = tokenizer.Storage
+		toks[lang] = tokenizer
+	}
+
+	return &MultiLangTokenizer{
+		Tokenizers:       toks,
+		Default:          def,
+		LanguageDetector: NewStorageDetector(models),
+		WordTokenizer:    NewWordTokenizer(NewPunctStrings()),
+	}, nil
+}
+
+// AnnotateTokens annotates tokens with the default language tokenizer
+func (m *MultiLangTokenizer) AnnotateTokens(tokens []*Token, annotate ...AnnotateTokens) []*Token {
+	return m.tokenizer(m.Default).AnnotateTokens(tokens, annotate...)
+}
+
+/*
+Paragraphs returns the language spans of text, one per run of paragraphs that
+were detected as the same language.  Paragraph boundaries come from the
+ParaStart marker of the word tokenizer, a span ends where the last word of the
+previous paragraph ends so whitespace stays with the following span, the same
+way it does for sentences.
+*/
+func (m *MultiLangTokenizer) Paragraphs(text string) []LangSpan {
+	tokens := m.WordTokenizer.Tokenize(text, false)
+
+	bounds := []int{0}
+	for i, token := range tokens {
+		if i == 0 || !token.ParaStart {
+			continue
+		}
+		bounds = append(bounds, tokens[i-1].Position)
+	}
+	bounds = append(bounds, len(text))
+
+	spans := make([]LangSpan, 0, len(bounds))
+	for i := 0; i < len(bounds)-1; i++ {
+		start, end := bounds[i], bounds[i+1]
+		lang := m.detect(text[start:end])
+
+		if len(spans) > 0 && spans[len(spans)-1].Lang == lang {
+			spans[len(spans)-1].End = end
+			continue
+		}
+
+		spans = append(spans, LangSpan{start, end, lang})
+	}
+
+	return spans
+}
+
+// Tokenize detects the language of every paragraph and splits text into sentences.
+func (m *MultiLangTokenizer) Tokenize(text string) []*Sentence {
+	return m.TokenizeSpans(text, m.Paragraphs(text))
+}
+
+/*
+TokenizeSpans splits text into sentences using the language given for each
+span.  Spans must be sorted and must not overlap, any text that is not
+covered by a span is tokenized with the default language.
+*/
+func (m *MultiLangTokenizer) TokenizeSpans(text string, spans []LangSpan) []*Sentence {
+	sentences := make([]*Sentence, 0, len(spans))
+
+	cursor := 0
+	for _, span := range spans {
+		if span.Start > cursor {
+			sentences = append(sentences, m.tokenizeSpan(text, LangSpan{cursor, span.Start, m.Default})...)
+		}
+		sentences = append(sentences, m.tokenizeSpan(text, span)...)
+		cursor = span.End
+	}
+
+	if cursor < len(text) {
+		sentences = append(sentences, m.tokenizeSpan(text, LangSpan{cursor, len(text), m.Default})...)
+	}
+
+	return sentences
+}
+
+func (m *MultiLangTokenizer) tokenizeSpan(text string, span LangSpan) []*Sentence {
+	lang := m.known(span.Lang)
+	sentences := m.tokenizer(lang).Tokenize(text[span.Start:span.End])
+	for _, sentence := range sentences {
+		sentence.Start += span.Start
+		sentence.End += span.Start
+		sentence.Lang = lang
+	}
+
+	return sentences
+}
+
+func (m *MultiLangTokenizer) detect(text string) string {
+	if m.LanguageDetector == nil {
+		return m.Default
+	}
+
+	return m.known(m.LanguageDetector.Detect(text))
+}
+
+// known returns lang if there is a tokenizer for it and the default language otherwise
+func (m *MultiLangTokenizer) known(lang string) string {
+	if _, ok := m.Tokenizers[lang]; !ok {
+		return m.Default
+	}
+
+	return lang
+}
+
+func (m *MultiLangTokenizer) tokenizer(lang string) SentenceTokenizer {
+	return m.Tokenizers[m.known(lang)]
+}
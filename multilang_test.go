@@ -0,0 +1,129 @@
+package sentences
+
+import (
+	"strings"
+	"testing"
+)
+
+func loadTokenizerFile(fname string) *DefaultSentenceTokenizer {
+	training, err := LoadTraining([]byte(readFile(fname)))
+	if err != nil {
+		panic(err)
+	}
+
+	return NewSentenceTokenizer(training)
+}
+
+func TestMultiLangParagraphs(t *testing.T) {
+	t.Log("Tokenizer should switch models between english and german paragraphs")
+
+	tokenizer, err := NewMultiLangTokenizer(map[string]*DefaultSentenceTokenizer{
+		"en": loadTokenizer("data/english.json"),
+		"de": loadTokenizerFile("data/german.json"),
+	}, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualText := "The report was published yesterday. It quotes a German paper.\n\n" +
+		"Die Regierung hat am Montag neue Regeln beschlossen. Sie gelten ab sofort.\n\n" +
+		"Nobody expected that."
+
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []Sentence{
+		{Text: "The report was published yesterday.", Lang: "en"},
+		{Text: " It quotes a German paper.", Lang: "en"},
+		{Text: "\n\nDie Regierung hat am Montag neue Regeln beschlossen.", Lang: "de"},
+		{Text: " Sie gelten ab sofort.", Lang: "de"},
+		{Text: "\n\nNobody expected that.", Lang: "en"},
+	}
+
+	t.Logf("%v", actual)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index].Text || sent.Lang != expected[index].Lang {
+			t.Fatalf("Actual: %s (%s)\nExpected: %s (%s)", sent.Text, sent.Lang, expected[index].Text, expected[index].Lang)
+		}
+
+		if actualText[sent.Start:sent.End] != sent.Text {
+			t.Fatalf("Offsets [%d:%d] do not match sentence text %q", sent.Start, sent.End, sent.Text)
+		}
+	}
+}
+
+func TestMultiLangSpans(t *testing.T) {
+	t.Log("Tokenizer should use the language of caller supplied spans")
+
+	tokenizer, err := NewMultiLangTokenizer(map[string]*DefaultSentenceTokenizer{
+		"en": loadTokenizer("data/english.json"),
+		"de": loadTokenizerFile("data/german.json"),
+	}, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualText := "He wrote it down. Es war einmal ein König. The end."
+	start := strings.Index(actualText, " Es")
+	end := strings.Index(actualText, " The")
+	spans := []LangSpan{{Start: start, End: end, Lang: "de"}}
+
+	actual := tokenizer.TokenizeSpans(actualText, spans)
+
+	expected := []Sentence{
+		{Start: 0, End: start, Lang: "en"},
+		{Start: start, End: end, Lang: "de"},
+		{Start: end, End: len(actualText), Lang: "en"},
+	}
+
+	t.Logf("%v", actual)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Start != expected[index].Start || sent.End != expected[index].End || sent.Lang != expected[index].Lang {
+			t.Fatalf("Actual: [%d:%d] %s\nExpected: [%d:%d] %s", sent.Start, sent.End, sent.Lang,
+				expected[index].Start, expected[index].End, expected[index].Lang)
+		}
+	}
+}
+
+// fixedDetector detects the same language in every text
+type fixedDetector string
+
+func (d fixedDetector) Detect(string) string {
+	return string(d)
+}
+
+func TestMultiLangDefault(t *testing.T) {
+	t.Log("Tokenizer should need a default tokenizer and fall back to it for unknown languages")
+
+	tokenizers := map[string]*DefaultSentenceTokenizer{"en": loadTokenizer("data/english.json")}
+
+	if _, err := NewMultiLangTokenizer(tokenizers, "de"); err == nil {
+		t.Fatalf("Expected an error for a default language without a tokenizer")
+	}
+
+	tokenizer, err := NewMultiLangTokenizer(tokenizers, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer.LanguageDetector = fixedDetector("fr")
+
+	actualText := "Il est parti. Puis il est venu."
+	actual := tokenizer.Tokenize(actualText)
+	if len(actual) != 2 || actual[0].Lang != "en" || actual[1].Lang != "en" {
+		t.Fatalf("Actual: %v, Expected: 2 sentences in en", actual)
+	}
+
+	actual = tokenizer.TokenizeSpans(actualText, []LangSpan{{Start: 0, End: len(actualText), Lang: "fr"}})
+	if len(actual) != 2 || actual[0].Lang != "en" {
+		t.Fatalf("Actual: %v, Expected: 2 sentences in en", actual)
+	}
+}
@@ -24,6 +24,55 @@ const (
 	orthoLc = orthoBegLc + orthoMidLc + orthoUnkLc
 )
 
+// orthoNames names every orthographic context flag, in the order of their bits
+var orthoNames = []struct {
+	flag        int
+	name        string
+	description string
+}{
+	{orthoBegUc, "BEG_UC", "upper case at the beginning of a sentence"},
+	{orthoMidUc, "MID_UC", "upper case in the middle of a sentence"},
+	{orthoUnkUc, "UNK_UC", "upper case at an unknown position"},
+	{orthoBegLc, "BEG_LC", "lower case at the beginning of a sentence"},
+	{orthoMidLc, "MID_LC", "lower case in the middle of a sentence"},
+	{orthoUnkLc, "UNK_LC", "lower case at an unknown position"},
+}
+
+// OrthoNames decodes a set of orthographic context flags into their names, e.g. BEG_UC
+func OrthoNames(flags int) []string {
+	names := make([]string, 0, len(orthoNames))
+	for _, ortho := range orthoNames {
+		if flags&ortho.flag != 0 {
+			names = append(names, ortho.name)
+		}
+	}
+
+	return names
+}
+
+// DescribeOrtho decodes a set of orthographic context flags into words
+func DescribeOrtho(flags int) []string {
+	descriptions := make([]string, 0, len(orthoNames))
+	for _, ortho := range orthoNames {
+		if flags&ortho.flag != 0 {
+			descriptions = append(descriptions, ortho.description)
+		}
+	}
+
+	return descriptions
+}
+
+// OrthoFlag returns the flag for a name returned by OrthoNames, or 0 if it is unknown
+func OrthoFlag(name string) int {
+	for _, ortho := range orthoNames {
+		if ortho.name == name {
+			return ortho.flag
+		}
+	}
+
+	return 0
+}
+
 /*
 A map from context position and first-letter case to the
 appropriate orthographic context flag.
@@ -43,6 +92,12 @@ type Ortho interface {
 	Heuristic(*Token) int
 }
 
+// StorageOrtho is implemented by orthographic heuristics that read training data
+type StorageOrtho interface {
+	Ortho
+	WithStorage(*Storage) Ortho
+}
+
 // OrthoContext determines whether a token is capitalized, sentence starter, etc.
 type OrthoContext struct {
 	*Storage
@@ -51,6 +106,13 @@ type OrthoContext struct {
 	TokenFirst
 }
 
+// WithStorage returns a copy of the heuristic that reads from s
+func (o *OrthoContext) WithStorage(s *Storage) Ortho {
+	ctx := *o
+	ctx.Storage = s
+	return &ctx
+}
+
 /*
 Heuristic decides whether the given token is the first token in a sentence.
 */
@@ -65,7 +127,7 @@ func (o *OrthoContext) Heuristic(token *Token) int {
 		}
 	}
 
-	orthoCtx := o.Storage.OrthoContext[o.TokenType.TypeNoSentPeriod(token)]
+	orthoCtx := o.Storage.OrthoFlags(o.TokenType.TypeNoSentPeriod(token))
 	/*
 	   If the word is capitalized, occurs at least once with a
 	   lower case first letter, and never occurs with an upper case
@@ -0,0 +1,399 @@
+package sentences
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Changes is a set of entries added to (true) or removed from (false) a
+// section of the training data.
+type Changes map[string]bool
+
+// Add marks a key as added
+func (c Changes) Add(key string) {
+	c[key] = true
+}
+
+// Remove marks a key as removed
+func (c Changes) Remove(key string) {
+	c[key] = false
+}
+
+// OrthoChange sets and clears orthographic context flags, or abbreviation classes, for a word type
+type OrthoChange struct {
+	Add    int
+	Remove int
+}
+
+/*
+Overlay is a layer of adjustments to a Storage.  It never modifies the
+training data it sits on, instead Storage.WithOverlays returns a view that
+consults every layer, from the last to the first, before the base data.
+*/
+type Overlay struct {
+	AbbrevTypes  Changes
+	Collocations Changes
+	SentStarters Changes
+	OrthoContext map[string]OrthoChange
+	// AbbrevClasses sets and clears the classes of abbreviations
+	AbbrevClasses map[string]OrthoChange
+}
+
+// NewOverlay creates an empty overlay
+func NewOverlay() *Overlay {
+	return &Overlay{
+		AbbrevTypes:   Changes{},
+		Collocations:  Changes{},
+		SentStarters:  Changes{},
+		OrthoContext:  map[string]OrthoChange{},
+		AbbrevClasses: map[string]OrthoChange{},
+	}
+}
+
+func abbrevChanges(o *Overlay) Changes      { return o.AbbrevTypes }
+func collocationChanges(o *Overlay) Changes { return o.Collocations }
+func sentStarterChanges(o *Overlay) Changes { return o.SentStarters }
+
+// all of the orthographic context flags
+const orthoAll = orthoUc | orthoLc
+
+/*
+LoadOverlay reads an overlay from a simple text format, one entry per line.
+An entry starting with "-" is removed instead of added and a line starting
+with "# " is a comment.  Entries are abbreviations until a section header
+changes that:
+
+	# supervised abbreviations
+	sgt
+	-etc
+
+	[Collocations]
+	##number##,november
+
+	[SentStarters]
+	however
+
+	[OrthoContext]
+	apple 32
+	-pear 4
+	-plum
+
+	[AbbrevClasses]
+	dr title
+	etc terminal
+	-ft unit
+
+Orthographic entries take the flags to add or remove, without flags every
+flag of the word is removed.  Abbreviation classes are written like
+orthographic flags, as a number or as names joined by "|", e.g. title|terminal.
+*/
+func LoadOverlay(r io.Reader) (*Overlay, error) {
+	overlay := NewOverlay()
+	section := "AbbrevTypes"
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+
+		// "#" followed by a space is a comment, "##number##" is a word type
+		if line == "" || line == "#" || strings.HasPrefix(line, "# ") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			switch section {
+			case "AbbrevTypes", "Collocations", "SentStarters", "OrthoContext", "AbbrevClasses":
+			default:
+				return nil, fmt.Errorf("overlay line %d: unknown section %q", lineNum, section)
+			}
+			continue
+		}
+
+		add := true
+		if strings.HasPrefix(line, "-") {
+			add = false
+			line = strings.TrimSpace(line[1:])
+		}
+
+		if err := overlay.set(section, line, add); err != nil {
+			return nil, fmt.Errorf("overlay line %d: %v", lineNum, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return overlay, nil
+}
+
+// copy returns an overlay with the same changes that can be modified on its own
+func (o *Overlay) copy() *Overlay {
+	overlay := NewOverlay()
+	for key, add := range o.AbbrevTypes {
+		overlay.AbbrevTypes[key] = add
+	}
+	for key, add := range o.Collocations {
+		overlay.Collocations[key] = add
+	}
+	for key, add := range o.SentStarters {
+		overlay.SentStarters[key] = add
+	}
+	for key, change := range o.OrthoContext {
+		overlay.OrthoContext[key] = change
+	}
+	for key, change := range o.AbbrevClasses {
+		overlay.AbbrevClasses[key] = change
+	}
+
+	return overlay
+}
+
+func (o *Overlay) set(section, entry string, add bool) error {
+	if entry == "" {
+		return fmt.Errorf("empty entry")
+	}
+
+	switch section {
+	case "AbbrevTypes":
+		o.AbbrevTypes[strings.ToLower(entry)] = add
+	case "SentStarters":
+		o.SentStarters[strings.ToLower(entry)] = add
+	case "Collocations":
+		if strings.Count(entry, ",") != 1 {
+			return fmt.Errorf("collocation %q must be two words separated by a comma", entry)
+		}
+		o.Collocations[strings.ToLower(entry)] = add
+	case "OrthoContext":
+		fields := strings.Fields(entry)
+		typ := strings.ToLower(fields[0])
+
+		flags := orthoAll
+		if len(fields) > 1 {
+			parsed, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return fmt.Errorf("orthographic flags %q are not a number", fields[1])
+			}
+			flags = parsed
+		} else if add {
+			return fmt.Errorf("orthographic context %q needs flags to add", entry)
+		}
+
+		o.OrthoContext[typ] = changeFlags(o.OrthoContext[typ], flags, add)
+	case "AbbrevClasses":
+		fields := strings.Fields(entry)
+		typ := strings.ToLower(fields[0])
+
+		classes := abbrevClassAll
+		if len(fields) > 1 {
+			parsed, err := ParseAbbrevClasses(fields[1])
+			if err != nil {
+				return err
+			}
+			classes = parsed
+		} else if add {
+			return fmt.Errorf("abbreviation %q needs classes to add", entry)
+		}
+
+		o.AbbrevClasses[typ] = changeFlags(o.AbbrevClasses[typ], classes, add)
+	}
+
+	return nil
+}
+
+// changeFlags adds or removes flags, the last change of a flag wins
+func changeFlags(change OrthoChange, flags int, add bool) OrthoChange {
+	if add {
+		change.Add |= flags
+		change.Remove &^= flags
+	} else {
+		change.Remove |= flags
+		change.Add &^= flags
+	}
+
+	return change
+}
+
+// sorted returns the keys of a set of changes in order
+func (c Changes) sorted() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+/*
+WriteTo writes the overlay in the text format read by LoadOverlay.  Sections
+and entries are sorted so the output is stable.
+*/
+func (o *Overlay) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	sections := []struct {
+		name    string
+		changes Changes
+	}{
+		{"AbbrevTypes", o.AbbrevTypes},
+		{"Collocations", o.Collocations},
+		{"SentStarters", o.SentStarters},
+	}
+
+	for _, section := range sections {
+		if len(section.changes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "[%s]\n", section.name)
+		for _, key := range section.changes.sorted() {
+			if !section.changes[key] {
+				buf.WriteString("-")
+			}
+			fmt.Fprintf(&buf, "%s\n", key)
+		}
+		buf.WriteString("\n")
+	}
+
+	flagSections := []struct {
+		name    string
+		changes map[string]OrthoChange
+		format  func(int) string
+	}{
+		{"OrthoContext", o.OrthoContext, strconv.Itoa},
+		{"AbbrevClasses", o.AbbrevClasses, func(classes int) string {
+			return strings.Join(AbbrevClassNames(classes), "|")
+		}},
+	}
+
+	for _, section := range flagSections {
+		if len(section.changes) == 0 {
+			continue
+		}
+
+		typs := make([]string, 0, len(section.changes))
+		for typ := range section.changes {
+			typs = append(typs, typ)
+		}
+		sort.Strings(typs)
+
+		fmt.Fprintf(&buf, "[%s]\n", section.name)
+		for _, typ := range typs {
+			change := section.changes[typ]
+			if change.Add != 0 {
+				fmt.Fprintf(&buf, "%s %s\n", typ, section.format(change.Add))
+			}
+			if change.Remove != 0 {
+				fmt.Fprintf(&buf, "-%s %s\n", typ, section.format(change.Remove))
+			}
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.WriteTo(w)
+}
+
+/*
+DiffStorage returns the overlay that turns the training data in from into the
+training data in to, i.e. from.WithOverlays(DiffStorage(from, to)) makes the
+same decisions as to.
+*/
+func DiffStorage(from, to *Storage) *Overlay {
+	overlay := NewOverlay()
+
+	diffSets := func(a, b SetString, changes Changes) {
+		for key := range a {
+			if a.Has(key) && !b.Has(key) {
+				changes.Remove(key)
+			}
+		}
+		for key := range b {
+			if b.Has(key) && !a.Has(key) {
+				changes.Add(key)
+			}
+		}
+	}
+
+	diffSets(from.AbbrevTypes, to.AbbrevTypes, overlay.AbbrevTypes)
+	diffSets(from.Collocations, to.Collocations, overlay.Collocations)
+	diffSets(from.SentStarters, to.SentStarters, overlay.SentStarters)
+
+	diffFlags := func(a, b SetString, changes map[string]OrthoChange) {
+		typs := SetString{}
+		for typ := range a {
+			typs.Add(typ)
+		}
+		for typ := range b {
+			typs.Add(typ)
+		}
+
+		for typ := range typs {
+			before := a[typ]
+			after := b[typ]
+			if before == after {
+				continue
+			}
+
+			changes[typ] = OrthoChange{
+				Add:    after &^ before,
+				Remove: before &^ after,
+			}
+		}
+	}
+
+	diffFlags(from.OrthoContext, to.OrthoContext, overlay.OrthoContext)
+	diffFlags(from.AbbrevClasses, to.AbbrevClasses, overlay.AbbrevClasses)
+
+	return overlay
+}
+
+/*
+Flatten returns a copy of the training data with its overlays applied, it
+makes the same decisions as p but has no layers.
+*/
+func (p *Storage) Flatten() *Storage {
+	storage := p.Expand()
+
+	applySet := func(changes Changes, set SetString) {
+		for key, add := range changes {
+			if add {
+				set.Add(key)
+			} else {
+				set.Remove(key)
+			}
+		}
+	}
+
+	applyFlags := func(changes map[string]OrthoChange, set SetString) {
+		for typ, change := range changes {
+			if flags := (set[typ] | change.Add) &^ change.Remove; flags != 0 {
+				set[typ] = flags
+			} else {
+				delete(set, typ)
+			}
+		}
+	}
+
+	for _, layer := range p.layers {
+		applySet(layer.AbbrevTypes, storage.AbbrevTypes)
+		applySet(layer.Collocations, storage.Collocations)
+		applySet(layer.SentStarters, storage.SentStarters)
+		applyFlags(layer.OrthoContext, storage.OrthoContext)
+
+		if len(layer.AbbrevClasses) > 0 && storage.AbbrevClasses == nil {
+			storage.AbbrevClasses = SetString{}
+		}
+		applyFlags(layer.AbbrevClasses, storage.AbbrevClasses)
+	}
+
+	return storage
+}
@@ -0,0 +1,204 @@
+package sentences
+
+import (
+	"reflect"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLoadOverlay(t *testing.T) {
+	t.Log("Overlay should load entries from a text file")
+
+	overlay, err := LoadOverlay(strings.NewReader(`# supervised abbreviations
+sgt
+-Etc
+
+[Collocations]
+##number##,november
+
+[SentStarters]
+however
+
+[OrthoContext]
+apple 32
+-pear 4
+-plum
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := NewStorage()
+	base.AbbrevTypes.Add("etc")
+	base.OrthoContext["pear"] = 36
+	base.OrthoContext["plum"] = 96
+
+	storage := base.WithOverlays(overlay)
+
+	if !storage.IsAbbr("sgt") || storage.IsAbbr("etc") {
+		t.Fatalf("Abbreviations were not layered: sgt=%t etc=%t", storage.IsAbbr("sgt"), storage.IsAbbr("etc"))
+	}
+
+	if !storage.IsCollocation("##number##", "november") {
+		t.Fatalf("Collocation was not added")
+	}
+
+	if !storage.IsSentStarter("however") {
+		t.Fatalf("Sentence starter was not added")
+	}
+
+	if storage.OrthoFlags("apple") != 32 || storage.OrthoFlags("pear") != 32 || storage.OrthoFlags("plum") != 0 {
+		t.Fatalf("Orthographic context was not layered: apple=%d pear=%d plum=%d",
+			storage.OrthoFlags("apple"), storage.OrthoFlags("pear"), storage.OrthoFlags("plum"))
+	}
+
+	if !base.IsAbbr("etc") || base.IsAbbr("sgt") || base.OrthoFlags("pear") != 36 {
+		t.Fatalf("Base storage was modified by an overlay")
+	}
+}
+
+func TestLoadOverlayErrors(t *testing.T) {
+	t.Log("Overlay should report the line of a malformed entry")
+
+	_, err := LoadOverlay(strings.NewReader("sgt\n[Collocations]\nonlyone\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("Expected an error on line 3, got: %v", err)
+	}
+
+	_, err = LoadOverlay(strings.NewReader("[Unknown]\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Fatalf("Expected an error on line 1, got: %v", err)
+	}
+}
+
+func TestTokenizeWithOverlays(t *testing.T) {
+	t.Log("Tokenizer should apply overlays for a single call")
+
+	tokenizer := loadTokenizer("data/english.json")
+
+	actualText := "Ask Capt. Smith about it. He knows."
+
+	overlay := NewOverlay()
+	overlay.AbbrevTypes.Add("capt")
+
+	actual := tokenizer.WithOverlays(overlay).Tokenize(actualText)
+	expected := []string{
+		"Ask Capt. Smith about it.",
+		" He knows.",
+	}
+
+	t.Logf("%v", actual)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+	}
+
+	if tokenizer.IsAbbr("capt") {
+		t.Fatalf("Overlay leaked into the tokenizer's storage")
+	}
+
+	if len(tokenizer.Tokenize(actualText)) != 3 {
+		t.Fatalf("Tokenizer without overlay should break after Capt.")
+	}
+}
+
+func TestDiffStorage(t *testing.T) {
+	t.Log("The difference of two storages should turn one into the other and survive a round trip")
+
+	from := NewStorage()
+	from.AbbrevTypes.Add("etc")
+	from.AbbrevTypes.Add("dr")
+	from.SentStarters.Add("however")
+	from.OrthoContext["pear"] = 36
+
+	to := NewStorage()
+	to.AbbrevTypes.Add("dr")
+	to.AbbrevTypes.Add("sgt")
+	to.Collocations.Add("##number##,november")
+	to.OrthoContext["pear"] = 34
+	to.OrthoContext["apple"] = 32
+
+	var buf bytes.Buffer
+	if _, err := DiffStorage(from, to).WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `[AbbrevTypes]
+-etc
+sgt
+
+[Collocations]
+##number##,november
+
+[SentStarters]
+-however
+
+[OrthoContext]
+apple 32
+pear 2
+-pear 4
+
+`
+	if buf.String() != expected {
+		t.Fatalf("Actual:\n%s\nExpected:\n%s", buf.String(), expected)
+	}
+
+	overlay, err := LoadOverlay(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage := from.WithOverlays(overlay)
+	for _, typ := range []string{"etc", "dr", "sgt"} {
+		if storage.IsAbbr(typ) != to.IsAbbr(typ) {
+			t.Fatalf("Abbreviation %s: Actual: %t, Expected: %t", typ, storage.IsAbbr(typ), to.IsAbbr(typ))
+		}
+	}
+
+	if storage.IsSentStarter("however") || !storage.IsCollocation("##number##", "november") {
+		t.Fatalf("Sentence starters and collocations were not patched")
+	}
+
+	for _, typ := range []string{"pear", "apple"} {
+		if storage.OrthoFlags(typ) != to.OrthoContext[typ] {
+			t.Fatalf("Ortho %s: Actual: %d, Expected: %d", typ, storage.OrthoFlags(typ), to.OrthoContext[typ])
+		}
+	}
+
+	t.Log("Flattening the view should apply the overlay to a copy of the training data")
+
+	flat := storage.Flatten()
+	if len(flat.Overlays()) != 0 || !reflect.DeepEqual(DiffStorage(flat, to), NewOverlay()) {
+		t.Fatalf("Actual: %+v, Expected: no difference", DiffStorage(flat, to))
+	}
+
+	if !from.AbbrevTypes.Has("etc") || from.OrthoContext["pear"] != 36 {
+		t.Fatalf("The training data under the overlay should be untouched")
+	}
+}
+
+func TestOrthoNames(t *testing.T) {
+	t.Log("Orthographic context flags should decode into names and back")
+
+	names := OrthoNames(110)
+	expected := []string{"BEG_UC", "MID_UC", "UNK_UC", "MID_LC", "UNK_LC"}
+	if strings.Join(names, "|") != strings.Join(expected, "|") {
+		t.Fatalf("Actual: %v, Expected: %v", names, expected)
+	}
+
+	flags := 0
+	for _, name := range names {
+		flags |= OrthoFlag(name)
+	}
+
+	if flags != 110 {
+		t.Fatalf("Actual: %d, Expected: %d", flags, 110)
+	}
+}
@@ -0,0 +1,87 @@
+package sentences
+
+import "fmt"
+
+/*
+ExternalToken is a word token found by another tokenizer, e.g. an
+Elasticsearch analyzer or a spaCy export, with the byte offsets of its start
+and end in the text it came from.
+*/
+type ExternalToken struct {
+	Text      string `json:"text"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+	LineStart bool   `json:"line_start,omitempty"`
+	ParaStart bool   `json:"para_start,omitempty"`
+}
+
+/*
+NewTokensFrom builds the tokens the annotations work on from the tokens of
+another tokenizer, keeping their offsets.  They have to be in the order of
+the text and must not overlap.
+*/
+func NewTokensFrom(external []ExternalToken) ([]*Token, error) {
+	tokens := make([]*Token, 0, len(external))
+
+	end := 0
+	for i, ext := range external {
+		if ext.Text == "" {
+			return nil, fmt.Errorf("token %d is empty", i)
+		}
+		if ext.Start < end || ext.End < ext.Start {
+			return nil, fmt.Errorf("token %d %q at [%d:%d] is out of order", i, ext.Text, ext.Start, ext.End)
+		}
+		end = ext.End
+
+		token := NewToken(ext.Text)
+		token.Start = ext.Start
+		token.Position = ext.End
+		token.LineStart = ext.LineStart
+		token.ParaStart = ext.ParaStart
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+/*
+SegmentTokens runs the annotations over tokens that were not found by the
+tokenizer's WordTokenizer, see NewTokensFrom, and returns the spans of the
+sentences from the start of their first token to the end of their last one.
+An adaptive tokenizer adapts to the tokens, the text is never seen.
+*/
+func (s *DefaultSentenceTokenizer) SegmentTokens(tokens []*Token) []Span {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	if s.Adaptive != nil {
+		// the first pass annotates copies, the tokens are annotated again with what it learned
+		first := make([]*Token, len(tokens))
+		for i, tok := range tokens {
+			copied := *tok
+			first[i] = &copied
+		}
+
+		adapted := s.WithOverlays(s.Adaptive.overlay(s, first))
+		adapted.Adaptive = nil
+		return adapted.SegmentTokens(tokens)
+	}
+
+	tokens = s.AnnotateTokens(tokens, s.Annotations...)
+
+	spans := make([]Span, 0, len(tokens)/10+1)
+	start := tokens[0].Start
+	for i, tok := range tokens {
+		if !tok.SentBreak && i != len(tokens)-1 {
+			continue
+		}
+
+		spans = append(spans, Span{start, tok.Position})
+		if i+1 < len(tokens) {
+			start = tokens[i+1].Start
+		}
+	}
+
+	return spans
+}
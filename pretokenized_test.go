@@ -0,0 +1,103 @@
+package sentences
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// externalTokens splits text on spaces and detaches final periods, the way other tokenizers do
+func externalTokens(text string) []ExternalToken {
+	external := []ExternalToken{}
+	offset := 0
+	for _, field := range strings.Fields(text) {
+		start := offset + strings.Index(text[offset:], field)
+		offset = start + len(field)
+
+		if len(field) > 1 && strings.HasSuffix(field, ".") {
+			external = append(external, ExternalToken{Text: field[:len(field)-1], Start: start, End: offset - 1})
+			external = append(external, ExternalToken{Text: ".", Start: offset - 1, End: offset})
+			continue
+		}
+		external = append(external, ExternalToken{Text: field, Start: start, End: offset})
+	}
+	return external
+}
+
+func TestTokenStart(t *testing.T) {
+	t.Log("Tokens should keep the offset of their start")
+
+	text := "  Hello there,\n\n(Mr. Page) went  home.\tBye"
+	tokens := NewWordTokenizer(NewPunctStrings()).Tokenize(text, false)
+
+	for _, tok := range tokens {
+		if text[tok.Start:tok.Position] != tok.Tok {
+			t.Fatalf("Actual: %q, Expected: %q", text[tok.Start:tok.Position], tok.Tok)
+		}
+	}
+}
+
+func TestSegmentTokens(t *testing.T) {
+	t.Log("Tokens from another tokenizer should be segmented into sentence spans")
+
+	tokenizer := loadTokenizer("data/english.json")
+	text := "He went home. Then he left for Washington. It rained."
+
+	tokens, err := NewTokensFrom(externalTokens(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := []string{}
+	for _, span := range tokenizer.SegmentTokens(tokens) {
+		actual = append(actual, text[span.Start:span.End])
+	}
+
+	expected := sentenceTexts(tokenizer, text)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+
+	if spans := tokenizer.SegmentTokens(nil); spans != nil {
+		t.Fatalf("Actual: %v, Expected: no spans", spans)
+	}
+
+	t.Log("An adaptive tokenizer should adapt to the tokens")
+
+	tokenizer.Adaptive = NewAdaptive()
+	external := []ExternalToken{}
+	for _, tok := range tokenizer.WordTokenizer.Tokenize(adaptiveText, false) {
+		external = append(external, ExternalToken{Text: tok.Tok, Start: tok.Start, End: tok.Position})
+	}
+
+	tokens, err = NewTokensFrom(external)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual = []string{}
+	for _, span := range tokenizer.SegmentTokens(tokens) {
+		actual = append(actual, adaptiveText[span.Start:span.End])
+	}
+
+	expected = sentenceTexts(tokenizer, adaptiveText)
+	if len(expected) != 4 || !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+}
+
+func TestNewTokensFromErrors(t *testing.T) {
+	t.Log("Tokens out of order should be rejected")
+
+	tests := [][]ExternalToken{
+		{{Text: "b", Start: 4, End: 5}, {Text: "a", Start: 0, End: 1}},
+		{{Text: "a", Start: 2, End: 1}},
+		{{Text: "", Start: 0, End: 0}},
+	}
+
+	for _, test := range tests {
+		if _, err := NewTokensFrom(test); err == nil {
+			t.Fatalf("Expected an error for %v", test)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package sentences
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+/*
+AnnotatorEnv is what a registered annotator is built from: the training data,
+punctuation and word tokenizer of the tokenizer it joins, and the directory
+relative file names in its options are read from.
+*/
+type AnnotatorEnv struct {
+	*Storage
+	PunctStrings
+	WordTokenizer
+	Dir string
+	// ortho is shared by the annotators of a tokenizer
+	ortho *OrthoContext
+}
+
+// Ortho returns the orthographic heuristic shared by the annotators of the tokenizer
+func (e *AnnotatorEnv) Ortho() Ortho {
+	if e.ortho == nil {
+		e.ortho = &OrthoContext{e.Storage, e.PunctStrings, e.WordTokenizer, e.WordTokenizer}
+	}
+	return e.ortho
+}
+
+// Path resolves a file name of an option against Dir
+func (e *AnnotatorEnv) Path(fname string) string {
+	if filepath.IsAbs(fname) || e.Dir == "" {
+		return fname
+	}
+	return filepath.Join(e.Dir, fname)
+}
+
+// AnnotatorFactory builds an annotator from its options in a profile, which are nil when it has none
+type AnnotatorFactory func(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error)
+
+// LanguageFactory builds the tokenizer of a language, from its shipped model when s is nil
+type LanguageFactory func(s *Storage) (*DefaultSentenceTokenizer, error)
+
+var annotatorRegistry = map[string]AnnotatorFactory{
+	"type-based": func(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error) {
+		return &TypeBasedAnnotation{env.Storage, env.PunctStrings, env.WordTokenizer}, DecodeOptions(options, nil)
+	},
+	"token-based": func(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error) {
+		return &TokenBasedAnnotation{env.Storage, env.PunctStrings, env.WordTokenizer, &DefaultTokenGrouper{}, env.Ortho(), nil}, DecodeOptions(options, nil)
+	},
+	"abbrev-class": func(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error) {
+		return &AbbrevClassAnnotation{env.Storage, env.WordTokenizer, env.Ortho()}, DecodeOptions(options, nil)
+	},
+	"realign": func(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error) {
+		return &RealignAnnotation{}, DecodeOptions(options, nil)
+	},
+	"gazetteer": newProfileGazetteer,
+	"rules":     newProfileRules,
+}
+
+var languageRegistry = map[string]LanguageFactory{}
+
+/*
+RegisterAnnotator makes an annotator available to profiles under name.
+Packages register their annotators in init, the language packages prefix
+them with the language, e.g. "english.multi-punct".  It panics if the name
+is taken.
+*/
+func RegisterAnnotator(name string, factory AnnotatorFactory) {
+	if _, ok := annotatorRegistry[name]; ok {
+		panic(fmt.Sprintf("sentences: annotator %q registered twice", name))
+	}
+	annotatorRegistry[name] = factory
+}
+
+// RegisterLanguage makes the tokenizer of a language available to profiles, it panics if the name is taken
+func RegisterLanguage(name string, factory LanguageFactory) {
+	if _, ok := languageRegistry[name]; ok {
+		panic(fmt.Sprintf("sentences: language %q registered twice", name))
+	}
+	languageRegistry[name] = factory
+}
+
+// Annotators returns the names of the registered annotators, sorted
+func Annotators() []string {
+	names := make([]string, 0, len(annotatorRegistry))
+	for name := range annotatorRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Languages returns the names of the registered languages, sorted
+func Languages() []string {
+	names := make([]string, 0, len(languageRegistry))
+	for name := range languageRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DecodeOptions reads the options of an annotator into v, unknown options are an error and an annotator without options passes a nil v
+func DecodeOptions(options json.RawMessage, v interface{}) error {
+	if len(options) == 0 || string(options) == "null" {
+		return nil
+	}
+	if v == nil {
+		return fmt.Errorf("takes no options")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(options))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// newProfileGazetteer reads the names of a gazetteer from text files, or JSON files if they end in .json
+func newProfileGazetteer(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error) {
+	opts := struct {
+		Files []string `json:"files"`
+		Names []string `json:"names"`
+	}{}
+	if err := DecodeOptions(options, &opts); err != nil {
+		return nil, err
+	}
+
+	gazetteer := NewGazetteer()
+	for _, name := range opts.Names {
+		gazetteer.Add(name, "")
+	}
+
+	for _, fname := range opts.Files {
+		b, err := ioutil.ReadFile(env.Path(fname))
+		if err != nil {
+			return nil, err
+		}
+
+		var names *Gazetteer
+		if strings.HasSuffix(fname, ".json") {
+			names, err = LoadGazetteerJSON(b)
+		} else {
+			names, err = LoadGazetteer(bytes.NewReader(b))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+
+		gazetteer.Merge(names)
+	}
+
+	return &GazetteerAnnotation{gazetteer, env.WordTokenizer, env.Storage}, nil
+}
+
+// newProfileRules reads boundary rules from files and the rules written in the options, in that order
+func newProfileRules(env *AnnotatorEnv, options json.RawMessage) (AnnotateTokens, error) {
+	opts := struct {
+		Files []string `json:"files"`
+		Rules []string `json:"rules"`
+	}{}
+	if err := DecodeOptions(options, &opts); err != nil {
+		return nil, err
+	}
+
+	rules := []*Rule{}
+	for _, fname := range opts.Files {
+		f, err := os.Open(env.Path(fname))
+		if err != nil {
+			return nil, err
+		}
+
+		loaded, err := LoadRules(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+
+		rules = append(rules, loaded...)
+	}
+
+	for i, line := range opts.Rules {
+		rule, err := ParseRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i+1, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return &RuleAnnotation{rules, env.WordTokenizer}, nil
+}
+
+// ProfileAnnotator is an annotator of a profile and its options
+type ProfileAnnotator struct {
+	Name    string          `json:"name"`
+	Options json.RawMessage `json:"options,omitempty"`
+}
+
+/*
+Profile is a segmentation setup that can be kept in version control:
+
+	{
+		"language": "english",
+		"overlays": ["legal.overlay"],
+		"annotators": [
+			{"name": "type-based"},
+			{"name": "token-based"},
+			{"name": "abbrev-class"},
+			{"name": "english.multi-punct", "options": {"left": 1, "right": 2}},
+			{"name": "rules", "options": {"files": ["fixes.rules"]}},
+			{"name": "realign"}
+		]
+	}
+
+Language is a registered language, its package has to be imported.  Model is
+a JSON, binary or punkt_tab model that replaces the language's shipped model,
+a profile without a language needs one.  Annotators replace those of the
+language in the order they are listed, see Annotators for their names.
+*/
+type Profile struct {
+	Language   string             `json:"language,omitempty"`
+	Model      string             `json:"model,omitempty"`
+	Overlays   []string           `json:"overlays,omitempty"`
+	Annotators []ProfileAnnotator `json:"annotators,omitempty"`
+	Adaptive   bool               `json:"adaptive,omitempty"`
+	// Dir is where the relative file names of the profile are read from
+	Dir string `json:"-"`
+}
+
+// LoadProfile reads a profile, unknown fields are an error so a misspelled option is not ignored
+func LoadProfile(r io.Reader) (*Profile, error) {
+	profile := &Profile{}
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(profile); err != nil {
+		return nil, fmt.Errorf("profile: %v", err)
+	}
+
+	return profile, nil
+}
+
+// path resolves a file name of the profile against its Dir
+func (p *Profile) path(fname string) string {
+	return (&AnnotatorEnv{Dir: p.Dir}).Path(fname)
+}
+
+// loadModel reads a punkt_tab directory, a binary model or a JSON model
+func (p *Profile) loadModel() (*Storage, error) {
+	fname := p.path(p.Model)
+
+	if info, err := os.Stat(fname); err == nil && info.IsDir() {
+		return LoadPunktTab(os.DirFS(fname))
+	}
+
+	b, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(b, []byte(binaryMagic)) {
+		return LoadBinary(b)
+	}
+	return LoadTraining(b)
+}
+
+// NewFromProfile builds the tokenizer a profile describes
+func NewFromProfile(profile *Profile) (*DefaultSentenceTokenizer, error) {
+	var storage *Storage
+	if profile.Model != "" {
+		var err error
+		storage, err = profile.loadModel()
+		if err != nil {
+			return nil, fmt.Errorf("profile model: %v", err)
+		}
+	}
+
+	var tokenizer *DefaultSentenceTokenizer
+	if profile.Language != "" {
+		factory, ok := languageRegistry[profile.Language]
+		if !ok {
+			return nil, fmt.Errorf("profile: unknown language %q, registered: %s", profile.Language, strings.Join(Languages(), ", "))
+		}
+
+		var err error
+		tokenizer, err = factory(storage)
+		if err != nil {
+			return nil, err
+		}
+	} else if storage != nil {
+		tokenizer = NewSentenceTokenizer(storage)
+	} else {
+		return nil, fmt.Errorf("profile: needs a language or a model")
+	}
+
+	if len(profile.Annotators) > 0 {
+		env := &AnnotatorEnv{
+			Storage:       tokenizer.Storage,
+			PunctStrings:  tokenizer.PunctStrings,
+			WordTokenizer: tokenizer.WordTokenizer,
+			Dir:           profile.Dir,
+		}
+
+		annotations := make([]AnnotateTokens, 0, len(profile.Annotators))
+		for _, annotator := range profile.Annotators {
+			factory, ok := annotatorRegistry[annotator.Name]
+			if !ok {
+				return nil, fmt.Errorf("profile: unknown annotator %q, registered: %s", annotator.Name, strings.Join(Annotators(), ", "))
+			}
+
+			ann, err := factory(env, annotator.Options)
+			if err != nil {
+				return nil, fmt.Errorf("profile annotator %s: %v", annotator.Name, err)
+			}
+			annotations = append(annotations, ann)
+		}
+
+		tokenizer = &DefaultSentenceTokenizer{
+			Storage:       tokenizer.Storage,
+			WordTokenizer: tokenizer.WordTokenizer,
+			PunctStrings:  tokenizer.PunctStrings,
+			Annotations:   annotations,
+		}
+	}
+
+	overlays := make([]*Overlay, 0, len(profile.Overlays))
+	for _, fname := range profile.Overlays {
+		f, err := os.Open(profile.path(fname))
+		if err != nil {
+			return nil, err
+		}
+
+		overlay, err := LoadOverlay(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fname, err)
+		}
+
+		overlays = append(overlays, overlay)
+	}
+	if len(overlays) > 0 {
+		tokenizer = tokenizer.WithOverlays(overlays...)
+	}
+
+	if profile.Adaptive {
+		tokenizer.Adaptive = NewAdaptive()
+	}
+
+	return tokenizer, nil
+}
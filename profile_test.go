@@ -0,0 +1,83 @@
+package sentences
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func loadTestProfile(t *testing.T, profile string) *DefaultSentenceTokenizer {
+	p, err := LoadProfile(strings.NewReader(profile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenizer, err := NewFromProfile(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tokenizer
+}
+
+func TestProfile(t *testing.T) {
+	t.Log("A profile should pick the model and the annotators in their order")
+
+	text := "The valve is shown in Fig. 3 on the left. It was removed."
+
+	tokenizer := loadTestProfile(t, `{"model": "data/english.json"}`)
+	expected := sentenceTexts(loadTokenizer("data/english.json"), text)
+	if actual := sentenceTexts(tokenizer, text); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+
+	tokenizer = loadTestProfile(t, `{
+		"model": "data/english.json",
+		"annotators": [
+			{"name": "type-based"},
+			{"name": "token-based"},
+			{"name": "rules", "options": {"rules": ["nobreak: text /^Fig\\.$/ and next.type ##number##"]}},
+			{"name": "realign"}
+		]
+	}`)
+
+	expected = []string{"The valve is shown in Fig. 3 on the left.", "It was removed."}
+	if actual := sentenceTexts(tokenizer, text); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %q, Expected: %q", actual, expected)
+	}
+
+	if len(tokenizer.Annotations) != 4 {
+		t.Fatalf("Actual: %d annotations, Expected: 4", len(tokenizer.Annotations))
+	}
+	if _, ok := tokenizer.Annotations[2].(*RuleAnnotation); !ok {
+		t.Fatalf("Actual: %T, Expected: the rules third", tokenizer.Annotations[2])
+	}
+}
+
+func TestProfileErrors(t *testing.T) {
+	t.Log("A profile should reject what it does not know")
+
+	tests := []struct {
+		profile  string
+		expected string
+	}{
+		{`{"model": "data/english.json", "annotator": []}`, `unknown field "annotator"`},
+		{`{}`, "needs a language or a model"},
+		{`{"language": "klingon"}`, `unknown language "klingon"`},
+		{`{"model": "data/english.json", "annotators": [{"name": "magic"}]}`, `unknown annotator "magic"`},
+		{`{"model": "data/english.json", "annotators": [{"name": "realign", "options": {"quotes": true}}]}`, "annotator realign: takes no options"},
+		{`{"model": "data/english.json", "annotators": [{"name": "rules", "options": {"rule": []}}]}`, `unknown field "rule"`},
+		{`{"model": "data/english.json", "annotators": [{"name": "rules", "options": {"rules": ["break abbr"]}}]}`, "rule 1: expected an action"},
+	}
+
+	for _, test := range tests {
+		profile, err := LoadProfile(strings.NewReader(test.profile))
+		if err == nil {
+			_, err = NewFromProfile(profile)
+		}
+
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Fatalf("Actual: %v, Expected: %s", err, test.expected)
+		}
+	}
+}
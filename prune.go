@@ -0,0 +1,347 @@
+package sentences
+
+import "sort"
+
+/*
+Usage counts something for every entry of the training data: how often it
+answered a lookup for Storage.WithUsage, how many sentence breaks it decided
+for a Pruner.
+*/
+type Usage struct {
+	AbbrevTypes  map[string]int `json:"AbbrevTypes"`
+	Collocations map[string]int `json:"Collocations"`
+	SentStarters map[string]int `json:"SentStarters"`
+	OrthoContext map[string]int `json:"OrthoContext"`
+}
+
+// NewUsage creates empty usage counts
+func NewUsage() *Usage {
+	return &Usage{
+		AbbrevTypes:  map[string]int{},
+		Collocations: map[string]int{},
+		SentStarters: map[string]int{},
+		OrthoContext: map[string]int{},
+	}
+}
+
+func (u *Usage) sections() [numSections]map[string]int {
+	return [numSections]map[string]int{u.AbbrevTypes, u.Collocations, u.SentStarters, u.OrthoContext}
+}
+
+// record counts a lookup of a section, a nil Usage records nothing
+func (u *Usage) record(section int, key string) {
+	if u == nil {
+		return
+	}
+	// storing key in the map would make every key passed to lookup escape,
+	// collocation keys would then allocate even without a Usage; only the
+	// copy escapes, and it is only made when something is recorded
+	u.sections()[section][string([]byte(key))]++
+}
+
+// drop takes an entry out of the training data the overlay is put on
+func (o *Overlay) drop(section int, key string) {
+	switch section {
+	case abbrevSection:
+		o.AbbrevTypes.Remove(key)
+	case collocationSection:
+		o.Collocations.Remove(key)
+	case sentStarterSection:
+		o.SentStarters.Remove(key)
+	case orthoSection:
+		o.OrthoContext[key] = OrthoChange{Remove: orthoUc | orthoLc}
+	}
+}
+
+// undrop puts back an entry drop took out
+func (o *Overlay) undrop(section int, key string) {
+	switch section {
+	case abbrevSection:
+		delete(o.AbbrevTypes, key)
+	case collocationSection:
+		delete(o.Collocations, key)
+	case sentStarterSection:
+		delete(o.SentStarters, key)
+	case orthoSection:
+		delete(o.OrthoContext, key)
+	}
+}
+
+// sentBreaks returns the positions of the tokens followed by a sentence break
+func sentBreaks(tokens []*Token) map[int]bool {
+	breaks := map[int]bool{}
+	for _, tok := range tokens {
+		if tok.SentBreak {
+			breaks[tok.Position] = true
+		}
+	}
+	return breaks
+}
+
+// changedBreaks counts the sentence breaks only one of two annotations of the same text has
+func changedBreaks(breaks, other map[int]bool) int {
+	changed := 0
+	for pos := range breaks {
+		if !other[pos] {
+			changed++
+		}
+	}
+	for pos := range other {
+		if !breaks[pos] {
+			changed++
+		}
+	}
+	return changed
+}
+
+// names of the sections in the order of the binary format
+var sectionNames = [numSections]string{"AbbrevTypes", "Collocations", "SentStarters", "OrthoContext"}
+
+// PruneResult is a pruned model and what was taken out of it
+type PruneResult struct {
+	Storage *Storage
+	// Kept and Removed count the entries of every section
+	Kept    map[string]int
+	Removed map[string]int
+	// Size and PrunedSize are the sizes of the models in the binary format
+	Size       int
+	PrunedSize int
+}
+
+/*
+Pruner drops the entries of a model that a reference corpus does not need.
+An entry is used as many times as the corpus has sentence breaks that are
+different without it.  Documents are looked at in spans of a few tokens: every
+entry that answers a lookup in a span is taken out, along with the entries of
+that document taken out before it that changed nothing, and the spans it
+answered lookups in are tokenized again.  Entries that only answer lookups
+once others are out are tried the same way.  Entries used fewer than MinUses
+times are dropped, after that the least used entries go until the binary
+model fits the Budget.  How much a budget costs is measured with Evaluate on
+gold data.
+*/
+type Pruner struct {
+	Tokenizer *DefaultSentenceTokenizer
+	// MinUses is how often an entry has to be used to be kept
+	MinUses int
+	// Budget is the size in bytes of the binary model to prune to, 0 has no budget
+	Budget int
+
+	usage *Usage
+}
+
+// NewPruner creates a pruner that drops the entries the corpus does not use
+func NewPruner(tokenizer *DefaultSentenceTokenizer) *Pruner {
+	return &Pruner{Tokenizer: tokenizer, MinUses: 1, usage: NewUsage()}
+}
+
+// pruneSpan is the number of tokens of a document the pruner tokenizes again at once
+const pruneSpan = 8
+
+/*
+Record tokenizes a document of the reference corpus and counts the sentence
+breaks every entry decides.  Only the spans of the document an entry answers
+lookups in are tokenized again without it.
+*/
+func (p *Pruner) Record(text string) {
+	tokenizer := p.Tokenizer.WithOverlays()
+	// the first pass of the adaptive mode looks entries up for its own statistics
+	tokenizer.Adaptive = nil
+
+	tokens := tokenizer.AnnotatedTokens(text)
+	spanEnd := func(from int) int {
+		if from+pruneSpan > len(tokens) {
+			return len(tokens)
+		}
+		return from + pruneSpan
+	}
+
+	// the breaks of every span, by its first token, and the spans every entry answers lookups in
+	breaks := map[int]map[int]bool{}
+	spans := map[pruneEntry][]int{}
+	tried := map[pruneEntry]bool{}
+	queue := []pruneEntry{}
+
+	for from := 0; from < len(tokens); from += pruneSpan {
+		hits := NewUsage()
+		breaks[from] = tokenizer.WithUsage(hits).spanBreaks(text, tokens, from, spanEnd(from))
+		for _, entry := range hitEntries(hits) {
+			spans[entry] = append(spans[entry], from)
+			if !tried[entry] {
+				tried[entry] = true
+				queue = append(queue, entry)
+			}
+		}
+	}
+	sortEntries(queue)
+
+	// the entries that decide nothing stay out, so the ones after them are
+	// tried without all of them, and entries that are only looked up once
+	// others are out, e.g. a sentence starter after the orthographic context
+	// of the word, are tried as well
+	unused := NewOverlay()
+	usage := p.usage.sections()
+	for len(queue) > 0 {
+		entry := queue[0]
+		queue = queue[1:]
+
+		unused.drop(entry.section, entry.key)
+		view := tokenizer.WithOverlays(unused)
+
+		changed := 0
+		more := map[pruneEntry][]int{}
+		for _, from := range spans[entry] {
+			hits := NewUsage()
+			other := view.WithUsage(hits).spanBreaks(text, tokens, from, spanEnd(from))
+			changed += changedBreaks(breaks[from], other)
+
+			for _, hit := range hitEntries(hits) {
+				if !tried[hit] {
+					more[hit] = append(more[hit], from)
+				}
+			}
+		}
+
+		if changed > 0 {
+			usage[entry.section][entry.key] += changed
+			unused.undrop(entry.section, entry.key)
+			continue
+		}
+
+		found := make([]pruneEntry, 0, len(more))
+		for hit, from := range more {
+			tried[hit] = true
+			spans[hit] = append(spans[hit], from...)
+			found = append(found, hit)
+		}
+		sortEntries(found)
+		queue = append(queue, found...)
+	}
+}
+
+// hitEntries returns the entries with hits
+func hitEntries(hits *Usage) []pruneEntry {
+	entries := []pruneEntry{}
+	for section, counts := range hits.sections() {
+		for key := range counts {
+			entries = append(entries, pruneEntry{section: section, key: key})
+		}
+	}
+	return entries
+}
+
+// sortEntries sorts entries by section and key
+func sortEntries(entries []pruneEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].section != entries[j].section {
+			return entries[i].section < entries[j].section
+		}
+		return entries[i].key < entries[j].key
+	})
+}
+
+// Usage returns the sentence breaks every entry decided so far
+func (p *Pruner) Usage() *Usage {
+	return p.usage
+}
+
+// pruneEntry is an entry of the model that might be dropped
+type pruneEntry struct {
+	section int
+	key     string
+	uses    int
+}
+
+// size is what the entry takes up in the binary format: its bound, its bytes and its flags
+func (e pruneEntry) size(classes bool) int {
+	if e.section == orthoSection || (e.section == abbrevSection && classes) {
+		return 4 + len(e.key) + 1
+	}
+	return 4 + len(e.key)
+}
+
+// Prune returns the model without the entries the corpus does not need
+func (p *Pruner) Prune() (*PruneResult, error) {
+	storage := p.Tokenizer.Storage.Expand()
+
+	size, err := storage.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PruneResult{
+		Storage: storage,
+		Kept:    map[string]int{},
+		Removed: map[string]int{},
+		Size:    len(size),
+	}
+
+	sets := [numSections]SetString{storage.AbbrevTypes, storage.Collocations, storage.SentStarters, storage.OrthoContext}
+	usage := p.usage.sections()
+
+	// abbreviations take a byte more if the model has classes
+	classes := len(storage.AbbrevClasses) > 0
+	pruned := result.Size
+	entries := []pruneEntry{}
+	for section, set := range sets {
+		for key := range set {
+			entry := pruneEntry{section, key, usage[section][key]}
+			if entry.uses < p.MinUses {
+				set.Remove(key)
+				result.Removed[sectionNames[section]]++
+				pruned -= entry.size(classes)
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	// the least used first, of those the largest, in a stable order
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.uses != b.uses {
+			return a.uses < b.uses
+		}
+		if a.size(classes) != b.size(classes) {
+			return a.size(classes) > b.size(classes)
+		}
+		if a.section != b.section {
+			return a.section < b.section
+		}
+		return a.key < b.key
+	})
+
+	for _, entry := range entries {
+		if p.Budget == 0 || pruned <= p.Budget {
+			break
+		}
+		sets[entry.section].Remove(entry.key)
+		result.Removed[sectionNames[entry.section]]++
+		pruned -= entry.size(classes)
+	}
+
+	for section, set := range sets {
+		result.Kept[sectionNames[section]] = len(set)
+	}
+
+	// classes go with their abbreviations
+	for typ := range storage.AbbrevClasses {
+		if !storage.AbbrevTypes.Has(typ) {
+			delete(storage.AbbrevClasses, typ)
+		}
+	}
+
+	storage.UpdateChecksum()
+	out, err := storage.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	result.PrunedSize = len(out)
+
+	return result, nil
+}
+
+// Evaluate counts the sentence break decisions of a tokenizer against gold documents
+func Evaluate(tokenizer *DefaultSentenceTokenizer, docs []GoldDocument) Accuracy {
+	return NewLearner(tokenizer).evaluate(docs, NewOverlay()).acc
+}
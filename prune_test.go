@@ -0,0 +1,92 @@
+package sentences
+
+import "testing"
+
+func TestPruner(t *testing.T) {
+	t.Log("Pruning the unused entries should not change a decision on the reference corpus")
+
+	docs := loadGold(t)
+	tokenizer := NewSentenceTokenizer(loadStorage("english"))
+
+	pruner := NewPruner(tokenizer)
+	for _, doc := range docs {
+		pruner.Record(doc.Text)
+	}
+
+	if pruner.Usage().AbbrevTypes["dr"] == 0 {
+		t.Fatalf("Actual: %v, Expected: dr to be used", pruner.Usage().AbbrevTypes)
+	}
+
+	// the is looked up after sentence breaks but decides none of them
+	hits := NewUsage()
+	for _, doc := range docs {
+		tokenizer.WithUsage(hits).AnnotatedTokens(doc.Text)
+	}
+	if hits.OrthoContext["the"] == 0 || pruner.Usage().OrthoContext["the"] != 0 {
+		t.Fatalf("Actual: %d hits, %d uses, Expected: hits but no uses", hits.OrthoContext["the"], pruner.Usage().OrthoContext["the"])
+	}
+
+	result, err := pruner.Prune()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.PrunedSize*10 > result.Size || result.Removed["OrthoContext"] == 0 {
+		t.Fatalf("Actual: %d of %d bytes left, Expected: less than a tenth", result.PrunedSize, result.Size)
+	}
+
+	if !result.Storage.IsAbbr("dr") || result.Kept["AbbrevTypes"] != len(result.Storage.AbbrevTypes) {
+		t.Fatalf("Used entries should be kept")
+	}
+
+	before := Evaluate(tokenizer, docs)
+	after := Evaluate(NewSentenceTokenizer(result.Storage), docs)
+	if after != before {
+		t.Fatalf("Actual: %+v, Expected: %+v", after, before)
+	}
+
+	t.Log("A budget should drop the least used entries until the model fits")
+
+	pruner.Budget = result.PrunedSize / 2
+	budgeted, err := pruner.Prune()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if budgeted.PrunedSize > pruner.Budget {
+		t.Fatalf("Actual: %d bytes, Expected: at most %d", budgeted.PrunedSize, pruner.Budget)
+	}
+
+	if !budgeted.Storage.IsAbbr("dr") {
+		t.Fatalf("The most used entries should be kept")
+	}
+
+	if len(tokenizer.Storage.OrthoContext) != result.Kept["OrthoContext"]+result.Removed["OrthoContext"] {
+		t.Fatalf("The model of the tokenizer should not be modified")
+	}
+}
+
+func TestUsage(t *testing.T) {
+	t.Log("Only lookups answered by the base training data should be counted")
+
+	storage := loadStorage("english")
+	overlay := NewOverlay()
+	overlay.AbbrevTypes.Remove("dr")
+
+	usage := NewUsage()
+	view := storage.WithUsage(usage).WithOverlays(overlay)
+
+	view.IsAbbr("dr")
+	view.IsAbbr("mr")
+	view.IsAbbr("notanabbreviation")
+	view.IsCollocation("##number##", "nov")
+
+	if usage.AbbrevTypes["dr"] != 0 || usage.AbbrevTypes["mr"] != 1 || len(usage.AbbrevTypes) != 1 {
+		t.Fatalf("Actual: %v, Expected: map[mr:1]", usage.AbbrevTypes)
+	}
+
+	storage.IsAbbr("mr")
+	if usage.AbbrevTypes["mr"] != 1 {
+		t.Fatalf("The storage the view was made from should not record")
+	}
+}
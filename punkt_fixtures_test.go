@@ -0,0 +1,183 @@
+package sentences
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+/*
+punktFixture is the expected output of punkt for a set of texts, spans are in
+characters the way NLTK reports them.
+*/
+type punktFixture struct {
+	Lang   string `json:"lang"`
+	Source string `json:"source"`
+	Cases  []struct {
+		Text      string   `json:"text"`
+		Sentences []string `json:"sentences"`
+		Spans     [][2]int `json:"spans"`
+	} `json:"cases"`
+}
+
+// loadPunktFixtures reads the fixtures of a directory of test_files
+func loadPunktFixtures(t *testing.T, dir string) []punktFixture {
+	fnames, _ := filepath.Glob(filepath.Join("test_files", dir, "*.json"))
+	fixtures := make([]punktFixture, 0, len(fnames))
+
+	for _, fname := range fnames {
+		var fixture punktFixture
+		if err := json.Unmarshal([]byte(readFile(fname)), &fixture); err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+
+	return fixtures
+}
+
+// testPunktFixtures compares the sentences and spans of every case, and requires a fixture for every language
+func testPunktFixtures(t *testing.T, fixtures []punktFixture) {
+	covered := map[string]bool{}
+
+	for _, fixture := range fixtures {
+		covered[fixture.Lang] = true
+
+		tokenizer := NewSentenceTokenizer(loadStorage(fixture.Lang)).WithRealignment()
+
+		for _, test := range fixture.Cases {
+			spans := tokenizer.SpanTokenize(test.Text)
+
+			actual := make([]string, 0, len(spans))
+			for _, span := range spans {
+				actual = append(actual, test.Text[span[0]:span[1]])
+			}
+
+			if strings.Join(actual, "|") != strings.Join(test.Sentences, "|") {
+				t.Fatalf("%s %q\nActual: %q\nExpected: %q", fixture.Lang, test.Text, actual, test.Sentences)
+			}
+
+			for index, span := range spans {
+				// NLTK counts characters, spans here are byte offsets
+				start := utf8.RuneCountInString(test.Text[:span[0]])
+				end := utf8.RuneCountInString(test.Text[:span[1]])
+
+				if start != test.Spans[index][0] || end != test.Spans[index][1] {
+					t.Fatalf("%s %q: Actual: [%d, %d], Expected: %v", fixture.Lang, test.Text, start, end, test.Spans[index])
+				}
+			}
+		}
+	}
+
+	for _, lang := range binaryLanguages {
+		if !covered[lang] {
+			t.Fatalf("There are no fixtures for %s", lang)
+		}
+	}
+}
+
+func TestPunktFixtures(t *testing.T) {
+	t.Log("DefaultSentenceTokenizer should keep finding the sentences and spans of the hand-written punkt fixtures")
+
+	testPunktFixtures(t, loadPunktFixtures(t, "punkt"))
+}
+
+func TestNLTKParity(t *testing.T) {
+	t.Log("DefaultSentenceTokenizer should find the same sentences and spans as NLTK")
+
+	fixtures := loadPunktFixtures(t, "nltk")
+	if len(fixtures) == 0 {
+		t.Skip("No NLTK recordings, run python3 test_files/nltk/record.py english french german spanish with NLTK installed")
+	}
+
+	for _, fixture := range fixtures {
+		if !strings.HasPrefix(fixture.Source, "recorded with nltk") {
+			t.Fatalf("The %s fixture was not recorded by NLTK: %s", fixture.Lang, fixture.Source)
+		}
+	}
+
+	testPunktFixtures(t, fixtures)
+}
+
+func TestRealignAnnotation(t *testing.T) {
+	t.Log("Closing punctuation after a break should move into the sentence it closes")
+
+	tokenizer := loadTokenizer("data/english.json").WithRealignment()
+
+	actualText := "He left. )-- then he came."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{"He left. )", "-- then he came."}
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if strings.TrimSpace(sent.Text) != expected[index] {
+			t.Fatalf("Actual: %q, Expected: %q", sent.Text, expected[index])
+		}
+	}
+
+	for _, tok := range []string{")", `"'`, ")--", "))"} {
+		if realignment(tok) == 0 {
+			t.Fatalf("%q should be realigned", tok)
+		}
+	}
+
+	for _, tok := range []string{")Then", "-)", `"Now."`} {
+		if realignment(tok) != 0 {
+			t.Fatalf("%q should not be realigned", tok)
+		}
+	}
+}
+
+func TestRealignAnnotationStart(t *testing.T) {
+	t.Log("A split token should keep the start of the token it came from, whose text can be shorter than its span")
+
+	// a pre-tokenized ")--" that was written ") --" in the text
+	left := NewToken("left.")
+	left.Start, left.Position, left.SentBreak = 3, 8, true
+	closers := NewToken(")--")
+	closers.Start, closers.Position = 9, 13
+
+	tokens := (&RealignAnnotation{}).Annotate([]*Token{left, closers})
+	if len(tokens) != 3 {
+		t.Fatalf("Actual: %v, Expected: 3 tokens", tokens)
+	}
+
+	if tokens[1].Start != 9 || tokens[1].Position != 10 || tokens[2].Start != 10 || tokens[2].Position != 13 {
+		t.Fatalf("Actual: [%d:%d] [%d:%d], Expected: [9:10] [10:13]", tokens[1].Start, tokens[1].Position, tokens[2].Start, tokens[2].Position)
+	}
+}
+
+func TestNoRealignmentByDefault(t *testing.T) {
+	t.Log("Tokenizers should only realign closing punctuation when asked to, and split as they always did otherwise")
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"He left. ) Then he came.", []string{"He left.", " ) Then he came."}},
+		{"He left. )-- then he came.", []string{"He left.", " )-- then he came."}},
+		{"She said \"Stop. \" Then she left.", []string{"She said \"Stop.", " \" Then she left."}},
+	}
+
+	tokenizer := loadTokenizer("data/english.json")
+	for _, test := range tests {
+		actual := []string{}
+		for _, sent := range tokenizer.Tokenize(test.text) {
+			actual = append(actual, sent.Text)
+		}
+
+		if strings.Join(actual, "|") != strings.Join(test.expected, "|") {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+	}
+
+	realigned := tokenizer.WithRealignment()
+	if realigned.WithRealignment() != realigned || len(realigned.Annotations) != len(tokenizer.Annotations)+1 {
+		t.Fatalf("WithRealignment should add a single RealignAnnotation")
+	}
+}
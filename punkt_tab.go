@@ -0,0 +1,155 @@
+package sentences
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+Files of an NLTK punkt_tab model directory, e.g. tokenizers/punkt_tab/english.
+The .txt files hold one word type per line, collocations.tab holds two tab
+separated word types per line and ortho_context.tab a word type and its flags.
+*/
+const (
+	punktTabAbbrevs      = "abbrev_types.txt"
+	punktTabCollocations = "collocations.tab"
+	punktTabSentStarters = "sent_starters.txt"
+	punktTabOrthoContext = "ortho_context.tab"
+)
+
+// punktTabLines returns the lines of a punkt_tab file, NLTK writes them without a final newline
+func punktTabLines(fsys fs.FS, name string) ([]string, error) {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, bytes.Count(b, []byte("\n"))+1)
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+}
+
+/*
+LoadPunktTab reads a model in the punkt_tab format NLTK uses since 3.8.2, e.g.
+LoadPunktTab(os.DirFS("nltk_data/tokenizers/punkt_tab/english")).  The
+model has no metadata and must pass Validate.
+*/
+func LoadPunktTab(fsys fs.FS) (*Storage, error) {
+	storage := NewStorage()
+
+	for name, set := range map[string]SetString{
+		punktTabAbbrevs:      storage.AbbrevTypes,
+		punktTabSentStarters: storage.SentStarters,
+	} {
+		lines, err := punktTabLines(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, line := range lines {
+			set.Add(line)
+		}
+	}
+
+	lines, err := punktTabLines(fsys, punktTabCollocations)
+	if err != nil {
+		return nil, err
+	}
+
+	for num, line := range lines {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 || strings.Contains(line, ",") {
+			return nil, fmt.Errorf("%s line %d: %q is not two tab separated word types without commas", punktTabCollocations, num+1, line)
+		}
+		storage.Collocations.Add(fields[0] + "," + fields[1])
+	}
+
+	lines, err = punktTabLines(fsys, punktTabOrthoContext)
+	if err != nil {
+		return nil, err
+	}
+
+	for num, line := range lines {
+		sep := strings.LastIndexByte(line, '\t')
+		if sep < 0 {
+			return nil, fmt.Errorf("%s line %d: %q is not a word type and its flags separated by a tab", punktTabOrthoContext, num+1, line)
+		}
+
+		flags, err := strconv.Atoi(line[sep+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: flags %q are not a number", punktTabOrthoContext, num+1, line[sep+1:])
+		}
+		storage.OrthoContext[line[:sep]] = flags
+	}
+
+	if err := storage.Validate(); err != nil {
+		return nil, err
+	}
+
+	return storage, nil
+}
+
+/*
+WritePunktTab writes the base training data to dir in the punkt_tab format,
+sorted the way NLTK writes it.  Metadata and overlays are not part of the
+format.
+*/
+func (p *Storage) WritePunktTab(dir string) error {
+	base := p.Expand()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	colloc := make([]string, 0, len(base.Collocations))
+	for _, key := range base.Collocations.Array() {
+		parts := strings.Split(key, ",")
+		if len(parts) != 2 {
+			return fmt.Errorf("collocation %q is not two word types separated by a comma", key)
+		}
+		colloc = append(colloc, parts[0]+"\t"+parts[1])
+	}
+	// NLTK sorts the pairs, which is not the order of the joined strings
+	sort.Slice(colloc, func(i, j int) bool {
+		a := strings.SplitN(colloc[i], "\t", 2)
+		b := strings.SplitN(colloc[j], "\t", 2)
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		return a[1] < b[1]
+	})
+
+	ortho := make([]string, 0, len(base.OrthoContext))
+	for _, typ := range base.OrthoContext.Array() {
+		ortho = append(ortho, typ+"\t"+strconv.Itoa(base.OrthoContext[typ]))
+	}
+
+	files := map[string][]string{
+		punktTabAbbrevs:      base.AbbrevTypes.Array(),
+		punktTabCollocations: colloc,
+		punktTabSentStarters: base.SentStarters.Array(),
+		punktTabOrthoContext: ortho,
+	}
+
+	for name, lines := range files {
+		content := []byte(strings.Join(lines, "\n"))
+		if err := ioutil.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
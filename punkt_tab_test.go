@@ -0,0 +1,93 @@
+package sentences
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestPunktTabRoundTrip(t *testing.T) {
+	t.Log("Every shipped language should survive a round trip through punkt_tab")
+
+	dir, err := ioutil.TempDir("", "punkt_tab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	models, _ := filepath.Glob("data/*.json")
+	if len(models) == 0 {
+		t.Fatalf("No models found in data/")
+	}
+
+	for _, model := range models {
+		storage, err := LoadTraining([]byte(readFile(model)))
+		if err != nil {
+			t.Fatalf("%s: %v", model, err)
+		}
+
+		lang := strings.TrimSuffix(filepath.Base(model), ".json")
+		first := filepath.Join(dir, lang)
+		if err := storage.WritePunktTab(first); err != nil {
+			t.Fatalf("%s: %v", model, err)
+		}
+
+		tab, err := LoadPunktTab(os.DirFS(first))
+		if err != nil {
+			t.Fatalf("%s: %v", model, err)
+		}
+
+		if !reflect.DeepEqual(tab.Expand(), storage.Expand()) {
+			t.Fatalf("%s: punkt_tab does not hold the same training data", model)
+		}
+
+		second := filepath.Join(dir, lang+"-again")
+		if err := tab.WritePunktTab(second); err != nil {
+			t.Fatalf("%s: %v", model, err)
+		}
+
+		for _, name := range []string{punktTabAbbrevs, punktTabCollocations, punktTabSentStarters, punktTabOrthoContext} {
+			if readFile(filepath.Join(first, name)) != readFile(filepath.Join(second, name)) {
+				t.Fatalf("%s: %s is not written the same way twice", model, name)
+			}
+		}
+	}
+}
+
+func TestLoadPunktTab(t *testing.T) {
+	t.Log("punkt_tab files written by NLTK should load, including a trailing newline")
+
+	fsys := fstest.MapFS{
+		punktTabAbbrevs:      {Data: []byte("dr\nmr\ne.g")},
+		punktTabCollocations: {Data: []byte("##number##\tnov\nst\tlouis\n")},
+		punktTabSentStarters: {Data: []byte("however\r\nbut")},
+		punktTabOrthoContext: {Data: []byte("monday\t14\nthe\t96")},
+	}
+
+	storage, err := LoadPunktTab(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !storage.IsAbbr("e.g") || !storage.IsCollocation("st", "louis") || !storage.IsSentStarter("however") {
+		t.Fatalf("Entries are missing: %v", storage.Expand())
+	}
+
+	if storage.OrthoFlags("monday") != 14 || storage.OrthoFlags("the") != 96 {
+		t.Fatalf("Actual: %d %d, Expected: 14 96", storage.OrthoFlags("monday"), storage.OrthoFlags("the"))
+	}
+
+	fsys[punktTabCollocations] = &fstest.MapFile{Data: []byte("st louis")}
+	if _, err := LoadPunktTab(fsys); err == nil || !strings.Contains(err.Error(), "collocations.tab line 1") {
+		t.Fatalf("Actual: %v, Expected: an error for collocations.tab line 1", err)
+	}
+
+	delete(fsys, punktTabCollocations)
+	if _, err := LoadPunktTab(fsys); err == nil {
+		t.Fatalf("Expected an error for a missing collocations.tab")
+	}
+}
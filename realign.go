@@ -0,0 +1,122 @@
+package sentences
+
+import (
+	"strings"
+	"unicode"
+)
+
+// characters that NLTK's punkt moves from the start of a sentence to the end of the previous one
+const realignClosers = `"')]}`
+
+/*
+realignment returns how much of a token that starts a sentence belongs to the
+previous sentence.  It follows the regular expression NLTK uses,
+
+	["\')\]}]+?(?:\s+|(?=--)|$)
+
+matched at the start of the sentence: a run of closing quotes and brackets
+that is followed by whitespace, the end of the text or "--".
+*/
+func realignment(tok string) int {
+	rest := strings.TrimLeft(tok, realignClosers)
+	closers := len(tok) - len(rest)
+
+	if closers == 0 || (rest != "" && !strings.HasPrefix(rest, "--")) {
+		return 0
+	}
+
+	return closers
+}
+
+/*
+RealignAnnotation moves the closing quotes and brackets that follow a
+sentence break into the sentence they close, like the boundary realignment of
+NLTK's punkt.  In `He left. ) Then he came.` the first sentence ends after
+the bracket instead of the second one starting with it.  It has to run after
+every annotation that decides on sentence breaks.  Tokenizers do not realign
+unless they are made WithRealignment or a profile lists "realign".
+*/
+type RealignAnnotation struct{}
+
+// Annotate moves sentence breaks past closing punctuation, splitting a token such as `)--`
+func (a *RealignAnnotation) Annotate(tokens []*Token) []*Token {
+	for i := 0; i < len(tokens)-1; i++ {
+		tok := tokens[i]
+		next := tokens[i+1]
+
+		if !tok.SentBreak {
+			continue
+		}
+
+		closers := realignment(next.Tok)
+		if closers == 0 {
+			continue
+		}
+
+		tok.SentBreak = false
+
+		if closers < len(next.Tok) {
+			closing := NewToken(next.Tok[:closers])
+			closing.Start = next.Start
+			closing.Position = closing.Start + closers
+			closing.LineStart = next.LineStart
+			closing.ParaStart = next.ParaStart
+
+			rest := NewToken(next.Tok[closers:])
+			rest.Start = closing.Position
+			rest.Position = next.Position
+			rest.SentBreak = next.SentBreak
+			rest.Abbr = next.Abbr
+			rest.Protected = next.Protected
+			rest.ForcedBreak = next.ForcedBreak
+			rest.Attrs = next.Attrs
+
+			tokens = append(tokens[:i+1], append([]*Token{closing, rest}, tokens[i+2:]...)...)
+			next = closing
+		}
+
+		next.SentBreak = true
+		// NLTK only realigns the first run of closers after a break
+		i++
+	}
+
+	return tokens
+}
+
+/*
+WithRealignment returns a tokenizer that realigns sentence breaks after its
+annotations, like NLTK, see RealignAnnotation.  It is the tokenizer itself if
+it already does.
+*/
+func (s *DefaultSentenceTokenizer) WithRealignment() *DefaultSentenceTokenizer {
+	for _, ann := range s.Annotations {
+		if _, ok := ann.(*RealignAnnotation); ok {
+			return s
+		}
+	}
+
+	return s.withAnnotation(&RealignAnnotation{})
+}
+
+/*
+SpanTokenize returns the start and end byte offsets of every sentence the way
+NLTK's span_tokenize does: whitespace between sentences belongs to neither of
+them and empty sentences are left out.  The sentences only end where NLTK's do
+if the tokenizer realigns its breaks, see WithRealignment.
+*/
+func (s *DefaultSentenceTokenizer) SpanTokenize(text string) [][2]int {
+	sentences := s.Tokenize(text)
+	spans := make([][2]int, 0, len(sentences))
+
+	for _, sentence := range sentences {
+		trimmed := strings.TrimLeftFunc(sentence.Text, unicode.IsSpace)
+		start := sentence.End - len(trimmed)
+		end := sentence.Start + len(strings.TrimRightFunc(sentence.Text, unicode.IsSpace))
+
+		if start < end {
+			spans = append(spans, [2]int{start, end})
+		}
+	}
+
+	return spans
+}
@@ -0,0 +1,423 @@
+package sentences
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RuleAction is what a rule does to the sentence breaks around a token
+type RuleAction int
+
+const (
+	// RuleBreak ends a sentence after the token
+	RuleBreak RuleAction = iota
+	// RuleNoBreak takes back the sentence break after the token
+	RuleNoBreak
+	// RuleBreakBefore starts a sentence at the token
+	RuleBreakBefore
+	// RuleNoBreakBefore takes back the sentence break before the token
+	RuleNoBreakBefore
+	// RuleSet records an attribute of the token
+	RuleSet
+)
+
+var ruleActions = map[string]RuleAction{
+	"break":          RuleBreak,
+	"nobreak":        RuleNoBreak,
+	"break-before":   RuleBreakBefore,
+	"nobreak-before": RuleNoBreakBefore,
+	"set":            RuleSet,
+}
+
+func (a RuleAction) String() string {
+	for name, action := range ruleActions {
+		if action == a {
+			return name
+		}
+	}
+	return fmt.Sprintf("RuleAction(%d)", int(a))
+}
+
+// ruleFeatures are the features of a token a condition can test, and whether they take a value
+var ruleFeatures = map[string]bool{
+	"text":        true,
+	"type":        true,
+	"abbr":        false,
+	"first-upper": false,
+	"first-lower": false,
+	"line-start":  false,
+	"para-start":  false,
+	"break":       false,
+}
+
+// RuleCondition tests a feature of the token a rule is applied to or of one of its neighbours
+type RuleCondition struct {
+	// Offset is the token tested: 0 the token itself, -1 the previous and 1 the next one
+	Offset  int
+	Feature string
+	// Value is the type for the type feature and the expression for the text feature
+	Value  string
+	Negate bool
+	re     *regexp.Regexp
+}
+
+// Rule is a sentence break action taken at every token that meets all of its conditions
+type Rule struct {
+	Action     RuleAction
+	Conditions []RuleCondition
+	// Attr and Value are the attribute a set rule records
+	Attr  string
+	Value interface{}
+	// Line is the line of the rule in the file it was loaded from
+	Line int
+}
+
+/*
+LoadRules reads boundary rules, one per line.  A rule is an action, a colon
+and conditions joined by "and":
+
+	# never break after a figure reference before its number
+	nobreak: text /^(Fig|Eq|Tab)\.$/ and next.type ##number##
+	# a transcript question always starts a sentence
+	break-before: line-start and text /^Q:/
+
+The actions are break, nobreak, break-before and nobreak-before, and set,
+which records an attribute of the token instead, e.g. "set url" or "set
+lang=de".  A condition is a feature of the token, of the previous one with
+"prev." or of the next one with "next.", and "not" negates it:
+  - text /regex/: the text of the token matches the expression.
+  - type word: the type of the token without its period, e.g. ##number##.
+  - abbr, first-upper, first-lower, line-start, para-start and break, the
+    token is an abbreviation, is capitalized, is lower case, starts a line
+    or a paragraph, or is followed by a sentence break.
+
+A condition on a token before the start or past the end of the text is never
+met, negated or not.  Lines starting with "# " are comments.
+*/
+func LoadRules(r io.Reader) ([]*Rule, error) {
+	rules := []*Rule{}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || line == "#" || strings.HasPrefix(line, "# ") {
+			continue
+		}
+
+		rule, err := ParseRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("rules line %d: %v", lineNum, err)
+		}
+		rule.Line = lineNum
+
+		rules = append(rules, rule)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// ParseRule parses a single rule, see LoadRules for the syntax
+func ParseRule(line string) (*Rule, error) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return nil, fmt.Errorf("expected an action and a colon: %q", line)
+	}
+
+	fields := strings.Fields(line[:colon])
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("expected an action before the colon")
+	}
+
+	name := fields[0]
+	action, ok := ruleActions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown action %q", name)
+	}
+
+	rule := &Rule{Action: action}
+	if action == RuleSet {
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("set takes one attribute, e.g. set url or set lang=de")
+		}
+		rule.Attr, rule.Value = parseRuleAttr(fields[1])
+	} else if len(fields) > 1 {
+		return nil, fmt.Errorf("%s takes no attribute", name)
+	}
+
+	words, err := ruleWords(line[colon+1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("%s has no conditions", name)
+	}
+
+	for len(words) > 0 {
+		var cond RuleCondition
+		cond, words, err = parseRuleCondition(words)
+		if err != nil {
+			return nil, err
+		}
+		rule.Conditions = append(rule.Conditions, cond)
+
+		if len(words) == 0 {
+			break
+		}
+		if words[0] != "and" {
+			return nil, fmt.Errorf("expected \"and\", got %q", words[0])
+		}
+		if len(words) == 1 {
+			return nil, fmt.Errorf("expected a condition after \"and\"")
+		}
+		words = words[1:]
+	}
+
+	return rule, nil
+}
+
+// parseRuleAttr reads key or key=value, a value is a number, true, false or a string
+func parseRuleAttr(attr string) (string, interface{}) {
+	eq := strings.Index(attr, "=")
+	if eq < 0 {
+		return attr, true
+	}
+
+	key, value := attr[:eq], attr[eq+1:]
+	if n, err := strconv.Atoi(value); err == nil {
+		return key, n
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return key, b
+	}
+	return key, value
+}
+
+// ruleWords splits conditions on white space, an expression between slashes is one word
+func ruleWords(text string) ([]string, error) {
+	words := []string{}
+
+	for i := 0; i < len(text); {
+		if text[i] == ' ' || text[i] == '\t' {
+			i++
+			continue
+		}
+
+		start := i
+		if text[i] == '/' {
+			i++
+			for i < len(text) && text[i] != '/' {
+				if text[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(text) {
+				return nil, fmt.Errorf("unterminated expression %s", text[start:])
+			}
+			i++
+		} else {
+			for i < len(text) && text[i] != ' ' && text[i] != '\t' {
+				i++
+			}
+		}
+
+		words = append(words, text[start:i])
+	}
+
+	return words, nil
+}
+
+func parseRuleCondition(words []string) (RuleCondition, []string, error) {
+	cond := RuleCondition{}
+
+	if words[0] == "not" {
+		cond.Negate = true
+		words = words[1:]
+		if len(words) == 0 {
+			return cond, nil, fmt.Errorf("expected a condition after \"not\"")
+		}
+	}
+
+	feature := words[0]
+	words = words[1:]
+	if strings.HasPrefix(feature, "prev.") {
+		cond.Offset = -1
+		feature = strings.TrimPrefix(feature, "prev.")
+	} else if strings.HasPrefix(feature, "next.") {
+		cond.Offset = 1
+		feature = strings.TrimPrefix(feature, "next.")
+	}
+
+	takesValue, ok := ruleFeatures[feature]
+	if !ok {
+		return cond, nil, fmt.Errorf("unknown feature %q", feature)
+	}
+	cond.Feature = feature
+
+	if !takesValue {
+		return cond, words, nil
+	}
+
+	if len(words) == 0 || words[0] == "and" {
+		return cond, nil, fmt.Errorf("%s needs a value", feature)
+	}
+	cond.Value = words[0]
+	words = words[1:]
+
+	if feature == "text" {
+		if len(cond.Value) < 2 || !strings.HasPrefix(cond.Value, "/") || !strings.HasSuffix(cond.Value, "/") {
+			return cond, nil, fmt.Errorf("text needs an expression between slashes, got %s", cond.Value)
+		}
+		cond.Value = cond.Value[1 : len(cond.Value)-1]
+
+		re, err := regexp.Compile(cond.Value)
+		if err != nil {
+			return cond, nil, fmt.Errorf("text /%s/: %v", cond.Value, err)
+		}
+		cond.re = re
+	}
+
+	return cond, words, nil
+}
+
+// String is the condition as it is written in a rule
+func (c RuleCondition) String() string {
+	feature := c.Feature
+	switch c.Offset {
+	case -1:
+		feature = "prev." + feature
+	case 1:
+		feature = "next." + feature
+	}
+	if c.Negate {
+		feature = "not " + feature
+	}
+
+	switch c.Feature {
+	case "text":
+		return fmt.Sprintf("%s /%s/", feature, c.Value)
+	case "type":
+		return fmt.Sprintf("%s %s", feature, c.Value)
+	}
+	return feature
+}
+
+// String is the rule as it is written in a rules file
+func (r *Rule) String() string {
+	conditions := make([]string, 0, len(r.Conditions))
+	for _, cond := range r.Conditions {
+		conditions = append(conditions, cond.String())
+	}
+	action := r.Action.String()
+	if r.Action == RuleSet {
+		action = "set " + r.Attr
+		if r.Value != true {
+			action = fmt.Sprintf("%s=%v", action, r.Value)
+		}
+	}
+	return fmt.Sprintf("%s: %s", action, strings.Join(conditions, " and "))
+}
+
+/*
+RuleAnnotation applies boundary rules to every token in the order they were
+written, so a later rule has the last word.  It runs after the annotations it
+corrects.
+*/
+type RuleAnnotation struct {
+	Rules []*Rule
+	TokenParser
+}
+
+// Annotate applies the actions of the rules whose conditions a token meets
+func (a *RuleAnnotation) Annotate(tokens []*Token) []*Token {
+	grouper := &SlidingTokenGrouper{Left: 1, Right: 1}
+	for _, window := range grouper.Windows(tokens) {
+		for _, rule := range a.Rules {
+			if a.matches(rule, window) {
+				a.apply(rule, window)
+			}
+		}
+	}
+
+	return tokens
+}
+
+func (a *RuleAnnotation) matches(rule *Rule, window TokenWindow) bool {
+	for _, cond := range rule.Conditions {
+		tok := window.Token
+		switch cond.Offset {
+		case -1:
+			tok = window.Prev(1)
+		case 1:
+			tok = window.Next(1)
+		}
+
+		if tok == nil || a.feature(cond, tok) == cond.Negate {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a *RuleAnnotation) feature(cond RuleCondition, tok *Token) bool {
+	switch cond.Feature {
+	case "text":
+		return cond.re.MatchString(tok.Tok)
+	case "type":
+		return a.TypeNoPeriod(tok) == cond.Value
+	case "abbr":
+		return tok.Abbr
+	case "first-upper":
+		return a.FirstUpper(tok)
+	case "first-lower":
+		return a.FirstLower(tok)
+	case "line-start":
+		return tok.LineStart
+	case "para-start":
+		return tok.ParaStart
+	case "break":
+		return tok.SentBreak
+	}
+
+	return false
+}
+
+func (a *RuleAnnotation) apply(rule *Rule, window TokenWindow) {
+	switch rule.Action {
+	case RuleBreak:
+		window.Token.SentBreak = true
+	case RuleNoBreak:
+		window.Token.SentBreak = false
+	case RuleBreakBefore:
+		if prev := window.Prev(1); prev != nil {
+			prev.SentBreak = true
+		}
+	case RuleNoBreakBefore:
+		if prev := window.Prev(1); prev != nil {
+			prev.SentBreak = false
+		}
+	case RuleSet:
+		window.Token.SetAttr(rule.Attr, rule.Value)
+	}
+}
+
+/*
+WithRules returns a tokenizer that applies the rules after its annotations
+have decided on the sentence breaks.  The annotation is added before a
+RealignAnnotation, or last if there is none.
+*/
+func (s *DefaultSentenceTokenizer) WithRules(rules []*Rule) *DefaultSentenceTokenizer {
+	return s.withAnnotation(&RuleAnnotation{rules, s.WordTokenizer})
+}
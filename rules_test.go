@@ -0,0 +1,109 @@
+package sentences
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testRules = `# figure references are followed by their number
+nobreak: text /^(Fig|Eq|Tab)\.$/ and next.type ##number##
+
+# transcripts
+break-before: line-start and text /^[QA]:$/
+`
+
+func TestRules(t *testing.T) {
+	t.Log("Rules should add and take back sentence breaks")
+
+	rules, err := LoadRules(strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 2 || rules[0].Line != 2 || rules[1].Line != 5 {
+		t.Fatalf("Actual: %v, Expected: rules on lines 2 and 5", rules)
+	}
+
+	tokenizer := loadTokenizer("data/english.json")
+	ruled := tokenizer.WithRules(rules)
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{
+			"The valve is shown in Fig. 3 on the left.",
+			[]string{"The valve is shown in Fig. 3 on the left."},
+		},
+		{
+			"Q: where were you\nA: at home",
+			[]string{"Q: where were you", "A: at home"},
+		},
+		{
+			"The valve is shown in Fig. We removed it.",
+			[]string{"The valve is shown in Fig.", "We removed it."},
+		},
+	}
+
+	for _, test := range tests {
+		actual := sentenceTexts(ruled, test.text)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+	}
+
+	if actual := sentenceTexts(tokenizer, tests[0].text); reflect.DeepEqual(actual, tests[0].expected) {
+		t.Fatalf("The rules made no difference for %q", tests[0].text)
+	}
+}
+
+func TestRuleString(t *testing.T) {
+	t.Log("Rules should be written the way they are parsed")
+
+	for _, line := range []string{
+		`nobreak: text /^(Fig|Eq|Tab)\.$/ and next.type ##number##`,
+		`break: not prev.abbr and first-upper and next.para-start`,
+		`nobreak-before: text /a b/ and not break`,
+		`set url: text /^https?:/`,
+		`set lang=de: text /^Straße$/`,
+	} {
+		rule, err := ParseRule(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if rule.String() != line {
+			t.Fatalf("Actual: %s, Expected: %s", rule.String(), line)
+		}
+	}
+}
+
+func TestRuleErrors(t *testing.T) {
+	t.Log("Rule syntax errors should report their line")
+
+	tests := []struct {
+		rules    string
+		expected string
+	}{
+		{"break: abbr\nsplit: abbr", `rules line 2: unknown action "split"`},
+		{"# comment\nbreak abbr", "rules line 2: expected an action and a colon"},
+		{"break:", "rules line 1: break has no conditions"},
+		{"break: capitalized", `rules line 1: unknown feature "capitalized"`},
+		{"break: abbr first-upper", `rules line 1: expected "and", got "first-upper"`},
+		{"break: abbr and", `rules line 1: expected a condition after "and"`},
+		{"break: type and abbr", "rules line 1: type needs a value"},
+		{"break: text Fig", "rules line 1: text needs an expression between slashes"},
+		{"\n\nbreak: text /^(Fig/", "rules line 3: text /^(Fig/: error parsing regexp"},
+		{"break: text /^Fig", "rules line 1: unterminated expression"},
+		{"set: abbr", "rules line 1: set takes one attribute"},
+		{"break url: abbr", "rules line 1: break takes no attribute"},
+	}
+
+	for _, test := range tests {
+		_, err := LoadRules(strings.NewReader(test.rules))
+		if err == nil || !strings.HasPrefix(err.Error(), test.expected) {
+			t.Fatalf("Actual: %v, Expected: %s", err, test.expected)
+		}
+	}
+}
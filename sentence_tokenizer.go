@@ -17,6 +17,10 @@ type DefaultSentenceTokenizer struct {
 	WordTokenizer
 	PunctStrings
 	Annotations []AnnotateTokens
+	// Adaptive learns from every text before tokenizing it when it is set
+	Adaptive *Adaptive
+	// Aggregations are how attributes of the tokens are aggregated on sentences, see WithAggregations
+	Aggregations map[string]AttrAggregation
 }
 
 // NewSentenceTokenizer are the sane defaults for the sentence tokenizer
@@ -51,6 +55,73 @@ func NewTokenizer(s *Storage, word WordTokenizer, lang PunctStrings) *DefaultSen
 	return tokenizer
 }
 
+/*
+WithOverlays returns a tokenizer that reads its training data through the
+given overlays.  The tokenizer it is called on and its Storage are left
+untouched, so it can be used for a single call:
+
+	tokenizer.WithOverlays(overlay).Tokenize(text)
+
+Annotations that read training data must implement StorageAnnotation to see
+the overlays.
+*/
+func (s *DefaultSentenceTokenizer) WithOverlays(layers ...*Overlay) *DefaultSentenceTokenizer {
+	return s.withStorage(s.Storage.WithOverlays(layers...))
+}
+
+/*
+WithUsage returns a tokenizer that counts in u the entries of its training
+data that answer a lookup, see Storage.WithUsage.
+*/
+func (s *DefaultSentenceTokenizer) WithUsage(u *Usage) *DefaultSentenceTokenizer {
+	return s.withStorage(s.Storage.WithUsage(u))
+}
+
+// withStorage returns a copy of the tokenizer with its annotations bound to storage
+func (s *DefaultSentenceTokenizer) withStorage(storage *Storage) *DefaultSentenceTokenizer {
+	annotations := make([]AnnotateTokens, 0, len(s.Annotations))
+	for _, ann := range s.Annotations {
+		if bound, ok := ann.(StorageAnnotation); ok {
+			ann = bound.WithStorage(storage)
+		}
+		annotations = append(annotations, ann)
+	}
+
+	return &DefaultSentenceTokenizer{
+		Storage:       storage,
+		WordTokenizer: s.WordTokenizer,
+		PunctStrings:  s.PunctStrings,
+		Annotations:   annotations,
+		Adaptive:      s.Adaptive,
+		Aggregations:  s.Aggregations,
+	}
+}
+
+// withAnnotation returns a copy of the tokenizer with ann added before its RealignAnnotation, or last
+func (s *DefaultSentenceTokenizer) withAnnotation(ann AnnotateTokens) *DefaultSentenceTokenizer {
+	annotations := make([]AnnotateTokens, 0, len(s.Annotations)+1)
+	added := false
+	for _, existing := range s.Annotations {
+		if _, ok := existing.(*RealignAnnotation); ok && !added {
+			annotations = append(annotations, ann)
+			added = true
+		}
+		annotations = append(annotations, existing)
+	}
+	if !added {
+		annotations = append(annotations, ann)
+	}
+
+	return &DefaultSentenceTokenizer{
+		Storage:       s.Storage,
+		WordTokenizer: s.WordTokenizer,
+		PunctStrings:  s.PunctStrings,
+		Annotations:   annotations,
+		Adaptive:      s.Adaptive,
+		Aggregations:  s.Aggregations,
+	}
+}
+
 /*
 AnnotateTokens given a set of tokens augmented with markers for line-start and
 paragraph-start, returns an iterator through those tokens with full
@@ -68,6 +139,17 @@ func (s *DefaultSentenceTokenizer) AnnotateTokens(tokens []*Token, annotate ...A
 AnnotatedTokens are the fully annotated word tokens.  This allows for adhoc adjustments to the tokens
 */
 func (s *DefaultSentenceTokenizer) AnnotatedTokens(text string) []*Token {
+	return s.annotatedTokens(text, nil)
+}
+
+// annotatedTokens annotates the word tokens of text within the constraints of c, which may be nil
+func (s *DefaultSentenceTokenizer) annotatedTokens(text string, c *Constraints) []*Token {
+	if s.Adaptive != nil {
+		adapted := s.WithOverlays(s.Adaptive.Overlay(s, text))
+		adapted.Adaptive = nil
+		return adapted.annotatedTokens(text, c)
+	}
+
 	// Use the default word tokenizer but only grab the tokens that
 	// relate to a sentence ending punctuation.  This means grab the word
 	// before and after the punctuation.
@@ -77,7 +159,48 @@ func (s *DefaultSentenceTokenizer) AnnotatedTokens(text string) []*Token {
 		return nil
 	}
 
-	return s.AnnotateTokens(tokens, s.Annotations...)
+	c.mark(text, tokens)
+	tokens = s.AnnotateTokens(tokens, s.Annotations...)
+	c.enforce(tokens)
+
+	return tokens
+}
+
+// spanContext is the number of tokens annotated on each side of a span so that its tokens are decided like in the whole text
+const spanContext = 3
+
+/*
+spanBreaks annotates tokens[from:to] of text again, together with the
+spanContext tokens around them, and returns the positions of those followed by
+a sentence break.  Punkt decides a break from the tokens next to it, so a span
+is decided like in the whole text for a fraction of the work.  The adaptive
+mode is not applied, it looks at the whole text.
+*/
+func (s *DefaultSentenceTokenizer) spanBreaks(text string, tokens []*Token, from, to int) map[int]bool {
+	lo, hi := from-spanContext, to+spanContext
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > len(tokens) {
+		hi = len(tokens)
+	}
+
+	// the span starts at the start of the text if it has the first token, which may start a paragraph
+	offset := 0
+	if lo > 0 {
+		offset = tokens[lo].Start
+	}
+
+	breaks := map[int]bool{}
+	span := s.WordTokenizer.Tokenize(text[offset:tokens[hi-1].Position], false)
+	for _, tok := range s.AnnotateTokens(span, s.Annotations...) {
+		position := tok.Position + offset
+		if tok.SentBreak && position >= tokens[from].Position && position <= tokens[to-1].Position {
+			breaks[position] = true
+		}
+	}
+
+	return breaks
 }
 
 /*
@@ -110,6 +233,11 @@ type Sentence struct {
 	Start int    `json:"start"`
 	End   int    `json:"end"`
 	Text  string `json:"text"`
+	// Lang is the language tag of the model used to find this sentence,
+	// it is only set by tokenizers that switch between models.
+	Lang string `json:"lang,omitempty"`
+	// Attrs are the attributes of the tokens of the sentence, aggregated as they were registered
+	Attrs Attrs `json:"attrs,omitempty"`
 }
 
 func (s Sentence) String() string {
@@ -118,26 +246,5 @@ func (s Sentence) String() string {
 
 // Tokenize splits text input into sentence tokens.
 func (s *DefaultSentenceTokenizer) Tokenize(text string) []*Sentence {
-	annotatedTokens := s.AnnotatedTokens(text)
-
-	lastBreak := 0
-	sentences := make([]*Sentence, 0, len(annotatedTokens))
-	for _, token := range annotatedTokens {
-		if !token.SentBreak {
-			continue
-		}
-
-		sentence := &Sentence{lastBreak, token.Position, text[lastBreak:token.Position]}
-		sentences = append(sentences, sentence)
-
-		lastBreak = token.Position
-	}
-
-	if lastBreak != len(text) {
-		lastChar := len(text)
-		sentence := &Sentence{lastBreak, lastChar, text[lastBreak:lastChar]}
-		sentences = append(sentences, sentence)
-	}
-
-	return sentences
+	return s.TokenizeWith(text, nil)
 }
@@ -1,15 +1,37 @@
 package sentences
 
 import (
+	"fmt"
 	"io/ioutil"
 	"strings"
 	"testing"
 
-	td "github.com/neurosnap/sentences/data"
+	englishdata "github.com/neurosnap/sentences/data/english"
+	frenchdata "github.com/neurosnap/sentences/data/french"
+	germandata "github.com/neurosnap/sentences/data/german"
+	spanishdata "github.com/neurosnap/sentences/data/spanish"
 )
 
+// assets are the models embedded for the language packages
+var assets = []func(string) ([]byte, error){
+	englishdata.Asset,
+	frenchdata.Asset,
+	germandata.Asset,
+	spanishdata.Asset,
+}
+
+// asset reads an embedded model, e.g. data/english.json
+func asset(name string) ([]byte, error) {
+	for _, load := range assets {
+		if b, err := load(name); err == nil {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
 func loadTokenizer(data string) *DefaultSentenceTokenizer {
-	b, err := td.Asset(data)
+	b, err := asset(data)
 	if err != nil {
 		panic(err)
 	}
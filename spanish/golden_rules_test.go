@@ -0,0 +1,113 @@
+package spanish
+
+import (
+	"testing"
+)
+
+func compareSentences(t *testing.T, actualText string, expected []string, test string) bool {
+	actual := tokenizer.Tokenize(actualText)
+
+	if len(actual) != len(expected) {
+		t.Log(test)
+		t.Logf("Actual: %v\n", actual)
+		t.Errorf("Actual: %d, Expected: %d\n", len(actual), len(expected))
+		t.Log("===")
+		return false
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Log(test)
+			t.Errorf("Actual: [%s] Expected: [%s]\n", sent.Text, expected[index])
+			t.Log("===")
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestGoldenRules(t *testing.T) {
+	var actualText string
+	var expected []string
+	var test string
+
+	test = "1. Question at the start of the text"
+	actualText = "¿Qué hora es? Son las tres."
+	expected = []string{
+		"¿Qué hora es?",
+		" Son las tres.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "2. Inverted mark after an abbreviation"
+	actualText = "Llegó a las tres de la tarde aprox. ¿Qué hacemos ahora?"
+	expected = []string{
+		"Llegó a las tres de la tarde aprox.",
+		" ¿Qué hacemos ahora?",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "3. Exclamation followed by a question"
+	actualText = "¡Qué bonito día! ¿Salimos a pasear?"
+	expected = []string{
+		"¡Qué bonito día!",
+		" ¿Salimos a pasear?",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "4. Em-dash dialogue"
+	actualText = "—¿Vienes? —preguntó. —Sí, ahora voy."
+	expected = []string{
+		"—¿Vienes? —preguntó.",
+		" —Sí, ahora voy.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "5. Internal question continues in lower case"
+	actualText = "Le dije que ¿por qué no? y se fue."
+	expected = []string{
+		"Le dije que ¿por qué no? y se fue.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "6. Internal question at the end of a sentence"
+	actualText = "Y entonces, ¿qué pasó? Nadie lo sabe."
+	expected = []string{
+		"Y entonces, ¿qué pasó?",
+		" Nadie lo sabe.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "7. Period inside a question"
+	actualText = "¿Fue en el año 1999. o en el 2000? No lo recuerdo."
+	expected = []string{
+		"¿Fue en el año 1999. o en el 2000?",
+		" No lo recuerdo.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "8. Title abbreviations"
+	actualText = "El Sr. García llegó tarde. La Dra. López no."
+	expected = []string{
+		"El Sr. García llegó tarde.",
+		" La Dra. López no.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "9. Abbreviation inside a question"
+	actualText = "¿Viste al Dr. Pérez en la clínica? Sí."
+	expected = []string{
+		"¿Viste al Dr. Pérez en la clínica?",
+		" Sí.",
+	}
+	compareSentences(t, actualText, expected, test)
+
+	test = "10. Dialogue with an exclamation and a question"
+	actualText = "¡Hola! ¿Qué tal? —dijo ella."
+	expected = []string{
+		"¡Hola!",
+		" ¿Qué tal? —dijo ella.",
+	}
+	compareSentences(t, actualText, expected, test)
+}
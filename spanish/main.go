@@ -0,0 +1,248 @@
+package spanish
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/neurosnap/sentences"
+	data "github.com/neurosnap/sentences/data/spanish"
+)
+
+/*
+WordTokenizer looks past the opening punctuation Spanish puts in front of a
+word (¿ ¡ and the dialogue dash) when deciding the case of a token, so
+"¿Vienes?" is capitalized and "—preguntó" is lower case.
+*/
+type WordTokenizer struct {
+	sentences.DefaultWordTokenizer
+}
+
+// characters that can come before an inverted mark, e.g. a dialogue dash
+const dialogueOpeners = "—–«\"“'("
+
+// characters that open a question, an exclamation or a line of dialogue
+const openers = "¿¡" + dialogueOpeners
+
+func init() {
+	sentences.RegisterLanguage("spanish", NewSentenceTokenizer)
+	sentences.RegisterAnnotator("spanish.inverted-mark", func(env *sentences.AnnotatorEnv, options json.RawMessage) (sentences.AnnotateTokens, error) {
+		return &InvertedMarkAnnotation{env.WordTokenizer}, sentences.DecodeOptions(options, nil)
+	})
+}
+
+// Spanish customized sentence tokenizer.
+func NewSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceTokenizer, error) {
+	training := s
+
+	if training == nil {
+		b, err := data.Asset("data/spanish.json")
+		if err != nil {
+			return nil, err
+		}
+
+		training, err = sentences.LoadTraining(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// supervisor abbreviations, layered on top so the caller's storage is untouched
+	supervised := sentences.NewOverlay()
+	abbrevs := []string{"sr", "sra", "srta", "dr", "dra", "ud", "uds", "pág", "núm", "ej"}
+	for _, abbr := range abbrevs {
+		supervised.AbbrevTypes.Add(abbr)
+	}
+	training = training.WithOverlays(supervised)
+
+	lang := sentences.NewPunctStrings()
+	word := NewWordTokenizer(lang)
+	annotations := sentences.NewAnnotations(training, lang, word)
+
+	inverted := &InvertedMarkAnnotation{
+		TokenParser: word,
+	}
+
+	annotations = append(annotations, inverted)
+
+	tokenizer := &sentences.DefaultSentenceTokenizer{
+		Storage:       training,
+		PunctStrings:  lang,
+		WordTokenizer: word,
+		Annotations:   annotations,
+	}
+
+	return tokenizer, nil
+}
+
+func NewWordTokenizer(p sentences.PunctStrings) *WordTokenizer {
+	word := &WordTokenizer{}
+	word.PunctStrings = p
+
+	return word
+}
+
+// firstLetter returns the first rune after any opening punctuation
+func firstLetter(tok string) (rune, bool) {
+	trimmed := strings.TrimLeft(tok, openers)
+	for _, char := range trimmed {
+		return char, true
+	}
+
+	return 0, false
+}
+
+// FirstUpper is true if the token's first letter is uppercase.
+func (e *WordTokenizer) FirstUpper(t *sentences.Token) bool {
+	char, ok := firstLetter(t.Tok)
+	return ok && unicode.IsUpper(char)
+}
+
+// FirstLower is true if the token's first letter is lowercase.
+func (e *WordTokenizer) FirstLower(t *sentences.Token) bool {
+	char, ok := firstLetter(t.Tok)
+	return ok && unicode.IsLower(char)
+}
+
+// Find any punctuation that might mean the end of a sentence but doesn't have to
+func (e *WordTokenizer) HasUnreliableEndChars(t *sentences.Token) bool {
+	enders := []string{
+		`."`, `.'`, `.)`, `.’`, `.”`, `.»`,
+		`?"`, `?'`, `?)`, `?’`, `?”`, `?»`,
+		`!"`, `!'`, `!)`, `!’`, `!”`, `!»`,
+	}
+	for _, ender := range enders {
+		if strings.HasSuffix(t.Tok, ender) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find any punctuation excluding the period final
+func (e *WordTokenizer) HasSentEndChars(t *sentences.Token) bool {
+	enders := []string{
+		`."`, `.'`, `.)`, `.’`, `.”`, `.»`,
+		`?`, `?"`, `?'`, `?)`, `?’`, `?”`, `?»`,
+		`!`, `!"`, `!'`, `!)`, `!’`, `!”`, `!»`,
+	}
+
+	for _, ender := range enders {
+		if strings.HasSuffix(t.Tok, ender) {
+			return true
+		}
+	}
+
+	parens := []string{
+		`.[`, `.(`, `."`, `.'`,
+		`?[`, `?(`,
+		`![`, `!(`,
+	}
+
+	for _, paren := range parens {
+		if strings.Index(t.Tok, paren) != -1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+InvertedMarkAnnotation uses the inverted question and exclamation marks as
+evidence for sentence boundaries:
+  - a token that opens with ¿ or ¡ starts a new sentence, so the token
+    before it is a sentence break if it ends in a period, unless it is an
+    abbreviation (Sr. ¿Vino?) which is left to the orthographic heuristic.
+  - a ? or ! followed by a lower case word (often after a dialogue dash,
+    —¿Vienes? —preguntó.) does not end the sentence.
+  - no sentence ends between an opening mark and its closer, so a period
+    inside a question does not split it.
+*/
+type InvertedMarkAnnotation struct {
+	sentences.TokenParser
+}
+
+// the furthest, in tokens, an opening mark is matched with its closer
+const maxMarkSpan = 60
+
+func (a *InvertedMarkAnnotation) Annotate(tokens []*sentences.Token) []*sentences.Token {
+	inside := make([]bool, len(tokens))
+	for _, span := range matchMarks(tokens) {
+		for i := span[0]; i < span[1]; i++ {
+			tokens[i].SentBreak = false
+			inside[i] = true
+		}
+	}
+
+	for i, tok := range tokens {
+		if i == len(tokens)-1 {
+			break
+		}
+		next := tokens[i+1]
+
+		if !inside[i] && startsInverted(next) && ((a.HasPeriodFinal(tok) && !tok.Abbr) || a.HasSentEndChars(tok)) {
+			tok.SentBreak = true
+			continue
+		}
+
+		if a.HasSentEndChars(tok) && a.FirstLower(next) {
+			tok.SentBreak = false
+		}
+	}
+
+	return tokens
+}
+
+/*
+matchMarks pairs every ¿ and ¡ with the ? or ! that closes it and returns the
+token index of the opener and of the closer for each pair.  Marks are never
+matched across paragraphs.
+*/
+func matchMarks(tokens []*sentences.Token) [][2]int {
+	type openMark struct {
+		index  int
+		closer rune
+	}
+
+	spans := make([][2]int, 0, 4)
+	stack := make([]openMark, 0, 2)
+
+	for i, tok := range tokens {
+		if tok.ParaStart {
+			stack = stack[:0]
+		}
+
+		for _, char := range strings.TrimLeft(tok.Tok, dialogueOpeners) {
+			if char == '¿' {
+				stack = append(stack, openMark{i, '?'})
+			} else if char == '¡' {
+				stack = append(stack, openMark{i, '!'})
+			} else {
+				break
+			}
+		}
+
+		trimmed := []rune(strings.TrimRight(tok.Tok, "\"'”’»),;:"))
+		for len(trimmed) > 0 && len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if trimmed[len(trimmed)-1] != top.closer {
+				break
+			}
+
+			stack = stack[:len(stack)-1]
+			trimmed = trimmed[:len(trimmed)-1]
+			if i-top.index <= maxMarkSpan {
+				spans = append(spans, [2]int{top.index, i})
+			}
+		}
+	}
+
+	return spans
+}
+
+// startsInverted is true if a token opens with ¿ or ¡, possibly after a dialogue dash
+func startsInverted(t *sentences.Token) bool {
+	trimmed := strings.TrimLeft(t.Tok, dialogueOpeners)
+	return strings.HasPrefix(trimmed, "¿") || strings.HasPrefix(trimmed, "¡")
+}
@@ -0,0 +1,79 @@
+package spanish
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neurosnap/sentences"
+)
+
+var tokenizer, _ = NewSentenceTokenizer(nil)
+
+func TestSpanishDialogue(t *testing.T) {
+	t.Log("Tokenizer should keep em-dash dialogue together ...")
+
+	actualText := "—¿Vienes? —preguntó. —Sí, ahora voy."
+	actual := tokenizer.Tokenize(actualText)
+
+	expected := []string{
+		"—¿Vienes? —preguntó.",
+		" —Sí, ahora voy.",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for index, sent := range actual {
+		if sent.Text != expected[index] {
+			t.Fatalf("Actual: %s\nExpected: %s", sent.Text, expected[index])
+		}
+	}
+}
+
+func TestSpanishMatchMarks(t *testing.T) {
+	t.Log("Inverted marks should be matched with their closers ...")
+
+	word := NewWordTokenizer(sentences.NewPunctStrings())
+	tokens := word.Tokenize("Dijo ¿vienes o no? y luego ¡ya! ¿Seguro?", false)
+
+	actual := matchMarks(tokens)
+	expected := [][2]int{{1, 3}, {6, 6}, {7, 7}}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Actual: %v, Expected: %v", actual, expected)
+	}
+}
+
+func TestSpanishInvertedMarksAfterAbbreviations(t *testing.T) {
+	t.Log("Tokenizer should not break after an abbreviation before ¿ or ¡ ...")
+
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{
+			"Preguntó el Sr. ¿Vino usted solo? Nadie contestó.",
+			[]string{"Preguntó el Sr. ¿Vino usted solo?", " Nadie contestó."},
+		},
+		{
+			"Hay riesgos, p. ej. ¡Ojo con el fuego! Nada más.",
+			[]string{"Hay riesgos, p. ej. ¡Ojo con el fuego!", " Nada más."},
+		},
+		{
+			"Se fue a casa. ¿Vino usted solo?",
+			[]string{"Se fue a casa.", " ¿Vino usted solo?"},
+		},
+	}
+
+	for _, test := range tests {
+		actual := []string{}
+		for _, sent := range tokenizer.Tokenize(test.text) {
+			actual = append(actual, sent.Text)
+		}
+
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+	}
+}
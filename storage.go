@@ -1,6 +1,11 @@
 package sentences
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
 
 // SetString is an  implementation of a set of strings
 // probably not the best way to do this but oh well.
@@ -25,13 +30,14 @@ func (ss SetString) Has(str string) bool {
 	return true
 }
 
-// Array returns and array of keys from the set
+// Array returns a sorted array of keys from the set
 func (ss SetString) Array() []string {
 	arr := make([]string, 0, len(ss))
 
 	for key := range ss {
 		arr = append(arr, key)
 	}
+	sort.Strings(arr)
 
 	return arr
 }
@@ -43,25 +49,78 @@ type Storage struct {
 	Collocations SetString `json:"Collocations"`
 	SentStarters SetString `json:"SentStarters"`
 	OrthoContext SetString `json:"OrthoContext"`
+	// AbbrevClasses are the optional classes of abbreviations, see AbbrevTitle
+	AbbrevClasses SetString `json:"AbbrevClasses,omitempty"`
+	Metadata      *Metadata `json:"Metadata,omitempty"`
+	// overlays consulted before the maps above, the last one wins
+	layers []*Overlay
+	// read-only tables of a binary model, consulted along with the maps
+	tables *binaryTables
+	// counts the base entries that answered a lookup, if set
+	usage *Usage
 }
 
 // LoadTraining is the primary function to load JSON training data.  By default, the sentence tokenizer
 // loads in english automatically, but other languages could be loaded into a
-// binary file using the `make <lang>` command.
+// binary file using the `make <lang>` command.  The data must pass Validate.
 func LoadTraining(data []byte) (*Storage, error) {
 	var storage Storage
-	err := json.Unmarshal(data, &storage)
 
-	if err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&storage); err != nil {
+		return nil, fmt.Errorf("training data: %v", err)
+	}
+
+	if err := storage.Validate(); err != nil {
 		return nil, err
 	}
 
 	return &storage, nil
 }
 
+// MarshalJSON encodes the base training data, including that of a binary model
+func (p *Storage) MarshalJSON() ([]byte, error) {
+	// an alias without methods so encoding does not recurse
+	type storage Storage
+
+	if p.tables != nil {
+		return json.Marshal((*storage)(p.Expand()))
+	}
+
+	return json.Marshal((*storage)(p))
+}
+
 // NewStorage creates the default storage container
 func NewStorage() *Storage {
-	return &Storage{SetString{}, SetString{}, SetString{}, SetString{}}
+	return &Storage{
+		AbbrevTypes:  SetString{},
+		Collocations: SetString{},
+		SentStarters: SetString{},
+		OrthoContext: SetString{},
+	}
+}
+
+// Section returns one of the sets of training data by its JSON name
+func (p *Storage) Section(name string) (SetString, error) {
+	switch name {
+	case "AbbrevTypes":
+		return p.AbbrevTypes, nil
+	case "Collocations":
+		return p.Collocations, nil
+	case "SentStarters":
+		return p.SentStarters, nil
+	case "OrthoContext":
+		return p.OrthoContext, nil
+	case "AbbrevClasses":
+		// the section is optional, it is created for edits
+		if p.AbbrevClasses == nil {
+			p.AbbrevClasses = SetString{}
+		}
+		return p.AbbrevClasses, nil
+	}
+
+	return nil, fmt.Errorf("unknown storage section %q", name)
 }
 
 // Used in the training to add a type to the ortho context
@@ -69,13 +128,94 @@ func (p *Storage) addOrthoContext(typ string, flag int) {
 	p.OrthoContext[typ] |= flag
 }
 
+/*
+WithOverlays returns a view of the training data with layers stacked on top of
+it.  Neither the Storage nor the overlays are modified, the view shares their
+data so creating one is cheap and it is safe to do for every call to Tokenize.
+*/
+func (p *Storage) WithOverlays(layers ...*Overlay) *Storage {
+	stacked := make([]*Overlay, 0, len(p.layers)+len(layers))
+	stacked = append(stacked, p.layers...)
+	stacked = append(stacked, layers...)
+
+	return &Storage{
+		AbbrevTypes:   p.AbbrevTypes,
+		Collocations:  p.Collocations,
+		SentStarters:  p.SentStarters,
+		OrthoContext:  p.OrthoContext,
+		AbbrevClasses: p.AbbrevClasses,
+		Metadata:      p.Metadata,
+		layers:        stacked,
+		tables:        p.tables,
+		usage:         p.usage,
+	}
+}
+
+/*
+WithUsage returns a view of the training data that counts in u every time an
+entry of the base data answers a lookup.  A lookup hit does not mean the entry
+changed a decision, see Pruner for that.  Entries an overlay decides are not
+counted.  The counts are not safe for concurrent use.
+*/
+func (p *Storage) WithUsage(u *Usage) *Storage {
+	view := p.WithOverlays()
+	view.usage = u
+	return view
+}
+
+// Overlays returns the layers consulted before the base training data
+func (p *Storage) Overlays() []*Overlay {
+	return p.layers
+}
+
+// lookup checks the layers from the top down and falls back to the base set
+func (p *Storage) lookup(key string, base SetString, table int, section func(*Overlay) Changes) bool {
+	for i := len(p.layers) - 1; i >= 0; i-- {
+		if add, ok := section(p.layers[i])[key]; ok {
+			return add
+		}
+	}
+
+	if base.Has(key) || p.tables.has(table, key) {
+		p.usage.record(table, key)
+		return true
+	}
+
+	return false
+}
+
 // IsAbbr detemines if any of the tokens are an abbreviation
 func (p *Storage) IsAbbr(tokens ...string) bool {
 	for _, token := range tokens {
-		if p.AbbrevTypes.Has(token) {
+		if p.lookup(token, p.AbbrevTypes, abbrevSection, abbrevChanges) {
 			return true
 		}
 	}
 
 	return false
 }
+
+// IsCollocation determines if two word types are a known collocation
+func (p *Storage) IsCollocation(first, second string) bool {
+	return p.lookup(first+","+second, p.Collocations, collocationSection, collocationChanges)
+}
+
+// IsSentStarter determines if a word type frequently starts a sentence
+func (p *Storage) IsSentStarter(typ string) bool {
+	return p.lookup(typ, p.SentStarters, sentStarterSection, sentStarterChanges)
+}
+
+// OrthoFlags returns the orthographic context a word type has been seen in
+func (p *Storage) OrthoFlags(typ string) int {
+	flags := p.OrthoContext[typ] | p.tables.orthoFlags(typ)
+	if flags != 0 {
+		p.usage.record(orthoSection, typ)
+	}
+
+	for _, layer := range p.layers {
+		change := layer.OrthoContext[typ]
+		flags = (flags | change.Add) &^ change.Remove
+	}
+
+	return flags
+}
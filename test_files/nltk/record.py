@@ -0,0 +1,46 @@
+"""
+Record the sentences and spans NLTK's punkt finds for the texts of the punkt
+fixtures, for the NLTK parity test.
+
+    python3 test_files/nltk/record.py english german french spanish
+
+The texts are read from test_files/punkt/<lang>.json and the output of NLTK
+is written to test_files/nltk/<lang>.json, spans are offsets in characters,
+the way NLTK reports them.  The punkt_tab models NLTK uses must hold the same
+training data as data/<lang>.json, e.g. after
+`sentences storage convert <lang> <dir>/`.
+"""
+import json
+import sys
+
+import nltk
+from nltk.tokenize.punkt import PunktTokenizer
+
+
+def record(lang):
+    with open("test_files/punkt/%s.json" % lang, encoding="utf-8") as f:
+        texts = [case["text"] for case in json.load(f)["cases"]]
+
+    tokenizer = PunktTokenizer(lang)
+    cases = []
+    for text in texts:
+        spans = [[start, end] for start, end in tokenizer.span_tokenize(text)]
+        cases.append({
+            "text": text,
+            "sentences": [text[start:end] for start, end in spans],
+            "spans": spans,
+        })
+
+    fixture = {
+        "lang": lang,
+        "source": "recorded with nltk %s" % nltk.__version__,
+        "cases": cases,
+    }
+    with open("test_files/nltk/%s.json" % lang, "w", encoding="utf-8") as f:
+        json.dump(fixture, f, ensure_ascii=False, indent=2)
+        f.write("\n")
+
+
+if __name__ == "__main__":
+    for lang in sys.argv[1:]:
+        record(lang)
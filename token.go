@@ -34,14 +34,119 @@ func (p *DefaultTokenGrouper) Group(tokens []*Token) [][2]*Token {
 	return pairTokens
 }
 
-// Token stores a token of text with annotations produced during sentence boundary detection.
+// Windows gives every token the one after it, the windows of the pairs returned by Group
+func (p *DefaultTokenGrouper) Windows(tokens []*Token) []TokenWindow {
+	return (&SlidingTokenGrouper{Left: 0, Right: 1}).Windows(tokens)
+}
+
+/*
+PairWindowGrouper turns the pairs of a TokenGrouper into windows, so
+annotations that work on windows can still be given a TokenGrouper.  A
+window holds the token of the pair before it and the second token of its own
+pair.
+*/
+type PairWindowGrouper struct {
+	TokenGrouper
+}
+
+// Windows returns a window for every pair
+func (p *PairWindowGrouper) Windows(tokens []*Token) []TokenWindow {
+	pairs := p.Group(tokens)
+	windows := make([]TokenWindow, len(pairs))
+
+	for i, pair := range pairs {
+		windows[i].Token = pair[0]
+		if i > 0 {
+			windows[i].Left = []*Token{pairs[i-1][0]}
+		}
+		if pair[1] != nil {
+			windows[i].Right = []*Token{pair[1]}
+		}
+	}
+
+	return windows
+}
+
+// TokenWindow is a token with the tokens around it
+type TokenWindow struct {
+	Token *Token
+	// Left are the tokens before Token, the closest one last
+	Left []*Token
+	// Right are the tokens after Token, the closest one first
+	Right []*Token
+}
+
+// Prev returns the n-th token before the window's token, 1 is the one right before it, or nil
+func (w TokenWindow) Prev(n int) *Token {
+	if n < 1 || n > len(w.Left) {
+		return nil
+	}
+	return w.Left[len(w.Left)-n]
+}
+
+// Next returns the n-th token after the window's token, 1 is the one right after it, or nil
+func (w TokenWindow) Next(n int) *Token {
+	if n < 1 || n > len(w.Right) {
+		return nil
+	}
+	return w.Right[n-1]
+}
+
+// WindowGrouper gives annotations every token along with the tokens around it
+type WindowGrouper interface {
+	Windows([]*Token) []TokenWindow
+}
+
+/*
+SlidingTokenGrouper slides a window over the tokens that holds up to Left
+tokens before and Right tokens after each of them, fewer at the start and
+the end of the text.  The windows share the token slice, so they are cheap to
+make and see the changes annotations make to the tokens.  With a Left of 0
+and a Right of 1 it is the DefaultTokenGrouper.
+*/
+type SlidingTokenGrouper struct {
+	Left  int
+	Right int
+}
+
+// Windows returns a window for every token
+func (p *SlidingTokenGrouper) Windows(tokens []*Token) []TokenWindow {
+	windows := make([]TokenWindow, len(tokens))
+
+	for i, tok := range tokens {
+		start := i - p.Left
+		if start < 0 {
+			start = 0
+		}
+		end := i + 1 + p.Right
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+
+		windows[i] = TokenWindow{Token: tok, Left: tokens[start:i:i], Right: tokens[i+1 : end : end]}
+	}
+
+	return windows
+}
+
+/*
+Token stores a token of text with annotations produced during sentence boundary detection.
+Start and Position are the byte offsets of the start and the end of the token in the text.
+Protected is set when a sentence break after the token would fall inside a span the caller
+protected and ForcedBreak when the caller forced a sentence boundary after it, see Constraints.
+Annotations record anything else they find out about the token in Attrs.
+*/
 type Token struct {
 	Tok                    string
+	Start                  int
 	Position               int
 	SentBreak              bool
 	ParaStart              bool
 	LineStart              bool
 	Abbr                   bool
+	Protected              bool
+	ForcedBreak            bool
+	Attrs                  Attrs
 	periodFinal            bool
 	reEllipsis             *regexp.Regexp
 	reNumeric              *regexp.Regexp
@@ -0,0 +1,83 @@
+package sentences
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSlidingTokenGrouper(t *testing.T) {
+	t.Log("Windows should hold the tokens around each token, fewer at the edges of the text")
+
+	tokens := NewWordTokenizer(NewPunctStrings()).Tokenize("One two three four", false)
+	windows := (&SlidingTokenGrouper{Left: 2, Right: 1}).Windows(tokens)
+
+	if len(windows) != len(tokens) {
+		t.Fatalf("Actual: %d, Expected: %d", len(windows), len(tokens))
+	}
+
+	tests := []struct {
+		index int
+		prev  []string
+		next  []string
+	}{
+		{0, []string{}, []string{"two"}},
+		{1, []string{"One"}, []string{"three"}},
+		{3, []string{"three", "two"}, []string{}},
+	}
+
+	for _, test := range tests {
+		window := windows[test.index]
+		prev, next := []string{}, []string{}
+		for n := 1; window.Prev(n) != nil; n++ {
+			prev = append(prev, window.Prev(n).Tok)
+		}
+		for n := 1; window.Next(n) != nil; n++ {
+			next = append(next, window.Next(n).Tok)
+		}
+
+		if window.Token != tokens[test.index] || !reflect.DeepEqual(prev, test.prev) || !reflect.DeepEqual(next, test.next) {
+			t.Fatalf("Actual: %v %v, Expected: %v %v", prev, next, test.prev, test.next)
+		}
+	}
+
+	if windows[1].Prev(0) != nil || windows[1].Next(-1) != nil {
+		t.Fatalf("Expected no token outside of the window")
+	}
+}
+
+func TestDefaultTokenGrouperWindows(t *testing.T) {
+	t.Log("The default windows should be the pairs of Group")
+
+	tokens := NewWordTokenizer(NewPunctStrings()).Tokenize("Mr. Page went home. He slept.", false)
+	grouper := &DefaultTokenGrouper{}
+
+	pairs := grouper.Group(tokens)
+	windows := grouper.Windows(tokens)
+	if len(pairs) != len(windows) {
+		t.Fatalf("Actual: %d, Expected: %d", len(windows), len(pairs))
+	}
+
+	for i, pair := range pairs {
+		if windows[i].Token != pair[0] || windows[i].Next(1) != pair[1] || windows[i].Prev(1) != nil {
+			t.Fatalf("Actual: %v, Expected: %v", windows[i], pair)
+		}
+	}
+}
+
+func TestPairWindowGrouper(t *testing.T) {
+	t.Log("The windows of the pairs of a TokenGrouper should hold the tokens around each pair")
+
+	tokens := NewWordTokenizer(NewPunctStrings()).Tokenize("Mr. Page went home. He slept.", false)
+
+	actual := (&PairWindowGrouper{&DefaultTokenGrouper{}}).Windows(tokens)
+	expected := (&SlidingTokenGrouper{Left: 1, Right: 1}).Windows(tokens)
+	if len(actual) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(actual), len(expected))
+	}
+
+	for i, window := range actual {
+		if window.Token != expected[i].Token || window.Prev(1) != expected[i].Prev(1) || window.Next(1) != expected[i].Next(1) {
+			t.Fatalf("Actual: %v, Expected: %v", window, expected[i])
+		}
+	}
+}
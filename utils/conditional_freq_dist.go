@@ -0,0 +1,132 @@
+package utils
+
+import "sort"
+
+/*
+ConditionalFreqDist is a collection of frequency distributions for a single
+experiment run under different conditions, e.g. the words that follow every
+word of a text.  It is a FreqDist per condition, one is created the first time
+an outcome is recorded for its condition.
+*/
+type ConditionalFreqDist struct {
+	Conditions map[string]*FreqDist
+}
+
+func NewConditionalFreqDist() *ConditionalFreqDist {
+	return &ConditionalFreqDist{map[string]*FreqDist{}}
+}
+
+// Inc records one outcome of sample under condition
+func (c *ConditionalFreqDist) Inc(condition, sample string) {
+	c.Add(condition, sample, 1)
+}
+
+// Add records count outcomes of sample under condition
+func (c *ConditionalFreqDist) Add(condition, sample string, count int) {
+	dist, ok := c.Conditions[condition]
+	if !ok {
+		dist = NewFreqDist(nil)
+		c.Conditions[condition] = dist
+	}
+	dist.Add(sample, count)
+}
+
+// Get returns the distribution of a condition, an empty one if nothing was recorded for it
+func (c *ConditionalFreqDist) Get(condition string) *FreqDist {
+	if dist, ok := c.Conditions[condition]; ok {
+		return dist
+	}
+	return NewFreqDist(nil)
+}
+
+// Count returns the number of outcomes recorded for sample under condition
+func (c *ConditionalFreqDist) Count(condition, sample string) int {
+	return c.Get(condition).Count(sample)
+}
+
+// SortedConditions returns the conditions in sorted order
+func (c *ConditionalFreqDist) SortedConditions() []string {
+	conditions := make([]string, 0, len(c.Conditions))
+	for condition := range c.Conditions {
+		conditions = append(conditions, condition)
+	}
+
+	sort.Strings(conditions)
+	return conditions
+}
+
+// N returns the total number of outcomes recorded under all conditions
+func (c *ConditionalFreqDist) N() float64 {
+	sum := 0.0
+	for _, dist := range c.Conditions {
+		sum += dist.N()
+	}
+	return sum
+}
+
+// Merge adds the counts of other distributions to this one
+func (c *ConditionalFreqDist) Merge(others ...*ConditionalFreqDist) {
+	for _, other := range others {
+		for condition, dist := range other.Conditions {
+			for _, sample := range dist.Keys() {
+				c.Add(condition, sample, dist.Samples[sample])
+			}
+		}
+	}
+}
+
+/*
+BigramFreqDist counts the words of a text and the pairs of words that follow
+each other, the counts NLTK's collocation finders and punkt's trainer score
+collocations with.
+*/
+type BigramFreqDist struct {
+	Words *FreqDist
+	Pairs *ConditionalFreqDist
+}
+
+func NewBigramFreqDist() *BigramFreqDist {
+	return &BigramFreqDist{
+		Words: NewFreqDist(nil),
+		Pairs: NewConditionalFreqDist(),
+	}
+}
+
+/*
+AddWords counts a sequence of words, the first and last word are only counted
+as words.  Sequences added separately do not form pairs across each other.
+*/
+func (b *BigramFreqDist) AddWords(words []string) {
+	for i, word := range words {
+		b.Words.Inc(word)
+		if i > 0 {
+			b.Pairs.Inc(words[i-1], word)
+		}
+	}
+}
+
+// Count returns how often second followed first
+func (b *BigramFreqDist) Count(first, second string) int {
+	return b.Pairs.Count(first, second)
+}
+
+/*
+LogLikelihood is the collocation log-likelihood of first followed by second,
+see ColLogLikelihood.
+*/
+func (b *BigramFreqDist) LogLikelihood(first, second string) float64 {
+	return ColLogLikelihood(
+		float64(b.Words.Count(first)),
+		float64(b.Words.Count(second)),
+		float64(b.Count(first, second)),
+		b.Words.N(),
+	)
+}
+
+// Merge adds the counts of other distributions to this one
+func (b *BigramFreqDist) Merge(others ...*BigramFreqDist) {
+	for _, other := range others {
+		b.Words.Merge(other.Words)
+		b.Pairs.Merge(other.Pairs)
+	}
+}
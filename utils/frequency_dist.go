@@ -1,6 +1,9 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 /*
 A frequency distribution for the outcomes of an experiment.  A
@@ -13,13 +16,64 @@ sample occurred as an outcome.
 Frequency distributions are generally constructed by running a
 number of experiments, and incrementing the count for a sample
 every time it is an outcome of an experiment.
+Like NLTK's FreqDist, samples with the same count are kept in the order they
+were first seen.
 */
 type FreqDist struct {
 	Samples map[string]int
+	// Order is the order samples were first seen in, samples missing from it come after it sorted by value
+	Order []string
 }
 
 func NewFreqDist(samples map[string]int) *FreqDist {
-	return &FreqDist{samples}
+	if samples == nil {
+		samples = map[string]int{}
+	}
+	return &FreqDist{Samples: samples}
+}
+
+// Inc records one outcome of sample
+func (f *FreqDist) Inc(sample string) {
+	f.Add(sample, 1)
+}
+
+// Add records count outcomes of sample
+func (f *FreqDist) Add(sample string, count int) {
+	if _, ok := f.Samples[sample]; !ok {
+		f.Order = append(f.Order, sample)
+	}
+	f.Samples[sample] += count
+}
+
+// Keys returns the samples in the order they were first seen
+func (f *FreqDist) Keys() []string {
+	keys := make([]string, 0, len(f.Samples))
+	seen := make(map[string]bool, len(f.Samples))
+	for _, key := range f.Order {
+		if _, ok := f.Samples[key]; ok && !seen[key] {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+	}
+
+	if len(keys) == len(f.Samples) {
+		return keys
+	}
+
+	rest := make([]string, 0, len(f.Samples)-len(keys))
+	for key := range f.Samples {
+		if !seen[key] {
+			rest = append(rest, key)
+		}
+	}
+
+	sort.Strings(rest)
+	return append(keys, rest...)
+}
+
+// Count returns the number of outcomes recorded for sample
+func (f *FreqDist) Count(sample string) int {
+	return f.Samples[sample]
 }
 
 // N returns the total number of sample outcomes that have been recorded by this FreqDist.
@@ -36,12 +90,24 @@ func (f *FreqDist) B() int {
 	return len(f.Samples)
 }
 
-// Return a list of all Samples that occur once (hapax legomena)
-func (f *FreqDist) hapaxes() []string {
+/*
+Merge adds the counts of other distributions to this one, e.g. the counts of
+shards of a corpus that were processed separately.
+*/
+func (f *FreqDist) Merge(others ...*FreqDist) {
+	for _, other := range others {
+		for _, sample := range other.Keys() {
+			f.Add(sample, other.Samples[sample])
+		}
+	}
+}
+
+// Hapaxes returns all Samples that occur once (hapax legomena) in the order they were first seen
+func (f *FreqDist) Hapaxes() []string {
 	hap := make([]string, 0, f.B())
 
-	for key, val := range f.Samples {
-		if val != 1 {
+	for _, key := range f.Keys() {
+		if f.Samples[key] != 1 {
 			continue
 		}
 		hap = append(hap, key)
@@ -50,8 +116,12 @@ func (f *FreqDist) hapaxes() []string {
 	return hap
 }
 
-// Return the dictionary mapping r to Nr, the number of Samples with frequency r, where Nr > 0
-func (f *FreqDist) rToNr(bins int) map[int]int {
+/*
+RToNr returns the dictionary mapping r to Nr, the number of Samples with
+frequency r, where Nr > 0.  The count of samples with frequency 0 is bins
+minus the number of samples, or 0 if bins is 0.
+*/
+func (f *FreqDist) RToNr(bins int) map[int]int {
 	tmpRToNr := map[int]int{}
 
 	for _, value := range f.Samples {
@@ -67,56 +137,73 @@ func (f *FreqDist) rToNr(bins int) map[int]int {
 	return tmpRToNr
 }
 
-// Return the cumulative frequencies of the specified Samples.
-// If no Samples are specified, all counts are returned, starting with the largest.
-func (f *FreqDist) cumulativeFrequencies(Samples []string) []int {
-	cf := make([]int, 0, len(f.Samples))
+// CumulativeFrequencies returns the cumulative frequencies of the specified Samples.
+func (f *FreqDist) CumulativeFrequencies(samples []string) []int {
+	cf := make([]int, 0, len(samples))
 
-	for _, val := range Samples {
-		cf = append(cf, f.Samples[val])
+	sum := 0
+	for _, val := range samples {
+		sum += f.Samples[val]
+		cf = append(cf, sum)
 	}
 
 	return cf
 }
 
 /*
-Return the frequency of a given sample.  The frequency of a
+Freq returns the frequency of a given sample.  The frequency of a
 sample is defined as the count of that sample divided by the
-total number of sample outcomes that have been recorded by
-this FreqDist.  The count of a sample is defined as the
+total number of sample outcomes that have been recorded by this
+FreqDist.  The count of a sample is defined as the
 number of times that sample outcome was recorded by this
 FreqDist.  Frequencies are always real numbers in the range
 [0, 1].
 */
-func (f *FreqDist) freq(sample string) float64 {
-	if f.N() == 0 {
+func (f *FreqDist) Freq(sample string) float64 {
+	n := f.N()
+	if n == 0 {
 		return 0
 	}
-	return float64(f.Samples[sample]) / f.N()
+	return float64(f.Samples[sample]) / n
 }
 
-type maxFreq struct {
+// SampleCount is a sample and the number of its outcomes
+type SampleCount struct {
 	Key string
 	Val int
 }
 
 /*
-Return the sample with the greatest number of outcomes in this
+MostCommon returns the n samples with the most outcomes, all of them if n is
+0.  Samples with the same number of outcomes are in the order they were first
+seen, like NLTK's most_common.
+*/
+func (f *FreqDist) MostCommon(n int) []SampleCount {
+	common := make([]SampleCount, 0, len(f.Samples))
+	for _, key := range f.Keys() {
+		common = append(common, SampleCount{key, f.Samples[key]})
+	}
+
+	sort.SliceStable(common, func(i, j int) bool {
+		return common[i].Val > common[j].Val
+	})
+
+	if n > 0 && n < len(common) {
+		common = common[:n]
+	}
+
+	return common
+}
+
+/*
+Max returns the sample with the greatest number of outcomes in this
 frequency distribution.  If two or more Samples have the same
-number of outcomes, return one of them; which sample is
-returned is undefined.
+number of outcomes, the one seen first is returned.
 */
-func (f *FreqDist) max() (string, error) {
+func (f *FreqDist) Max() (string, error) {
 	if len(f.Samples) == 0 {
 		return "", fmt.Errorf("No Samples loaded, please add samples before getting max")
 	}
 
-	max := maxFreq{}
-	for key, val := range f.Samples {
-		if val > max.Val {
-			max.Key = key
-			max.Val = val
-		}
-	}
-	return max.Key, nil
+	return f.MostCommon(1)[0].Key, nil
 }
@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// corpus is counted by every test, the expected numbers are what NLTK's FreqDist reports for it, see nltk_reference.py
+const corpus = "the cat sat on the mat . the dog sat on the log . a cat and a dog met on a mat ."
+
+func corpusDist() *FreqDist {
+	dist := NewFreqDist(nil)
+	for _, word := range strings.Fields(corpus) {
+		dist.Inc(word)
+	}
+	return dist
+}
+
+func TestFreqDist(t *testing.T) {
+	t.Log("FreqDist should count like NLTK's FreqDist")
+
+	dist := corpusDist()
+
+	if dist.N() != 24 || dist.B() != 11 {
+		t.Fatalf("Actual: N %f, B %d, Expected: N 24, B 11", dist.N(), dist.B())
+	}
+
+	if freq := dist.Freq("the"); math.Abs(freq-0.16666666666666666) > 1e-12 {
+		t.Fatalf("Actual: %f, Expected: 0.1667", freq)
+	}
+
+	if freq := NewFreqDist(nil).Freq("the"); freq != 0 {
+		t.Fatalf("Actual: %f, Expected: 0 for an empty distribution", freq)
+	}
+
+	if max, err := dist.Max(); err != nil || max != "the" {
+		t.Fatalf("Actual: %q, %v, Expected: the", max, err)
+	}
+
+	if _, err := NewFreqDist(nil).Max(); err == nil {
+		t.Fatalf("Expected an error for the max of an empty distribution")
+	}
+
+	if hapaxes := dist.Hapaxes(); !reflect.DeepEqual(hapaxes, []string{"log", "and", "met"}) {
+		t.Fatalf("Actual: %v, Expected: [log and met]", hapaxes)
+	}
+
+	expected := map[int]int{0: 0, 1: 3, 2: 4, 3: 3, 4: 1}
+	if rToNr := dist.RToNr(0); !reflect.DeepEqual(rToNr, expected) {
+		t.Fatalf("Actual: %v, Expected: %v", rToNr, expected)
+	}
+
+	if rToNr := dist.RToNr(20); rToNr[0] != 9 {
+		t.Fatalf("Actual: %d, Expected: 9 samples that were not seen", rToNr[0])
+	}
+
+	if cf := dist.CumulativeFrequencies([]string{"the", "on", "cat"}); !reflect.DeepEqual(cf, []int{4, 7, 9}) {
+		t.Fatalf("Actual: %v, Expected: [4 7 9]", cf)
+	}
+
+	// NLTK keeps samples with the same count in the order they were first seen
+	common := dist.MostCommon(4)
+	expectedCommon := []SampleCount{{"the", 4}, {"on", 3}, {".", 3}, {"a", 3}}
+	if !reflect.DeepEqual(common, expectedCommon) {
+		t.Fatalf("Actual: %v, Expected: %v", common, expectedCommon)
+	}
+
+	// without a first-seen order samples with the same count are sorted by value
+	fromMap := NewFreqDist(map[string]int{"b": 1, "c": 2, "a": 1})
+	fromMap.Inc("d")
+	if hapaxes := fromMap.Hapaxes(); !reflect.DeepEqual(hapaxes, []string{"d", "a", "b"}) {
+		t.Fatalf("Actual: %v, Expected: [d a b]", hapaxes)
+	}
+}
+
+func TestFreqDistMerge(t *testing.T) {
+	t.Log("Counts of shards should merge into the counts of the whole corpus")
+
+	words := strings.Fields(corpus)
+	first, second := NewFreqDist(nil), NewFreqDist(nil)
+	for i, word := range words {
+		if i < len(words)/2 {
+			first.Inc(word)
+		} else {
+			second.Inc(word)
+		}
+	}
+
+	b, err := json.Marshal(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var loaded FreqDist
+	if err := json.Unmarshal(b, &loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	first.Merge(&loaded)
+	if !reflect.DeepEqual(first, corpusDist()) {
+		t.Fatalf("Actual: %v, Expected: %v", first.Samples, corpusDist().Samples)
+	}
+}
+
+func TestConditionalFreqDist(t *testing.T) {
+	t.Log("ConditionalFreqDist should count the outcomes of every condition")
+
+	cfd := NewConditionalFreqDist()
+	words := strings.Fields(corpus)
+	for i := 1; i < len(words); i++ {
+		cfd.Inc(words[i-1], words[i])
+	}
+
+	if cfd.N() != 23 || len(cfd.Conditions) != 11 {
+		t.Fatalf("Actual: N %f, %d conditions, Expected: N 23, 11 conditions", cfd.N(), len(cfd.Conditions))
+	}
+
+	if cfd.Count("on", "the") != 2 || cfd.Count("on", "a") != 1 || cfd.Get("the").B() != 4 {
+		t.Fatalf("Actual: %v", cfd.Get("on").Samples)
+	}
+
+	if cfd.Get("missing").N() != 0 || cfd.Conditions["missing"] != nil {
+		t.Fatalf("Get should not create a condition")
+	}
+
+	b, err := json.Marshal(cfd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	merged := NewConditionalFreqDist()
+	if err := json.Unmarshal(b, merged); err != nil {
+		t.Fatal(err)
+	}
+	merged.Merge(cfd)
+
+	if merged.N() != 46 || merged.Count("sat", "on") != 4 {
+		t.Fatalf("Actual: N %f, sat on %d, Expected: N 46, sat on 4", merged.N(), merged.Count("sat", "on"))
+	}
+
+	expected := []string{".", "a", "and", "cat", "dog", "log", "mat", "met", "on", "sat", "the"}
+	if conditions := cfd.SortedConditions(); !reflect.DeepEqual(conditions, expected) {
+		t.Fatalf("Actual: %v, Expected: %v", conditions, expected)
+	}
+}
+
+func TestBigramFreqDist(t *testing.T) {
+	t.Log("BigramFreqDist should score collocations like punkt's trainer")
+
+	bigrams := NewBigramFreqDist()
+	bigrams.AddWords(strings.Fields(corpus))
+
+	if bigrams.Words.N() != 24 || bigrams.Count("sat", "on") != 2 {
+		t.Fatalf("Actual: N %f, sat on %d", bigrams.Words.N(), bigrams.Count("sat", "on"))
+	}
+
+	// nltk_reference.py prints these with punkt's trainer
+	for _, test := range []struct {
+		first, second string
+		expected      float64
+	}{
+		{"sat", "on", 9.949042176926836},
+		{"on", "the", 4.599180561994498},
+	} {
+		if ll := bigrams.LogLikelihood(test.first, test.second); math.Abs(ll-test.expected) > 1e-9 {
+			t.Fatalf("%s %s: Actual: %f, Expected: %f", test.first, test.second, ll, test.expected)
+		}
+	}
+
+	shard := NewBigramFreqDist()
+	shard.AddWords([]string{"sat", "on"})
+	bigrams.Merge(shard)
+
+	if bigrams.Count("sat", "on") != 3 || bigrams.Words.Count("sat") != 3 {
+		t.Fatalf("Actual: %d, Expected: 3", bigrams.Count("sat", "on"))
+	}
+}
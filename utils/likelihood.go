@@ -0,0 +1,58 @@
+package utils
+
+import "math"
+
+/*
+DunningLogLikelihood is the modified log-likelihood ratio of Kiss and Strunk
+(2006) that punkt scores abbreviations with: countA is how often a type
+occurs, countB how many tokens end in a period, countAB how often the type
+ends in a period and n the number of tokens.  It assumes the probability of a
+period after an abbreviation is 0.99 instead of the maximum likelihood
+estimate.
+*/
+func DunningLogLikelihood(countA, countB, countAB, n float64) float64 {
+	p1 := countB / n
+	p2 := 0.99
+
+	if p1 <= 0 || p1 >= 1 {
+		return 0
+	}
+
+	null := countAB*math.Log(p1) + (countA-countAB)*math.Log(1-p1)
+	alt := countAB*math.Log(p2) + (countA-countAB)*math.Log(1-p2)
+
+	return -2 * (null - alt)
+}
+
+/*
+ColLogLikelihood is Dunning's log-likelihood ratio that two types occur
+together more often than by chance: countA and countB are how often each of
+them occurs, countAB how often they occur together and n the number of
+tokens.  Terms that would take the logarithm of 0 are left out, like NLTK
+does.
+*/
+func ColLogLikelihood(countA, countB, countAB, n float64) float64 {
+	p := countB / n
+	p1 := countAB / countA
+	p2 := 1.0
+	if n != countA {
+		p2 = (countB - countAB) / (n - countA)
+	}
+
+	summand1, summand2, summand3, summand4 := 0.0, 0.0, 0.0, 0.0
+
+	if p > 0 && p < 1 {
+		summand1 = countAB*math.Log(p) + (countA-countAB)*math.Log(1-p)
+		summand2 = (countB-countAB)*math.Log(p) + (n-countA-countB+countAB)*math.Log(1-p)
+	}
+
+	if countA != countAB && p1 > 0 && p1 < 1 {
+		summand3 = countAB*math.Log(p1) + (countA-countAB)*math.Log(1-p1)
+	}
+
+	if countB != countAB && p2 > 0 && p2 < 1 {
+		summand4 = (countB-countAB)*math.Log(p2) + (n-countA-countB+countAB)*math.Log(1-p2)
+	}
+
+	return -2 * (summand1 + summand2 - summand3 - summand4)
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLogLikelihood(t *testing.T) {
+	t.Log("The log-likelihood ratios should match punkt's trainer")
+
+	// the first three values are what nltk_reference.py prints
+
+	for _, test := range []struct {
+		actual, expected float64
+	}{
+		{DunningLogLikelihood(5, 3, 2, 23), -18.68512309604744},
+		{DunningLogLikelihood(3, 40, 3, 200), 9.596325459483593},
+		{ColLogLikelihood(10, 5, 5, 100), 25.840105057975613},
+		// undefined terms are left out, where NLTK's trainer raises a math domain error
+		{DunningLogLikelihood(3, 0, 3, 100), 0},
+		{ColLogLikelihood(10, 10, 10, 10), 0},
+	} {
+		if math.Abs(test.actual-test.expected) > 1e-9 {
+			t.Fatalf("Actual: %f, Expected: %f", test.actual, test.expected)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+import nltk
+from nltk.tokenize.punkt import PunktTrainer
+
+# prints the NLTK values the tests of this package compare with
+
+corpus = "the cat sat on the mat . the dog sat on the log . a cat and a dog met on a mat ."
+dist = nltk.FreqDist(corpus.split())
+
+print('nltk', nltk.__version__)
+print('N', dist.N(), 'B', dist.B())
+print('max', dist.max())
+print('hapaxes', dist.hapaxes())
+print('most_common', dist.most_common(4))
+
+print('dunning(5, 3, 2, 23)', repr(PunktTrainer._dunning_log_likelihood(5, 3, 2, 23)))
+print('dunning(3, 40, 3, 200)', repr(PunktTrainer._dunning_log_likelihood(3, 40, 3, 200)))
+print('col(10, 5, 5, 100)', repr(PunktTrainer._col_log_likelihood(10, 5, 5, 100)))
+
+words = corpus.split()
+bigrams = nltk.FreqDist(nltk.bigrams(words))
+for first, second in [('sat', 'on'), ('on', 'the')]:
+    ll = PunktTrainer._col_log_likelihood(dist[first], dist[second], bigrams[(first, second)], dist.N())
+    print('col', first, second, repr(ll))
@@ -96,13 +96,6 @@ func (p *DefaultWordTokenizer) Tokenize(text string, onlyPeriodContext bool) []*
 			i += len(string(char))
 		}
 
-		if char == '\n' {
-			if lineStart {
-				paragraphStart = true
-			}
-			lineStart = true
-		}
-
 		var cursor int
 		if i == textLength-1 {
 			cursor = textLength
@@ -110,27 +103,31 @@ func (p *DefaultWordTokenizer) Tokenize(text string, onlyPeriodContext bool) []*
 			cursor = i
 		}
 
-		word := strings.TrimSpace(text[lastSpace:cursor])
+		segment := text[lastSpace:cursor]
+		word := strings.TrimSpace(segment)
 
 		if word == "" {
+			lineStart, paragraphStart = newlineState(char, lineStart, paragraphStart)
 			continue
 		}
 
 		hasSentencePunct := p.PunctStrings.HasSentencePunct(word)
 		if onlyPeriodContext && !hasSentencePunct && !getNextWord {
 			lastSpace = cursor
+			lineStart, paragraphStart = newlineState(char, false, false)
 			continue
 		}
 
 		token := NewToken(word)
+		token.Start = lastSpace + len(segment) - len(strings.TrimLeftFunc(segment, unicode.IsSpace))
 		token.Position = cursor
 		token.ParaStart = paragraphStart
 		token.LineStart = lineStart
 		tokens = append(tokens, token)
 
 		lastSpace = cursor
-		lineStart = false
-		paragraphStart = false
+		// the newline that ended this word marks the start of the next one
+		lineStart, paragraphStart = newlineState(char, false, false)
 
 		if hasSentencePunct {
 			getNextWord = true
@@ -148,6 +145,16 @@ func (p *DefaultWordTokenizer) Tokenize(text string, onlyPeriodContext bool) []*
 	return tokens
 }
 
+// newlineState updates the line and paragraph markers for the next word,
+// two newlines without a word in between start a new paragraph.
+func newlineState(char rune, lineStart, paragraphStart bool) (bool, bool) {
+	if char != '\n' {
+		return lineStart, paragraphStart
+	}
+
+	return true, paragraphStart || lineStart
+}
+
 // Type returns a case-normalized representation of the token.
 func (p *DefaultWordTokenizer) Type(t *Token) string {
 	typ := t.reNumeric.ReplaceAllString(strings.ToLower(t.Tok), "##number##")
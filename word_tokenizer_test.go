@@ -2,6 +2,7 @@ package sentences
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -47,3 +48,93 @@ func compareTokens(t *testing.T, actualTokens []*Token, expected []string) {
 		t.Fatalf("Actual tokens do not match expected tokens")
 	}
 }
+
+func TestWordTokenizerParagraphs(t *testing.T) {
+	t.Log("Word tokenizer should mark the first word after newlines as line and paragraph starts")
+
+	wordTokenizer := NewWordTokenizer(NewPunctStrings())
+	tokens := wordTokenizer.Tokenize("One line.\nTwo lines.\n\nNew paragraph.", false)
+
+	expected := []struct {
+		tok       string
+		lineStart bool
+		paraStart bool
+	}{
+		{"One", false, false},
+		{"line.", false, false},
+		{"Two", true, false},
+		{"lines.", false, false},
+		{"New", true, true},
+		{"paragraph.", false, false},
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("Actual: %d, Expected: %d", len(tokens), len(expected))
+	}
+
+	for index, token := range tokens {
+		exp := expected[index]
+		if token.Tok != exp.tok || token.LineStart != exp.lineStart || token.ParaStart != exp.paraStart {
+			t.Fatalf("Actual: %q line=%t para=%t, Expected: %q line=%t para=%t",
+				token.Tok, token.LineStart, token.ParaStart, exp.tok, exp.lineStart, exp.paraStart)
+		}
+	}
+}
+
+func TestWordTokenizerNewlines(t *testing.T) {
+	t.Log("Line and paragraph starts should mark the word after the newlines, with or without punctuation before them")
+
+	type flags struct {
+		tok       string
+		lineStart bool
+		paraStart bool
+	}
+
+	tests := []struct {
+		text              string
+		onlyPeriodContext bool
+		expected          []flags
+	}{
+		{"One line\nTwo", false, []flags{{"One", false, false}, {"line", false, false}, {"Two", true, false}}},
+		{"One line.\nTwo", false, []flags{{"One", false, false}, {"line.", false, false}, {"Two", true, false}}},
+		{"One line\n\nTwo", false, []flags{{"One", false, false}, {"line", false, false}, {"Two", true, true}}},
+		{"One line.\n\nTwo", false, []flags{{"One", false, false}, {"line.", false, false}, {"Two", true, true}}},
+		{"One line.\r\n\r\nTwo", false, []flags{{"One", false, false}, {"line.", false, false}, {"Two", true, true}}},
+		{"One line. \n \n Two", false, []flags{{"One", false, false}, {"line.", false, false}, {"Two", true, true}}},
+		{"\n\nOne line.", false, []flags{{"One", true, true}, {"line.", false, false}}},
+		// the words without a period are left out but still take the newlines before them
+		{"One line.\nTwo\nlines.\n\nThree", true, []flags{{"line.", false, false}, {"Two", true, false}, {"lines.", true, false}, {"Three", true, true}}},
+	}
+
+	wordTokenizer := NewWordTokenizer(NewPunctStrings())
+	for _, test := range tests {
+		actual := []flags{}
+		for _, token := range wordTokenizer.Tokenize(test.text, test.onlyPeriodContext) {
+			actual = append(actual, flags{token.Tok, token.LineStart, token.ParaStart})
+		}
+
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("%q: Actual: %v, Expected: %v", test.text, actual, test.expected)
+		}
+	}
+
+	t.Log("A paragraph start after a terminal abbreviation should end the sentence")
+
+	overlay, err := LoadOverlay(strings.NewReader("etc\n\n[AbbrevClasses]\netc terminal\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer := loadTokenizer("data/english.json").WithOverlays(overlay)
+
+	for _, test := range []struct {
+		text     string
+		expected []string
+	}{
+		{"We sold pears, etc.\n\nthe rest went to the market.", []string{"We sold pears, etc.", "the rest went to the market."}},
+		{"We sold pears, etc.\nthe rest went to the market.", []string{"We sold pears, etc.\nthe rest went to the market."}},
+	} {
+		if actual := sentenceTexts(tokenizer, test.text); !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("Actual: %q, Expected: %q", actual, test.expected)
+		}
+	}
+}